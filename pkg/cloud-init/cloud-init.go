@@ -75,6 +75,12 @@ type CloudInitData struct {
 	NetworkData         string
 	DevicesData         *[]DeviceData
 	VolumeName          string
+	// GenerateNetworkData requests NetworkData to be generated from the VMI when the local data is generated
+	GenerateNetworkData bool
+	// InterfaceMACs holds the MAC addresses of the domain interfaces by interface name
+	InterfaceMACs map[string]string
+	// DHCPInterfaces holds the names of the interfaces whose IPv4 address is served over DHCP by virt-launcher
+	DHCPInterfaces map[string]bool
 }
 
 type PublicSSHKey struct {
@@ -357,7 +363,7 @@ func readRawOrBase64Data(rawData, base64Data string) (string, error) {
 
 // readCloudInitData reads user and network data raw or in base64 encoding,
 // regardless from which data source they are coming from
-func readCloudInitData(userData, userDataBase64, networkData, networkDataBase64 string) (string, string, error) {
+func readCloudInitData(userData, userDataBase64, networkData, networkDataBase64 string, generateNetworkData bool) (string, string, error) {
 	readUserData, err := readRawOrBase64Data(userData, userDataBase64)
 	if err != nil {
 		return "", "", err
@@ -368,7 +374,7 @@ func readCloudInitData(userData, userDataBase64, networkData, networkDataBase64
 		return "", "", err
 	}
 
-	if readUserData == "" && readNetworkData == "" {
+	if readUserData == "" && readNetworkData == "" && !generateNetworkData {
 		return "", "", fmt.Errorf("userDataBase64, userData, networkDataBase64 or networkData is required for a cloud-init data source")
 	}
 
@@ -376,30 +382,34 @@ func readCloudInitData(userData, userDataBase64, networkData, networkDataBase64
 }
 
 func readCloudInitNoCloudSource(source *v1.CloudInitNoCloudSource) (*CloudInitData, error) {
+	generateNetworkData := source.GenerateNetworkData != nil && *source.GenerateNetworkData
 	userData, networkData, err := readCloudInitData(source.UserData,
-		source.UserDataBase64, source.NetworkData, source.NetworkDataBase64)
+		source.UserDataBase64, source.NetworkData, source.NetworkDataBase64, generateNetworkData)
 	if err != nil {
 		return &CloudInitData{}, err
 	}
 
 	return &CloudInitData{
-		DataSource:  DataSourceNoCloud,
-		UserData:    userData,
-		NetworkData: networkData,
+		DataSource:          DataSourceNoCloud,
+		UserData:            userData,
+		NetworkData:         networkData,
+		GenerateNetworkData: generateNetworkData,
 	}, nil
 }
 
 func readCloudInitConfigDriveSource(source *v1.CloudInitConfigDriveSource) (*CloudInitData, error) {
+	generateNetworkData := source.GenerateNetworkData != nil && *source.GenerateNetworkData
 	userData, networkData, err := readCloudInitData(source.UserData,
-		source.UserDataBase64, source.NetworkData, source.NetworkDataBase64)
+		source.UserDataBase64, source.NetworkData, source.NetworkDataBase64, generateNetworkData)
 	if err != nil {
 		return &CloudInitData{}, err
 	}
 
 	return &CloudInitData{
-		DataSource:  DataSourceConfigDrive,
-		UserData:    userData,
-		NetworkData: networkData,
+		DataSource:          DataSourceConfigDrive,
+		UserData:            userData,
+		NetworkData:         networkData,
+		GenerateNetworkData: generateNetworkData,
 	}, nil
 }
 
@@ -628,6 +638,12 @@ func GenerateLocalData(vmi *v1.VirtualMachineInstance, instanceType string, data
 		return err
 	}
 
+	if data.GenerateNetworkData {
+		if data.NetworkData, err = generateNetworkData(vmi, data.DataSource, data.InterfaceMACs, data.DHCPInterfaces); err != nil {
+			return err
+		}
+	}
+
 	if data.UserData == "" && data.NetworkData == "" {
 		return fmt.Errorf("UserData or NetworkData is required for cloud-init data source")
 	}
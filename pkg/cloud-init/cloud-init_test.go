@@ -37,6 +37,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -610,6 +611,31 @@ var _ = Describe("CloudInit", func() {
 			err = GenerateLocalData(vmi, instancetype, cloudInitData)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should generate the network data with the MAC addresses and the DHCP interfaces of the domain", func() {
+			vmi := libvmi.New(
+				libvmi.WithName("fake-domain"),
+				libvmi.WithNamespace("fake-namespace"),
+				libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			)
+			cloudInitData := &CloudInitData{
+				DataSource:          DataSourceNoCloud,
+				GenerateNetworkData: true,
+				InterfaceMACs:       map[string]string{"default": "02:00:00:00:00:01"},
+				DHCPInterfaces:      map[string]bool{"default": true},
+			}
+
+			Expect(GenerateLocalData(vmi, "", cloudInitData)).To(Succeed())
+			Expect(cloudInitData.NetworkData).To(MatchYAML(`
+version: 2
+ethernets:
+  default:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    dhcp4: true
+`))
+		})
 	})
 
 	Describe("PrepareLocalPath", func() {
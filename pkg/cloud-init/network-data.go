@@ -0,0 +1,285 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const defaultIPv4Route = "0.0.0.0/0"
+
+// generatedInterface is the data source independent configuration of a
+// single guest interface, as derived from the VMI spec and status.
+type generatedInterface struct {
+	name        string
+	mac         string
+	dhcp4       bool
+	addresses   []*net.IPNet
+	gateway4    string
+	nameservers []string
+	searches    []string
+}
+
+// generateNetworkData builds the networkdata of the given data source from the
+// VMI interfaces, their MAC addresses, the network status, the IP pool
+// allocations and the DNS configuration. The MAC addresses which are not set
+// in the VMI spec are taken from the domain interfaces, by interface name.
+// Only the interfaces whose IPv4 address is served by the virt-launcher DHCP
+// server are configured over DHCP.
+func generateNetworkData(vmi *v1.VirtualMachineInstance, dataSource DataSourceType, domainMACs map[string]string, dhcpIfaces map[string]bool) (string, error) {
+	ifaces := generateInterfaces(vmi, domainMACs, dhcpIfaces)
+	switch dataSource {
+	case DataSourceNoCloud:
+		return renderNetworkConfigV2(ifaces)
+	case DataSourceConfigDrive:
+		return renderOpenStackNetworkData(ifaces)
+	}
+	return "", fmt.Errorf("Invalid cloud-init data source: '%v'", dataSource)
+}
+
+func generateInterfaces(vmi *v1.VirtualMachineInstance, domainMACs map[string]string, dhcpIfaces map[string]bool) []generatedInterface {
+	var nameservers, searches []string
+	if vmi.Spec.DNSConfig != nil {
+		nameservers = vmi.Spec.DNSConfig.Nameservers
+		searches = vmi.Spec.DNSConfig.Searches
+	}
+
+	// Only a single default route is configured. A pod network served by
+	// DHCP already provides it.
+	hasDefaultRoute := false
+	if podNetwork := vmispec.LookupPodNetwork(vmi.Spec.Networks); podNetwork != nil {
+		hasDefaultRoute = dhcpIfaces[podNetwork.Name] && lookupIPAllocation(vmi, podNetwork.Name) == nil
+	}
+
+	var ifaces []generatedInterface
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, iface.Name)
+
+		mac := iface.MacAddress
+		if mac == "" {
+			mac = domainMACs[iface.Name]
+		}
+		if mac == "" && ifaceStatus != nil {
+			mac = ifaceStatus.MAC
+		}
+		if mac == "" {
+			log.Log.Object(vmi).Warningf("Skipping interface %s in the generated networkdata: MAC address is unknown", iface.Name)
+			continue
+		}
+
+		generated := generatedInterface{name: iface.Name, mac: strings.ToLower(mac)}
+		switch allocation := lookupIPAllocation(vmi, iface.Name); {
+		case allocation != nil:
+			if ip, ipNet, err := net.ParseCIDR(allocation.Address); err == nil {
+				ipNet.IP = ip
+				generated.addresses = append(generated.addresses, ipNet)
+			}
+			if allocation.Gateway != "" && !hasDefaultRoute {
+				generated.gateway4 = allocation.Gateway
+				hasDefaultRoute = true
+			}
+		case dhcpIfaces[iface.Name]:
+			generated.dhcp4 = true
+		case ifaceStatus != nil:
+			// Addresses reported without a prefix length are configured as host addresses.
+			generated.addresses = parseStatusIPs(ifaceStatus.IPs)
+		}
+
+		if len(generated.addresses) > 0 {
+			generated.nameservers = nameservers
+			generated.searches = searches
+		}
+		ifaces = append(ifaces, generated)
+	}
+	return ifaces
+}
+
+func lookupIPAllocation(vmi *v1.VirtualMachineInstance, networkName string) *v1.VirtualMachineInstanceIPAllocation {
+	for i := range vmi.Status.IPAllocations {
+		if vmi.Status.IPAllocations[i].Name == networkName {
+			return &vmi.Status.IPAllocations[i]
+		}
+	}
+	return nil
+}
+
+func parseStatusIPs(ips []string) []*net.IPNet {
+	var addresses []*net.IPNet
+	for _, address := range ips {
+		if ip, ipNet, err := net.ParseCIDR(address); err == nil {
+			ipNet.IP = ip
+			addresses = append(addresses, ipNet)
+			continue
+		}
+		ip := net.ParseIP(address)
+		if ip == nil || ip.IsLinkLocalUnicast() {
+			continue
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			addresses = append(addresses, &net.IPNet{IP: ip4, Mask: net.CIDRMask(net.IPv4len*8, net.IPv4len*8)})
+		} else {
+			addresses = append(addresses, &net.IPNet{IP: ip, Mask: net.CIDRMask(net.IPv6len*8, net.IPv6len*8)})
+		}
+	}
+	return addresses
+}
+
+type networkConfigV2 struct {
+	Version   int                              `json:"version"`
+	Ethernets map[string]networkConfigV2Device `json:"ethernets"`
+}
+
+type networkConfigV2Device struct {
+	Match       networkConfigV2Match        `json:"match"`
+	DHCP4       bool                        `json:"dhcp4,omitempty"`
+	Addresses   []string                    `json:"addresses,omitempty"`
+	Routes      []networkConfigV2Route      `json:"routes,omitempty"`
+	Nameservers *networkConfigV2Nameservers `json:"nameservers,omitempty"`
+}
+
+type networkConfigV2Match struct {
+	MACAddress string `json:"macaddress"`
+}
+
+type networkConfigV2Route struct {
+	To  string `json:"to"`
+	Via string `json:"via"`
+}
+
+type networkConfigV2Nameservers struct {
+	Addresses []string `json:"addresses,omitempty"`
+	Search    []string `json:"search,omitempty"`
+}
+
+func renderNetworkConfigV2(ifaces []generatedInterface) (string, error) {
+	config := networkConfigV2{Version: 2, Ethernets: map[string]networkConfigV2Device{}}
+	for _, iface := range ifaces {
+		device := networkConfigV2Device{
+			Match: networkConfigV2Match{MACAddress: iface.mac},
+			DHCP4: iface.dhcp4,
+		}
+		for _, address := range iface.addresses {
+			device.Addresses = append(device.Addresses, address.String())
+		}
+		if iface.gateway4 != "" {
+			device.Routes = append(device.Routes, networkConfigV2Route{To: defaultIPv4Route, Via: iface.gateway4})
+		}
+		if len(iface.nameservers) > 0 || len(iface.searches) > 0 {
+			device.Nameservers = &networkConfigV2Nameservers{Addresses: iface.nameservers, Search: iface.searches}
+		}
+		config.Ethernets[iface.name] = device
+	}
+
+	networkData, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(networkData), nil
+}
+
+// openStackNetworkData is the network_data.json format of the OpenStack config drive.
+type openStackNetworkData struct {
+	Links    []openStackLink    `json:"links"`
+	Networks []openStackNetwork `json:"networks"`
+	Services []openStackService `json:"services,omitempty"`
+}
+
+type openStackLink struct {
+	ID                 string `json:"id"`
+	Type               string `json:"type"`
+	EthernetMACAddress string `json:"ethernet_mac_address"`
+}
+
+type openStackNetwork struct {
+	ID        string           `json:"id"`
+	Link      string           `json:"link"`
+	Type      string           `json:"type"`
+	IPAddress string           `json:"ip_address,omitempty"`
+	Netmask   string           `json:"netmask,omitempty"`
+	Routes    []openStackRoute `json:"routes,omitempty"`
+}
+
+type openStackRoute struct {
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+	Gateway string `json:"gateway"`
+}
+
+type openStackService struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+}
+
+func renderOpenStackNetworkData(ifaces []generatedInterface) (string, error) {
+	networkData := openStackNetworkData{Links: []openStackLink{}, Networks: []openStackNetwork{}}
+	var nameservers []string
+	for _, iface := range ifaces {
+		networkData.Links = append(networkData.Links, openStackLink{ID: iface.name, Type: "phy", EthernetMACAddress: iface.mac})
+
+		if iface.dhcp4 {
+			networkData.Networks = append(networkData.Networks, openStackNetwork{
+				ID:   fmt.Sprintf("network%d", len(networkData.Networks)),
+				Link: iface.name,
+				Type: "ipv4_dhcp",
+			})
+		}
+		for _, address := range iface.addresses {
+			network := openStackNetwork{
+				ID:        fmt.Sprintf("network%d", len(networkData.Networks)),
+				Link:      iface.name,
+				Type:      "ipv4",
+				IPAddress: address.IP.String(),
+				Netmask:   net.IP(address.Mask).String(),
+			}
+			if address.IP.To4() == nil {
+				network.Type = "ipv6"
+			}
+			if network.Type == "ipv4" && iface.gateway4 != "" {
+				network.Routes = []openStackRoute{{Network: "0.0.0.0", Netmask: "0.0.0.0", Gateway: iface.gateway4}}
+			}
+			networkData.Networks = append(networkData.Networks, network)
+		}
+		for _, nameserver := range iface.nameservers {
+			if !slices.Contains(nameservers, nameserver) {
+				nameservers = append(nameservers, nameserver)
+			}
+		}
+	}
+	for _, nameserver := range nameservers {
+		networkData.Services = append(networkData.Services, openStackService{Type: "dns", Address: nameserver})
+	}
+
+	rendered, err := json.Marshal(networkData)
+	if err != nil {
+		return "", err
+	}
+	return string(rendered), nil
+}
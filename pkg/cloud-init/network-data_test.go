@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cloudinit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Generated network data", func() {
+	const (
+		podMAC   = "02:00:00:00:00:01"
+		blueMAC  = "02:00:00:00:00:02"
+		sriovMAC = "02:00:00:00:00:03"
+	)
+
+	newVMI := func(volume v1.Volume) *v1.VirtualMachineInstance {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "default",
+				MacAddress:             podMAC,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			}),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-nad")),
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "sriov",
+				MacAddress:             sriovMAC,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("sriov", "sriov-nad")),
+		)
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, volume)
+		vmi.Spec.DNSConfig = &k8sv1.PodDNSConfig{Nameservers: []string{"10.0.0.10"}, Searches: []string{"example.com"}}
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: "sriov", MAC: sriovMAC, IPs: []string{"172.16.0.5", "fe80::1"}},
+		}
+		vmi.Status.IPAllocations = []v1.VirtualMachineInstanceIPAllocation{
+			{Name: "blue", IPPool: "blue-pool", Address: "192.168.10.5/24", Gateway: "192.168.10.1"},
+		}
+		return vmi
+	}
+
+	It("should generate network-config version 2 for a NoCloud volume", func() {
+		vmi := newVMI(v1.Volume{
+			Name: "cloudinit",
+			VolumeSource: v1.VolumeSource{
+				CloudInitNoCloud: &v1.CloudInitNoCloudSource{GenerateNetworkData: pointer.P(true)},
+			},
+		})
+
+		cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cloudInitData.GenerateNetworkData).To(BeTrue())
+
+		// the MAC of the blue interface is assigned to the domain
+		networkData, err := generateNetworkData(vmi, cloudInitData.DataSource, map[string]string{"blue": blueMAC}, map[string]bool{"default": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(MatchYAML(`
+version: 2
+ethernets:
+  default:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    dhcp4: true
+  blue:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    addresses: [192.168.10.5/24]
+    nameservers:
+      addresses: [10.0.0.10]
+      search: [example.com]
+  sriov:
+    match:
+      macaddress: "02:00:00:00:00:03"
+    addresses: [172.16.0.5/32]
+    nameservers:
+      addresses: [10.0.0.10]
+      search: [example.com]
+`))
+	})
+
+	It("should generate OpenStack network data for a config drive volume", func() {
+		vmi := newVMI(v1.Volume{
+			Name: "cloudinit",
+			VolumeSource: v1.VolumeSource{
+				CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserData: "#cloud-config", GenerateNetworkData: pointer.P(true)},
+			},
+		})
+
+		cloudInitData, err := ReadCloudInitVolumeDataSource(vmi, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cloudInitData.UserData).To(Equal("#cloud-config"))
+		Expect(cloudInitData.GenerateNetworkData).To(BeTrue())
+
+		networkData, err := generateNetworkData(vmi, cloudInitData.DataSource, map[string]string{"blue": blueMAC}, map[string]bool{"default": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(MatchJSON(`{
+  "links": [
+    {"id": "default", "type": "phy", "ethernet_mac_address": "02:00:00:00:00:01"},
+    {"id": "blue", "type": "phy", "ethernet_mac_address": "02:00:00:00:00:02"},
+    {"id": "sriov", "type": "phy", "ethernet_mac_address": "02:00:00:00:00:03"}
+  ],
+  "networks": [
+    {"id": "network0", "link": "default", "type": "ipv4_dhcp"},
+    {"id": "network1", "link": "blue", "type": "ipv4", "ip_address": "192.168.10.5", "netmask": "255.255.255.0"},
+    {"id": "network2", "link": "sriov", "type": "ipv4", "ip_address": "172.16.0.5", "netmask": "255.255.255.255"}
+  ],
+  "services": [{"type": "dns", "address": "10.0.0.10"}]
+}`))
+	})
+
+	It("should add a default route through the IP pool gateway when the pod network does not provide one", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				MacAddress:             blueMAC,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-nad")),
+		)
+		vmi.Status.IPAllocations = []v1.VirtualMachineInstanceIPAllocation{
+			{Name: "blue", IPPool: "blue-pool", Address: "192.168.10.5/24", Gateway: "192.168.10.1"},
+		}
+
+		networkData, err := generateNetworkData(vmi, DataSourceNoCloud, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(MatchYAML(`
+version: 2
+ethernets:
+  blue:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    addresses: [192.168.10.5/24]
+    routes:
+    - to: 0.0.0.0/0
+      via: 192.168.10.1
+`))
+	})
+
+	It("should take the MAC address of interfaces without an explicit one from the domain", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(*v1.DefaultMasqueradeNetworkInterface()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		networkData, err := generateNetworkData(vmi, DataSourceNoCloud, map[string]string{"default": "02:00:00:AB:CD:EF"}, map[string]bool{"default": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(MatchYAML(`
+version: 2
+ethernets:
+  default:
+    match:
+      macaddress: "02:00:00:ab:cd:ef"
+    dhcp4: true
+`))
+	})
+
+	It("should skip interfaces with an unknown MAC address", func() {
+		vmi := libvmi.New(
+			libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+
+		networkData, err := generateNetworkData(vmi, DataSourceNoCloud, nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(MatchYAML(`{version: 2, ethernets: {}}`))
+	})
+
+	DescribeTable("should configure a secondary bridge interface", func(dhcpIfaces map[string]bool, expectedNetworkData string) {
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:                   "blue",
+				MacAddress:             blueMAC,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("blue", "blue-nad")),
+		)
+		vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+			{Name: "blue", MAC: blueMAC, IPs: []string{"10.10.10.5/24"}},
+		}
+
+		networkData, err := generateNetworkData(vmi, DataSourceNoCloud, nil, dhcpIfaces)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkData).To(MatchYAML(expectedNetworkData))
+	},
+		Entry("over DHCP when its address is served by virt-launcher", map[string]bool{"blue": true}, `
+version: 2
+ethernets:
+  blue:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    dhcp4: true
+`),
+		Entry("with the Multus addresses when its address is not served by virt-launcher", nil, `
+version: 2
+ethernets:
+  blue:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    addresses: [10.10.10.5/24]
+`),
+	)
+})
@@ -339,8 +339,8 @@ func (m *VirtualMachineControllerRefManager) ClaimMatchedDataVolumes(dataVolumes
 	var errlist []error
 
 	match := func(obj metav1.Object) bool {
-		return true
-
+		// DataVolumes retained from a removed VM must not be re-adopted by it
+		return obj.GetAnnotations()[virtv1.VirtualMachinePoolRetainedFromAnnotation] != string(m.Controller.GetUID())
 	}
 	adopt := func(obj metav1.Object) error {
 		return m.AdoptDataVolume(obj.(*cdiv1.DataVolume))
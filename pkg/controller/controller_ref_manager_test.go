@@ -279,6 +279,29 @@ func TestClaimDataVolume(t *testing.T) {
 				claimed:     []*cdiv1.DataVolume{datavolumeToDelete1},
 			}
 		}(),
+		func() test {
+			controller := v1.ReplicationController{}
+			controller.UID = types.UID(controllerUID)
+			retainedFromController := newDataVolume("datavolume1", nil)
+			retainedFromController.Annotations = map[string]string{
+				virtv1.VirtualMachinePoolRetainedFromAnnotation: controllerUID,
+			}
+			retainedFromOther := newDataVolume("datavolume2", nil)
+			retainedFromOther.Annotations = map[string]string{
+				virtv1.VirtualMachinePoolRetainedFromAnnotation: "AAAAA",
+			}
+
+			return test{
+				name: "Controller does not re-adopt datavolumes retained from it",
+				manager: NewVirtualMachineControllerRefManager(&FakeVirtualMachineControl{},
+					&controller,
+					productionLabelSelector,
+					controllerKind,
+					func() error { return nil }),
+				datavolumes: []*cdiv1.DataVolume{retainedFromController, retainedFromOther},
+				claimed:     []*cdiv1.DataVolume{retainedFromOther},
+			}
+		}(),
 	}
 	for _, test := range tests {
 		claimed, err := test.manager.ClaimMatchedDataVolumes(test.datavolumes)
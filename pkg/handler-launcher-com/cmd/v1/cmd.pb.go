@@ -42,6 +42,7 @@ It has these top-level messages:
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
 	DirtyRateStatsResponse
+	BackupRequest
 */
 package v1
 
@@ -957,6 +958,30 @@ func (m *DirtyRateStatsResponse) GetDirtyRateMbs() int64 {
 	return 0
 }
 
+type BackupRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *BackupRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -990,6 +1015,7 @@ func init() {
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
 	proto.RegisterType((*DirtyRateStatsResponse)(nil), "kubevirt.cmd.v1.DirtyRateStatsResponse")
+	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1035,6 +1061,7 @@ type CmdClient interface {
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
 	GetDomainDirtyRateStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DirtyRateStatsResponse, error)
+	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1333,6 +1360,15 @@ func (c *cmdClient) GetDomainDirtyRateStats(ctx context.Context, in *EmptyReques
 	return out, nil
 }
 
+func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1368,6 +1404,7 @@ type CmdServer interface {
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
 	GetDomainDirtyRateStats(context.Context, *EmptyRequest) (*DirtyRateStatsResponse, error)
+	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1950,6 +1987,24 @@ func _Cmd_GetDomainDirtyRateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2082,6 +2137,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDomainDirtyRateStats",
 			Handler:    _Cmd_GetDomainDirtyRateStats_Handler,
 		},
+		{
+			MethodName: "BackupVirtualMachine",
+			Handler:    _Cmd_BackupVirtualMachine_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2090,121 +2149,122 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1852 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
-	0x11, 0x17, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x12, 0xc3, 0x92, 0x7c, 0x56, 0x6b, 0x5b, 0xc5, 0x74,
-	0x5c, 0xa5, 0x93, 0x48, 0xb5, 0xe3, 0x64, 0x3a, 0x9e, 0x4e, 0xc6, 0x11, 0x45, 0x29, 0x4a, 0x4c,
-	0x9b, 0x39, 0x4a, 0xf2, 0x34, 0x6d, 0x26, 0x03, 0xdd, 0x41, 0x14, 0xaa, 0x3b, 0x80, 0x39, 0xe0,
-	0x58, 0x33, 0x4f, 0x9d, 0x49, 0xa7, 0x0f, 0x9d, 0xe9, 0x57, 0xe9, 0xd7, 0xe9, 0x5b, 0xbf, 0x45,
-	0xdf, 0x3b, 0xc0, 0xe1, 0xa8, 0x23, 0xef, 0x4e, 0xb2, 0x86, 0x7c, 0xe2, 0x01, 0xd8, 0xfd, 0xed,
-	0x62, 0xb1, 0xbb, 0xf8, 0xdd, 0x11, 0x3e, 0xea, 0x5f, 0xf6, 0x76, 0x2f, 0x08, 0xf7, 0x03, 0x1a,
-	0x7d, 0x12, 0x90, 0x98, 0x7b, 0x17, 0x34, 0xfa, 0xc4, 0x13, 0xe1, 0xae, 0x17, 0xfa, 0xbb, 0x83,
-	0xa7, 0xfa, 0x67, 0xa7, 0x1f, 0x09, 0x25, 0xd0, 0x07, 0x97, 0xf1, 0x19, 0x1d, 0xb0, 0x48, 0xed,
-	0xe8, 0xb9, 0xc1, 0x53, 0x7c, 0x0e, 0xf7, 0xbe, 0xa5, 0x61, 0x7c, 0x4a, 0x23, 0xc9, 0x04, 0x77,
-	0xa9, 0xec, 0x0b, 0x2e, 0x29, 0xfa, 0x0c, 0xea, 0x91, 0x7d, 0x76, 0x2a, 0x5b, 0x95, 0xed, 0xa5,
-	0x67, 0x0f, 0x76, 0x26, 0x54, 0x77, 0x52, 0x61, 0x77, 0x24, 0x8a, 0x1c, 0xb8, 0x33, 0x48, 0x90,
-	0x9c, 0xf9, 0xad, 0xca, 0x76, 0xc3, 0x4d, 0x87, 0xf8, 0x31, 0x54, 0x4f, 0xdb, 0x47, 0x46, 0x20,
-	0x64, 0x5f, 0x4b, 0xc1, 0x0d, 0xec, 0xb2, 0x9b, 0x0e, 0xf1, 0x53, 0xa8, 0x36, 0x3b, 0x27, 0x68,
-	0x15, 0xe6, 0x99, 0x6f, 0xd6, 0x56, 0xdc, 0x79, 0xe6, 0xa3, 0x4d, 0xa8, 0x4b, 0x76, 0x16, 0x30,
-	0xde, 0x93, 0xce, 0xfc, 0x56, 0x75, 0x7b, 0xc5, 0x1d, 0x8d, 0xf1, 0x2e, 0xdc, 0xe9, 0x26, 0xcf,
-	0x39, 0xb5, 0x35, 0x58, 0x18, 0x90, 0x20, 0xa6, 0xc6, 0x8d, 0x9a, 0x9b, 0x0c, 0x70, 0x0b, 0x16,
-	0x3a, 0xa4, 0x47, 0xa5, 0x5e, 0xf6, 0x44, 0xcc, 0x95, 0xd1, 0xa8, 0xb9, 0xc9, 0x00, 0x21, 0xa8,
-	0xc5, 0x9c, 0x29, 0xeb, 0xba, 0x79, 0xd6, 0x73, 0x92, 0xfd, 0x44, 0x9d, 0xaa, 0x81, 0x36, 0xcf,
-	0xf8, 0x39, 0x2c, 0xb6, 0x69, 0x28, 0xa2, 0x21, 0xda, 0x80, 0x45, 0x12, 0x66, 0x80, 0xec, 0xa8,
-	0x08, 0x09, 0xff, 0xa7, 0x02, 0xb5, 0x26, 0x0d, 0x82, 0x9c, 0xaf, 0xbb, 0xb0, 0x18, 0x1a, 0x38,
-	0x23, 0xbe, 0xf4, 0xec, 0x7e, 0x2e, 0xd2, 0x89, 0x35, 0xd7, 0x8a, 0xa1, 0x8f, 0x61, 0xa1, 0xaf,
-	0xb7, 0xe1, 0x54, 0xb7, 0xaa, 0xdb, 0x4b, 0xcf, 0x36, 0x72, 0xf2, 0x66, 0x93, 0x6e, 0x22, 0x84,
-	0x3e, 0x87, 0x86, 0xcf, 0xa4, 0x22, 0xdc, 0xa3, 0xd2, 0xa9, 0x19, 0x0d, 0x27, 0xa7, 0x61, 0xe3,
-	0xe8, 0x5e, 0x89, 0xa2, 0x6d, 0xa8, 0x79, 0xfd, 0x58, 0x3a, 0x0b, 0x46, 0x65, 0x2d, 0xa7, 0xd2,
-	0xec, 0x9c, 0xb8, 0x46, 0x02, 0xbf, 0x84, 0xfa, 0xb1, 0xe8, 0x8b, 0x40, 0xf4, 0x86, 0xe8, 0x39,
-	0x00, 0x8f, 0x43, 0xf2, 0x83, 0x47, 0x83, 0x40, 0x3a, 0x15, 0xa3, 0xbb, 0x9e, 0xd7, 0xa5, 0x41,
-	0xe0, 0x36, 0xb4, 0xa0, 0x7e, 0x92, 0xf8, 0x9f, 0x15, 0x58, 0xec, 0xb6, 0xf7, 0x98, 0x90, 0x08,
-	0xc3, 0x72, 0x48, 0x78, 0x7c, 0x4e, 0x3c, 0x15, 0x47, 0x34, 0x32, 0x71, 0x6a, 0xb8, 0x63, 0x73,
-	0x3a, 0x8b, 0xfa, 0x91, 0xf0, 0x63, 0x2f, 0x8d, 0x70, 0x3a, 0xcc, 0x26, 0x60, 0x75, 0x2c, 0x01,
-	0xd1, 0x87, 0x50, 0x95, 0x97, 0xb1, 0x53, 0x33, 0xb3, 0xfa, 0x51, 0x1f, 0xde, 0x39, 0x09, 0x59,
-	0x30, 0x74, 0x16, 0xcc, 0xa4, 0x1d, 0xe1, 0x7f, 0x54, 0xa0, 0xbe, 0xcf, 0xe4, 0xe5, 0x11, 0x3f,
-	0x17, 0x46, 0x48, 0x44, 0x21, 0x51, 0xd6, 0x11, 0x3b, 0x42, 0x5b, 0xb0, 0x74, 0x46, 0xbc, 0x4b,
-	0xc6, 0x7b, 0x07, 0x2c, 0xa0, 0xd6, 0x8d, 0xec, 0x14, 0x7a, 0x04, 0xa0, 0xfd, 0x25, 0x41, 0x37,
-	0xcd, 0x9f, 0x9a, 0x9b, 0x99, 0xd1, 0x08, 0x3a, 0x24, 0xa9, 0x40, 0xcd, 0x08, 0x64, 0xa7, 0xf0,
-	0xff, 0x2a, 0xb0, 0xd2, 0x0c, 0x62, 0xa9, 0x68, 0xd4, 0x14, 0xfc, 0x9c, 0xf5, 0xd0, 0x0e, 0xa0,
-	0xd6, 0xbb, 0x3e, 0xe1, 0xbe, 0xf6, 0x4f, 0xb6, 0x38, 0x39, 0x0b, 0x68, 0x92, 0x4a, 0x75, 0xb7,
-	0x60, 0x05, 0xfd, 0x01, 0x1e, 0x1c, 0x44, 0x94, 0xea, 0x7c, 0x70, 0x69, 0x5f, 0x44, 0x8a, 0xf1,
-	0xde, 0x3e, 0x93, 0x89, 0xda, 0xbc, 0x51, 0x2b, 0x17, 0x40, 0x2f, 0xc0, 0xd9, 0x13, 0xde, 0x85,
-	0xdc, 0x67, 0xb2, 0x1f, 0x90, 0xe1, 0x81, 0x88, 0x5a, 0x07, 0x47, 0x87, 0x31, 0x95, 0x4a, 0x9a,
-	0xfd, 0xd4, 0xdd, 0xd2, 0x75, 0xad, 0xdb, 0xa5, 0x11, 0x23, 0x41, 0x53, 0x70, 0x29, 0x02, 0xfa,
-	0x4a, 0x5c, 0x19, 0xae, 0x25, 0xba, 0x65, 0xeb, 0xf8, 0x53, 0x78, 0x70, 0xc4, 0x15, 0x8d, 0xce,
-	0x89, 0x47, 0xf7, 0x18, 0xf7, 0x19, 0xef, 0xb5, 0x59, 0x2f, 0x22, 0x4a, 0x9f, 0xe3, 0x86, 0x2e,
-	0x3e, 0x75, 0x21, 0xfc, 0xf4, 0x40, 0x92, 0x11, 0xfe, 0xef, 0x1d, 0x58, 0x3f, 0x4d, 0x82, 0xd7,
-	0x26, 0xde, 0x05, 0xe3, 0xf4, 0x4d, 0x5f, 0x2b, 0x48, 0xf4, 0x0d, 0xac, 0x8d, 0x2f, 0x24, 0x99,
-	0x66, 0xfb, 0x5a, 0xbe, 0xda, 0x92, 0x65, 0xb7, 0x50, 0x09, 0x3d, 0x87, 0xf5, 0x36, 0x0d, 0xf7,
-	0x48, 0x10, 0x08, 0xc1, 0xbb, 0x8a, 0x28, 0xd9, 0xa1, 0x11, 0x13, 0x49, 0x34, 0x57, 0xdc, 0xe2,
-	0x45, 0xf4, 0x3b, 0xb8, 0xd7, 0x89, 0xa8, 0x9e, 0xf7, 0x88, 0xa2, 0xfe, 0xa9, 0x08, 0xe2, 0xd0,
-	0xd6, 0x6f, 0xc3, 0x2d, 0x5a, 0xd2, 0x0d, 0x58, 0xd9, 0x9a, 0x32, 0xf1, 0x2a, 0x6a, 0xc0, 0x69,
-	0xd1, 0xb9, 0x23, 0x51, 0xd4, 0x85, 0x86, 0x49, 0x00, 0x9d, 0xbb, 0xb6, 0x72, 0x3f, 0xcb, 0xe9,
-	0x15, 0x86, 0x69, 0x67, 0xa4, 0xd7, 0xe2, 0x2a, 0x1a, 0xba, 0x57, 0x38, 0x25, 0x59, 0xb7, 0x58,
-	0x9a, 0x75, 0xfb, 0xb0, 0xe2, 0x65, 0xd3, 0xd6, 0xb9, 0x63, 0x36, 0xf0, 0x28, 0xdf, 0x06, 0xb2,
-	0x52, 0xee, 0xb8, 0x12, 0xfa, 0xb9, 0x02, 0x0f, 0x58, 0x9a, 0x06, 0xfb, 0x22, 0x24, 0x8c, 0x7f,
-	0xa9, 0x14, 0xf1, 0x2e, 0x42, 0xca, 0x95, 0x53, 0x37, 0x7b, 0x6b, 0xbd, 0xe7, 0xde, 0x8e, 0xca,
-	0x70, 0x92, 0xbd, 0x96, 0xdb, 0x41, 0x1c, 0xd0, 0x68, 0x71, 0x94, 0x84, 0x4e, 0xc3, 0x58, 0xff,
-	0xe2, 0xb6, 0xd6, 0x47, 0x00, 0x89, 0xd9, 0x02, 0xe4, 0xcd, 0xb7, 0xb0, 0x3a, 0x7e, 0x10, 0xba,
-	0x71, 0x5d, 0xd2, 0xa1, 0xcd, 0x76, 0xfd, 0x88, 0x76, 0xb3, 0x97, 0x5b, 0x51, 0x62, 0xa4, 0xdd,
-	0xcb, 0xde, 0x7b, 0x2f, 0xe6, 0x7f, 0x5f, 0xd9, 0x7c, 0x05, 0x8f, 0xae, 0x8f, 0x42, 0x81, 0xa1,
-	0xb1, 0x5b, 0xb4, 0x91, 0x45, 0xfb, 0x11, 0xee, 0x97, 0xec, 0xaa, 0x00, 0xe6, 0xe5, 0xb8, 0xbf,
-	0xbf, 0xcd, 0xf9, 0x5b, 0x5a, 0xed, 0x19, 0x93, 0x78, 0x00, 0x70, 0xda, 0x3e, 0x72, 0xe9, 0x8f,
-	0xba, 0xc1, 0xa0, 0x27, 0x50, 0x1d, 0x84, 0xcc, 0xd6, 0x70, 0xfe, 0x72, 0xd2, 0x92, 0x5a, 0x00,
-	0xbd, 0x84, 0x3b, 0x22, 0x39, 0x06, 0x6b, 0xfd, 0xc9, 0xfb, 0x1d, 0x9a, 0x9b, 0xaa, 0xe1, 0x63,
-	0xf8, 0xf0, 0xca, 0x9f, 0x5b, 0x5a, 0x77, 0xc6, 0xad, 0x2f, 0x5f, 0xa1, 0xfe, 0x5c, 0x81, 0xa5,
-	0xd6, 0x3b, 0xea, 0xa5, 0x88, 0x8f, 0x00, 0x7c, 0x73, 0x2a, 0xaf, 0x49, 0x48, 0x6d, 0xf0, 0x32,
-	0x33, 0x1a, 0xa9, 0x29, 0xc2, 0x90, 0x70, 0x3f, 0xbd, 0xf2, 0xec, 0x50, 0x73, 0x8d, 0x2f, 0xa3,
-	0x5e, 0xda, 0x4c, 0xcc, 0x33, 0x7a, 0x02, 0xab, 0x8a, 0x85, 0x54, 0xc4, 0xaa, 0x4b, 0x3d, 0xc1,
-	0x7d, 0x69, 0x7a, 0xc8, 0x82, 0x3b, 0x31, 0x8b, 0x57, 0x61, 0xb9, 0x15, 0xf6, 0xd5, 0xd0, 0x7a,
-	0x81, 0xbf, 0x80, 0xba, 0x9b, 0xe1, 0x72, 0x32, 0xf6, 0x3c, 0x2a, 0xa5, 0xbd, 0x60, 0xd2, 0xa1,
-	0x5e, 0x09, 0xa9, 0x94, 0xa4, 0x97, 0x26, 0x46, 0x3a, 0xc4, 0x3f, 0xc0, 0x6a, 0x92, 0x5b, 0xd3,
-	0x12, 0xc9, 0x0d, 0x58, 0x4c, 0x36, 0x6f, 0x2d, 0xd8, 0x11, 0xe6, 0x70, 0x2f, 0x31, 0x60, 0xba,
-	0xeb, 0xb4, 0x56, 0xb6, 0x60, 0xc9, 0xbf, 0x42, 0x4b, 0x2f, 0xf1, 0xcc, 0x14, 0x7e, 0x07, 0x77,
-	0xcd, 0x85, 0x66, 0xaa, 0x69, 0x4a, 0x6b, 0x1f, 0xc3, 0xdd, 0xde, 0x24, 0x96, 0xb5, 0x99, 0x5f,
-	0xc0, 0x7f, 0xaf, 0xc0, 0xba, 0x31, 0x7d, 0x22, 0x69, 0xf4, 0x8a, 0x49, 0x35, 0xad, 0xf9, 0xe7,
-	0xb0, 0xde, 0x2b, 0xc2, 0xb3, 0x2e, 0x14, 0x2f, 0xe2, 0x7f, 0x55, 0xc0, 0x31, 0x6e, 0x68, 0x4e,
-	0x23, 0x87, 0x52, 0xd1, 0x70, 0xea, 0xb0, 0xbf, 0x00, 0xa7, 0x57, 0x02, 0x69, 0x9d, 0x29, 0x5d,
-	0xc7, 0x43, 0x58, 0x4e, 0xca, 0x66, 0x3a, 0x17, 0x36, 0xa1, 0x4e, 0xdf, 0x31, 0xd5, 0x14, 0x7e,
-	0x62, 0x72, 0xc1, 0x1d, 0x8d, 0x75, 0xee, 0x49, 0xe5, 0xbf, 0x89, 0x95, 0xa5, 0x90, 0x76, 0x84,
-	0xbf, 0x83, 0x0f, 0x4d, 0x24, 0x3a, 0x9a, 0x28, 0xbf, 0x67, 0xd9, 0xe6, 0x0b, 0x71, 0xbe, 0xb0,
-	0x10, 0xbf, 0xb6, 0x79, 0x96, 0x60, 0x4f, 0xb5, 0x37, 0x2c, 0x60, 0x45, 0x73, 0xba, 0x9f, 0xe8,
-	0x6d, 0xbb, 0xd5, 0xe7, 0xb0, 0x11, 0xf3, 0x73, 0xa3, 0x7a, 0x5c, 0xe4, 0x74, 0xc9, 0x2a, 0x7e,
-	0x0b, 0x77, 0x93, 0x37, 0x94, 0xfd, 0x38, 0xec, 0xdf, 0xd6, 0xe8, 0x26, 0xd4, 0xfd, 0x38, 0xec,
-	0x77, 0x88, 0xba, 0xb0, 0x87, 0x3f, 0x1a, 0xe3, 0x33, 0xf8, 0xa0, 0xdb, 0x3a, 0x9d, 0x45, 0xed,
-	0xe9, 0x66, 0x46, 0x07, 0x86, 0x15, 0xd9, 0x46, 0x6c, 0x87, 0xf8, 0x6f, 0x15, 0x78, 0xf0, 0xca,
-	0xbc, 0x33, 0xb7, 0x29, 0x91, 0x71, 0x44, 0xf5, 0x85, 0x38, 0x83, 0x52, 0x0f, 0x26, 0x31, 0xad,
-	0xe1, 0xfc, 0x02, 0xfe, 0x5e, 0xf3, 0xdd, 0xbf, 0x50, 0x4f, 0x25, 0x7e, 0x74, 0xa9, 0x17, 0x51,
-	0x35, 0xbb, 0xab, 0x46, 0xc2, 0xc6, 0x3e, 0x8b, 0xd4, 0xd0, 0x25, 0x8a, 0xce, 0xa4, 0x6d, 0x62,
-	0x58, 0xf6, 0x53, 0xc0, 0xf6, 0x59, 0x62, 0xaf, 0xea, 0x8e, 0xcd, 0x3d, 0xfb, 0xf7, 0x3a, 0x54,
-	0x9b, 0xa1, 0x8f, 0x5e, 0x03, 0xea, 0x0e, 0xb9, 0x37, 0x7e, 0xc7, 0xa2, 0x5f, 0x14, 0xee, 0x23,
-	0xd9, 0xf1, 0x66, 0xb9, 0x0f, 0x78, 0x0e, 0xbd, 0x81, 0x7b, 0x1d, 0x12, 0x4b, 0x3a, 0x33, 0xc0,
-	0x6f, 0x61, 0xfd, 0x84, 0xf7, 0x67, 0x0a, 0xd9, 0x85, 0xb5, 0xa4, 0x00, 0x27, 0x10, 0xf3, 0x04,
-	0x78, 0xac, 0x4e, 0xaf, 0x07, 0x75, 0x61, 0xe3, 0xc4, 0x96, 0xdf, 0x2c, 0x83, 0xe9, 0x52, 0x49,
-	0xd5, 0xcc, 0x00, 0x8f, 0xc1, 0xe9, 0x8a, 0x73, 0xe5, 0xd2, 0x33, 0x21, 0x66, 0x87, 0xea, 0xc2,
-	0x46, 0xf7, 0x22, 0x56, 0xbe, 0xf8, 0x2b, 0x9f, 0x19, 0xe6, 0x6b, 0x40, 0xdf, 0xb0, 0x20, 0x98,
-	0x19, 0x5e, 0x07, 0xd6, 0xf6, 0x69, 0x40, 0xd5, 0xec, 0x0e, 0xe7, 0x2d, 0xac, 0x27, 0xbc, 0x73,
-	0x12, 0xf2, 0x57, 0xf9, 0xef, 0x43, 0x13, 0xfc, 0xf4, 0xc6, 0x53, 0xd7, 0x25, 0x39, 0x52, 0x3a,
-	0x26, 0x51, 0x8f, 0xaa, 0x29, 0x3c, 0xfd, 0x23, 0x3c, 0x6c, 0x12, 0xee, 0xd1, 0x89, 0x68, 0x5e,
-	0xbd, 0xb3, 0x4f, 0x77, 0xf4, 0xac, 0xc7, 0x49, 0x90, 0x38, 0xd9, 0x11, 0x7e, 0x33, 0xa0, 0x84,
-	0xc7, 0xfd, 0x29, 0x30, 0xff, 0x04, 0x8f, 0x0f, 0x18, 0x27, 0x01, 0x9b, 0xac, 0xa4, 0x59, 0x38,
-	0xfc, 0x1a, 0xd0, 0x57, 0x42, 0xf5, 0x83, 0xb8, 0xf7, 0x95, 0x90, 0x6a, 0x9f, 0x0e, 0x98, 0x47,
-	0xe5, 0x14, 0x78, 0x6d, 0x68, 0x1c, 0x52, 0x95, 0x70, 0x5e, 0xf4, 0x30, 0x27, 0x99, 0x65, 0xef,
-	0x9b, 0x8f, 0xf3, 0x2f, 0x82, 0x63, 0x64, 0xdc, 0x24, 0xd5, 0xea, 0x08, 0xce, 0xdc, 0x05, 0x37,
-	0x61, 0xfe, 0xba, 0x04, 0x73, 0xec, 0x22, 0x31, 0x3d, 0x6f, 0xf9, 0x90, 0xaa, 0x11, 0x57, 0xbe,
-	0x09, 0x16, 0xe7, 0x96, 0x73, 0x34, 0xdb, 0x80, 0xd6, 0x0f, 0xa9, 0xe1, 0xa4, 0x37, 0xfa, 0xf9,
-	0xa4, 0x18, 0x30, 0xc7, 0x67, 0xe7, 0xd0, 0x9f, 0x4d, 0x08, 0x32, 0xdc, 0xf2, 0x26, 0xe8, 0x8f,
-	0x8a, 0xa1, 0x8b, 0xd8, 0xe9, 0x1c, 0xda, 0x83, 0x9a, 0xe6, 0x70, 0x37, 0x61, 0x5e, 0x7b, 0xe6,
-	0x2d, 0xa8, 0x69, 0x8e, 0x8b, 0x7e, 0x99, 0xc7, 0xb8, 0x7a, 0x63, 0xdc, 0x7c, 0x58, 0xb2, 0x9a,
-	0x69, 0xc6, 0x8d, 0x11, 0xa7, 0x2c, 0x68, 0x1a, 0x93, 0x5c, 0xb6, 0xec, 0x4c, 0xb2, 0x94, 0xd4,
-	0x54, 0x8f, 0x33, 0x51, 0x35, 0x23, 0xea, 0x87, 0x70, 0xc9, 0x97, 0xeb, 0x0c, 0x2f, 0xbc, 0xa9,
-	0xe7, 0xe9, 0xb3, 0xc9, 0xfc, 0x21, 0x71, 0xfb, 0xf4, 0x2c, 0xf8, 0x37, 0xc3, 0xf6, 0x91, 0x1c,
-	0x0d, 0x69, 0x76, 0x4e, 0xe4, 0x94, 0x97, 0x5d, 0x0e, 0xd3, 0xfe, 0x31, 0x30, 0xcd, 0x9d, 0x0c,
-	0x87, 0x54, 0x59, 0xda, 0x7b, 0xd3, 0xf6, 0xb7, 0xf2, 0x1f, 0x2f, 0xc7, 0xf9, 0x32, 0x9e, 0x43,
-	0x04, 0xd6, 0x0e, 0xa9, 0xca, 0x51, 0xdc, 0xeb, 0x5d, 0xcc, 0x7f, 0xa3, 0x29, 0xe5, 0xc8, 0x78,
-	0x0e, 0x7d, 0x0f, 0x28, 0x4f, 0x60, 0x51, 0xd1, 0x77, 0x9e, 0x12, 0x96, 0x7b, 0x7d, 0x48, 0x3c,
-	0xb8, 0x3f, 0x6a, 0x5a, 0xe3, 0x4c, 0xf6, 0xa6, 0xf8, 0xfc, 0xa6, 0xe0, 0xd3, 0x58, 0x11, 0x13,
-	0xc6, 0x73, 0x7b, 0xb5, 0xef, 0xe6, 0x07, 0x4f, 0xcf, 0x16, 0xcd, 0xdf, 0x64, 0x9f, 0xfe, 0x3f,
-	0x00, 0x00, 0xff, 0xff, 0xf4, 0x5f, 0x67, 0x81, 0x53, 0x1b, 0x00, 0x00,
+	// 1863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0xdb, 0xc8,
+	0x11, 0xb7, 0x2c, 0xd9, 0x96, 0xc6, 0x7f, 0x2e, 0xd9, 0xd8, 0x0e, 0xed, 0x36, 0x89, 0xbb, 0x28,
+	0x52, 0x5f, 0x71, 0x67, 0x37, 0xb9, 0xdc, 0xa1, 0x08, 0x8a, 0x43, 0xce, 0xb2, 0xec, 0xf3, 0x5d,
+	0x94, 0x28, 0x94, 0xed, 0xa0, 0xd7, 0x1e, 0x0e, 0x6b, 0x72, 0x25, 0x6f, 0x4d, 0xee, 0xea, 0xb8,
+	0x4b, 0x35, 0xca, 0x53, 0x81, 0x14, 0x7d, 0x28, 0xd0, 0xcf, 0xd7, 0xb7, 0x7e, 0x8b, 0xbe, 0x1f,
+	0x76, 0x49, 0xca, 0x94, 0x48, 0x5a, 0x31, 0xa4, 0x27, 0x71, 0x77, 0x66, 0x7e, 0x33, 0xbb, 0x3b,
+	0x33, 0xfb, 0x23, 0x05, 0x9f, 0xf6, 0xae, 0xba, 0xfb, 0x97, 0x84, 0xbb, 0x1e, 0x0d, 0x3e, 0xf7,
+	0x48, 0xc8, 0x9d, 0x4b, 0x1a, 0x7c, 0xee, 0x08, 0x7f, 0xdf, 0xf1, 0xdd, 0xfd, 0xfe, 0x13, 0xfd,
+	0xb3, 0xd7, 0x0b, 0x84, 0x12, 0xe8, 0x93, 0xab, 0xf0, 0x82, 0xf6, 0x59, 0xa0, 0xf6, 0xf4, 0x5c,
+	0xff, 0x09, 0xee, 0xc0, 0xbd, 0x37, 0xd4, 0x0f, 0xcf, 0x69, 0x20, 0x99, 0xe0, 0x36, 0x95, 0x3d,
+	0xc1, 0x25, 0x45, 0x5f, 0x42, 0x35, 0x88, 0x9f, 0xad, 0xd2, 0x4e, 0x69, 0x77, 0xf9, 0xe9, 0xd6,
+	0xde, 0x98, 0xe9, 0x5e, 0xa2, 0x6c, 0x0f, 0x55, 0x91, 0x05, 0x4b, 0xfd, 0x08, 0xc9, 0x9a, 0xdf,
+	0x29, 0xed, 0xd6, 0xec, 0x64, 0x88, 0x1f, 0x41, 0xf9, 0xbc, 0x79, 0x62, 0x14, 0x7c, 0xf6, 0x9d,
+	0x14, 0xdc, 0xc0, 0xae, 0xd8, 0xc9, 0x10, 0x3f, 0x81, 0x72, 0xbd, 0x75, 0x86, 0xd6, 0x60, 0x9e,
+	0xb9, 0x46, 0xb6, 0x6a, 0xcf, 0x33, 0x17, 0x6d, 0x43, 0x55, 0xb2, 0x0b, 0x8f, 0xf1, 0xae, 0xb4,
+	0xe6, 0x77, 0xca, 0xbb, 0xab, 0xf6, 0x70, 0x8c, 0xf7, 0x61, 0xa9, 0x1d, 0x3d, 0x67, 0xcc, 0xd6,
+	0x61, 0xa1, 0x4f, 0xbc, 0x90, 0x9a, 0x30, 0x2a, 0x76, 0x34, 0xc0, 0x0d, 0x58, 0x68, 0x91, 0x2e,
+	0x95, 0x5a, 0xec, 0x88, 0x90, 0x2b, 0x63, 0x51, 0xb1, 0xa3, 0x01, 0x42, 0x50, 0x09, 0x39, 0x53,
+	0x71, 0xe8, 0xe6, 0x59, 0xcf, 0x49, 0xf6, 0x9e, 0x5a, 0x65, 0x03, 0x6d, 0x9e, 0xf1, 0x33, 0x58,
+	0x6c, 0x52, 0x5f, 0x04, 0x03, 0xb4, 0x09, 0x8b, 0xc4, 0x4f, 0x01, 0xc5, 0xa3, 0x3c, 0x24, 0xfc,
+	0xdf, 0x12, 0x54, 0xea, 0xd4, 0xf3, 0x32, 0xb1, 0xee, 0xc3, 0xa2, 0x6f, 0xe0, 0x8c, 0xfa, 0xf2,
+	0xd3, 0xfb, 0x99, 0x9d, 0x8e, 0xbc, 0xd9, 0xb1, 0x1a, 0xfa, 0x0c, 0x16, 0x7a, 0x7a, 0x19, 0x56,
+	0x79, 0xa7, 0xbc, 0xbb, 0xfc, 0x74, 0x33, 0xa3, 0x6f, 0x16, 0x69, 0x47, 0x4a, 0xe8, 0x2b, 0xa8,
+	0xb9, 0x4c, 0x2a, 0xc2, 0x1d, 0x2a, 0xad, 0x8a, 0xb1, 0xb0, 0x32, 0x16, 0xf1, 0x3e, 0xda, 0xd7,
+	0xaa, 0x68, 0x17, 0x2a, 0x4e, 0x2f, 0x94, 0xd6, 0x82, 0x31, 0x59, 0xcf, 0x98, 0xd4, 0x5b, 0x67,
+	0xb6, 0xd1, 0xc0, 0x2f, 0xa0, 0x7a, 0x2a, 0x7a, 0xc2, 0x13, 0xdd, 0x01, 0x7a, 0x06, 0xc0, 0x43,
+	0x9f, 0xfc, 0xe4, 0x50, 0xcf, 0x93, 0x56, 0xc9, 0xd8, 0x6e, 0x64, 0x6d, 0xa9, 0xe7, 0xd9, 0x35,
+	0xad, 0xa8, 0x9f, 0x24, 0xfe, 0x77, 0x09, 0x16, 0xdb, 0xcd, 0x03, 0x26, 0x24, 0xc2, 0xb0, 0xe2,
+	0x13, 0x1e, 0x76, 0x88, 0xa3, 0xc2, 0x80, 0x06, 0x66, 0x9f, 0x6a, 0xf6, 0xc8, 0x9c, 0xce, 0xa2,
+	0x5e, 0x20, 0xdc, 0xd0, 0x49, 0x76, 0x38, 0x19, 0xa6, 0x13, 0xb0, 0x3c, 0x92, 0x80, 0xe8, 0x0e,
+	0x94, 0xe5, 0x55, 0x68, 0x55, 0xcc, 0xac, 0x7e, 0xd4, 0x87, 0xd7, 0x21, 0x3e, 0xf3, 0x06, 0xd6,
+	0x82, 0x99, 0x8c, 0x47, 0xf8, 0x5f, 0x25, 0xa8, 0x1e, 0x32, 0x79, 0x75, 0xc2, 0x3b, 0xc2, 0x28,
+	0x89, 0xc0, 0x27, 0x2a, 0x0e, 0x24, 0x1e, 0xa1, 0x1d, 0x58, 0xbe, 0x20, 0xce, 0x15, 0xe3, 0xdd,
+	0x23, 0xe6, 0xd1, 0x38, 0x8c, 0xf4, 0x14, 0x7a, 0x08, 0xa0, 0xe3, 0x25, 0x5e, 0x3b, 0xc9, 0x9f,
+	0x8a, 0x9d, 0x9a, 0xd1, 0x08, 0x7a, 0x4b, 0x12, 0x85, 0x8a, 0x51, 0x48, 0x4f, 0xe1, 0xff, 0x97,
+	0x60, 0xb5, 0xee, 0x85, 0x52, 0xd1, 0xa0, 0x2e, 0x78, 0x87, 0x75, 0xd1, 0x1e, 0xa0, 0xc6, 0xbb,
+	0x1e, 0xe1, 0xae, 0x8e, 0x4f, 0x36, 0x38, 0xb9, 0xf0, 0x68, 0x94, 0x4a, 0x55, 0x3b, 0x47, 0x82,
+	0xfe, 0x04, 0x5b, 0x47, 0x01, 0xa5, 0x3a, 0x1f, 0x6c, 0xda, 0x13, 0x81, 0x62, 0xbc, 0x7b, 0xc8,
+	0x64, 0x64, 0x36, 0x6f, 0xcc, 0x8a, 0x15, 0xd0, 0x73, 0xb0, 0x0e, 0x84, 0x73, 0x29, 0x0f, 0x99,
+	0xec, 0x79, 0x64, 0x70, 0x24, 0x82, 0xc6, 0xd1, 0xc9, 0x71, 0x48, 0xa5, 0x92, 0x66, 0x3d, 0x55,
+	0xbb, 0x50, 0xae, 0x6d, 0xdb, 0x34, 0x60, 0xc4, 0xab, 0x0b, 0x2e, 0x85, 0x47, 0x5f, 0x8a, 0x6b,
+	0xc7, 0x95, 0xc8, 0xb6, 0x48, 0x8e, 0xbf, 0x80, 0xad, 0x13, 0xae, 0x68, 0xd0, 0x21, 0x0e, 0x3d,
+	0x60, 0xdc, 0x65, 0xbc, 0xdb, 0x64, 0xdd, 0x80, 0x28, 0x7d, 0x8e, 0x9b, 0xba, 0xf8, 0xd4, 0xa5,
+	0x70, 0x93, 0x03, 0x89, 0x46, 0xf8, 0x7f, 0x4b, 0xb0, 0x71, 0x1e, 0x6d, 0x5e, 0x93, 0x38, 0x97,
+	0x8c, 0xd3, 0xd7, 0x3d, 0x6d, 0x20, 0xd1, 0xf7, 0xb0, 0x3e, 0x2a, 0x88, 0x32, 0xcd, 0x2a, 0x15,
+	0x54, 0x5b, 0x24, 0xb6, 0x73, 0x8d, 0xd0, 0x33, 0xd8, 0x68, 0x52, 0xff, 0x80, 0x78, 0x9e, 0x10,
+	0xbc, 0xad, 0x88, 0x92, 0x2d, 0x1a, 0x30, 0x11, 0xed, 0xe6, 0xaa, 0x9d, 0x2f, 0x44, 0x7f, 0x80,
+	0x7b, 0xad, 0x80, 0xea, 0x79, 0x87, 0x28, 0xea, 0x9e, 0x0b, 0x2f, 0xf4, 0xe3, 0xfa, 0xad, 0xd9,
+	0x79, 0x22, 0xdd, 0x80, 0x55, 0x5c, 0x53, 0x56, 0xa5, 0xa0, 0x01, 0x27, 0x45, 0x67, 0x0f, 0x55,
+	0x51, 0x1b, 0x6a, 0x26, 0x01, 0x74, 0xee, 0xc6, 0x95, 0xfb, 0x65, 0xc6, 0x2e, 0x77, 0x9b, 0xf6,
+	0x86, 0x76, 0x0d, 0xae, 0x82, 0x81, 0x7d, 0x8d, 0x53, 0x90, 0x75, 0x8b, 0x85, 0x59, 0x77, 0x08,
+	0xab, 0x4e, 0x3a, 0x6d, 0xad, 0x25, 0xb3, 0x80, 0x87, 0xd9, 0x36, 0x90, 0xd6, 0xb2, 0x47, 0x8d,
+	0xd0, 0x87, 0x12, 0x6c, 0xb1, 0x24, 0x0d, 0x0e, 0x85, 0x4f, 0x18, 0xff, 0x46, 0x29, 0xe2, 0x5c,
+	0xfa, 0x94, 0x2b, 0xab, 0x6a, 0xd6, 0xd6, 0xf8, 0xc8, 0xb5, 0x9d, 0x14, 0xe1, 0x44, 0x6b, 0x2d,
+	0xf6, 0x83, 0x38, 0xa0, 0xa1, 0x70, 0x98, 0x84, 0x56, 0xcd, 0x78, 0xff, 0xfa, 0xb6, 0xde, 0x87,
+	0x00, 0x91, 0xdb, 0x1c, 0xe4, 0xed, 0xb7, 0xb0, 0x36, 0x7a, 0x10, 0xba, 0x71, 0x5d, 0xd1, 0x41,
+	0x9c, 0xed, 0xfa, 0x11, 0xed, 0xa7, 0x2f, 0xb7, 0xbc, 0xc4, 0x48, 0xba, 0x57, 0x7c, 0xef, 0x3d,
+	0x9f, 0xff, 0x63, 0x69, 0xfb, 0x25, 0x3c, 0xbc, 0x79, 0x17, 0x72, 0x1c, 0x8d, 0xdc, 0xa2, 0xb5,
+	0x34, 0xda, 0xcf, 0x70, 0xbf, 0x60, 0x55, 0x39, 0x30, 0x2f, 0x46, 0xe3, 0xfd, 0x7d, 0x26, 0xde,
+	0xc2, 0x6a, 0x4f, 0xb9, 0xc4, 0x7d, 0x80, 0xf3, 0xe6, 0x89, 0x4d, 0x7f, 0xd6, 0x0d, 0x06, 0x3d,
+	0x86, 0x72, 0xdf, 0x67, 0x71, 0x0d, 0x67, 0x2f, 0x27, 0xad, 0xa9, 0x15, 0xd0, 0x0b, 0x58, 0x12,
+	0xd1, 0x31, 0xc4, 0xde, 0x1f, 0x7f, 0xdc, 0xa1, 0xd9, 0x89, 0x19, 0x3e, 0x85, 0x3b, 0xd7, 0xf1,
+	0xdc, 0xd2, 0xbb, 0x35, 0xea, 0x7d, 0xe5, 0x1a, 0xf5, 0x43, 0x09, 0x96, 0x1b, 0xef, 0xa8, 0x93,
+	0x20, 0x3e, 0x04, 0x70, 0xcd, 0xa9, 0xbc, 0x22, 0x3e, 0x8d, 0x37, 0x2f, 0x35, 0xa3, 0x91, 0xea,
+	0xc2, 0xf7, 0x09, 0x77, 0x93, 0x2b, 0x2f, 0x1e, 0x6a, 0xae, 0xf1, 0x4d, 0xd0, 0x4d, 0x9a, 0x89,
+	0x79, 0x46, 0x8f, 0x61, 0x4d, 0x31, 0x9f, 0x8a, 0x50, 0xb5, 0xa9, 0x23, 0xb8, 0x2b, 0x4d, 0x0f,
+	0x59, 0xb0, 0xc7, 0x66, 0xf1, 0x1a, 0xac, 0x34, 0xfc, 0x9e, 0x1a, 0xc4, 0x51, 0xe0, 0xaf, 0xa1,
+	0x6a, 0xa7, 0xb8, 0x9c, 0x0c, 0x1d, 0x87, 0x4a, 0x19, 0x5f, 0x30, 0xc9, 0x50, 0x4b, 0x7c, 0x2a,
+	0x25, 0xe9, 0x26, 0x89, 0x91, 0x0c, 0xf1, 0x4f, 0xb0, 0x16, 0xe5, 0xd6, 0xb4, 0x44, 0x72, 0x13,
+	0x16, 0xa3, 0xc5, 0xc7, 0x1e, 0xe2, 0x11, 0xe6, 0x70, 0x2f, 0x72, 0x60, 0xba, 0xeb, 0xb4, 0x5e,
+	0x76, 0x60, 0xd9, 0xbd, 0x46, 0x4b, 0x2e, 0xf1, 0xd4, 0x14, 0x7e, 0x07, 0x77, 0xcd, 0x85, 0x66,
+	0xaa, 0x69, 0x4a, 0x6f, 0x9f, 0xc1, 0xdd, 0xee, 0x38, 0x56, 0xec, 0x33, 0x2b, 0xc0, 0xff, 0x2c,
+	0xc1, 0x86, 0x71, 0x7d, 0x26, 0x69, 0xf0, 0x92, 0x49, 0x35, 0xad, 0xfb, 0x67, 0xb0, 0xd1, 0xcd,
+	0xc3, 0x8b, 0x43, 0xc8, 0x17, 0xe2, 0xff, 0x94, 0xc0, 0x32, 0x61, 0x68, 0x4e, 0x23, 0x07, 0x52,
+	0x51, 0x7f, 0xea, 0x6d, 0x7f, 0x0e, 0x56, 0xb7, 0x00, 0x32, 0x0e, 0xa6, 0x50, 0x8e, 0x07, 0xb0,
+	0x12, 0x95, 0xcd, 0x74, 0x21, 0x6c, 0x43, 0x95, 0xbe, 0x63, 0xaa, 0x2e, 0xdc, 0xc8, 0xe5, 0x82,
+	0x3d, 0x1c, 0xeb, 0xdc, 0x93, 0xca, 0x7d, 0x1d, 0xaa, 0x98, 0x42, 0xc6, 0x23, 0xfc, 0x03, 0xdc,
+	0x31, 0x3b, 0xd1, 0xd2, 0x44, 0xf9, 0x23, 0xcb, 0x36, 0x5b, 0x88, 0xf3, 0xb9, 0x85, 0xf8, 0x1d,
+	0xdc, 0x4d, 0x61, 0x4f, 0xb5, 0x36, 0x2c, 0x60, 0x55, 0x73, 0xba, 0xf7, 0xf4, 0xb6, 0xdd, 0xea,
+	0x2b, 0xd8, 0x0c, 0x79, 0xc7, 0x98, 0x9e, 0xe6, 0x05, 0x5d, 0x20, 0xc5, 0x6f, 0xe1, 0x6e, 0xf4,
+	0x86, 0x72, 0x18, 0xfa, 0xbd, 0xdb, 0x3a, 0xdd, 0x86, 0xaa, 0x1b, 0xfa, 0xbd, 0x16, 0x51, 0x97,
+	0xf1, 0xe1, 0x0f, 0xc7, 0xf8, 0x02, 0x3e, 0x69, 0x37, 0xce, 0x67, 0x51, 0x7b, 0xba, 0x99, 0xd1,
+	0xbe, 0x61, 0x45, 0x71, 0x23, 0x8e, 0x87, 0xf8, 0x1f, 0x25, 0xd8, 0x7a, 0x69, 0xde, 0x99, 0x9b,
+	0x94, 0xc8, 0x30, 0xa0, 0xfa, 0x42, 0x9c, 0x41, 0xa9, 0x7b, 0xe3, 0x98, 0xb1, 0xe3, 0xac, 0x00,
+	0xff, 0xa8, 0xf9, 0xee, 0xdf, 0xa8, 0xa3, 0xa2, 0x38, 0xda, 0xd4, 0x09, 0xa8, 0x9a, 0xdd, 0x55,
+	0x23, 0x61, 0xf3, 0x90, 0x05, 0x6a, 0x60, 0x13, 0x45, 0x67, 0xd2, 0x36, 0x31, 0xac, 0xb8, 0x09,
+	0x60, 0xf3, 0x22, 0xf2, 0x57, 0xb6, 0x47, 0xe6, 0xf0, 0x1b, 0x58, 0x3d, 0x20, 0xce, 0x55, 0xd8,
+	0x9b, 0xd9, 0x3a, 0x9e, 0x7e, 0xd8, 0x84, 0x72, 0xdd, 0x77, 0xd1, 0x2b, 0x40, 0xed, 0x01, 0x77,
+	0x46, 0xaf, 0x6d, 0xf4, 0xab, 0x5c, 0xc8, 0xc8, 0xf9, 0x76, 0xf1, 0xb2, 0xf0, 0x1c, 0x7a, 0x0d,
+	0xf7, 0x5a, 0x24, 0x94, 0x74, 0x66, 0x80, 0x6f, 0x60, 0xe3, 0x8c, 0xf7, 0x66, 0x0a, 0xd9, 0x86,
+	0xf5, 0xa8, 0xa6, 0xc7, 0x10, 0xb3, 0x9c, 0x7a, 0xa4, 0xf4, 0x6f, 0x06, 0xb5, 0x61, 0xf3, 0x8c,
+	0x77, 0xf2, 0x60, 0xa7, 0xda, 0x4c, 0x9b, 0x4a, 0xaa, 0x66, 0x06, 0x78, 0x0a, 0x56, 0x5b, 0x74,
+	0x94, 0x4d, 0x2f, 0x84, 0x98, 0x1d, 0xaa, 0x0d, 0x9b, 0xed, 0xcb, 0x50, 0xb9, 0xe2, 0xef, 0x7c,
+	0x66, 0x98, 0xaf, 0x00, 0x7d, 0xcf, 0x3c, 0x6f, 0x66, 0x78, 0x2d, 0x58, 0x3f, 0xa4, 0x1e, 0x55,
+	0xb3, 0x3b, 0x9c, 0xb7, 0xb0, 0x11, 0x51, 0xd9, 0x71, 0xc8, 0xdf, 0x64, 0xac, 0xc6, 0x29, 0xef,
+	0xc4, 0x53, 0xd7, 0x25, 0x39, 0x34, 0x3a, 0x25, 0x41, 0x97, 0xaa, 0x29, 0x22, 0xfd, 0x33, 0x3c,
+	0xa8, 0xeb, 0xcf, 0x50, 0x63, 0xbb, 0x39, 0x74, 0x30, 0xe5, 0xd1, 0xb3, 0x2e, 0x27, 0x5e, 0x14,
+	0x64, 0x4b, 0xb8, 0x75, 0x8f, 0x12, 0x1e, 0xf6, 0xa6, 0xc0, 0xfc, 0x0b, 0x3c, 0x3a, 0x62, 0x9c,
+	0x78, 0xec, 0x3d, 0x9d, 0x7d, 0xc0, 0xaf, 0x00, 0x7d, 0x2b, 0x54, 0xcf, 0x0b, 0xbb, 0xdf, 0x0a,
+	0xa9, 0x0e, 0x69, 0x9f, 0x39, 0x54, 0x4e, 0x81, 0xd7, 0x84, 0xda, 0x31, 0x55, 0x11, 0x8d, 0x46,
+	0x0f, 0x32, 0x9a, 0xe9, 0x17, 0x82, 0xed, 0x47, 0xd9, 0x77, 0xcb, 0x11, 0x7e, 0x6f, 0x92, 0x6a,
+	0x6d, 0x08, 0x67, 0xae, 0x97, 0x49, 0x98, 0xbf, 0x2d, 0xc0, 0x1c, 0xb9, 0x9b, 0x4c, 0xcf, 0x5b,
+	0x39, 0xa6, 0x6a, 0x48, 0xbf, 0x27, 0xc1, 0xe2, 0x8c, 0x38, 0xc3, 0xdc, 0x0d, 0x68, 0xf5, 0x98,
+	0x1a, 0x9a, 0x3b, 0x31, 0xce, 0xc7, 0xf9, 0x80, 0x19, 0x8a, 0x3c, 0x87, 0xfe, 0x6a, 0xb6, 0x20,
+	0x45, 0x57, 0x27, 0x41, 0x7f, 0x9a, 0x0f, 0x9d, 0x47, 0x78, 0xe7, 0xd0, 0x01, 0x54, 0x34, 0x2d,
+	0x9c, 0x84, 0x79, 0xe3, 0x99, 0x37, 0xa0, 0xa2, 0x69, 0x33, 0xfa, 0x75, 0x16, 0xe3, 0xfa, 0x25,
+	0x74, 0xfb, 0x41, 0x81, 0x34, 0xd5, 0x8c, 0x6b, 0x43, 0x9a, 0x9a, 0xd3, 0x34, 0xc6, 0xe9, 0xf1,
+	0x36, 0xbe, 0x49, 0x25, 0x55, 0x3d, 0xd6, 0x58, 0xd5, 0x0c, 0xd9, 0x24, 0xc2, 0x05, 0x1f, 0xc3,
+	0x53, 0x54, 0x73, 0x52, 0xcf, 0xd3, 0x67, 0x93, 0xfa, 0x8f, 0xe3, 0xf6, 0xe9, 0x99, 0xf3, 0x07,
+	0x49, 0xdc, 0x47, 0x32, 0x34, 0xa4, 0xde, 0x3a, 0x93, 0x53, 0x5e, 0x76, 0x19, 0xcc, 0x68, 0xc1,
+	0x53, 0xdd, 0xc9, 0x70, 0x4c, 0x55, 0xcc, 0xa4, 0x27, 0x2d, 0x7f, 0x27, 0x23, 0x1e, 0xa3, 0xe0,
+	0x78, 0x0e, 0x11, 0x58, 0x3f, 0xa6, 0x2a, 0xc3, 0x9a, 0x6f, 0x0e, 0x31, 0xfb, 0xd9, 0xa7, 0x90,
+	0x76, 0xe3, 0x39, 0xf4, 0x23, 0xa0, 0x2c, 0x27, 0x46, 0x79, 0x9f, 0x8e, 0x0a, 0x88, 0xf3, 0xcd,
+	0x5b, 0xe2, 0xc0, 0xfd, 0x61, 0xd3, 0x1a, 0x25, 0xc7, 0x93, 0xf6, 0xe7, 0x77, 0x39, 0x5f, 0xdb,
+	0xf2, 0xc8, 0x75, 0x44, 0xda, 0x22, 0x0e, 0x3c, 0x91, 0xb4, 0x8d, 0x50, 0xe5, 0x1b, 0x23, 0x3f,
+	0xa8, 0xfc, 0x30, 0xdf, 0x7f, 0x72, 0xb1, 0x68, 0xfe, 0xce, 0xfb, 0xe2, 0x97, 0x01, 0x00, 0x75,
+	0xb2, 0x11, 0x92, 0xfb, 0x1b, 0x00, 0x00,
 }
@@ -41,6 +41,26 @@ func (m *MockCmdClient) EXPECT() *MockCmdClientMockRecorder {
 	return m.recorder
 }
 
+// BackupVirtualMachine mocks base method.
+func (m *MockCmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackupVirtualMachine", varargs...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupVirtualMachine indicates an expected call of BackupVirtualMachine.
+func (mr *MockCmdClientMockRecorder) BackupVirtualMachine(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).BackupVirtualMachine), varargs...)
+}
+
 // CancelVirtualMachineMigration mocks base method.
 func (m *MockCmdClient) CancelVirtualMachineMigration(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -705,6 +725,21 @@ func (m *MockCmdServer) EXPECT() *MockCmdServerMockRecorder {
 	return m.recorder
 }
 
+// BackupVirtualMachine mocks base method.
+func (m *MockCmdServer) BackupVirtualMachine(arg0 context.Context, arg1 *BackupRequest) (*Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupVirtualMachine", arg0, arg1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupVirtualMachine indicates an expected call of BackupVirtualMachine.
+func (mr *MockCmdServerMockRecorder) BackupVirtualMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).BackupVirtualMachine), arg0, arg1)
+}
+
 // CancelVirtualMachineMigration mocks base method.
 func (m *MockCmdServer) CancelVirtualMachineMigration(arg0 context.Context, arg1 *VMIRequest) (*Response, error) {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ package admitter_test
 import (
 	"testing"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/testutils"
 )
 
@@ -34,6 +35,7 @@ type stubClusterConfigChecker struct {
 	macvtapFeatureGateEnabled    bool
 	passtFeatureGateEnabled      bool
 	bindingPluginFGEnabled       bool
+	networkBindings              map[string]v1.InterfaceBindingPlugin
 }
 
 func (s stubClusterConfigChecker) IsBridgeInterfaceOnPodNetworkEnabled() bool {
@@ -47,3 +49,7 @@ func (s stubClusterConfigChecker) MacvtapEnabled() bool {
 func (s stubClusterConfigChecker) PasstEnabled() bool {
 	return s.passtFeatureGateEnabled
 }
+
+func (s stubClusterConfigChecker) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
+	return s.networkBindings
+}
@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func validateInterfaceBandwidth(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bandwidth == nil {
+			continue
+		}
+		bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+
+		if iface.SRIOV != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's bandwidth is not supported for SR-IOV NICs", iface.Name),
+				Field:   bandwidthField.String(),
+			})
+		}
+
+		causes = append(causes, validateBandwidthLimit(bandwidthField.Child("inbound"), iface.Name, iface.Bandwidth.Inbound)...)
+		causes = append(causes, validateBandwidthLimit(bandwidthField.Child("outbound"), iface.Name, iface.Bandwidth.Outbound)...)
+	}
+	return causes
+}
+
+func validateBandwidthLimit(field *k8sfield.Path, ifaceName string, limit *v1.BandwidthLimit) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if limit == nil {
+		return causes
+	}
+
+	if limit.Average == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's average bandwidth must be greater than 0", ifaceName),
+			Field:   field.Child("average").String(),
+		})
+	}
+	if limit.Peak != nil && *limit.Peak < limit.Average {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's peak bandwidth must not be lower than its average bandwidth", ifaceName),
+			Field:   field.Child("peak").String(),
+		})
+	}
+	if limit.Burst != nil && *limit.Burst == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's burst must be greater than 0", ifaceName),
+			Field:   field.Child("burst").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface bandwidth", func() {
+	newSpec := func(iface v1.Interface) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{{
+			Name:          iface.Name,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}},
+		}}
+		return spec
+	}
+
+	bridgeIface := func(bandwidth *v1.InterfaceBandwidth) v1.Interface {
+		return v1.Interface{
+			Name:                   "foo",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Bandwidth:              bandwidth,
+		}
+	}
+
+	It("should accept bandwidth limits on a bridge interface", func() {
+		spec := newSpec(bridgeIface(&v1.InterfaceBandwidth{
+			Inbound:  &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(uint32(2000)), Burst: pointer.P(uint32(512))},
+			Outbound: &v1.BandwidthLimit{Average: 500},
+		}))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject bandwidth limits on a SR-IOV interface", func() {
+		spec := newSpec(v1.Interface{
+			Name:                   "foo",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			Bandwidth:              &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}},
+		})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: `"foo" interface's bandwidth is not supported for SR-IOV NICs`,
+			Field:   "fake.domain.devices.interfaces[0].bandwidth",
+		}))
+	})
+
+	DescribeTable("should reject invalid bandwidth limits", func(bandwidth *v1.InterfaceBandwidth, expectedField string) {
+		spec := newSpec(bridgeIface(bandwidth))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		causes := validator.Validate()
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("with zero average",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{}},
+			"fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+		),
+		Entry("with peak lower than average",
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(uint32(500))}},
+			"fake.domain.devices.interfaces[0].bandwidth.outbound.peak",
+		),
+		Entry("with zero burst",
+			&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Burst: pointer.P(uint32(0))}},
+			"fake.domain.devices.interfaces[0].bandwidth.outbound.burst",
+		),
+	)
+})
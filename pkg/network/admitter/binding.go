@@ -42,6 +42,7 @@ func validateInterfaceBinding(
 		causes = append(causes, validateBridgeBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
 		causes = append(causes, validateMacvtapBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
 		causes = append(causes, validatePasstBinding(fieldPath, idx, iface, networksByName[iface.Name], config)...)
+		causes = append(causes, validateVhostUserBinding(fieldPath, idx, iface, spec, config)...)
 	}
 	return causes
 }
@@ -97,3 +98,32 @@ func validateBridgeBinding(
 	}
 	return nil
 }
+
+func validateVhostUserBinding(
+	fieldPath *field.Path, idx int, iface v1.Interface, spec *v1.VirtualMachineInstanceSpec, config clusterConfigChecker,
+) []metav1.StatusCause {
+	if iface.Binding == nil {
+		return nil
+	}
+	if plugin, exists := config.GetNetworkBindings()[iface.Binding.Name]; !exists || plugin.DomainAttachmentType != v1.VhostUser {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	if iface.Model != "" && iface.Model != v1.VirtIO {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("interface %s with vhost-user domain attachment supports only the %s model", iface.Name, v1.VirtIO),
+			Field:   fieldPath.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+		})
+	}
+	// The userspace datapath accesses the guest memory, which is shared from hugepages.
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Hugepages == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("interface %s with vhost-user domain attachment requires hugepages", iface.Name),
+			Field:   fieldPath.Child("domain", "memory", "hugepages").String(),
+		})
+	}
+	return causes
+}
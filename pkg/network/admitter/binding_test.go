@@ -148,3 +148,48 @@ var _ = Describe("Validating core binding", func() {
 		}))
 	})
 })
+
+var _ = Describe("Validating vhost-user domain attachment", func() {
+	const pluginName = "vhostuser"
+
+	clusterConfig := stubClusterConfigChecker{
+		networkBindings: map[string]v1.InterfaceBindingPlugin{pluginName: {DomainAttachmentType: v1.VhostUser}},
+	}
+
+	newVMI := func(model string, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append(opts,
+			libvmi.WithInterface(v1.Interface{Name: "dpdk", Model: model, Binding: &v1.PluginBinding{Name: pluginName}}),
+			libvmi.WithNetwork(libvmi.MultusNetwork("dpdk", "dpdk-net")),
+		)
+		return libvmi.New(opts...)
+	}
+
+	It("should accept a virtio interface when hugepages are requested", func() {
+		vmi := newVMI(v1.VirtIO, libvmi.WithHugepages("2Mi"))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, clusterConfig)
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject an interface when hugepages are not requested", func() {
+		vmi := newVMI("")
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueRequired",
+			Message: "interface dpdk with vhost-user domain attachment requires hugepages",
+			Field:   "fake.domain.memory.hugepages",
+		}))
+	})
+
+	It("should reject an interface with a non virtio model", func() {
+		vmi := newVMI("e1000", libvmi.WithHugepages("2Mi"))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, clusterConfig)
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueNotSupported",
+			Message: "interface dpdk with vhost-user domain attachment supports only the virtio model",
+			Field:   "fake.domain.devices.interfaces[0].model",
+		}))
+	})
+})
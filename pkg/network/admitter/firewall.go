@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const maxFirewallPort = 65535
+
+func validateInterfaceFirewall(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Firewall == nil {
+			continue
+		}
+		firewallField := field.Child("domain", "devices", "interfaces").Index(idx).Child("firewall")
+
+		if iface.Bridge == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's firewall is supported only for bridge binding", iface.Name),
+				Field:   firewallField.String(),
+			})
+		}
+
+		causes = append(causes, validateFirewallRules(firewallField.Child("ingress"), iface.Name, iface.Firewall.Ingress)...)
+		causes = append(causes, validateFirewallRules(firewallField.Child("egress"), iface.Name, iface.Firewall.Egress)...)
+	}
+	return causes
+}
+
+func validateFirewallRules(field *k8sfield.Path, ifaceName string, rules *v1.FirewallRules) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if rules == nil {
+		return causes
+	}
+
+	for idx, rule := range rules.Allow {
+		ruleField := field.Child("allow").Index(idx)
+
+		switch rule.Protocol {
+		case "", v1.FirewallProtocolTCP, v1.FirewallProtocolUDP, v1.FirewallProtocolICMP, v1.FirewallProtocolICMPv6:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%q interface's firewall protocol %q is not supported", ifaceName, rule.Protocol),
+				Field:   ruleField.Child("protocol").String(),
+			})
+		}
+
+		if len(rule.Ports) > 0 && rule.Protocol != v1.FirewallProtocolTCP && rule.Protocol != v1.FirewallProtocolUDP {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's firewall ports are supported only for TCP and UDP", ifaceName),
+				Field:   ruleField.Child("ports").String(),
+			})
+		}
+		for portIdx, port := range rule.Ports {
+			causes = append(causes, validateFirewallPort(ruleField.Child("ports").Index(portIdx), ifaceName, port)...)
+		}
+
+		for networkIdx, network := range rule.Networks {
+			if _, _, err := net.ParseCIDR(network); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%q interface's firewall network %q is not a valid CIDR", ifaceName, network),
+					Field:   ruleField.Child("networks").Index(networkIdx).String(),
+				})
+			}
+		}
+
+		if rule.PeerSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(rule.PeerSelector); err != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%q interface's firewall peer selector is invalid: %v", ifaceName, err),
+					Field:   ruleField.Child("peerSelector").String(),
+				})
+			}
+		}
+	}
+	return causes
+}
+
+func validateFirewallPort(field *k8sfield.Path, ifaceName string, port v1.FirewallPort) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if port.Port < 1 || port.Port > maxFirewallPort {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's firewall port must be between 1 and %d", ifaceName, maxFirewallPort),
+			Field:   field.Child("port").String(),
+		})
+	}
+	if port.EndPort != nil && (*port.EndPort < port.Port || *port.EndPort > maxFirewallPort) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%q interface's firewall end port must be between port and %d", ifaceName, maxFirewallPort),
+			Field:   field.Child("endPort").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validating interface firewall", func() {
+	newSpec := func(iface v1.Interface) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		spec.Networks = []v1.Network{{
+			Name:          iface.Name,
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test"}},
+		}}
+		return spec
+	}
+
+	bridgeIface := func(firewall *v1.InterfaceFirewall) v1.Interface {
+		return v1.Interface{
+			Name:                   "foo",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Firewall:               firewall,
+		}
+	}
+
+	It("should accept a firewall on a bridge interface", func() {
+		spec := newSpec(bridgeIface(&v1.InterfaceFirewall{
+			Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+				{
+					Protocol: v1.FirewallProtocolTCP,
+					Ports:    []v1.FirewallPort{{Port: 22}, {Port: 8000, EndPort: pointer.P(int32(8080))}},
+					Networks: []string{"10.0.0.0/8", "fd00::/64"},
+				},
+				{Protocol: v1.FirewallProtocolICMP},
+			}},
+			Egress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			}},
+			AntiSpoofing: pointer.P(false),
+		}))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject a firewall on a SR-IOV interface", func() {
+		spec := newSpec(v1.Interface{
+			Name:                   "foo",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+			Firewall:               &v1.InterfaceFirewall{},
+		})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: `"foo" interface's firewall is supported only for bridge binding`,
+			Field:   "fake.domain.devices.interfaces[0].firewall",
+		}))
+	})
+
+	DescribeTable("should reject invalid firewall rules", func(rule v1.FirewallRule, expectedField string) {
+		spec := newSpec(bridgeIface(&v1.InterfaceFirewall{
+			Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{rule}},
+		}))
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		causes := validator.Validate()
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("with unknown protocol",
+			v1.FirewallRule{Protocol: "SCTP"},
+			"fake.domain.devices.interfaces[0].firewall.ingress.allow[0].protocol",
+		),
+		Entry("with ports on ICMP",
+			v1.FirewallRule{Protocol: v1.FirewallProtocolICMP, Ports: []v1.FirewallPort{{Port: 80}}},
+			"fake.domain.devices.interfaces[0].firewall.ingress.allow[0].ports",
+		),
+		Entry("with zero port",
+			v1.FirewallRule{Protocol: v1.FirewallProtocolTCP, Ports: []v1.FirewallPort{{Port: 0}}},
+			"fake.domain.devices.interfaces[0].firewall.ingress.allow[0].ports[0].port",
+		),
+		Entry("with end port lower than port",
+			v1.FirewallRule{Protocol: v1.FirewallProtocolUDP, Ports: []v1.FirewallPort{{Port: 80, EndPort: pointer.P(int32(79))}}},
+			"fake.domain.devices.interfaces[0].firewall.ingress.allow[0].ports[0].endPort",
+		),
+		Entry("with invalid network",
+			v1.FirewallRule{Networks: []string{"10.0.0.1"}},
+			"fake.domain.devices.interfaces[0].firewall.ingress.allow[0].networks[0]",
+		),
+		Entry("with invalid peer selector",
+			v1.FirewallRule{PeerSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Unknown"},
+			}}},
+			"fake.domain.devices.interfaces[0].firewall.ingress.allow[0].peerSelector",
+		),
+	)
+})
@@ -0,0 +1,62 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+)
+
+var _ = Describe("Validating IP pool networks", func() {
+	newSpec := func(binding v1.InterfaceBindingMethod) *v1.VirtualMachineInstanceSpec {
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "foo", InterfaceBindingMethod: binding}}
+		spec.Networks = []v1.Network{{
+			Name:          "foo",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test", IPPool: "pool1"}},
+		}}
+		return spec
+	}
+
+	It("should accept an IP pool on a bridge interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	})
+
+	It("should reject an IP pool on a SR-IOV interface", func() {
+		spec := newSpec(v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}})
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+			Type:    "FieldValueInvalid",
+			Message: `"foo" network's IP pool is supported only for bridge binding`,
+			Field:   "fake.networks[0].multus.ipPool",
+		}))
+	})
+})
@@ -199,6 +199,7 @@ func validatePortConfiguration(field *k8sfield.Path, idx int, iface v1.Interface
 			causes = append(causes, validateForwardPortNonZero(field, idx, forwardPort, portIdx)...)
 			causes = append(causes, validateForwardPortInRange(field, idx, forwardPort, portIdx)...)
 			causes = append(causes, validateForwardPortProtocol(field, idx, forwardPort, portIdx)...)
+			causes = append(causes, validateForwardPortRangeAndExclusion(field, idx, iface, forwardPort, portIdx)...)
 		}
 	}
 	return causes
@@ -254,6 +255,35 @@ func validateForwardPortInRange(field *k8sfield.Path, idx int, forwardPort v1.Po
 	return causes
 }
 
+func validateForwardPortRangeAndExclusion(field *k8sfield.Path, idx int, iface v1.Interface, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
+	if forwardPort.EndPort == nil && !forwardPort.Exclude {
+		return nil
+	}
+	portField := field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx)
+	if iface.Masquerade == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Port ranges and port exclusions are supported only by the masquerade binding.",
+			Field:   portField.String(),
+		})
+	}
+	if forwardPort.Name != "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Port name cannot be set on a port range or on an excluded port.",
+			Field:   portField.Child("name").String(),
+		})
+	}
+	if forwardPort.EndPort != nil && (*forwardPort.EndPort < forwardPort.Port || *forwardPort.EndPort > 65535) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "EndPort field must be in range port <= x < 65536.",
+			Field:   portField.Child("endPort").String(),
+		})
+	}
+	return causes
+}
+
 func validateForwardPortNonZero(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Port == 0 {
 		causes = append(causes, metav1.StatusCause{
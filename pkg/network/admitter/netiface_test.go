@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -290,8 +291,61 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].ports[0].name",
 				}},
 			),
+			Entry(
+				"end port lower than the port",
+				[]v1.Port{{Port: 8080, EndPort: pointer.P(int32(8000))}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "EndPort field must be in range port <= x < 65536.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+				}},
+			),
+			Entry(
+				"end port out of range",
+				[]v1.Port{{Port: 8080, EndPort: pointer.P(int32(65536))}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "EndPort field must be in range port <= x < 65536.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+				}},
+			),
+			Entry(
+				"a named port range",
+				[]v1.Port{{Name: "http", Port: 8000, EndPort: pointer.P(int32(8080))}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "Port name cannot be set on a port range or on an excluded port.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].name",
+				}},
+			),
+			Entry(
+				"a named excluded port",
+				[]v1.Port{{Name: "ssh", Port: 22, Exclude: true}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "Port name cannot be set on a port range or on an excluded port.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].name",
+				}},
+			),
 		)
 
+		It("should reject a port range on a non masquerade interface", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Ports:                  []v1.Port{{Port: 8000, EndPort: pointer.P(int32(8080))}},
+			}}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "Port ranges and port exclusions are supported only by the masquerade binding.",
+				Field:   "fake.domain.devices.interfaces[0].ports[0]",
+			}))
+		})
+
 		DescribeTable("should accept interface with", func(ports []v1.Port) {
 			spec := &v1.VirtualMachineInstanceSpec{}
 			spec.Domain.Devices.Interfaces = []v1.Interface{{
@@ -310,6 +364,12 @@ var _ = Describe("Validating VMI network spec", func() {
 				"multiple ports, same number, different protocols",
 				[]v1.Port{{Port: 80}, {Protocol: "UDP", Port: 80}, {Protocol: "TCP", Port: 80}},
 			),
+			Entry("a port range", []v1.Port{{Port: 8000, EndPort: pointer.P(int32(8080))}}),
+			Entry("a single port range", []v1.Port{{Port: 8080, EndPort: pointer.P(int32(8080))}}),
+			Entry(
+				"excluded ports and ranges",
+				[]v1.Port{{Port: 22, Exclude: true}, {Protocol: "UDP", Port: 5000, EndPort: pointer.P(int32(5010)), Exclude: true}},
+			),
 		)
 	})
 
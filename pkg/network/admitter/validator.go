@@ -29,6 +29,7 @@ type clusterConfigChecker interface {
 	IsBridgeInterfaceOnPodNetworkEnabled() bool
 	MacvtapEnabled() bool
 	PasstEnabled() bool
+	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
 }
 
 type Validator struct {
@@ -52,6 +53,9 @@ func (v Validator) Validate() []metav1.StatusCause {
 	causes = append(causes, validateSingleNetworkSource(v.field, v.vmiSpec)...)
 	causes = append(causes, validateMultusNetworkSource(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceStateValue(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceBandwidth(v.field, v.vmiSpec)...)
+	causes = append(causes, validateInterfaceFirewall(v.field, v.vmiSpec)...)
+	causes = append(causes, validateIPPoolNetworks(v.field, v.vmiSpec)...)
 	causes = append(causes, validateInterfaceBinding(v.field, v.vmiSpec, v.configChecker)...)
 	causes = append(causes, validateNetworkNameUnique(v.field, v.vmiSpec)...)
 	causes = append(causes, validateNetworksAssignedToInterfaces(v.field, v.vmiSpec)...)
@@ -98,12 +98,13 @@ type DHCPConfig struct {
 	Mtu                 uint16
 	IPAMDisabled        bool
 	Gateway             net.IP
+	IPv6Gateway         net.IP
 	Subdomain           string
 }
 
 func (d DHCPConfig) String() string {
 	return fmt.Sprintf(
-		"DHCPConfig: { Name: %s, IPv4: %s, IPv6: %s, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPAMDisabled: %t, Routes: %v}",
+		"DHCPConfig: { Name: %s, IPv4: %s, IPv6: %s, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPv6Gateway: %s, IPAMDisabled: %t, Routes: %v}",
 		d.Name,
 		d.IP,
 		d.IPv6,
@@ -111,6 +112,7 @@ func (d DHCPConfig) String() string {
 		d.AdvertisingIPAddr,
 		d.Mtu,
 		d.Gateway,
+		d.IPv6Gateway,
 		d.IPAMDisabled,
 		d.Routes,
 	)
@@ -29,12 +29,14 @@ var _ = Describe("DHCPConfig", func() {
 	Context("String", func() {
 		It("returns correct string representation", func() {
 			dhcpConfig := createDummyDHCPConfig(vifName, ipv4Cidr, ipv4Gateway, "", mac, mtu, routes)
-			Expect(dhcpConfig.String()).To(Equal(fmt.Sprintf("DHCPConfig: { Name: %s, IPv4: %s, IPv6: <nil>, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPAMDisabled: false, Routes: %v}", vifName, ipv4Cidr, mac, ipv4Gateway, mtu, ipv4Gateway, &routes)))
+			Expect(dhcpConfig.String()).To(Equal(fmt.Sprintf("DHCPConfig: { Name: %s, IPv4: %s, IPv6: <nil>, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPv6Gateway: <nil>, IPAMDisabled: false, Routes: %v}", vifName, ipv4Cidr, mac, ipv4Gateway, mtu, ipv4Gateway, &routes)))
 		})
 		It("returns correct string representation with ipv6", func() {
+			const ipv6Gateway = "fe80::1"
 			dhcpConfig := createDummyDHCPConfig(vifName, ipv4Cidr, ipv4Gateway, ipv6Cidr, mac, mtu, routes)
+			dhcpConfig.IPv6Gateway = net.ParseIP(ipv6Gateway)
 			expRoutes := fmt.Sprintf("Routes: %v", &routes)
-			Expect(dhcpConfig.String()).To(Equal(fmt.Sprintf("DHCPConfig: { Name: %s, IPv4: %s, IPv6: %s, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPAMDisabled: false, %s}", vifName, ipv4Cidr, ipv6Cidr, mac, ipv4Gateway, mtu, ipv4Gateway, expRoutes)))
+			Expect(dhcpConfig.String()).To(Equal(fmt.Sprintf("DHCPConfig: { Name: %s, IPv4: %s, IPv6: %s, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPv6Gateway: %s, IPAMDisabled: false, %s}", vifName, ipv4Cidr, ipv6Cidr, mac, ipv4Gateway, mtu, ipv4Gateway, ipv6Gateway, expRoutes)))
 		})
 		It("returns correct string representation when an IP is not defined", func() {
 			gw := net.ParseIP(ipv4Gateway)
@@ -46,7 +48,7 @@ var _ = Describe("DHCPConfig", func() {
 				Mtu:               mtu,
 				Gateway:           gw,
 			}
-			Expect(dhcpConfig.String()).To(Equal(fmt.Sprintf("DHCPConfig: { Name: %s, IPv4: <nil>, IPv6: <nil>, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPAMDisabled: false, Routes: <nil>}", vifName, mac, ipv4Gateway, mtu, ipv4Gateway)))
+			Expect(dhcpConfig.String()).To(Equal(fmt.Sprintf("DHCPConfig: { Name: %s, IPv4: <nil>, IPv6: <nil>, MAC: %s, AdvertisingIPAddr: %s, MTU: %d, Gateway: %s, IPv6Gateway: <nil>, IPAMDisabled: false, Routes: <nil>}", vifName, mac, ipv4Gateway, mtu, ipv4Gateway)))
 		})
 	})
 })
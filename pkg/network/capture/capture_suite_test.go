@@ -0,0 +1,11 @@
+package capture_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestCapture(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package capture
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// maxFilterInstructions is the BPF_MAXINSNS limit of the kernel socket filters.
+const maxFilterInstructions = 4096
+
+// ParseFilter parses a classic BPF program in the decimal format printed by
+// `tcpdump -ddd`: the instruction count followed by one "code jt jf k"
+// instruction per line. Commas are accepted as line separators, so the
+// program can be passed as a single query parameter.
+// An empty filter returns a nil program, which captures all the packets.
+func ParseFilter(filter string) ([]unix.SockFilter, error) {
+	lines := strings.FieldsFunc(filter, func(r rune) bool {
+		return r == '\n' || r == ','
+	})
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter instruction count %q: %v", lines[0], err)
+	}
+	if count != len(lines)-1 {
+		return nil, fmt.Errorf("filter declares %d instructions but contains %d", count, len(lines)-1)
+	}
+	if count == 0 || count > maxFilterInstructions {
+		return nil, fmt.Errorf("filter must contain between 1 and %d instructions", maxFilterInstructions)
+	}
+
+	program := make([]unix.SockFilter, 0, count)
+	for i, line := range lines[1:] {
+		instruction, err := parseInstruction(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter instruction %d: %v", i, err)
+		}
+		program = append(program, instruction)
+	}
+	return program, nil
+}
+
+func parseInstruction(line string) (unix.SockFilter, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 4 {
+		return unix.SockFilter{}, fmt.Errorf("expected 4 fields, got %q", line)
+	}
+	code, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return unix.SockFilter{}, err
+	}
+	jt, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil {
+		return unix.SockFilter{}, err
+	}
+	jf, err := strconv.ParseUint(fields[2], 10, 8)
+	if err != nil {
+		return unix.SockFilter{}, err
+	}
+	k, err := strconv.ParseUint(fields[3], 10, 32)
+	if err != nil {
+		return unix.SockFilter{}, err
+	}
+	return unix.SockFilter{Code: uint16(code), Jt: uint8(jt), Jf: uint8(jf), K: uint32(k)}, nil
+}
@@ -0,0 +1,58 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package capture_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/kubevirt/pkg/network/capture"
+)
+
+var _ = Describe("BPF filter", func() {
+	It("should return no program for an empty filter", func() {
+		Expect(capture.ParseFilter("")).To(BeNil())
+	})
+
+	DescribeTable("should parse the tcpdump -ddd output", func(filter string) {
+		Expect(capture.ParseFilter(filter)).To(Equal([]unix.SockFilter{
+			{Code: 40, Jt: 0, Jf: 0, K: 12},
+			{Code: 21, Jt: 0, Jf: 1, K: 2054},
+			{Code: 6, Jt: 0, Jf: 0, K: 262144},
+			{Code: 6, Jt: 0, Jf: 0, K: 0},
+		}))
+	},
+		Entry("separated by newlines", "4\n40 0 0 12\n21 0 1 2054\n6 0 0 262144\n6 0 0 0\n"),
+		Entry("separated by commas", "4,40 0 0 12,21 0 1 2054,6 0 0 262144,6 0 0 0"),
+	)
+
+	DescribeTable("should reject", func(filter string) {
+		_, err := capture.ParseFilter(filter)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("a missing instruction count", "40 0 0 12"),
+		Entry("a mismatching instruction count", "2,6 0 0 0"),
+		Entry("an instruction with missing fields", "1,6 0 0"),
+		Entry("an out of range field", "1,6 0 256 0"),
+		Entry("a zero instruction count", "0"),
+	)
+})
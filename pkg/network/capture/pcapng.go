@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package capture
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// The pcapng block types and constants, see
+// https://www.ietf.org/archive/id/draft-ietf-opsawg-pcapng-02.html
+const (
+	sectionHeaderBlockType        uint32 = 0x0A0D0D0A
+	interfaceDescriptionBlockType uint32 = 0x00000001
+	enhancedPacketBlockType       uint32 = 0x00000006
+
+	byteOrderMagic   uint32 = 0x1A2B3C4D
+	linkTypeEthernet uint16 = 1
+
+	optionEndOfOpt uint16 = 0
+	optionIfName   uint16 = 2
+)
+
+// Writer writes the captured packets of a single interface as a pcapng stream.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter writes the section header and the interface description blocks
+// and returns a Writer for the packets captured on the given interface.
+func NewWriter(w io.Writer, interfaceName string, snapLen uint32) (*Writer, error) {
+	pw := &Writer{w: w}
+
+	shb := make([]byte, 0, 16)
+	shb = binary.LittleEndian.AppendUint32(shb, byteOrderMagic)
+	shb = binary.LittleEndian.AppendUint16(shb, 1) // major version
+	shb = binary.LittleEndian.AppendUint16(shb, 0) // minor version
+	// The section length is unknown while streaming.
+	shb = binary.LittleEndian.AppendUint64(shb, 0xFFFFFFFFFFFFFFFF)
+	if err := pw.writeBlock(sectionHeaderBlockType, shb); err != nil {
+		return nil, err
+	}
+
+	idb := make([]byte, 0, 16+len(interfaceName))
+	idb = binary.LittleEndian.AppendUint16(idb, linkTypeEthernet)
+	idb = binary.LittleEndian.AppendUint16(idb, 0) // reserved
+	idb = binary.LittleEndian.AppendUint32(idb, snapLen)
+	idb = appendOption(idb, optionIfName, []byte(interfaceName))
+	idb = appendOption(idb, optionEndOfOpt, nil)
+	if err := pw.writeBlock(interfaceDescriptionBlockType, idb); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// WritePacket writes an enhanced packet block with microsecond timestamp resolution.
+func (pw *Writer) WritePacket(timestamp time.Time, data []byte, originalLength int) error {
+	ts := uint64(timestamp.UnixMicro())
+	epb := make([]byte, 0, 20+len(data)+3)
+	epb = binary.LittleEndian.AppendUint32(epb, 0) // interface id
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts>>32))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data)))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(originalLength))
+	epb = appendPadded(epb, data)
+	return pw.writeBlock(enhancedPacketBlockType, epb)
+}
+
+// writeBlock frames the body with the block type and the total length, which
+// is repeated at the end of the block.
+func (pw *Writer) writeBlock(blockType uint32, body []byte) error {
+	totalLength := uint32(12 + len(body))
+	block := make([]byte, 0, totalLength)
+	block = binary.LittleEndian.AppendUint32(block, blockType)
+	block = binary.LittleEndian.AppendUint32(block, totalLength)
+	block = append(block, body...)
+	block = binary.LittleEndian.AppendUint32(block, totalLength)
+	_, err := pw.w.Write(block)
+	return err
+}
+
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, code)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	return appendPadded(b, value)
+}
+
+// appendPadded appends the data padded to 32 bits.
+func appendPadded(b, data []byte) []byte {
+	b = append(b, data...)
+	if padding := len(data) % 4; padding != 0 {
+		b = append(b, make([]byte, 4-padding)...)
+	}
+	return b
+}
@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package capture_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/network/capture"
+)
+
+var _ = Describe("pcapng writer", func() {
+	type block struct {
+		blockType uint32
+		body      []byte
+	}
+
+	readBlocks := func(data []byte) []block {
+		var blocks []block
+		for len(data) > 0 {
+			Expect(len(data)).To(BeNumerically(">=", 12))
+			blockType := binary.LittleEndian.Uint32(data[0:4])
+			totalLength := binary.LittleEndian.Uint32(data[4:8])
+			Expect(totalLength % 4).To(BeZero())
+			Expect(binary.LittleEndian.Uint32(data[totalLength-4 : totalLength])).To(Equal(totalLength))
+			blocks = append(blocks, block{blockType: blockType, body: data[8 : totalLength-4]})
+			data = data[totalLength:]
+		}
+		return blocks
+	}
+
+	It("should write the section header, interface description and packet blocks", func() {
+		var buf bytes.Buffer
+		writer, err := capture.NewWriter(&buf, "tap0", 65535)
+		Expect(err).ToNot(HaveOccurred())
+
+		packet := []byte{0xde, 0xad, 0xbe, 0xef, 0x01}
+		timestamp := time.UnixMicro(0x123456789)
+		Expect(writer.WritePacket(timestamp, packet, 60)).To(Succeed())
+
+		blocks := readBlocks(buf.Bytes())
+		Expect(blocks).To(HaveLen(3))
+
+		By("checking the section header block")
+		Expect(blocks[0].blockType).To(Equal(uint32(0x0A0D0D0A)))
+		Expect(binary.LittleEndian.Uint32(blocks[0].body[0:4])).To(Equal(uint32(0x1A2B3C4D)))
+
+		By("checking the interface description block")
+		Expect(blocks[1].blockType).To(Equal(uint32(1)))
+		Expect(binary.LittleEndian.Uint16(blocks[1].body[0:2])).To(Equal(uint16(1)))
+		Expect(binary.LittleEndian.Uint32(blocks[1].body[4:8])).To(Equal(uint32(65535)))
+		Expect(binary.LittleEndian.Uint16(blocks[1].body[8:10])).To(Equal(uint16(2)))
+		Expect(binary.LittleEndian.Uint16(blocks[1].body[10:12])).To(Equal(uint16(4)))
+		Expect(string(blocks[1].body[12:16])).To(Equal("tap0"))
+
+		By("checking the enhanced packet block")
+		body := blocks[2].body
+		Expect(blocks[2].blockType).To(Equal(uint32(6)))
+		Expect(binary.LittleEndian.Uint32(body[4:8])).To(Equal(uint32(0x1)))
+		Expect(binary.LittleEndian.Uint32(body[8:12])).To(Equal(uint32(0x23456789)))
+		Expect(binary.LittleEndian.Uint32(body[12:16])).To(Equal(uint32(len(packet))))
+		Expect(binary.LittleEndian.Uint32(body[16:20])).To(Equal(uint32(60)))
+		Expect(body[20 : 20+len(packet)]).To(Equal(packet))
+		Expect(body[20+len(packet):]).To(Equal([]byte{0, 0, 0}))
+	})
+})
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package capture
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// DefaultSnapLen is the maximum number of bytes captured of each packet.
+const DefaultSnapLen = 262144
+
+const readTimeout = 500 * time.Millisecond
+
+// Socket is a packet socket bound to a single network device.
+type Socket struct {
+	fd            int
+	interfaceName string
+	snapLen       int
+}
+
+// Open opens a packet socket capturing all the packets of the named device,
+// which pass the optional filter program.
+// The socket is bound to the network namespace of the calling thread.
+func Open(interfaceName string, filter []unix.SockFilter) (*Socket, error) {
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find device %s: %w", interfaceName, err)
+	}
+
+	protocol := htons(unix.ETH_P_ALL)
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, int(protocol))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	s := &Socket{fd: fd, interfaceName: interfaceName, snapLen: DefaultSnapLen}
+
+	// The filter is attached before binding, so unfiltered packets are never queued.
+	if len(filter) > 0 {
+		program := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+		if err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &program); err != nil {
+			s.Close()
+			return nil, fmt.Errorf("failed to attach the filter: %w", err)
+		}
+	}
+
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: protocol, Ifindex: iface.Index}); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("failed to bind to device %s: %w", interfaceName, err)
+	}
+
+	timeout := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("failed to set the read timeout: %w", err)
+	}
+	return s, nil
+}
+
+// Close closes the socket.
+func (s *Socket) Close() error {
+	return unix.Close(s.fd)
+}
+
+// Stream writes the captured packets as pcapng to the writer, until the stop
+// channel is closed or writing fails.
+func (s *Socket) Stream(w *Writer, stop <-chan struct{}) error {
+	buf := make([]byte, s.snapLen)
+	for {
+		select {
+		case <-stop:
+			return nil
+		default:
+		}
+
+		// MSG_TRUNC returns the original length of truncated packets.
+		n, _, err := unix.Recvfrom(s.fd, buf, unix.MSG_TRUNC)
+		if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read from device %s: %w", s.interfaceName, err)
+		}
+
+		captured := min(n, len(buf))
+		if err := w.WritePacket(time.Now(), buf[:captured], n); err != nil {
+			return err
+		}
+	}
+}
+
+// htons converts the value to the network byte order.
+func htons(v uint16) uint16 {
+	b := binary.BigEndian.AppendUint16(nil, v)
+	return binary.NativeEndian.Uint16(b)
+}
@@ -143,6 +143,11 @@ func applyDynamicIfaceRequestOnVMI(
 			vmIface.State != vmiIfaceCopy.State &&
 			vmiIfaceCopy.State != v1.InterfaceStateAbsent
 
+		shouldUpdateExistingIfaceBandwidth := existsInVMISpec &&
+			vmIface.State != v1.InterfaceStateAbsent &&
+			vmiIfaceCopy.State != v1.InterfaceStateAbsent &&
+			!equality.Semantic.DeepEqual(vmIface.Bandwidth, vmiIfaceCopy.Bandwidth)
+
 		switch {
 		case shouldHotplugIface:
 			vmiSpecCopy.Networks = append(vmiSpecCopy.Networks, vmIndexedNetworks[vmIface.Name])
@@ -154,6 +159,11 @@ func applyDynamicIfaceRequestOnVMI(
 				vmiIface.State = vmIface.State
 			}
 		}
+
+		if shouldUpdateExistingIfaceBandwidth {
+			vmiIface := vmispec.LookupInterfaceByName(vmiSpecCopy.Domain.Devices.Interfaces, vmIface.Name)
+			vmiIface.Bandwidth = vmIface.Bandwidth.DeepCopy()
+		}
 	}
 	return vmiSpecCopy
 }
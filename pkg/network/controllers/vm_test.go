@@ -39,6 +39,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/controllers"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("VM Network Controller", func() {
@@ -429,6 +430,55 @@ var _ = Describe("VM Network Controller", func() {
 		Entry("empty to empty", v1.InterfaceState(""), v1.InterfaceState("")),
 	)
 
+	DescribeTable("sync updates bandwidth of an existing interface", func(fromBandwidth, toBandwidth *v1.InterfaceBandwidth) {
+		clientset := fake.NewSimpleClientset()
+		c := controllers.NewVMController(clientset)
+		const defaultNetName = "default"
+		vmi := libvmi.New(
+			libvmi.WithInterface(v1.Interface{
+				Name:      defaultNetName,
+				Bandwidth: fromBandwidth,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{},
+				},
+			}),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+			libvmistatus.WithStatus(
+				libvmistatus.New(libvmistatus.WithInterfaceStatus(
+					v1.VirtualMachineInstanceNetworkInterface{Name: defaultNetName},
+				)),
+			),
+		)
+
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = toBandwidth
+
+		_, err = c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVMI, err := clientset.KubevirtV1().
+			VirtualMachineInstances(vmi.Namespace).
+			Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(
+			Equal(vm.Spec.Template.Spec.Domain.Devices.Interfaces))
+	},
+		Entry("none to limited", nil, &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}),
+		Entry("limited to none", &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}, nil),
+		Entry("limited to limited",
+			&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}},
+			&v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: 2000},
+				Outbound: &v1.BandwidthLimit{Average: 500, Peak: pointer.P(uint32(1000)), Burst: pointer.P(uint32(256))},
+			},
+		),
+	)
+
 	DescribeTable("sync doesn't update link state if hot-unplug is underway ", func(toState v1.InterfaceState) {
 		clientset := fake.NewSimpleClientset()
 		c := controllers.NewVMController(clientset)
@@ -0,0 +1,228 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	routerAdvertisementInterval = 10 * time.Minute
+	routerLifetime              = 3 * routerAdvertisementInterval
+
+	ndpHopLimit        = 255
+	advertisedHopLimit = 64
+
+	managedAddressConfigFlag = 0x80
+	otherConfigFlag          = 0x40
+
+	optionPrefixInformation = 3
+	optionMTU               = 5
+	prefixOnLinkFlag        = 0x80
+	infiniteLifetime        = 0xffffffff
+)
+
+var (
+	allNodesMulticast   = net.ParseIP("ff02::1")
+	allRoutersMulticast = net.ParseIP("ff02::2")
+)
+
+// RouterAdvertiser advertises a router to the hosts on a link, on behalf of that router.
+// The advertisement points the hosts to DHCPv6 for their address and other configuration.
+type RouterAdvertiser struct {
+	conn      *ipv6.PacketConn
+	iface     *net.Interface
+	sendIface *net.Interface
+	router    net.IP
+	message   []byte
+}
+
+// NewRouterAdvertiser opens the advertiser socket in the current network namespace.
+// Solicitations are read from the bridge the hosts are connected to, while the advertisements are sent only
+// through the given bridge port, so they do not leave the bridge through its other ports.
+// The router address is expected to be a link-local address of another node on the link, therefore the
+// socket is set to allow sending from a non-local address.
+func NewRouterAdvertiser(bridgeName, portName string, router net.IP, prefix *net.IPNet, mtu int) (*RouterAdvertiser, error) {
+	const errorString = "failed creating router advertiser"
+	if !router.IsLinkLocalUnicast() {
+		return nil, fmt.Errorf("%s: router address %s is not link-local", errorString, router)
+	}
+
+	iface, err := net.InterfaceByName(bridgeName)
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	sendIface, err := net.InterfaceByName(portName)
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+
+	lc := net.ListenConfig{Control: freebindControl}
+	c, err := lc.ListenPacket(context.Background(), "ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	conn := ipv6.NewPacketConn(c)
+	if err := setupRouterAdvertiserConn(conn, iface); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+
+	return &RouterAdvertiser{
+		conn:      conn,
+		iface:     iface,
+		sendIface: sendIface,
+		router:    router,
+		message:   routerAdvertisement(prefix, mtu),
+	}, nil
+}
+
+func setupRouterAdvertiserConn(conn *ipv6.PacketConn, iface *net.Interface) error {
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+	if err := conn.SetICMPFilter(&filter); err != nil {
+		return err
+	}
+	if err := conn.SetControlMessage(ipv6.FlagInterface, true); err != nil {
+		return err
+	}
+	if err := conn.SetMulticastHopLimit(ndpHopLimit); err != nil {
+		return err
+	}
+	if err := conn.SetHopLimit(ndpHopLimit); err != nil {
+		return err
+	}
+	return conn.JoinGroup(iface, &net.IPAddr{IP: allRoutersMulticast})
+}
+
+func freebindControl(_, _ string, c syscall.RawConn) error {
+	var opErr error
+	err := c.Control(func(fd uintptr) {
+		opErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_FREEBIND, 1)
+	})
+	if err != nil {
+		return err
+	}
+	return opErr
+}
+
+// Serve sends periodic advertisements and answers router solicitations, until the advertiser is closed.
+func (r *RouterAdvertiser) Serve() {
+	solicitations := make(chan struct{}, 1)
+	go r.readSolicitations(solicitations)
+
+	ticker := time.NewTicker(routerAdvertisementInterval)
+	defer ticker.Stop()
+
+	r.advertise()
+	for {
+		select {
+		case <-ticker.C:
+			r.advertise()
+		case _, ok := <-solicitations:
+			if !ok {
+				return
+			}
+			r.advertise()
+		}
+	}
+}
+
+func (r *RouterAdvertiser) Close() error {
+	return r.conn.Close()
+}
+
+func (r *RouterAdvertiser) readSolicitations(solicitations chan<- struct{}) {
+	defer close(solicitations)
+	buf := make([]byte, r.iface.MTU)
+	for {
+		_, cm, _, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if cm == nil || cm.IfIndex != r.iface.Index {
+			continue
+		}
+		select {
+		case solicitations <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (r *RouterAdvertiser) advertise() {
+	cm := &ipv6.ControlMessage{Src: r.router, IfIndex: r.sendIface.Index, HopLimit: ndpHopLimit}
+	dst := &net.IPAddr{IP: allNodesMulticast, Zone: r.sendIface.Name}
+	if _, err := r.conn.WriteTo(r.message, cm, dst); err != nil {
+		log.Log.Reason(err).Errorf("failed to send a router advertisement on %s", r.sendIface.Name)
+	}
+}
+
+// routerAdvertisement builds the ICMPv6 router advertisement message (RFC 4861).
+// The checksum is left empty as it is calculated by the kernel.
+func routerAdvertisement(prefix *net.IPNet, mtu int) []byte {
+	const (
+		headerLength           = 16
+		mtuOptionLength        = 8
+		prefixInfoOptionLength = 32
+	)
+
+	msg := make([]byte, headerLength, headerLength+mtuOptionLength+prefixInfoOptionLength)
+	msg[0] = byte(ipv6.ICMPTypeRouterAdvertisement)
+	msg[4] = advertisedHopLimit
+	msg[5] = managedAddressConfigFlag | otherConfigFlag
+	binary.BigEndian.PutUint16(msg[6:8], uint16(routerLifetime.Seconds()))
+
+	if mtu > 0 {
+		option := make([]byte, mtuOptionLength)
+		option[0] = optionMTU
+		option[1] = mtuOptionLength / 8
+		binary.BigEndian.PutUint32(option[4:8], uint32(mtu))
+		msg = append(msg, option...)
+	}
+
+	// Only the on-link information is advertised, the address itself is served by DHCPv6.
+	if prefix != nil {
+		if prefixLength, _ := prefix.Mask.Size(); prefixLength > 0 && prefixLength < 128 {
+			option := make([]byte, prefixInfoOptionLength)
+			option[0] = optionPrefixInformation
+			option[1] = prefixInfoOptionLength / 8
+			option[2] = byte(prefixLength)
+			option[3] = prefixOnLinkFlag
+			binary.BigEndian.PutUint32(option[4:8], infiniteLifetime)
+			binary.BigEndian.PutUint32(option[8:12], infiniteLifetime)
+			copy(option[16:32], prefix.IP.Mask(prefix.Mask).To16())
+			msg = append(msg, option...)
+		}
+	}
+
+	return msg
+}
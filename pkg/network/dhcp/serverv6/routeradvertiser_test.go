@@ -0,0 +1,66 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"encoding/hex"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router advertisement", func() {
+	const (
+		expectedHeader = "86000000" + "40c0" + "0708" + "00000000" + "00000000"
+		expectedMTU    = "0501" + "0000" + "000005dc"
+	)
+
+	It("should advertise the MTU and the on-link prefix", func() {
+		_, prefix, err := net.ParseCIDR("fd10:0:2::/64")
+		Expect(err).ToNot(HaveOccurred())
+
+		const expectedPrefixInformation = "0304" + "40" + "80" + "ffffffff" + "ffffffff" + "00000000" +
+			"fd100000000200000000000000000000"
+		Expect(routerAdvertisement(prefix, 1500)).To(Equal(hexToBytes(expectedHeader + expectedMTU + expectedPrefixInformation)))
+	})
+
+	It("should not advertise a host prefix", func() {
+		_, prefix, err := net.ParseCIDR("fd10:0:2::2/128")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(routerAdvertisement(prefix, 1500)).To(Equal(hexToBytes(expectedHeader + expectedMTU)))
+	})
+
+	It("should not advertise an unknown MTU", func() {
+		Expect(routerAdvertisement(nil, 0)).To(Equal(hexToBytes(expectedHeader)))
+	})
+
+	It("should refuse a router address that is not link-local", func() {
+		_, err := NewRouterAdvertiser("lo", "lo", net.ParseIP("fd10:0:2::1"), nil, 1500)
+		Expect(err).To(MatchError(ContainSubstring("is not link-local")))
+	})
+})
+
+func hexToBytes(s string) []byte {
+	b, err := hex.DecodeString(s)
+	Expect(err).ToNot(HaveOccurred())
+	return b
+}
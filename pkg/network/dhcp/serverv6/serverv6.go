@@ -20,6 +20,7 @@
 package serverv6
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"time"
@@ -37,10 +38,11 @@ const (
 
 type DHCPv6Handler struct {
 	clientIP  net.IP
+	clientMAC net.HardwareAddr
 	modifiers []dhcpv6.Modifier
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
+func SingleClientDHCPv6Server(clientIP net.IP, clientMAC net.HardwareAddr, serverIfaceName string, dnsServers []net.IP, searchDomains []string) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,10 +50,11 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr)
+	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, dnsServers, searchDomains)
 
 	handler := &DHCPv6Handler{
 		clientIP:  clientIP,
+		clientMAC: clientMAC,
 		modifiers: modifiers,
 	}
 
@@ -76,7 +79,10 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6.DHCPv6) {
 	log.Log.V(4).Info("DHCPv6 serving a new request")
 
-	// TODO if we extend the server to support bridge binding, we need to filter out non-vm requests
+	if len(h.clientMAC) != 0 && !h.isFromClient(peer, m) {
+		log.Log.V(4).Info("DHCPv6 - the request is not from our client")
+		return // Is not our client
+	}
 
 	response, err := h.buildResponse(m)
 	if err != nil {
@@ -89,6 +95,37 @@ func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6
 	}
 }
 
+// isFromClient identifies the client either by the link-layer address of its DUID, or, for DUIDs which do not
+// carry one, by its link-local address being derived from the client MAC.
+func (h *DHCPv6Handler) isFromClient(peer net.Addr, msg dhcpv6.DHCPv6) bool {
+	dhcpv6Msg, ok := msg.(*dhcpv6.Message)
+	if !ok {
+		return false
+	}
+
+	switch duid := dhcpv6Msg.Options.ClientID().(type) {
+	case *dhcpv6.DUIDLL:
+		return bytes.Equal(duid.LinkLayerAddr, h.clientMAC)
+	case *dhcpv6.DUIDLLT:
+		return bytes.Equal(duid.LinkLayerAddr, h.clientMAC)
+	}
+
+	udpPeer, ok := peer.(*net.UDPAddr)
+	return ok && udpPeer.IP.Equal(eui64LinkLocalAddress(h.clientMAC))
+}
+
+func eui64LinkLocalAddress(mac net.HardwareAddr) net.IP {
+	if len(mac) != 6 {
+		return nil
+	}
+	ip := make(net.IP, net.IPv6len)
+	ip[0], ip[1] = 0xfe, 0x80
+	ip[8], ip[9], ip[10] = mac[0]^0x02, mac[1], mac[2]
+	ip[11], ip[12] = 0xff, 0xfe
+	ip[13], ip[14], ip[15] = mac[3], mac[4], mac[5]
+	return ip
+}
+
 func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error) {
 	var response *dhcpv6.Message
 	var err error
@@ -121,9 +158,16 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr) []dhcpv6.Modifier {
+func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr, dnsServers []net.IP, searchDomains []string) []dhcpv6.Modifier {
 	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
 	duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
-	return []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	if len(dnsServers) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDNS(dnsServers...))
+	}
+	if len(searchDomains) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(searchDomains...))
+	}
+	return modifiers
 }
@@ -34,7 +34,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +53,71 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with DNS details", func() {
+		It("should contain the DNS servers and the domain search list", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			dnsServers := []net.IP{net.ParseIP("fd00:10:96::a")}
+			searchDomains := []string{"default.svc.cluster.local", "cluster.local"}
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, dnsServers, searchDomains)
+			Expect(modifiers).To(HaveLen(4))
+
+			msg := &dhcpv6.Message{
+				MessageType: dhcpv6.MessageTypeReply,
+			}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+			Expect(msg.Options.DNS()).To(Equal(dnsServers))
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal(searchDomains))
+		})
+	})
+	Context("isFromClient", func() {
+		var handler *DHCPv6Handler
+
+		BeforeEach(func() {
+			clientMac, _ := net.ParseMAC("34:56:78:9A:BC:DE")
+			handler = &DHCPv6Handler{clientMAC: clientMac}
+		})
+
+		newMessageFromDUID := func(duid dhcpv6.DUID) *dhcpv6.Message {
+			clientMessage, err := dhcpv6.NewMessage(dhcpv6.WithClientID(duid))
+			Expect(err).ToNot(HaveOccurred())
+			return clientMessage
+		}
+
+		peer := &net.UDPAddr{IP: net.ParseIP("fe80::1")}
+		otherMac, _ := net.ParseMAC("02:00:00:00:00:01")
+
+		It("should accept a request with the client MAC in its DUID", func() {
+			clientMessage, err := newMessage(dhcpv6.MessageTypeSolicit)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler.isFromClient(peer, clientMessage)).To(BeTrue())
+		})
+		It("should accept a request with the client MAC in its DUID-LLT", func() {
+			clientMessage := newMessageFromDUID(&dhcpv6.DUIDLLT{HWType: iana.HWTypeEthernet, LinkLayerAddr: handler.clientMAC})
+			Expect(handler.isFromClient(peer, clientMessage)).To(BeTrue())
+		})
+		It("should reject a request with another MAC in its DUID", func() {
+			clientMessage := newMessageFromDUID(&dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: otherMac})
+			Expect(handler.isFromClient(&net.UDPAddr{IP: net.ParseIP("fe80::3656:78ff:fe9a:bcde")}, clientMessage)).To(BeFalse())
+		})
+		It("should accept a request without a link-layer DUID from the client link-local address", func() {
+			clientMessage := newMessageFromDUID(&dhcpv6.DUIDUUID{UUID: [16]byte{1}})
+			Expect(handler.isFromClient(&net.UDPAddr{IP: net.ParseIP("fe80::3656:78ff:fe9a:bcde")}, clientMessage)).To(BeTrue())
+		})
+		It("should reject a request without a link-layer DUID from another link-local address", func() {
+			clientMessage := newMessageFromDUID(&dhcpv6.DUIDUUID{UUID: [16]byte{1}})
+			Expect(handler.isFromClient(peer, clientMessage)).To(BeFalse())
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
@@ -50,6 +50,29 @@ func ParseNameservers(content string) ([][]byte, error) {
 	return nameservers, nil
 }
 
+// ParseIPv6Nameservers returns the IPv6 nameservers, no default is applied when none is found.
+func ParseIPv6Nameservers(content string) ([]net.IP, error) {
+	var nameservers []net.IP
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != nameserverPrefix {
+			continue
+		}
+		if nameserver := net.ParseIP(fields[1]); nameserver != nil && nameserver.To4() == nil {
+			nameservers = append(nameservers, nameserver)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nameservers, nil
+}
+
 func ParseSearchDomains(content string) ([]string, error) {
 	var searchDomains []string
 
@@ -152,3 +175,15 @@ func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
 
 	return nameservers, searchDomains, err
 }
+
+// GetIPv6NameserversFromPod reads the IPv6 nameservers from the DNS resolver's configuration file.
+func GetIPv6NameserversFromPod() ([]net.IP, error) {
+	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
+	const resolvConf = "/etc/resolv.conf"
+
+	b, err := os.ReadFile(resolvConf)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIPv6Nameservers(string(b))
+}
@@ -0,0 +1,56 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec
+
+import (
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// BandwidthFromInterface renders the bandwidth limits of a VMI interface
+// as a libvirt interface bandwidth element.
+func BandwidthFromInterface(iface *v1.Interface) *api.BandWidth {
+	if iface.Bandwidth == nil || (iface.Bandwidth.Inbound == nil && iface.Bandwidth.Outbound == nil) {
+		return nil
+	}
+
+	return &api.BandWidth{
+		Inbound:  bandwidthLimit(iface.Bandwidth.Inbound),
+		Outbound: bandwidthLimit(iface.Bandwidth.Outbound),
+	}
+}
+
+func bandwidthLimit(limit *v1.BandwidthLimit) *api.BandWidthLimit {
+	if limit == nil {
+		return nil
+	}
+
+	domainLimit := &api.BandWidthLimit{Average: strconv.FormatUint(uint64(limit.Average), 10)}
+	if limit.Peak != nil {
+		domainLimit.Peak = strconv.FormatUint(uint64(*limit.Peak), 10)
+	}
+	if limit.Burst != nil {
+		domainLimit.Burst = strconv.FormatUint(uint64(*limit.Burst), 10)
+	}
+	return domainLimit
+}
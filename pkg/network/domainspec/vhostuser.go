@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+func HasVhostUserAttachment(domainAttachmentByInterfaceName map[string]string) bool {
+	for _, domainAttachment := range domainAttachmentByInterfaceName {
+		if domainAttachment == string(v1.VhostUser) {
+			return true
+		}
+	}
+	return false
+}
+
+// VhostUserSourceByInterfaceName maps the interfaces with a vhost-user domain attachment
+// to the socket reported in the network-info of their network.
+func VhostUserSourceByInterfaceName(
+	domainAttachmentByInterfaceName map[string]string,
+	networkInfoBytes []byte,
+) (map[string]api.InterfaceSource, error) {
+	if len(networkInfoBytes) == 0 {
+		return nil, errors.New("network-info is not populated")
+	}
+	var networkInfo downwardapi.NetworkInfo
+	if err := json.Unmarshal(networkInfoBytes, &networkInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network-info: %w", err)
+	}
+
+	vhostDeviceByNetworkName := map[string]*networkv1.VhostDevice{}
+	for _, iface := range networkInfo.Interfaces {
+		if iface.DeviceInfo != nil && iface.DeviceInfo.VhostUser != nil && iface.DeviceInfo.VhostUser.Path != "" {
+			vhostDeviceByNetworkName[iface.Network] = iface.DeviceInfo.VhostUser
+		}
+	}
+
+	sourceByInterfaceName := map[string]api.InterfaceSource{}
+	for ifaceName, domainAttachment := range domainAttachmentByInterfaceName {
+		if domainAttachment != string(v1.VhostUser) {
+			continue
+		}
+		vhostDevice, exists := vhostDeviceByNetworkName[ifaceName]
+		if !exists {
+			return nil, fmt.Errorf("vhost-user socket for network %q not found in network-info", ifaceName)
+		}
+		// The device info mode is the role of the socket consumer, which is the guest.
+		mode := vhostDevice.Mode
+		if mode == "" {
+			mode = networkv1.VhostDeviceModeClient
+		}
+		sourceByInterfaceName[ifaceName] = api.InterfaceSource{
+			Type: "unix",
+			Path: vhostDevice.Path,
+			Mode: mode,
+		}
+	}
+	return sourceByInterfaceName, nil
+}
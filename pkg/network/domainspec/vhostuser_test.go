@@ -0,0 +1,69 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package domainspec_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("vhost-user domain attachment", func() {
+	const networkInfo = `{"interfaces":[` +
+		`{"network":"dpdk","deviceInfo":{"type":"vhost-user","version":"1.0.0","vhost-user":{"mode":"server","path":"/var/run/vhostuser/dpdk.sock"}}},` +
+		`{"network":"sriov","deviceInfo":{"type":"pci","version":"1.0.0","pci":{"pci-address":"0000:65:00.2"}}}]}`
+
+	domainAttachments := map[string]string{
+		"default": string(v1.Tap),
+		"dpdk":    string(v1.VhostUser),
+	}
+
+	It("should detect a vhost-user domain attachment", func() {
+		Expect(domainspec.HasVhostUserAttachment(domainAttachments)).To(BeTrue())
+		Expect(domainspec.HasVhostUserAttachment(map[string]string{"default": string(v1.Tap)})).To(BeFalse())
+	})
+
+	It("should map the vhost-user interfaces to their socket", func() {
+		Expect(domainspec.VhostUserSourceByInterfaceName(domainAttachments, []byte(networkInfo))).To(Equal(
+			map[string]api.InterfaceSource{"dpdk": {Type: "unix", Path: "/var/run/vhostuser/dpdk.sock", Mode: "server"}},
+		))
+	})
+
+	It("should default to the client mode", func() {
+		const clientNetworkInfo = `{"interfaces":[{"network":"dpdk","deviceInfo":{"type":"vhost-user","vhost-user":{"path":"/dpdk.sock"}}}]}`
+		Expect(domainspec.VhostUserSourceByInterfaceName(domainAttachments, []byte(clientNetworkInfo))).To(Equal(
+			map[string]api.InterfaceSource{"dpdk": {Type: "unix", Path: "/dpdk.sock", Mode: "client"}},
+		))
+	})
+
+	DescribeTable("should fail", func(networkInfo, expectedErr string) {
+		_, err := domainspec.VhostUserSourceByInterfaceName(domainAttachments, []byte(networkInfo))
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("when the network-info is not populated", "", "network-info is not populated"),
+		Entry("when the network-info is malformed", "{", "failed to unmarshal network-info"),
+		Entry("when the socket is not reported", `{"interfaces":[{"network":"dpdk"}]}`,
+			`vhost-user socket for network "dpdk" not found in network-info`),
+	)
+})
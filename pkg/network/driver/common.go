@@ -194,10 +194,18 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 	}
 
 	if nic.IPv6.IPNet != nil {
+		ipv6Nameservers, err := dns.GetIPv6NameserversFromPod()
+		if err != nil {
+			return fmt.Errorf("Failed to get IPv6 DNS servers from resolv.conf: %v", err)
+		}
+
 		go func() {
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
+				nic.MAC,
 				bridgeInterfaceName,
+				ipv6Nameservers,
+				searchDomains,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6 Server")
 				panic(err)
@@ -22,6 +22,7 @@ package nft
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 type NFTBin struct{}
@@ -29,8 +30,9 @@ type NFTBin struct{}
 type IPFamily string
 
 const (
-	IPv4 IPFamily = "ip"
-	IPv6 IPFamily = "ip6"
+	IPv4   IPFamily = "ip"
+	IPv6   IPFamily = "ip6"
+	Bridge IPFamily = "bridge"
 )
 
 const (
@@ -48,12 +50,40 @@ func (n NFTBin) AddChain(family IPFamily, table, name string, chainspec ...strin
 	return execute(cmd)
 }
 
+func (n NFTBin) FlushChain(family IPFamily, table, name string) error {
+	cmd := exec.Command(nftBin, "flush", "chain", string(family), table, name)
+	return execute(cmd)
+}
+
 func (n NFTBin) AddRule(family IPFamily, table, chain string, rulespec ...string) error {
 	args := append([]string{"add", "rule", string(family), table, chain}, rulespec...)
 	cmd := exec.Command(nftBin, args...)
 	return execute(cmd)
 }
 
+func (n NFTBin) AddSet(family IPFamily, table, name string, setspec ...string) error {
+	args := append([]string{"add", "set", string(family), table, name}, setspec...)
+	cmd := exec.Command(nftBin, args...)
+	return execute(cmd)
+}
+
+func (n NFTBin) AddMap(family IPFamily, table, name string, mapspec ...string) error {
+	args := append([]string{"add", "map", string(family), table, name}, mapspec...)
+	cmd := exec.Command(nftBin, args...)
+	return execute(cmd)
+}
+
+func (n NFTBin) FlushSet(family IPFamily, table, name string) error {
+	cmd := exec.Command(nftBin, "flush", "set", string(family), table, name)
+	return execute(cmd)
+}
+
+func (n NFTBin) AddElements(family IPFamily, table, set string, elements ...string) error {
+	args := []string{"add", "element", string(family), table, set, "{ " + strings.Join(elements, ", ") + " }"}
+	cmd := exec.Command(nftBin, args...)
+	return execute(cmd)
+}
+
 func execute(cmd *exec.Cmd) error {
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("%s, error: %v", string(output), err)
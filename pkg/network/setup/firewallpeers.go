@@ -0,0 +1,54 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+)
+
+// syncFirewallPeers updates the firewall peer sets of the VMI interfaces with the addresses of the
+// peers selected by labels, which virt-controller resolves into the VMI status.
+// The peers change while the VMI is running, so they are reconciled against all the VMI interfaces,
+// regardless of the networks being set up. The applied peers are kept to skip the unchanged ones.
+func (c *NetConf) syncFirewallPeers(vmi *v1.VirtualMachineInstance, state *netpod.State) error {
+	vmiUID := string(vmi.UID)
+
+	c.firewallPeersMutex.Lock()
+	defer c.firewallPeersMutex.Unlock()
+
+	if applied, exists := c.firewallPeers[vmiUID]; exists && equality.Semantic.DeepEqual(applied, vmi.Status.FirewallPeers) {
+		return nil
+	}
+
+	err := state.NSExec.Do(func() error {
+		return c.firewallAdapter.UpdatePeers(vmi.Spec.Domain.Devices.Interfaces, vmi.Status.FirewallPeers)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update the firewall peers: %w", err)
+	}
+	c.firewallPeers[vmiUID] = vmi.Status.FirewallPeers
+	return nil
+}
@@ -34,10 +34,12 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/dhcp/serverv6"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
@@ -50,12 +52,23 @@ type clusterConfigurer interface {
 	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
 }
 
+type firewallAdapter interface {
+	UpdatePeers(ifaces []v1.Interface, peers []v1.VirtualMachineInstanceFirewallPeers) error
+}
+
 type NetConf struct {
 	cacheCreator     cacheCreator
 	nsFactory        nsFactory
 	state            map[string]*netpod.State
 	configStateMutex *sync.RWMutex
 
+	routerAdvertisers      map[string]map[string]*serverv6.RouterAdvertiser
+	routerAdvertisersMutex *sync.Mutex
+
+	firewallAdapter    firewallAdapter
+	firewallPeers      map[string][]v1.VirtualMachineInstanceFirewallPeers
+	firewallPeersMutex *sync.Mutex
+
 	clusterConfigurer clusterConfigurer
 }
 
@@ -79,6 +92,13 @@ func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator
 		cacheCreator:      cacheCreator,
 		nsFactory:         nsFactory,
 		clusterConfigurer: clusterConfigurer,
+
+		routerAdvertisers:      map[string]map[string]*serverv6.RouterAdvertiser{},
+		routerAdvertisersMutex: &sync.Mutex{},
+
+		firewallAdapter:    firewall.New(),
+		firewallPeers:      map[string][]v1.VirtualMachineInstanceFirewallPeers{},
+		firewallPeersMutex: &sync.Mutex{},
 	}
 }
 
@@ -118,11 +138,16 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		netpod.WithBindingPlugins(c.clusterConfigurer.GetNetworkBindings()),
 		netpod.WithLogger(log.Log.Object(vmi)),
 		netpod.WithVMIIfaceStatuses(vmi.Status.Interfaces),
+		netpod.WithIPAllocations(vmi.Status.IPAllocations),
 	)
 
 	if err := netpod.Setup(); err != nil {
 		return fmt.Errorf("setup failed, err: %w", err)
 	}
+	c.syncRouterAdvertisers(vmi, launcherPid)
+	if err := c.syncFirewallPeers(vmi, state); err != nil {
+		return fmt.Errorf("setup failed, err: %w", err)
+	}
 	return nil
 }
 
@@ -152,6 +177,12 @@ func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network
 }
 
 func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
+	c.stopRouterAdvertisers(vmi)
+
+	c.firewallPeersMutex.Lock()
+	delete(c.firewallPeers, string(vmi.UID))
+	c.firewallPeersMutex.Unlock()
+
 	c.configStateMutex.Lock()
 	delete(c.state, string(vmi.UID))
 	c.configStateMutex.Unlock()
@@ -38,7 +38,25 @@ import (
 func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Status, podIfaceStatus nmstate.Interface, vmiSpecIface v1.Interface, podIfaceName string) error {
 	var dhcpConfig cache.DHCPConfig
 	dhcpConfig.IPAMDisabled = true
-	if ipAddress := firstIPGlobalUnicast(podIfaceStatus.IPv4); ipAddress != nil {
+	if ipAllocation := n.lookupIPAllocation(vmiSpecIface.Name); ipAllocation != nil && !hasIPGlobalUnicast(podIfaceStatus.IPv4) {
+		dhcpConfig.IPAMDisabled = false
+
+		addr, err := vishnetlink.ParseAddr(ipAllocation.Address)
+		if err != nil {
+			return err
+		}
+		dhcpConfig.IP = *addr
+
+		mac, err := resolveMacAddress(podIfaceStatus.MacAddress, vmiSpecIface.MacAddress)
+		if err != nil {
+			return err
+		}
+		dhcpConfig.MAC = mac
+
+		if ipAllocation.Gateway != "" {
+			dhcpConfig.Gateway = net.ParseIP(ipAllocation.Gateway)
+		}
+	} else if ipAddress := firstIPGlobalUnicast(podIfaceStatus.IPv4); ipAddress != nil {
 		dhcpConfig.IPAMDisabled = false
 
 		addr, iperr := vishnetlink.ParseAddr(fmt.Sprintf("%s/%d", ipAddress.IP, ipAddress.PrefixLen))
@@ -73,6 +91,24 @@ func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Statu
 		}
 	}
 
+	if ipAddress := firstIPGlobalUnicast(podIfaceStatus.IPv6); ipAddress != nil {
+		dhcpConfig.IPAMDisabled = false
+
+		addr, err := vishnetlink.ParseAddr(fmt.Sprintf("%s/%d", ipAddress.IP, ipAddress.PrefixLen))
+		if err != nil {
+			return err
+		}
+		dhcpConfig.IPv6 = *addr
+
+		mac, err := resolveMacAddress(podIfaceStatus.MacAddress, vmiSpecIface.MacAddress)
+		if err != nil {
+			return err
+		}
+		dhcpConfig.MAC = mac
+
+		dhcpConfig.IPv6Gateway = lookupIPv6DefaultGateway(currentStatus, podIfaceName)
+	}
+
 	log.Log.V(4).Infof("The generated dhcpConfig: %s\nRoutes: %+v", dhcpConfig.String(), dhcpConfig.Routes)
 	if err := cache.WriteDHCPInterfaceCache(n.cacheCreator, strconv.Itoa(n.podPID), podIfaceName, &dhcpConfig); err != nil {
 		return fmt.Errorf("failed to save DHCP configuration: %v", err)
@@ -163,6 +199,18 @@ func filterIPv4RoutesByInterface(currentStatus *nmstate.Status, podIfaceName str
 	return linkRoutes, nil
 }
 
+// lookupIPv6DefaultGateway returns the next hop of the IPv6 default route through the pod interface.
+// A pod network may not provide an IPv6 default route, in which case nil is returned.
+func lookupIPv6DefaultGateway(currentStatus *nmstate.Status, podIfaceName string) net.IP {
+	defaultDestination := nmstate.DefaultDestinationRoute(vishnetlink.FAMILY_V6).String()
+	for _, route := range currentStatus.Routes.Running {
+		if route.NextHopInterface == podIfaceName && route.Destination == defaultDestination && route.NextHopAddress != "" {
+			return net.ParseIP(route.NextHopAddress)
+		}
+	}
+	return nil
+}
+
 func resolveMacAddress(macAddressFromCurrent string, macAddressFromVMISpec string) (net.HardwareAddr, error) {
 	macAddress := macAddressFromCurrent
 	if macAddressFromVMISpec != "" {
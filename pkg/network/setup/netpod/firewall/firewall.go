@@ -0,0 +1,438 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+)
+
+type nftable interface {
+	AddTable(family nft.IPFamily, name string) error
+	AddChain(family nft.IPFamily, table, name string, chainspec ...string) error
+	FlushChain(family nft.IPFamily, table, name string) error
+	AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error
+	AddSet(family nft.IPFamily, table, name string, setspec ...string) error
+	AddMap(family nft.IPFamily, table, name string, mapspec ...string) error
+	FlushSet(family nft.IPFamily, table, name string) error
+	AddElements(family nft.IPFamily, table, set string, elements ...string) error
+}
+
+type Firewall struct {
+	nftable            nftable
+	kernelModuleLoaded func(name string) bool
+}
+
+// Interface holds the addresses of a filtered interface and the tap device its traffic is bridged through.
+type Interface struct {
+	TapName string
+	Spec    v1.Interface
+	MAC     string
+	IPv4    string
+}
+
+const (
+	filterTable  = "kubevirt_fw"
+	forwardChain = "forward"
+
+	// The forward chain dispatches the traffic to the per-interface chains through maps keyed by the
+	// tap device, so the interfaces can be set up separately, e.g. on hotplug, without dropping the
+	// jumps to the chains of the interfaces set up before.
+	ingressJumpsMap = "ingress-jumps"
+	egressJumpsMap  = "egress-jumps"
+
+	ingressChainPrefix = "ingress-"
+	egressChainPrefix  = "egress-"
+
+	ingressDirection = "ingress"
+	egressDirection  = "egress"
+
+	// The addresses of the peers selected by labels are kept in sets, one per rule and IP family,
+	// which are updated as the peers come and go without rebuilding the rules.
+	peerSetPrefix = "peers-"
+
+	// bridgeConntrackModule provides the connection tracking of bridged traffic,
+	// which is needed to accept the replies to the allowed traffic.
+	bridgeConntrackModule = "nf_conntrack_bridge"
+	kernelModulesPath     = "/sys/module"
+)
+
+type option func(*Firewall)
+
+func New(opts ...option) Firewall {
+	f := Firewall{nftable: nft.NFTBin{}, kernelModuleLoaded: isKernelModuleLoaded}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return f
+}
+
+func WithNftableAdapter(h nftable) option {
+	return func(f *Firewall) {
+		f.nftable = h
+	}
+}
+
+func WithKernelModuleLookup(lookup func(name string) bool) option {
+	return func(f *Firewall) {
+		f.kernelModuleLoaded = lookup
+	}
+}
+
+// Setup filters the traffic bridged through the tap devices of the given interfaces.
+// The forward chain and the per-interface chains are flushed before being populated,
+// allowing the setup to be repeated. The jumps to the chains of interfaces which are
+// not given are kept.
+func (f Firewall) Setup(ifaces []Interface) error {
+	var filtered []Interface
+	for _, iface := range ifaces {
+		if iface.Spec.Firewall != nil {
+			filtered = append(filtered, iface)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	if slices.ContainsFunc(filtered, filtersDirection) && !f.kernelModuleLoaded(bridgeConntrackModule) {
+		return fmt.Errorf("filtering the ingress or egress traffic requires the %s kernel module to be loaded on the node", bridgeConntrackModule)
+	}
+
+	if err := f.nftable.AddTable(nft.Bridge, filterTable); err != nil {
+		return err
+	}
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, forwardChain, "{ type filter hook forward priority 0; }"); err != nil {
+		return err
+	}
+	if err := f.nftable.FlushChain(nft.Bridge, filterTable, forwardChain); err != nil {
+		return err
+	}
+	if err := f.addJumps("iifname", egressJumpsMap); err != nil {
+		return err
+	}
+	if err := f.addJumps("oifname", ingressJumpsMap); err != nil {
+		return err
+	}
+
+	for _, iface := range filtered {
+		if err := f.setupInterface(iface); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addJumps dispatches the forwarded traffic to the chain jumpsMap holds for its ifname.
+func (f Firewall) addJumps(ifname, jumpsMap string) error {
+	if err := f.nftable.AddMap(nft.Bridge, filterTable, jumpsMap, "{ type ifname : verdict; }"); err != nil {
+		return err
+	}
+	return f.nftable.AddRule(nft.Bridge, filterTable, forwardChain, ifname, "vmap", "@"+jumpsMap)
+}
+
+func (f Firewall) setupInterface(iface Interface) error {
+	// The peer sets are only created, their addresses are kept as the interface is set up again.
+	for _, set := range peerSets(iface.Spec, nil) {
+		if err := f.addPeerSet(set); err != nil {
+			return err
+		}
+	}
+
+	firewall := iface.Spec.Firewall
+	if egressRules := f.egressRules(iface.Spec.Name, firewall, iface.MAC, iface.IPv4); egressRules != nil {
+		chain := egressChainPrefix + iface.TapName
+		if err := f.setupChain(chain, egressRules); err != nil {
+			return err
+		}
+		if err := f.nftable.AddElements(nft.Bridge, filterTable, egressJumpsMap, iface.TapName+" : jump "+chain); err != nil {
+			return err
+		}
+	}
+
+	if firewall.Ingress != nil {
+		chain := ingressChainPrefix + iface.TapName
+		if err := f.setupChain(chain, directionRules(iface.Spec.Name, ingressDirection, firewall.Ingress)); err != nil {
+			return err
+		}
+		if err := f.nftable.AddElements(nft.Bridge, filterTable, ingressJumpsMap, iface.TapName+" : jump "+chain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func filtersDirection(iface Interface) bool {
+	return iface.Spec.Firewall.Ingress != nil || iface.Spec.Firewall.Egress != nil
+}
+
+func isKernelModuleLoaded(name string) bool {
+	_, err := os.Stat(filepath.Join(kernelModulesPath, name))
+	return err == nil
+}
+
+func (f Firewall) setupChain(chain string, rules [][]string) error {
+	if err := f.nftable.AddChain(nft.Bridge, filterTable, chain); err != nil {
+		return err
+	}
+	if err := f.nftable.FlushChain(nft.Bridge, filterTable, chain); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if err := f.nftable.AddRule(nft.Bridge, filterTable, chain, rule...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f Firewall) egressRules(ifaceName string, firewall *v1.InterfaceFirewall, mac, ipv4 string) [][]string {
+	var rules [][]string
+	if firewall.AntiSpoofing == nil || *firewall.AntiSpoofing {
+		rules = antiSpoofingRules(mac, ipv4)
+	}
+	if firewall.Egress != nil {
+		rules = append(rules, directionRules(ifaceName, egressDirection, firewall.Egress)...)
+	}
+	return rules
+}
+
+func antiSpoofingRules(mac, ipv4 string) [][]string {
+	var rules [][]string
+	if mac != "" {
+		rules = append(rules, []string{"ether", "saddr", "!=", mac, "counter", "drop"})
+	}
+	if ipv4 != "" {
+		rules = append(rules,
+			[]string{"ether", "type", "ip", "ip", "saddr", "!=", ipv4, "counter", "drop"},
+			[]string{"ether", "type", "arp", "arp", "saddr", "ip", "!=", ipv4, "counter", "drop"},
+		)
+	}
+	return rules
+}
+
+// directionRules renders the allow list of a single direction, where the peer is the
+// source of the ingress traffic and the destination of the egress traffic.
+func directionRules(ifaceName, direction string, firewallRules *v1.FirewallRules) [][]string {
+	peerMatch := "saddr"
+	if direction == egressDirection {
+		peerMatch = "daddr"
+	}
+
+	rules := [][]string{
+		{"ct", "state", "established,related", "counter", "accept"},
+		{"ether", "type", "arp", "counter", "accept"},
+		{"icmpv6", "type", "{ nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert }", "counter", "accept"},
+	}
+	for idx, rule := range firewallRules.Allow {
+		rules = append(rules, allowRules(rule, peerMatch, rulePeerSetsName(ifaceName, direction, idx))...)
+	}
+	return append(rules, []string{"counter", "drop"})
+}
+
+// allowRules renders a single allow rule, the peers selected by labels are matched
+// against the sets of the rule, which are named after peerSets.
+func allowRules(rule v1.FirewallRule, peerMatch, peerSets string) [][]string {
+	var match []string
+	if l4proto := protocolToL4Proto(rule.Protocol); l4proto != "" {
+		match = append(match, "meta", "l4proto", l4proto)
+	}
+	if len(rule.Ports) > 0 {
+		match = append(match, "th", "dport", portsSet(rule.Ports))
+	}
+
+	if len(rule.Networks) == 0 && rule.PeerSelector == nil {
+		return [][]string{append(match, "counter", "accept")}
+	}
+
+	ipv4Networks, ipv6Networks := splitByIPFamily(rule.Networks)
+
+	var rules [][]string
+	if len(ipv4Networks) > 0 {
+		rules = append(rules, peerRule(match, nft.IPv4, peerMatch, "{ "+strings.Join(ipv4Networks, ", ")+" }"))
+	}
+	if len(ipv6Networks) > 0 {
+		rules = append(rules, peerRule(match, nft.IPv6, peerMatch, "{ "+strings.Join(ipv6Networks, ", ")+" }"))
+	}
+	if rule.PeerSelector != nil {
+		rules = append(rules,
+			peerRule(match, nft.IPv4, peerMatch, "@"+peerSetName(peerSets, nft.IPv4)),
+			peerRule(match, nft.IPv6, peerMatch, "@"+peerSetName(peerSets, nft.IPv6)),
+		)
+	}
+	return rules
+}
+
+func peerRule(match []string, family nft.IPFamily, peerMatch, peers string) []string {
+	rule := append([]string{}, match...)
+	rule = append(rule, string(family), peerMatch, peers)
+	return append(rule, "counter", "accept")
+}
+
+// splitByIPFamily splits the given addresses or networks, the ones which cannot be parsed are
+// considered IPv4.
+func splitByIPFamily(peers []string) (ipv4Peers, ipv6Peers []string) {
+	for _, peer := range peers {
+		ip := net.ParseIP(peer)
+		if ip == nil {
+			ip, _, _ = net.ParseCIDR(peer)
+		}
+		if ip != nil && ip.To4() == nil {
+			ipv6Peers = append(ipv6Peers, peer)
+		} else {
+			ipv4Peers = append(ipv4Peers, peer)
+		}
+	}
+	return ipv4Peers, ipv6Peers
+}
+
+func protocolToL4Proto(protocol v1.FirewallProtocol) string {
+	switch protocol {
+	case v1.FirewallProtocolTCP:
+		return "tcp"
+	case v1.FirewallProtocolUDP:
+		return "udp"
+	case v1.FirewallProtocolICMP:
+		return "icmp"
+	case v1.FirewallProtocolICMPv6:
+		return "ipv6-icmp"
+	}
+	return ""
+}
+
+func portsSet(ports []v1.FirewallPort) string {
+	var elements []string
+	for _, port := range ports {
+		if port.EndPort != nil && *port.EndPort != port.Port {
+			elements = append(elements, fmt.Sprintf("%d-%d", port.Port, *port.EndPort))
+		} else {
+			elements = append(elements, fmt.Sprintf("%d", port.Port))
+		}
+	}
+	return "{ " + strings.Join(elements, ", ") + " }"
+}
+
+// UpdatePeers fills the sets of the rules selecting their peers by labels with the addresses of
+// the selected peers, which virt-controller reports in the VMI status. The sets are created when
+// missing, so the peers can be updated regardless of the interfaces being set up.
+func (f Firewall) UpdatePeers(ifaces []v1.Interface, peers []v1.VirtualMachineInstanceFirewallPeers) error {
+	var sets []peerSet
+	for _, iface := range ifaces {
+		if iface.Bridge == nil || iface.Firewall == nil || iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		sets = append(sets, peerSets(iface, lookupPeers(peers, iface.Name))...)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+
+	if err := f.nftable.AddTable(nft.Bridge, filterTable); err != nil {
+		return err
+	}
+	for _, set := range sets {
+		if err := f.addPeerSet(set); err != nil {
+			return err
+		}
+		if err := f.nftable.FlushSet(nft.Bridge, filterTable, set.name); err != nil {
+			return err
+		}
+		if len(set.addresses) == 0 {
+			continue
+		}
+		if err := f.nftable.AddElements(nft.Bridge, filterTable, set.name, set.addresses...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type peerSet struct {
+	name      string
+	family    nft.IPFamily
+	addresses []string
+}
+
+func (f Firewall) addPeerSet(set peerSet) error {
+	addressType := "ipv4_addr"
+	if set.family == nft.IPv6 {
+		addressType = "ipv6_addr"
+	}
+	return f.nftable.AddSet(nft.Bridge, filterTable, set.name, "{ type "+addressType+"; }")
+}
+
+// peerSets returns the sets of the interface rules selecting their peers by labels,
+// holding the given addresses of the selected peers.
+func peerSets(iface v1.Interface, peers *v1.VirtualMachineInstanceFirewallPeers) []peerSet {
+	var ingressPeers, egressPeers []v1.FirewallRulePeers
+	if peers != nil {
+		ingressPeers, egressPeers = peers.Ingress, peers.Egress
+	}
+	sets := directionPeerSets(iface.Name, ingressDirection, iface.Firewall.Ingress, ingressPeers)
+	return append(sets, directionPeerSets(iface.Name, egressDirection, iface.Firewall.Egress, egressPeers)...)
+}
+
+func directionPeerSets(ifaceName, direction string, firewallRules *v1.FirewallRules, rulesPeers []v1.FirewallRulePeers) []peerSet {
+	if firewallRules == nil {
+		return nil
+	}
+	var sets []peerSet
+	for idx, rule := range firewallRules.Allow {
+		if rule.PeerSelector == nil {
+			continue
+		}
+		var addresses []string
+		if idx < len(rulesPeers) {
+			addresses = rulesPeers[idx].Addresses
+		}
+		ipv4Addresses, ipv6Addresses := splitByIPFamily(addresses)
+		ruleSets := rulePeerSetsName(ifaceName, direction, idx)
+		sets = append(sets,
+			peerSet{name: peerSetName(ruleSets, nft.IPv4), family: nft.IPv4, addresses: ipv4Addresses},
+			peerSet{name: peerSetName(ruleSets, nft.IPv6), family: nft.IPv6, addresses: ipv6Addresses},
+		)
+	}
+	return sets
+}
+
+func rulePeerSetsName(ifaceName, direction string, ruleIdx int) string {
+	return fmt.Sprintf("%s%s-%s-%d", peerSetPrefix, direction, ifaceName, ruleIdx)
+}
+
+func peerSetName(ruleSets string, family nft.IPFamily) string {
+	return ruleSets + "-" + string(family)
+}
+
+func lookupPeers(peers []v1.VirtualMachineInstanceFirewallPeers, ifaceName string) *v1.VirtualMachineInstanceFirewallPeers {
+	for i := range peers {
+		if peers[i].Name == ifaceName {
+			return &peers[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,550 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nft"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	tapName = "tap0"
+	mac     = "02:00:00:00:00:01"
+	ipv4    = "10.244.0.10"
+)
+
+var _ = Describe("firewall", func() {
+	newFirewall := func(nftStub *nftableStub) firewall.Firewall {
+		return firewall.New(
+			firewall.WithNftableAdapter(nftStub),
+			firewall.WithKernelModuleLookup(func(string) bool { return true }),
+		)
+	}
+
+	newInterface := func(iface v1.Interface) []firewall.Interface {
+		return []firewall.Interface{{TapName: tapName, Spec: iface, MAC: mac, IPv4: ipv4}}
+	}
+
+	It("does nothing when the interface has no firewall", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		Expect(fw.Setup(newInterface(v1.Interface{Name: "default"}))).To(Succeed())
+		Expect(nftStub.String()).To(Equal("tables:\nchains:\nrules:\n"))
+	})
+
+	It("sets up anti-spoofing only, when no direction is filtered", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{}}
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+
+		Expect(nftStub.String()).To(Equal(`tables:
+family bridge name kubevirt_fw
+chains:
+family bridge table kubevirt_fw name forward chainspec [{ type filter hook forward priority 0; }]
+family bridge table kubevirt_fw name egress-tap0 chainspec []
+flushed:
+family bridge table kubevirt_fw name forward
+family bridge table kubevirt_fw name egress-tap0
+rules:
+family bridge table kubevirt_fw chain forward rulespec [iifname vmap @egress-jumps]
+family bridge table kubevirt_fw chain forward rulespec [oifname vmap @ingress-jumps]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [ether saddr != 02:00:00:00:00:01 counter drop]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [ether type ip ip saddr != 10.244.0.10 counter drop]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [ether type arp arp saddr ip != 10.244.0.10 counter drop]
+`))
+		Expect(nftStub.maps()).To(Equal(`egress-jumps [{ type ifname : verdict; }]:
+tap0 : jump egress-tap0
+ingress-jumps [{ type ifname : verdict; }]:
+`))
+	})
+
+	It("does not filter when anti-spoofing is disabled and no direction is filtered", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{AntiSpoofing: pointer.P(false)}}
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+
+		Expect(nftStub.maps()).To(Equal(`egress-jumps [{ type ifname : verdict; }]:
+ingress-jumps [{ type ifname : verdict; }]:
+`))
+	})
+
+	It("sets up the allowed ingress and egress traffic", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{
+			Name: "default",
+			Firewall: &v1.InterfaceFirewall{
+				AntiSpoofing: pointer.P(false),
+				Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+					{
+						Protocol: v1.FirewallProtocolTCP,
+						Ports:    []v1.FirewallPort{{Port: 22}, {Port: 8000, EndPort: pointer.P(int32(8080))}},
+						Networks: []string{"10.0.0.0/8", "fd00::/64"},
+					},
+					{Protocol: v1.FirewallProtocolICMP},
+				}},
+				Egress: &v1.FirewallRules{},
+			},
+		}
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+
+		Expect(nftStub.String()).To(Equal(`tables:
+family bridge name kubevirt_fw
+chains:
+family bridge table kubevirt_fw name forward chainspec [{ type filter hook forward priority 0; }]
+family bridge table kubevirt_fw name egress-tap0 chainspec []
+family bridge table kubevirt_fw name ingress-tap0 chainspec []
+flushed:
+family bridge table kubevirt_fw name forward
+family bridge table kubevirt_fw name egress-tap0
+family bridge table kubevirt_fw name ingress-tap0
+rules:
+family bridge table kubevirt_fw chain forward rulespec [iifname vmap @egress-jumps]
+family bridge table kubevirt_fw chain forward rulespec [oifname vmap @ingress-jumps]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [ct state established,related counter accept]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [ether type arp counter accept]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert } counter accept]
+family bridge table kubevirt_fw chain egress-tap0 rulespec [counter drop]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [ct state established,related counter accept]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [ether type arp counter accept]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert } counter accept]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [meta l4proto tcp th dport { 22, 8000-8080 } ip saddr { 10.0.0.0/8 } counter accept]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [meta l4proto tcp th dport { 22, 8000-8080 } ip6 saddr { fd00::/64 } counter accept]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [meta l4proto icmp counter accept]
+family bridge table kubevirt_fw chain ingress-tap0 rulespec [counter drop]
+`))
+		Expect(nftStub.maps()).To(Equal(`egress-jumps [{ type ifname : verdict; }]:
+tap0 : jump egress-tap0
+ingress-jumps [{ type ifname : verdict; }]:
+tap0 : jump ingress-tap0
+`))
+	})
+
+	It("rebuilds the interface chains on repeated setups", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{}}
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+
+		Expect(nftStub.ruleset()).To(Equal(`forward:
+[iifname vmap @egress-jumps]
+[oifname vmap @ingress-jumps]
+egress-tap0:
+[ether saddr != 02:00:00:00:00:01 counter drop]
+[ether type ip ip saddr != 10.244.0.10 counter drop]
+[ether type arp arp saddr ip != 10.244.0.10 counter drop]
+`))
+	})
+
+	It("sets up the chains of multiple interfaces", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		ifaces := []firewall.Interface{
+			{TapName: "tap0", Spec: v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{}}, MAC: mac},
+			{TapName: "tap1", Spec: v1.Interface{Name: "other"}},
+			{TapName: "tap2", Spec: v1.Interface{Name: "secondary", Firewall: &v1.InterfaceFirewall{}}, MAC: "02:00:00:00:00:02"},
+		}
+		Expect(fw.Setup(ifaces)).To(Succeed())
+
+		Expect(nftStub.ruleset()).To(Equal(`forward:
+[iifname vmap @egress-jumps]
+[oifname vmap @ingress-jumps]
+egress-tap0:
+[ether saddr != 02:00:00:00:00:01 counter drop]
+egress-tap2:
+[ether saddr != 02:00:00:00:00:02 counter drop]
+`))
+		Expect(nftStub.maps()).To(Equal(`egress-jumps [{ type ifname : verdict; }]:
+tap0 : jump egress-tap0
+tap2 : jump egress-tap2
+ingress-jumps [{ type ifname : verdict; }]:
+`))
+	})
+
+	It("keeps the jumps to the chains of the interfaces set up before", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		Expect(fw.Setup([]firewall.Interface{
+			{TapName: "tap0", Spec: v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{}}, MAC: mac},
+		})).To(Succeed())
+		Expect(fw.Setup([]firewall.Interface{
+			{TapName: "tap1", Spec: v1.Interface{Name: "hotplugged", Firewall: &v1.InterfaceFirewall{Ingress: &v1.FirewallRules{}}}},
+		})).To(Succeed())
+
+		Expect(nftStub.maps()).To(Equal(`egress-jumps [{ type ifname : verdict; }]:
+tap0 : jump egress-tap0
+ingress-jumps [{ type ifname : verdict; }]:
+tap1 : jump ingress-tap1
+`))
+	})
+
+	It("sets up the peer sets of the rules selecting their peers by labels", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{
+			Name: "default",
+			Firewall: &v1.InterfaceFirewall{
+				AntiSpoofing: pointer.P(false),
+				Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+					{Protocol: v1.FirewallProtocolICMP},
+					{
+						Protocol:     v1.FirewallProtocolTCP,
+						Networks:     []string{"10.0.0.0/8"},
+						PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					},
+				}},
+			},
+		}
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+
+		Expect(nftStub.sets()).To(Equal(`peers-ingress-default-1-ip [{ type ipv4_addr; }]:
+peers-ingress-default-1-ip6 [{ type ipv6_addr; }]:
+`))
+		Expect(nftStub.ruleset()).To(Equal(`forward:
+[iifname vmap @egress-jumps]
+[oifname vmap @ingress-jumps]
+ingress-tap0:
+[ct state established,related counter accept]
+[ether type arp counter accept]
+[icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit, nd-router-advert } counter accept]
+[meta l4proto icmp counter accept]
+[meta l4proto tcp ip saddr { 10.0.0.0/8 } counter accept]
+[meta l4proto tcp ip saddr @peers-ingress-default-1-ip counter accept]
+[meta l4proto tcp ip6 saddr @peers-ingress-default-1-ip6 counter accept]
+[counter drop]
+`))
+	})
+
+	It("keeps the addresses of the peer sets on repeated setups", func() {
+		nftStub := &nftableStub{}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Firewall: &v1.InterfaceFirewall{Egress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{}},
+			}}},
+		}
+		peers := []v1.VirtualMachineInstanceFirewallPeers{{
+			Name:   "default",
+			Egress: []v1.FirewallRulePeers{{Addresses: []string{"10.244.0.20"}}},
+		}}
+		Expect(fw.UpdatePeers([]v1.Interface{iface}, peers)).To(Succeed())
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+
+		Expect(nftStub.sets()).To(Equal(`peers-egress-default-0-ip [{ type ipv4_addr; }]:
+10.244.0.20
+peers-egress-default-0-ip6 [{ type ipv6_addr; }]:
+`))
+	})
+
+	It("fails when a direction is filtered without bridge connection tracking", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(
+			firewall.WithNftableAdapter(nftStub),
+			firewall.WithKernelModuleLookup(func(name string) bool { return name != "nf_conntrack_bridge" }),
+		)
+
+		iface := v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{Ingress: &v1.FirewallRules{}}}
+		Expect(fw.Setup(newInterface(iface))).To(MatchError(ContainSubstring("requires the nf_conntrack_bridge kernel module")))
+		Expect(nftStub.Tables).To(BeEmpty())
+	})
+
+	It("sets up anti-spoofing without bridge connection tracking", func() {
+		nftStub := &nftableStub{}
+		fw := firewall.New(
+			firewall.WithNftableAdapter(nftStub),
+			firewall.WithKernelModuleLookup(func(string) bool { return false }),
+		)
+
+		iface := v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{}}
+		Expect(fw.Setup(newInterface(iface))).To(Succeed())
+		Expect(nftStub.Rules).ToNot(BeEmpty())
+	})
+
+	It("fails when the table cannot be created", func() {
+		nftStub := &nftableStub{addTableErr: errors.New("test")}
+		fw := newFirewall(nftStub)
+
+		iface := v1.Interface{Name: "default", Firewall: &v1.InterfaceFirewall{}}
+		Expect(fw.Setup(newInterface(iface))).NotTo(Succeed())
+	})
+
+	Context("peers update", func() {
+		bridgeIface := func(firewall *v1.InterfaceFirewall) v1.Interface {
+			return v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Firewall:               firewall,
+			}
+		}
+
+		It("does nothing when no rule selects its peers by labels", func() {
+			nftStub := &nftableStub{}
+			fw := newFirewall(nftStub)
+
+			iface := bridgeIface(&v1.InterfaceFirewall{Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+				{Networks: []string{"10.0.0.0/8"}},
+			}}})
+			Expect(fw.UpdatePeers([]v1.Interface{iface, {Name: "other"}}, nil)).To(Succeed())
+			Expect(nftStub.Tables).To(BeEmpty())
+		})
+
+		It("replaces the addresses of the peer sets by the selected peers", func() {
+			nftStub := &nftableStub{}
+			fw := newFirewall(nftStub)
+
+			iface := bridgeIface(&v1.InterfaceFirewall{
+				Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+					{Networks: []string{"10.0.0.0/8"}},
+					{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+				}},
+				Egress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+					{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+				}},
+			})
+			Expect(fw.UpdatePeers([]v1.Interface{iface}, []v1.VirtualMachineInstanceFirewallPeers{{
+				Name:    "default",
+				Ingress: []v1.FirewallRulePeers{{}, {Addresses: []string{"10.244.0.20", "fd10:244::20"}}},
+				Egress:  []v1.FirewallRulePeers{{Addresses: []string{"10.244.0.30"}}},
+			}})).To(Succeed())
+			Expect(fw.UpdatePeers([]v1.Interface{iface}, []v1.VirtualMachineInstanceFirewallPeers{{
+				Name:    "default",
+				Ingress: []v1.FirewallRulePeers{{}, {Addresses: []string{"10.244.0.21", "10.244.0.22"}}},
+				Egress:  []v1.FirewallRulePeers{{Addresses: []string{"10.244.0.30"}}},
+			}})).To(Succeed())
+
+			Expect(nftStub.Tables).To(ConsistOf(tableData{nft.Bridge, "kubevirt_fw"}, tableData{nft.Bridge, "kubevirt_fw"}))
+			Expect(nftStub.sets()).To(Equal(`peers-ingress-default-1-ip [{ type ipv4_addr; }]:
+10.244.0.21
+10.244.0.22
+peers-ingress-default-1-ip6 [{ type ipv6_addr; }]:
+peers-egress-default-0-ip [{ type ipv4_addr; }]:
+10.244.0.30
+peers-egress-default-0-ip6 [{ type ipv6_addr; }]:
+`))
+		})
+
+		It("empties the peer sets until the peers are reported", func() {
+			nftStub := &nftableStub{}
+			fw := newFirewall(nftStub)
+
+			iface := bridgeIface(&v1.InterfaceFirewall{Ingress: &v1.FirewallRules{Allow: []v1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{}},
+			}}})
+			Expect(fw.UpdatePeers([]v1.Interface{iface}, []v1.VirtualMachineInstanceFirewallPeers{{
+				Name:    "default",
+				Ingress: []v1.FirewallRulePeers{{Addresses: []string{"10.244.0.20"}}},
+			}})).To(Succeed())
+			Expect(fw.UpdatePeers([]v1.Interface{iface}, nil)).To(Succeed())
+
+			Expect(nftStub.sets()).To(Equal(`peers-ingress-default-0-ip [{ type ipv4_addr; }]:
+peers-ingress-default-0-ip6 [{ type ipv6_addr; }]:
+`))
+		})
+	})
+})
+
+type nftableStub struct {
+	addTableErr error
+	Tables      []tableData
+	Chains      []chainData
+	Flushed     []chainData
+	Rules       []ruleData
+	liveRules   []ruleData
+	Sets        []setData
+	Maps        []setData
+	elements    map[string][]string
+}
+
+type setData struct {
+	Table   tableData
+	Name    string
+	Setspec []string
+}
+
+type tableData struct {
+	Family nft.IPFamily
+	Name   string
+}
+
+type chainData struct {
+	Table     tableData
+	Name      string
+	Chainspec []string
+}
+
+type ruleData struct {
+	Chain    chainData
+	Rulespec []string
+}
+
+func (n *nftableStub) AddTable(family nft.IPFamily, name string) error {
+	if n.addTableErr != nil {
+		return n.addTableErr
+	}
+	n.Tables = append(n.Tables, tableData{family, name})
+	return nil
+}
+
+func (n *nftableStub) AddChain(family nft.IPFamily, table, name string, chainspec ...string) error {
+	n.Chains = append(n.Chains, chainData{tableData{family, table}, name, chainspec})
+	return nil
+}
+
+func (n *nftableStub) FlushChain(family nft.IPFamily, table, name string) error {
+	n.Flushed = append(n.Flushed, chainData{Table: tableData{family, table}, Name: name})
+	n.liveRules = slices.DeleteFunc(n.liveRules, func(r ruleData) bool {
+		return r.Chain.Table.Family == family && r.Chain.Table.Name == table && r.Chain.Name == name
+	})
+	return nil
+}
+
+func (n *nftableStub) AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error {
+	rule := ruleData{
+		Chain:    chainData{Table: tableData{Family: family, Name: table}, Name: chain},
+		Rulespec: rulespec,
+	}
+	n.Rules = append(n.Rules, rule)
+	n.liveRules = append(n.liveRules, rule)
+	return nil
+}
+
+func (n *nftableStub) AddSet(family nft.IPFamily, table, name string, setspec ...string) error {
+	if !slices.ContainsFunc(n.Sets, func(s setData) bool { return s.Name == name }) {
+		n.Sets = append(n.Sets, setData{tableData{family, table}, name, setspec})
+	}
+	return nil
+}
+
+func (n *nftableStub) AddMap(family nft.IPFamily, table, name string, mapspec ...string) error {
+	if !slices.ContainsFunc(n.Maps, func(m setData) bool { return m.Name == name }) {
+		n.Maps = append(n.Maps, setData{tableData{family, table}, name, mapspec})
+	}
+	return nil
+}
+
+func (n *nftableStub) FlushSet(_ nft.IPFamily, _, name string) error {
+	delete(n.elements, name)
+	return nil
+}
+
+func (n *nftableStub) AddElements(_ nft.IPFamily, _, set string, elements ...string) error {
+	if n.elements == nil {
+		n.elements = map[string][]string{}
+	}
+	for _, element := range elements {
+		if !slices.Contains(n.elements[set], element) {
+			n.elements[set] = append(n.elements[set], element)
+		}
+	}
+	return nil
+}
+
+// sets returns the elements left in each set, taking the flushes into account
+func (n *nftableStub) sets() string {
+	return n.elementsOf(n.Sets)
+}
+
+// maps returns the elements of each map
+func (n *nftableStub) maps() string {
+	return n.elementsOf(n.Maps)
+}
+
+func (n *nftableStub) elementsOf(sets []setData) string {
+	var out string
+	for _, s := range sets {
+		out += fmt.Sprintf("%s %s:\n", s.Name, s.Setspec)
+		for _, element := range n.elements[s.Name] {
+			out += element + "\n"
+		}
+	}
+	return out
+}
+
+// ruleset returns the rules left in each chain, taking the flushes into account
+func (n *nftableStub) ruleset() string {
+	var chains []string
+	for _, c := range n.Chains {
+		if !slices.Contains(chains, c.Name) {
+			chains = append(chains, c.Name)
+		}
+	}
+	rules := map[string][]string{}
+	for _, r := range n.liveRules {
+		rules[r.Chain.Name] = append(rules[r.Chain.Name], fmt.Sprint(r.Rulespec))
+	}
+	var out string
+	for _, chain := range chains {
+		out += chain + ":\n"
+		for _, rule := range rules[chain] {
+			out += rule + "\n"
+		}
+	}
+	return out
+}
+
+func (n *nftableStub) String() string {
+	var out string
+
+	out += "tables:\n"
+	for _, t := range n.Tables {
+		out += fmt.Sprintf("family %s name %s\n", t.Family, t.Name)
+	}
+	out += "chains:\n"
+	for _, c := range n.Chains {
+		out += fmt.Sprintf("family %s table %s name %s chainspec %s\n", c.Table.Family, c.Table.Name, c.Name, c.Chainspec)
+	}
+	if len(n.Flushed) > 0 {
+		out += "flushed:\n"
+		for _, c := range n.Flushed {
+			out += fmt.Sprintf("family %s table %s name %s\n", c.Table.Family, c.Table.Name, c.Name)
+		}
+	}
+	out += "rules:\n"
+	for _, r := range n.Rules {
+		out += fmt.Sprintf("family %s table %s chain %s rulespec %s\n", r.Chain.Table.Family, r.Chain.Table.Name, r.Chain.Name, r.Rulespec)
+	}
+	return out
+}
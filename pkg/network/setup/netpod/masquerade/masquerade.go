@@ -150,17 +150,20 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 	}
 	addressesToDnatSpec := fmt.Sprintf("{ %s }", strings.Join(addressesToDnat, ", "))
 
-	for _, port := range vmiIface.Ports {
-		if port.Protocol == "" {
-			port.Protocol = "tcp"
+	forwardedPorts, excludedPorts := splitExcludedPorts(vmiIface.Ports)
+	for _, group := range groupPortsByProtocol(excludedPorts) {
+		if err := m.excludePorts(family, group); err != nil {
+			return err
 		}
-		protocol := strings.ToLower(port.Protocol)
+	}
+
+	for _, group := range groupPortsByProtocol(forwardedPorts) {
 		addressesToSnat := []string{ipLoopback(family)}
 
 		if m.istioEnabled {
 			var portsToForward []int
 			for _, nonProxiedPort := range istio.NonProxiedPorts() {
-				if int(port.Port) == nonProxiedPort {
+				if group.contains(nonProxiedPort) {
 					portsToForward = append(portsToForward, nonProxiedPort)
 				}
 			}
@@ -172,23 +175,23 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 				addressesToSnat = append(addressesToSnat, istio.GetLoopbackAddress())
 			}
 		} else {
-			if err := m.forwardPorts(family, guestIP, protocol, int(port.Port)); err != nil {
+			if err := m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, group.protocol, "dport", group.set(), "counter", "dnat", "to", guestIP); err != nil {
 				return err
 			}
 		}
 
 		addressesToSnatSpec := fmt.Sprintf("{ %s }", strings.Join(addressesToSnat, ", "))
 		gw := guestIPGateway(family, *bridgeIfaceSpec).String()
-		if err := m.nftable.AddRule(family, natTable, kubevirtPostInboundChain, protocol, "dport", strconv.Itoa(int(port.Port)), string(family), "saddr", addressesToSnatSpec, "counter", "snat", "to", gw); err != nil {
+		if err := m.nftable.AddRule(family, natTable, kubevirtPostInboundChain, group.protocol, "dport", group.set(), string(family), "saddr", addressesToSnatSpec, "counter", "snat", "to", gw); err != nil {
 			return err
 		}
 
-		if err := m.nftable.AddRule(family, natTable, outputChain, string(family), "daddr", addressesToDnatSpec, protocol, "dport", strconv.Itoa(int(port.Port)), "counter", "dnat", "to", guestIP); err != nil {
+		if err := m.nftable.AddRule(family, natTable, outputChain, string(family), "daddr", addressesToDnatSpec, group.protocol, "dport", group.set(), "counter", "dnat", "to", guestIP); err != nil {
 			return err
 		}
 	}
 
-	if len(vmiIface.Ports) == 0 {
+	if len(forwardedPorts) == 0 {
 		addressesToSnat := []string{ipLoopback(family)}
 		if m.istioEnabled {
 			// Skip forwarding for the reserved istio ports
@@ -232,6 +235,17 @@ func (m MasqPod) skipForwardPorts(family nft.IPFamily, ports ...int) error {
 	return nil
 }
 
+// excludePorts skips the translation of the given ports, for both inbound and local traffic.
+func (m MasqPod) excludePorts(family nft.IPFamily, group protocolPorts) error {
+	if err := m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, group.protocol, "dport", group.set(), "counter", "return"); err != nil {
+		return fmt.Errorf("failed to define port exclusion for: %s/%s %s, err: %v", family, group.protocol, group.set(), err)
+	}
+	if err := m.nftable.AddRule(family, natTable, outputChain, group.protocol, "dport", group.set(), "counter", "return"); err != nil {
+		return fmt.Errorf("failed to define port exclusion for: %s/%s %s, err: %v", family, group.protocol, group.set(), err)
+	}
+	return nil
+}
+
 func formatPorts(ports []int) []string {
 	var formattedPorts []string
 	for _, p := range ports {
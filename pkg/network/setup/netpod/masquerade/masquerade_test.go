@@ -320,21 +320,149 @@ rules:
 family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
 family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
-family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80 } counter dnat to 10.0.2.2]
-family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
-family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport 80 counter dnat to 10.0.2.2]
-family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 8080 } counter dnat to 10.0.2.2]
-family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
-family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport 8080 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80, 8080 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 80, 8080 } ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport { 80, 8080 } counter dnat to 10.0.2.2]
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
-family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80 } counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 80 counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 8080 } counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80, 8080 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 80, 8080 } ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport { 80, 8080 } counter dnat to fd10:0:2::2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
+	It("setup with IPv4, including port ranges of multiple protocols", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:       "k6t-eth0",
+				Index:      1,
+				TypeName:   nmstate.TypeBridge,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "bb:bb:bb:bb:bb:bb",
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "aa:aa:aa:aa:aa:aa",
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        "10.222.222.1",
+						PrefixLen: 30,
+					}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports: []v1.Port{
+					{Port: 22},
+					{Protocol: "UDP", Port: 10000, EndPort: pointer.P(int32(20000))},
+					{Protocol: "TCP", Port: 8000, EndPort: pointer.P(int32(8080))},
+				},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 22, 8000-8080 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 22, 8000-8080 } ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport { 22, 8000-8080 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport { 10000-20000 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [udp dport { 10000-20000 } ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } udp dport { 10000-20000 } counter dnat to 10.0.2.2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+	})
+
+	It("setup with IPv4, excluding ports", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:       "k6t-eth0",
+				Index:      1,
+				TypeName:   nmstate.TypeBridge,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "bb:bb:bb:bb:bb:bb",
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "aa:aa:aa:aa:aa:aa",
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        "10.222.222.1",
+						PrefixLen: 30,
+					}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports: []v1.Port{
+					{Port: 22, Exclude: true},
+					{Protocol: "UDP", Port: 5000, EndPort: pointer.P(int32(5010)), Exclude: true},
+				},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 22 } counter return]
+family ip table nat chain output rulespec [tcp dport { 22 } counter return]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport { 5000-5010 } counter return]
+family ip table nat chain output rulespec [udp dport { 5000-5010 } counter return]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat to 10.0.2.2]
 `
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
@@ -507,19 +635,15 @@ family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIR
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
 family ip table nat chain output rulespec [tcp dport { 49152, 49153 } ip saddr 127.0.0.1 counter return]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 49152, 49153 } ip saddr 127.0.0.1 counter return]
-family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip saddr { 127.0.0.1, 127.0.0.6 } counter snat to 10.0.2.1]
-family ip table nat chain output rulespec [ip daddr { 127.0.0.1, 10.222.222.1 } tcp dport 80 counter dnat to 10.0.2.2]
-family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip saddr { 127.0.0.1, 127.0.0.6 } counter snat to 10.0.2.1]
-family ip table nat chain output rulespec [ip daddr { 127.0.0.1, 10.222.222.1 } tcp dport 8080 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 80, 8080 } ip saddr { 127.0.0.1, 127.0.0.6 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1, 10.222.222.1 } tcp dport { 80, 8080 } counter dnat to 10.0.2.2]
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
 family ip6 table nat chain output rulespec [tcp dport { 49152, 49153 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 49152, 49153 } ip6 saddr ::1 counter return]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 80 counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 80, 8080 } ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport { 80, 8080 } counter dnat to fd10:0:2::2]
 `
 			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 		})
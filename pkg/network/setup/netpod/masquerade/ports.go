@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package masquerade
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const defaultPortProtocol = "tcp"
+
+// protocolPorts holds the ports, and port ranges, of a single protocol.
+type protocolPorts struct {
+	protocol string
+	ports    []v1.Port
+}
+
+// set formats the ports as an nft anonymous set, e.g. "{ 80, 8000-8080 }".
+func (p protocolPorts) set() string {
+	elements := make([]string, 0, len(p.ports))
+	for _, port := range p.ports {
+		element := strconv.Itoa(int(port.Port))
+		if port.EndPort != nil && *port.EndPort != port.Port {
+			element = fmt.Sprintf("%d-%d", port.Port, *port.EndPort)
+		}
+		elements = append(elements, element)
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(elements, ", "))
+}
+
+func (p protocolPorts) contains(number int) bool {
+	for _, port := range p.ports {
+		endPort := port.Port
+		if port.EndPort != nil {
+			endPort = *port.EndPort
+		}
+		if int(port.Port) <= number && number <= int(endPort) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitExcludedPorts(ports []v1.Port) (forwarded, excluded []v1.Port) {
+	for _, port := range ports {
+		if port.Exclude {
+			excluded = append(excluded, port)
+		} else {
+			forwarded = append(forwarded, port)
+		}
+	}
+	return forwarded, excluded
+}
+
+// groupPortsByProtocol groups the ports by their protocol, preserving the order of appearance.
+func groupPortsByProtocol(ports []v1.Port) []protocolPorts {
+	var groups []protocolPorts
+	indexByProtocol := map[string]int{}
+	for _, port := range ports {
+		protocol := defaultPortProtocol
+		if port.Protocol != "" {
+			protocol = strings.ToLower(port.Protocol)
+		}
+		idx, exists := indexByProtocol[protocol]
+		if !exists {
+			idx = len(groups)
+			indexByProtocol[protocol] = idx
+			groups = append(groups, protocolPorts{protocol: protocol})
+		}
+		groups[idx].ports = append(groups[idx].ports, port)
+	}
+	return groups
+}
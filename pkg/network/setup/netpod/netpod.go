@@ -36,6 +36,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/network/netmachinery"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod/masquerade"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
@@ -55,6 +56,10 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type firewallAdapter interface {
+	Setup(ifaces []firewall.Interface) error
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -67,6 +72,7 @@ type NetPod struct {
 	vmiSpecIfaces    []v1.Interface
 	vmiSpecNets      []v1.Network
 	vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface
+	ipAllocations    []v1.VirtualMachineInstanceIPAllocation
 	vmiUID           string
 	podPID           int
 	ownerID          int
@@ -74,6 +80,7 @@ type NetPod struct {
 
 	nmstateAdapter    nmstateAdapter
 	masqueradeAdapter masqueradeAdapter
+	firewallAdapter   firewallAdapter
 
 	cacheCreator cacheCreator
 	state        *State
@@ -96,6 +103,7 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 
 		nmstateAdapter:    nmstate.New(),
 		masqueradeAdapter: masquerade.New(),
+		firewallAdapter:   firewall.New(),
 
 		cacheCreator:         cache.CacheCreator{},
 		bindingPluginsByName: map[string]v1.InterfaceBindingPlugin{},
@@ -123,6 +131,12 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithFirewallAdapter(h firewallAdapter) option {
+	return func(n *NetPod) {
+		n.firewallAdapter = h
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
@@ -141,6 +155,12 @@ func WithLogger(logger *log.FilteredLogger) option {
 	}
 }
 
+func WithIPAllocations(ipAllocations []v1.VirtualMachineInstanceIPAllocation) option {
+	return func(n *NetPod) {
+		n.ipAllocations = ipAllocations
+	}
+}
+
 func WithVMIIfaceStatuses(vmiIfaceStatuses []v1.VirtualMachineInstanceNetworkInterface) option {
 	return func(n *NetPod) {
 		n.vmiIfaceStatuses = vmiIfaceStatuses
@@ -174,6 +194,10 @@ func (n NetPod) Setup() error {
 		return nil
 	}
 
+	if err := n.validateIPAllocations(pendingNets); err != nil {
+		return err
+	}
+
 	err = n.state.NSExec.Do(func() error {
 		currentStatus, err := n.nmstateAdapter.Read()
 		if err != nil {
@@ -231,6 +255,29 @@ func (n NetPod) validateNoNetworkReconfigured(startedNets []v1.Network) error {
 	return nil
 }
 
+// validateIPAllocations makes sure the networks using an IP pool are set up
+// only once their address has been allocated by the controller.
+func (n NetPod) validateIPAllocations(nets []v1.Network) error {
+	for _, net := range nets {
+		if net.Multus == nil || net.Multus.IPPool == "" {
+			continue
+		}
+		if n.lookupIPAllocation(net.Name) == nil {
+			return fmt.Errorf("network %s is waiting for an address from IP pool %s", net.Name, net.Multus.IPPool)
+		}
+	}
+	return nil
+}
+
+func (n NetPod) lookupIPAllocation(networkName string) *v1.VirtualMachineInstanceIPAllocation {
+	for i := range n.ipAllocations {
+		if n.ipAllocations[i].Name == networkName {
+			return &n.ipAllocations[i]
+		}
+	}
+	return nil
+}
+
 func (n NetPod) config(currentStatus *nmstate.Status) error {
 	desiredSpec, err := n.composeDesiredSpec(currentStatus)
 	if err != nil {
@@ -247,9 +294,12 @@ func (n NetPod) config(currentStatus *nmstate.Status) error {
 		return err
 	}
 
-	// Configuring NAT (nftables) is temporary done outside nmstate.
+	// Configuring NAT and firewall (nftables) is temporary done outside nmstate.
 	// This should be eventually embedded into the nmstate desired state and applied by it.
-	return n.setupNAT(desiredSpec, currentStatus)
+	if err = n.setupNAT(desiredSpec, currentStatus); err != nil {
+		return err
+	}
+	return n.setupFirewall(desiredSpec)
 }
 
 func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec, error) {
@@ -358,7 +408,7 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 		podStatusIface = ifaceStatusByName[podIfaceName]
 	}
 
-	if hasIPGlobalUnicast(podStatusIface.IPv4) {
+	if hasIPGlobalUnicast(podStatusIface.IPv4) || n.lookupIPAllocation(vmiNetworkName) != nil {
 		bridgeIface.IPv4 = nmstate.IP{
 			Enabled: pointer.P(true),
 			Address: []nmstate.IPAddress{
@@ -553,6 +603,46 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
 }
 
+func (n NetPod) setupFirewall(desiredSpec *nmstate.Spec) error {
+	var firewallIfaces []firewall.Interface
+	for _, vmiIface := range n.vmiSpecIfaces {
+		if vmiIface.Bridge == nil || vmiIface.Firewall == nil || vmiIface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		tapIfaceSpec := lookupInterfaceByNetworkAndType(desiredSpec.Interfaces, vmiIface.Name, nmstate.TypeTap)
+		if tapIfaceSpec == nil {
+			return fmt.Errorf("setup-firewall: tap device of interface %s is missing", vmiIface.Name)
+		}
+
+		firewallIface := firewall.Interface{TapName: tapIfaceSpec.Name, Spec: vmiIface}
+		if vmiIface.MacAddress != "" {
+			if hwAddr, err := net.ParseMAC(vmiIface.MacAddress); err == nil {
+				firewallIface.MAC = hwAddr.String()
+			}
+		}
+		if dummyIfaceSpec := lookupInterfaceByNetworkAndType(desiredSpec.Interfaces, vmiIface.Name, nmstate.TypeDummy); dummyIfaceSpec != nil {
+			if firewallIface.MAC == "" {
+				firewallIface.MAC = dummyIfaceSpec.MacAddress
+			}
+			if address := firstIPGlobalUnicast(dummyIfaceSpec.IPv4); address != nil {
+				firewallIface.IPv4 = address.IP
+			}
+		}
+		firewallIfaces = append(firewallIfaces, firewallIface)
+	}
+
+	if err := n.firewallAdapter.Setup(firewallIfaces); err != nil {
+		return fmt.Errorf("setup-firewall: %w", err)
+	}
+	return nil
+}
+
+func lookupInterfaceByNetworkAndType(ifacesSpec []nmstate.Interface, networkName, typeName string) *nmstate.Interface {
+	return nmstate.LookupInterface(ifacesSpec, func(i nmstate.Interface) bool {
+		return i.Metadata != nil && i.Metadata.NetworkName == networkName && i.TypeName == typeName
+	})
+}
+
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
 	masqueradeIfaces := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Masquerade != nil
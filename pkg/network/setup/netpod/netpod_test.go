@@ -44,6 +44,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/network/setup/netpod"
+	"kubevirt.io/kubevirt/pkg/network/setup/netpod/firewall"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 )
 
@@ -284,6 +285,7 @@ var _ = Describe("netpod", func() {
 	It("setup bridge binding with IP and a static route", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
+			defaultGatewayIP6Address = "fe80::1"
 
 			podIfaceOrignalMAC = "12:34:56:78:90:ab"
 		)
@@ -338,6 +340,13 @@ var _ = Describe("netpod", func() {
 					NextHopInterface: "eth0",
 					TableID:          0,
 				},
+				// IPv6 Default Route
+				{
+					Destination:      "::/0",
+					NextHopInterface: "eth0",
+					NextHopAddress:   defaultGatewayIP6Address,
+					TableID:          0,
+				},
 			}},
 		}}
 
@@ -428,12 +437,92 @@ var _ = Describe("netpod", func() {
 			"10.222.0.0/16",
 		)
 		Expect(err).NotTo(HaveOccurred())
+		ipv6, err := vishnetlink.ParseAddr(primaryIPv6Address + "/64")
+		Expect(err).NotTo(HaveOccurred())
+		expDHCPConfig.IPv6 = *ipv6
+		expDHCPConfig.IPv6Gateway = net.ParseIP(defaultGatewayIP6Address)
 		Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", "eth0")).To(Equal(expDHCPConfig))
 		Expect(cache.ReadDomainInterfaceCache(&baseCacheCreator, "0", defaultPodNetworkName)).To(Equal(&api.Interface{
 			MAC: &api.MAC{MAC: podIfaceOrignalMAC},
 		}))
 	})
 
+	It("setup bridge binding with firewall", func() {
+		const podIfaceOrignalMAC = "12:34:56:78:90:ab"
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: podIfaceOrignalMAC,
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        primaryIPv4Address,
+						PrefixLen: 30,
+					}},
+				},
+			}},
+			Routes: nmstate.Routes{Running: []nmstate.Route{{
+				Destination:      "0.0.0.0/0",
+				NextHopInterface: "eth0",
+				NextHopAddress:   "10.222.222.254",
+				TableID:          0,
+			}}},
+		}}
+
+		vmiIface := v1.Interface{
+			Name:                   defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Firewall:               &v1.InterfaceFirewall{Ingress: &v1.FirewallRules{}},
+		}
+		firewallstub := firewallStub{}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithFirewallAdapter(&firewallstub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(firewallstub.ifaces).To(Equal([]firewall.Interface{{
+			TapName: "tap0",
+			Spec:    vmiIface,
+			MAC:     podIfaceOrignalMAC,
+			IPv4:    primaryIPv4Address,
+		}}))
+	})
+
+	It("fails setup when firewall setup fails", func() {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "12:34:56:78:90:ab",
+			}},
+		}}
+
+		vmiIface := v1.Interface{
+			Name:                   defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			Firewall:               &v1.InterfaceFirewall{},
+		}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithFirewallAdapter(&firewallStub{setupErr: errFirewallSetup}),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(MatchError(errFirewallSetup))
+	})
+
 	It("setup bridge binding with IP custom primary interface name", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
@@ -585,6 +674,9 @@ var _ = Describe("netpod", func() {
 			"10.222.0.0/16",
 		)
 		Expect(err).NotTo(HaveOccurred())
+		ipv6, err := vishnetlink.ParseAddr(primaryIPv6Address + "/64")
+		Expect(err).NotTo(HaveOccurred())
+		expDHCPConfig.IPv6 = *ipv6
 		Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", customPrimaryIfaceName)).To(Equal(expDHCPConfig))
 		Expect(cache.ReadDomainInterfaceCache(&baseCacheCreator, "0", defaultPodNetworkName)).To(Equal(&api.Interface{
 			MAC: &api.MAC{MAC: podIfaceOrignalMAC},
@@ -1066,6 +1158,59 @@ var _ = Describe("netpod", func() {
 			Expect(masqstub.podIfaceSpec.Name).To(Equal("eth0"))
 			Expect(masqstub.vmiIfaceSpec.Name).To(Equal(defaultPodNetworkName))
 		})
+
+		Context("with an IP pool", func() {
+			BeforeEach(func() {
+				specNetworks[1].Multus.IPPool = "pool1"
+			})
+
+			It("serves the allocated address to the secondary bridge binding", func() {
+				netPod := netpod.NewNetPod(
+					specNetworks,
+					specInterfaces,
+					vmiUID, 0, 0, 0, state,
+					netpod.WithNMStateAdapter(&nmstatestub),
+					netpod.WithMasqueradeAdapter(&masqstub),
+					netpod.WithCacheCreator(&baseCacheCreator),
+					netpod.WithIPAllocations([]v1.VirtualMachineInstanceIPAllocation{{
+						Name:    secondaryNetworkName,
+						IPPool:  "pool1",
+						Address: "192.168.10.5/24",
+						Gateway: "192.168.10.1",
+					}}),
+				)
+				Expect(netPod.Setup()).To(Succeed())
+
+				bridgeIface := nmstate.LookupInterface(nmstatestub.spec.Interfaces, func(i nmstate.Interface) bool {
+					return i.TypeName == nmstate.TypeBridge && i.Metadata.NetworkName == secondaryNetworkName
+				})
+				Expect(bridgeIface).NotTo(BeNil())
+				Expect(bridgeIface.IPv4.Address).To(HaveLen(1))
+
+				dhcpConfig, err := cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", secondaryPodInterfaceName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(dhcpConfig.IPAMDisabled).To(BeFalse())
+				Expect(dhcpConfig.IP.String()).To(Equal("192.168.10.5/24"))
+				Expect(dhcpConfig.MAC.String()).To(Equal(secondaryPodIfaceOrignalMAC))
+				Expect(dhcpConfig.Gateway.String()).To(Equal("192.168.10.1"))
+			})
+
+			It("fails setup until the address is allocated", func() {
+				netPod := netpod.NewNetPod(
+					specNetworks,
+					specInterfaces,
+					vmiUID, 0, 0, 0, state,
+					netpod.WithNMStateAdapter(&nmstatestub),
+					netpod.WithMasqueradeAdapter(&masqstub),
+					netpod.WithCacheCreator(&baseCacheCreator),
+				)
+				err := netPod.Setup()
+				Expect(err).To(MatchError(ContainSubstring("waiting for an address from IP pool pool1")))
+				var criticalNetErr *neterrors.CriticalNetworkError
+				Expect(errors.As(err, &criticalNetErr)).To(BeFalse())
+				Expect(nmstatestub.spec.Interfaces).To(BeEmpty())
+			})
+		})
 	})
 
 	It("setup Passt binding", func() {
@@ -2058,6 +2203,21 @@ func expectedDHCPConfig(podIfaceCIDR, podIfaceMAC, defaultGW, staticRouteDst, st
 	}, nil
 }
 
+type firewallStub struct {
+	setupErr error
+	ifaces   []firewall.Interface
+}
+
+var errFirewallSetup = errors.New("firewall Setup Test Error")
+
+func (f *firewallStub) Setup(ifaces []firewall.Interface) error {
+	if f.setupErr != nil {
+		return f.setupErr
+	}
+	f.ifaces = ifaces
+	return nil
+}
+
 type netnsStub struct {
 	shouldFail bool
 }
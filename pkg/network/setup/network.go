@@ -91,3 +91,23 @@ func (n *VMNetworkConfigurator) SetupPodNetworkPhase2(domain *api.Domain, networ
 	}
 	return nil
 }
+
+// DHCPv4Interfaces returns the names of the interfaces whose guest IPv4 address is served over DHCP.
+// It is known only after the pod network phase2 was set up.
+func (n *VMNetworkConfigurator) DHCPv4Interfaces(domain *api.Domain, networks []v1.Network) (map[string]bool, error) {
+	nics, err := n.getPhase2NICs(domain, networks)
+	if err != nil {
+		return nil, err
+	}
+	dhcpIfaces := map[string]bool{}
+	for _, nic := range nics {
+		servesDHCPv4, err := nic.servesDHCPv4()
+		if err != nil {
+			return nil, fmt.Errorf("failed reading the dhcp configuration of nic '%s': %w", nic.podInterfaceName, err)
+		}
+		if servesDHCPv4 {
+			dhcpIfaces[nic.vmiSpecIface.Name] = true
+		}
+	}
+	return dhcpIfaces, nil
+}
@@ -111,6 +111,18 @@ func (l *podNIC) PlugPhase2(domain *api.Domain) error {
 	return nil
 }
 
+// servesDHCPv4 reports whether the guest IPv4 address of the interface is served by the virt-launcher DHCP server
+func (l *podNIC) servesDHCPv4() (bool, error) {
+	if l.dhcpConfigurator == nil {
+		return false, nil
+	}
+	dhcpConfig, err := l.dhcpConfigurator.Generate()
+	if err != nil {
+		return false, err
+	}
+	return !dhcpConfig.IPAMDisabled && dhcpConfig.IP.IPNet != nil, nil
+}
+
 func (l *podNIC) newDHCPConfigurator() dhcpconfigurator.Configurator {
 	var dhcpConfigurator dhcpconfigurator.Configurator
 	if l.vmiSpecIface.Bridge != nil {
@@ -2,11 +2,13 @@ package network
 
 import (
 	"fmt"
+	"net"
 	"runtime"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/vishvananda/netlink"
 	"go.uber.org/mock/gomock"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -104,6 +106,21 @@ var _ = Describe("podNIC", func() {
 
 		})
 	})
+
+	DescribeTable("should report whether the guest IPv4 address is served over DHCP", func(dhcpConfig *cache.DHCPConfig, expected bool) {
+		vmi := newVMIBridgeInterface("testnamespace", "testVmName")
+		podnic, err := newPhase2PodNICWithMocks(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		mockDHCPConfigurator.EXPECT().Generate().Return(dhcpConfig, nil)
+
+		Expect(podnic.servesDHCPv4()).To(Equal(expected))
+	},
+		Entry("when the pod interface has an IPv4 address",
+			&cache.DHCPConfig{IP: netlink.Addr{IPNet: &net.IPNet{IP: net.IPv4(10, 0, 0, 5), Mask: net.CIDRMask(24, 32)}}}, true),
+		Entry("not when IPAM is disabled", &cache.DHCPConfig{IPAMDisabled: true}, false),
+		Entry("not when the pod interface has only an IPv6 address",
+			&cache.DHCPConfig{IPv6: netlink.Addr{IPNet: &net.IPNet{IP: net.ParseIP("fd10::5"), Mask: net.CIDRMask(64, 128)}}}, false),
+	)
 })
 
 type fakeLibvirtSpecGenerator struct {
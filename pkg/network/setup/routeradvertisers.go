@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package network
+
+import (
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/dhcp/serverv6"
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// syncRouterAdvertisers advertises the pod IPv6 gateway to the guest of each bridge binding interface,
+// pointing it to the DHCPv6 server in virt-launcher for the address and DNS configuration.
+// The advertisements are sent on behalf of the gateway, which requires privileges virt-launcher does not have.
+// The advertisers are reconciled against all the VMI bridge interfaces, regardless of the networks being set up,
+// so they are recreated after a virt-handler restart.
+func (c *NetConf) syncRouterAdvertisers(vmi *v1.VirtualMachineInstance, launcherPid int) {
+	vmiUID := string(vmi.UID)
+	networks := bridgeNetworks(vmi)
+
+	c.routerAdvertisersMutex.Lock()
+	defer c.routerAdvertisersMutex.Unlock()
+
+	advertisers := c.routerAdvertisers[vmiUID]
+	for networkName, advertiser := range advertisers {
+		if vmispec.LookupNetworkByName(networks, networkName) == nil {
+			closeRouterAdvertiser(vmi, networkName, advertiser)
+			delete(advertisers, networkName)
+		}
+	}
+
+	for _, network := range networks {
+		if _, exists := advertisers[network.Name]; exists {
+			continue
+		}
+		advertiser, err := c.newRouterAdvertiser(vmi, network, launcherPid)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to start the router advertiser for network %s", network.Name)
+			continue
+		}
+		if advertiser == nil {
+			continue
+		}
+		if advertisers == nil {
+			advertisers = map[string]*serverv6.RouterAdvertiser{}
+			c.routerAdvertisers[vmiUID] = advertisers
+		}
+		advertisers[network.Name] = advertiser
+		go advertiser.Serve()
+	}
+}
+
+func bridgeNetworks(vmi *v1.VirtualMachineInstance) []v1.Network {
+	bridgeIfaces := vmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		return iface.Bridge != nil && iface.State != v1.InterfaceStateAbsent
+	})
+	return vmispec.FilterNetworksByInterfaces(vmi.Spec.Networks, bridgeIfaces)
+}
+
+// newRouterAdvertiser returns nil when the network has no IPv6 link-local gateway to advertise.
+func (c *NetConf) newRouterAdvertiser(vmi *v1.VirtualMachineInstance, network v1.Network, launcherPid int) (*serverv6.RouterAdvertiser, error) {
+	var advertiser *serverv6.RouterAdvertiser
+	err := c.nsFactory(launcherPid).Do(func() error {
+		podLink, err := link.DiscoverByNetwork(&netdriver.NetworkUtilsHandler{}, vmi.Spec.Networks, network, vmi.Status.Interfaces)
+		if err != nil || podLink == nil {
+			return err
+		}
+		podIfaceName := podLink.Attrs().Name
+
+		dhcpConfig, err := cache.ReadDHCPInterfaceCache(c.cacheCreator, strconv.Itoa(launcherPid), podIfaceName)
+		if err != nil {
+			return err
+		}
+		if dhcpConfig.IPv6.IPNet == nil || !dhcpConfig.IPv6Gateway.IsLinkLocalUnicast() {
+			return nil
+		}
+
+		advertiser, err = serverv6.NewRouterAdvertiser(
+			link.GenerateBridgeName(podIfaceName),
+			link.GenerateTapDeviceName(podIfaceName, network),
+			dhcpConfig.IPv6Gateway,
+			dhcpConfig.IPv6.IPNet,
+			podLink.Attrs().MTU,
+		)
+		return err
+	})
+	return advertiser, err
+}
+
+func (c *NetConf) stopRouterAdvertisers(vmi *v1.VirtualMachineInstance) {
+	c.routerAdvertisersMutex.Lock()
+	defer c.routerAdvertisersMutex.Unlock()
+
+	for networkName, advertiser := range c.routerAdvertisers[string(vmi.UID)] {
+		closeRouterAdvertiser(vmi, networkName, advertiser)
+	}
+	delete(c.routerAdvertisers, string(vmi.UID))
+}
+
+func closeRouterAdvertiser(vmi *v1.VirtualMachineInstance, networkName string, advertiser *serverv6.RouterAdvertiser) {
+	if err := advertiser.Close(); err != nil {
+		log.Log.Object(vmi).Reason(err).Warningf("failed to stop the router advertiser for network %s", networkName)
+	}
+}
@@ -186,7 +186,8 @@ func BindingPluginNetworkWithDeviceInfoExist(ifaces []v1.Interface, bindingPlugi
 func HasBindingPluginDeviceInfo(iface v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) bool {
 	if iface.Binding != nil {
 		binding, exist := bindingPlugins[iface.Binding.Name]
-		return exist && binding.DownwardAPI == v1.DeviceInfo
+		// The vhost-user socket is reported through the device info.
+		return exist && (binding.DownwardAPI == v1.DeviceInfo || binding.DomainAttachmentType == v1.VhostUser)
 	}
 	return false
 }
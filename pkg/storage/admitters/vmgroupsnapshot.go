@@ -0,0 +1,175 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMGroupSnapshotAdmitter validates VirtualMachineGroupSnapshots and VirtualMachineGroupRestores
+type VMGroupSnapshotAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMGroupSnapshotAdmitter creates a VMGroupSnapshotAdmitter
+func NewVMGroupSnapshotAdmitter(config *virtconfig.ClusterConfig) *VMGroupSnapshotAdmitter {
+	return &VMGroupSnapshotAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMGroupSnapshotAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		(ar.Request.Resource.Resource != "virtualmachinegroupsnapshots" &&
+			ar.Request.Resource.Resource != "virtualmachinegrouprestores") {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	if ar.Request.Operation != admissionv1.Create && ar.Request.Operation != admissionv1.Update {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	var causes []metav1.StatusCause
+	var err error
+	if ar.Request.Resource.Resource == "virtualmachinegroupsnapshots" {
+		causes, err = admitGroupSnapshot(ar.Request)
+	} else {
+		causes, err = admitGroupRestore(ar.Request)
+	}
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func admitGroupSnapshot(request *admissionv1.AdmissionRequest) ([]metav1.StatusCause, error) {
+	groupSnapshot := &snapshotv1.VirtualMachineGroupSnapshot{}
+	if err := json.Unmarshal(request.Object.Raw, groupSnapshot); err != nil {
+		return nil, err
+	}
+
+	if request.Operation == admissionv1.Update {
+		prevObj := &snapshotv1.VirtualMachineGroupSnapshot{}
+		if err := json.Unmarshal(request.OldObject.Raw, prevObj); err != nil {
+			return nil, err
+		}
+		return validateSpecUnchanged(prevObj.Spec, groupSnapshot.Spec), nil
+	}
+
+	return validateGroupSnapshotSpec(k8sfield.NewPath("spec"), &groupSnapshot.Spec), nil
+}
+
+func admitGroupRestore(request *admissionv1.AdmissionRequest) ([]metav1.StatusCause, error) {
+	groupRestore := &snapshotv1.VirtualMachineGroupRestore{}
+	if err := json.Unmarshal(request.Object.Raw, groupRestore); err != nil {
+		return nil, err
+	}
+
+	if request.Operation == admissionv1.Update {
+		prevObj := &snapshotv1.VirtualMachineGroupRestore{}
+		if err := json.Unmarshal(request.OldObject.Raw, prevObj); err != nil {
+			return nil, err
+		}
+		return validateSpecUnchanged(prevObj.Spec, groupRestore.Spec), nil
+	}
+
+	if groupRestore.Spec.VirtualMachineGroupSnapshotName == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing virtualMachineGroupSnapshotName",
+			Field:   k8sfield.NewPath("spec", "virtualMachineGroupSnapshotName").String(),
+		}}, nil
+	}
+	return nil, nil
+}
+
+func validateSpecUnchanged(prevSpec, spec interface{}) []metav1.StatusCause {
+	if equality.Semantic.DeepEqual(prevSpec, spec) {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "spec in immutable after creation",
+		Field:   k8sfield.NewPath("spec").String(),
+	}}
+}
+
+func validateGroupSnapshotSpec(field *k8sfield.Path, spec *snapshotv1.VirtualMachineGroupSnapshotSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	// an empty selector would snapshot every VM of the namespace
+	if len(spec.Selector.MatchLabels) == 0 && len(spec.Selector.MatchExpressions) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "selector must not be empty",
+			Field:   field.Child("selector").String(),
+		})
+	} else if _, err := metav1.LabelSelectorAsSelector(&spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid selector: %v", err),
+			Field:   field.Child("selector").String(),
+		})
+	}
+
+	if spec.DeletionPolicy != nil &&
+		*spec.DeletionPolicy != snapshotv1.VirtualMachineSnapshotContentDelete &&
+		*spec.DeletionPolicy != snapshotv1.VirtualMachineSnapshotContentRetain {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("deletionPolicy must be one of %s, %s", snapshotv1.VirtualMachineSnapshotContentDelete, snapshotv1.VirtualMachineSnapshotContentRetain),
+			Field:   field.Child("deletionPolicy").String(),
+		})
+	}
+
+	if spec.FailureDeadline != nil && spec.FailureDeadline.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failureDeadline must not be negative",
+			Field:   field.Child("failureDeadline").String(),
+		})
+	}
+
+	return causes
+}
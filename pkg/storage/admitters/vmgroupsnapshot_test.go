@@ -0,0 +1,180 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Validating VirtualMachineGroupSnapshot Admitter", func() {
+	newGroupSnapshot := func() *snapshotv1.VirtualMachineGroupSnapshot {
+		return &snapshotv1.VirtualMachineGroupSnapshot{
+			Spec: snapshotv1.VirtualMachineGroupSnapshotSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "shop"},
+				},
+				DeletionPolicy:  pointer.P(snapshotv1.VirtualMachineSnapshotContentDelete),
+				FailureDeadline: &metav1.Duration{Duration: time.Minute},
+			},
+		}
+	}
+
+	newGroupRestore := func() *snapshotv1.VirtualMachineGroupRestore {
+		return &snapshotv1.VirtualMachineGroupRestore{
+			Spec: snapshotv1.VirtualMachineGroupRestoreSpec{
+				VirtualMachineGroupSnapshotName: "shop",
+			},
+		}
+	}
+
+	It("should reject anything without the snapshot feature gate", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+		resp := NewVMGroupSnapshotAdmitter(config).Admit(context.Background(), createGroupAdmissionReview(admissionv1.Create, "virtualmachinegroupsnapshots", newGroupSnapshot(), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("with the snapshot feature gate", func() {
+		var admitter *VMGroupSnapshotAdmitter
+
+		BeforeEach(func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.SnapshotGate},
+				},
+			})
+			admitter = NewVMGroupSnapshotAdmitter(config)
+		})
+
+		It("should accept a valid group snapshot", func() {
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Create, "virtualmachinegroupsnapshots", newGroupSnapshot(), nil))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a valid group restore", func() {
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Create, "virtualmachinegrouprestores", newGroupRestore(), nil))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject a group snapshot with", func(update func(*snapshotv1.VirtualMachineGroupSnapshot), field string) {
+			groupSnapshot := newGroupSnapshot()
+			update(groupSnapshot)
+
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Create, "virtualmachinegroupsnapshots", groupSnapshot, nil))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an empty selector", func(gs *snapshotv1.VirtualMachineGroupSnapshot) {
+				gs.Spec.Selector = metav1.LabelSelector{}
+			}, "spec.selector"),
+			Entry("an invalid selector", func(gs *snapshotv1.VirtualMachineGroupSnapshot) {
+				gs.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}}
+			}, "spec.selector"),
+			Entry("an unknown deletion policy", func(gs *snapshotv1.VirtualMachineGroupSnapshot) {
+				gs.Spec.DeletionPolicy = pointer.P(snapshotv1.DeletionPolicy("Sometimes"))
+			}, "spec.deletionPolicy"),
+			Entry("a negative failure deadline", func(gs *snapshotv1.VirtualMachineGroupSnapshot) {
+				gs.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+			}, "spec.failureDeadline"),
+		)
+
+		It("should reject a group restore without group snapshot", func() {
+			groupRestore := newGroupRestore()
+			groupRestore.Spec.VirtualMachineGroupSnapshotName = ""
+
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Create, "virtualmachinegrouprestores", groupRestore, nil))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineGroupSnapshotName"))
+		})
+
+		It("should reject spec updates of a group snapshot", func() {
+			oldGroupSnapshot := newGroupSnapshot()
+			groupSnapshot := newGroupSnapshot()
+			groupSnapshot.Spec.Selector.MatchLabels["tier"] = "db"
+
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Update, "virtualmachinegroupsnapshots", groupSnapshot, oldGroupSnapshot))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should reject spec updates of a group restore", func() {
+			oldGroupRestore := newGroupRestore()
+			groupRestore := newGroupRestore()
+			groupRestore.Spec.VirtualMachineGroupSnapshotName = "other"
+
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Update, "virtualmachinegrouprestores", groupRestore, oldGroupRestore))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should accept metadata updates of a group snapshot", func() {
+			oldGroupSnapshot := newGroupSnapshot()
+			groupSnapshot := newGroupSnapshot()
+			groupSnapshot.Labels = map[string]string{"owner": "shop"}
+
+			resp := admitter.Admit(context.Background(), createGroupAdmissionReview(admissionv1.Update, "virtualmachinegroupsnapshots", groupSnapshot, oldGroupSnapshot))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createGroupAdmissionReview(operation admissionv1.Operation, resource string, obj, oldObj interface{}) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(obj)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+	if oldObj != nil {
+		oldBytes, _ := json.Marshal(oldObj)
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+	return ar
+}
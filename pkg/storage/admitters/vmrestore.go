@@ -172,6 +172,7 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 	namespace := vmRestore.Namespace
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
+	causes = append(causes, validateVolumeRestore(field, &vmRestore.Spec)...)
 
 	vmSnapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(ctx, vmRestore.Spec.VirtualMachineSnapshotName, metav1.GetOptions{})
 	if err != nil {
@@ -187,7 +188,16 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 	}
 
 	sourceTargetVmsAreDifferent := errors.IsNotFound(err) || (vmSnapshot.Status.SourceUID != nil && target.UID != *vmSnapshot.Status.SourceUID)
-	if sourceTargetVmsAreDifferent {
+	volumeRestore := len(vmRestore.Spec.Volumes) > 0
+	if volumeRestore && sourceTargetVmsAreDifferent {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Volume restore is only supported to the existing source VM of the snapshot",
+			Field:   field.Child("target").String(),
+		})
+	}
+
+	if sourceTargetVmsAreDifferent || volumeRestore {
 		contentName := vmSnapshot.Status.VirtualMachineSnapshotContentName
 		if contentName == nil {
 			return nil, fmt.Errorf("snapshot content name is nil in vmSnapshot status")
@@ -203,18 +213,73 @@ func (admitter *VMRestoreAdmitter) validateTargetVM(ctx context.Context, field *
 			return nil, fmt.Errorf("unexpected snapshot source")
 		}
 
-		if backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
+		if sourceTargetVmsAreDifferent && backendstorage.IsBackendStorageNeededForVMI(&snapshotVM.Spec.Template.Spec) {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: "Restore to a different VM not supported when using backend storage",
 				Field:   field.String(),
 			})
 		}
+
+		causes = append(causes, validateRestoreVolumesInSnapshot(field.Child("volumes"), vmRestore.Spec.Volumes, vmSnapshotContent)...)
 	}
 
 	return causes, nil
 }
 
+func validateVolumeRestore(field *k8sfield.Path, spec *snapshotv1.VirtualMachineRestoreSpec) (causes []metav1.StatusCause) {
+	if len(spec.Volumes) == 0 {
+		if spec.VolumeRestoreMode != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "volumeRestoreMode requires volumes",
+				Field:   field.Child("volumeRestoreMode").String(),
+			})
+		}
+		return causes
+	}
+
+	if spec.VolumeRestoreMode != nil &&
+		*spec.VolumeRestoreMode != snapshotv1.VolumeRestoreModeNewPVC &&
+		*spec.VolumeRestoreMode != snapshotv1.VolumeRestoreModeInPlace {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("volumeRestoreMode must be one of %s, %s", snapshotv1.VolumeRestoreModeNewPVC, snapshotv1.VolumeRestoreModeInPlace),
+			Field:   field.Child("volumeRestoreMode").String(),
+		})
+	}
+
+	if len(spec.Patches) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "patches are not supported when restoring volumes",
+			Field:   field.Child("patches").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateRestoreVolumesInSnapshot(field *k8sfield.Path, volumes []string, content *snapshotv1.VirtualMachineSnapshotContent) (causes []metav1.StatusCause) {
+	for i, volumeName := range volumes {
+		found := false
+		for _, vb := range content.Spec.VolumeBackups {
+			if vb.VolumeName == volumeName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is not part of the snapshot", volumeName),
+				Field:   field.Index(i).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
 	for _, patch := range patches {
@@ -561,6 +561,91 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				})
 			})
 
+			Context("when restoring volumes", func() {
+				var (
+					restore           *snapshotv1.VirtualMachineRestore
+					vmSnapshotContent *snapshotv1.VirtualMachineSnapshotContent
+				)
+
+				BeforeEach(func() {
+					vmSnapshotContent = &snapshotv1.VirtualMachineSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "snapshot-content",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+							Source: snapshotv1.SourceSpec{
+								VirtualMachine: &snapshotv1.VirtualMachine{
+									ObjectMeta: vm.ObjectMeta,
+									Spec: v1.VirtualMachineSpec{
+										Template: &v1.VirtualMachineInstanceTemplateSpec{},
+									},
+								},
+							},
+							VolumeBackups: []snapshotv1.VolumeBackup{
+								{VolumeName: "disk1"},
+								{VolumeName: "disk2"},
+							},
+						},
+					}
+					snapshot.Status.VirtualMachineSnapshotContentName = pointer.P(vmSnapshotContent.Name)
+
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+							Volumes:                    []string{"disk2"},
+						},
+					}
+				})
+
+				DescribeTable("should allow restoring volumes of the source VM", func(mode *snapshotv1.VolumeRestoreMode) {
+					restore.Spec.VolumeRestoreMode = mode
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeTrue())
+				},
+					Entry("with default mode", nil),
+					Entry("into new PVCs", pointer.P(snapshotv1.VolumeRestoreModeNewPVC)),
+					Entry("in place", pointer.P(snapshotv1.VolumeRestoreModeInPlace)),
+				)
+
+				DescribeTable("should reject", func(updateRestore func(*snapshotv1.VirtualMachineRestore), field string) {
+					updateRestore(restore)
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot, vmSnapshotContent).Admit(context.Background(), ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+				},
+					Entry("volumes missing from the snapshot", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Volumes = []string{"disk1", "missing"}
+					}, "spec.volumes[1]"),
+					Entry("a target other than the source VM", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Target.Name = "other-vm"
+					}, "spec.target"),
+					Entry("patches", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Patches = []string{`{"op": "add", "path": "/spec/running", "value": false}`}
+					}, "spec.patches"),
+					Entry("an unsupported volumeRestoreMode", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreMode("Invalid"))
+					}, "spec.volumeRestoreMode"),
+					Entry("a volumeRestoreMode without volumes", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Volumes = nil
+						r.Spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreModeInPlace)
+					}, "spec.volumeRestoreMode"),
+				)
+			})
 		})
 	})
 })
@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+				Retention: &snapshotv1.SnapshotRetentionPolicy{
+					MaxCount: pointer.P(int32(7)),
+					MaxAge:   &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				},
+				Quiesce: pointer.P(snapshotv1.QuiesceRequired),
+			},
+		}
+	}
+
+	It("should reject anything without the snapshot feature gate", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+		resp := NewVMSnapshotScheduleAdmitter(config).Admit(context.Background(), createSnapshotScheduleAdmissionReview(newSchedule()))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("with the snapshot feature gate", func() {
+		var admitter *VMSnapshotScheduleAdmitter
+
+		BeforeEach(func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{featuregate.SnapshotGate},
+				},
+			})
+			admitter = NewVMSnapshotScheduleAdmitter(config)
+		})
+
+		It("should accept a valid schedule", func() {
+			resp := admitter.Admit(context.Background(), createSnapshotScheduleAdmissionReview(newSchedule()))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(update func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			update(schedule)
+
+			resp := admitter.Admit(context.Background(), createSnapshotScheduleAdmissionReview(schedule))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an invalid cron expression", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "0 25 * * *"
+			}, "spec.schedule"),
+			Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "backup", Operator: "Bogus"}}
+			}, "spec.selector"),
+			Entry("a zero maxCount", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.MaxCount = pointer.P(int32(0))
+			}, "spec.retention.maxCount"),
+			Entry("a negative maxAge", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.MaxAge = &metav1.Duration{Duration: -time.Hour}
+			}, "spec.retention.maxAge"),
+			Entry("an unknown quiesce policy", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Quiesce = pointer.P(snapshotv1.QuiescePolicy("Sometimes"))
+			}, "spec.quiesce"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
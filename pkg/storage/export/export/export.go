@@ -122,6 +122,7 @@ const (
 	manifestData           = "manifest-data"
 	manifestsPath          = "/manifests/all"
 	secretManifestPath     = "/manifests/secret"
+	ovaPath                = "/ova/virtualmachine.ova"
 	externalHostKey        = "external_host"
 	internalHostKey        = "internal_host"
 	externalCaConfigMapKey = "external_ca_cm"
@@ -157,6 +158,10 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -988,6 +993,13 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
 	})
+	// only VMs and VM snapshots can be exported as an appliance
+	if !ctrl.isSourcePvc(&vmExport.Spec) {
+		podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "EXPORT_VM_OVA_URI",
+			Value: ovaPath,
+		})
+	}
 
 	tokenSecretRef := ""
 	if vmExport.Status != nil && vmExport.Status.TokenSecretRef != nil {
@@ -1184,6 +1196,9 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
 		})
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
@@ -1193,6 +1208,9 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
@@ -97,6 +97,12 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			Url:  scheme + path.Join(hostAndBase, linkType, paths.SecretURI),
 		})
 	}
+	if paths.OVAURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.OVA,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.OVAURI),
+		})
+	}
 
 	for _, pvc := range pvcs {
 		if pvc == nil || exporterPod.Status.Phase != corev1.PodRunning {
@@ -125,6 +131,12 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
@@ -15,12 +15,14 @@ type VolumeInfo struct {
 	DirURI     string
 	RawURI     string
 	RawGzURI   string
+	Qcow2URI   string
 }
 
 // ServerPaths contains static paths and per-volume paths
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
+	OVAURI    string
 	Volumes   []VolumeInfo
 }
 
@@ -48,6 +50,7 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
 		VMURI:     env["EXPORT_VM_DEF_URI"],
 		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		OVAURI:    env["EXPORT_VM_OVA_URI"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
@@ -58,6 +61,7 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
 				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
 				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Qcow2URI:   env[envPrefix+"_EXPORT_QCOW2_URI"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
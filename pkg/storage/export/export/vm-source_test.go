@@ -388,6 +388,10 @@ var _ = Describe("PVC source", func() {
 			Format: exportv1.KubeVirtGz,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
 		})
+		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.KubeVirtQcow2,
+			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
+		})
 		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
 			Format: exportv1.Dir,
 			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/dir", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
@@ -411,6 +415,10 @@ var _ = Describe("PVC source", func() {
 			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
 			Expect(ok).To(BeTrue())
 			verifyFunc(vmExport, vmExport.Name, testNamespace, "volume1", "volume2")
+			Expect(vmExport.Status.Links.Internal.Manifests).To(ContainElement(exportv1.VirtualMachineExportManifest{
+				Type: exportv1.OVA,
+				Url:  fmt.Sprintf("https://%s-%s.%s.svc/internal/ova/virtualmachine.ova", exportPrefix, vmExport.Name, testNamespace),
+			}))
 			for _, condition := range vmExport.Status.Conditions {
 				if condition.Type == exportv1.ConditionReady {
 					Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
@@ -34,6 +34,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -66,6 +67,9 @@ const (
 
 	external = "/external"
 	internal = "/internal"
+
+	// extentsQueryParam requests the list of data extents of a raw volume instead of its content
+	extentsQueryParam = "extents"
 )
 
 type TokenGetterFunc func() (string, error)
@@ -86,7 +90,9 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
+	OvaHandler         func([]export.VolumeInfo) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
 	PermissionChecker func(string) bool
@@ -136,6 +142,10 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getInternalBasePath, getInternalCAConfigMap)))
 		mux.Handle(filepath.Join(external, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getExternalBasePath, getExternalCAConfigMap)))
 	}
+	if s.Paths.OVAURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+		mux.Handle(filepath.Join(external, s.Paths.OVAURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+	}
 	if s.Paths.SecretURI != "" {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
@@ -184,6 +194,10 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
 	return result
 }
 
@@ -243,10 +257,18 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
 
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
 	if es.TokenSecretHandler == nil {
 		es.TokenSecretHandler = secretHandler
 	}
@@ -570,6 +592,36 @@ func gzipHandler(filePath string) http.Handler {
 	})
 }
 
+// qcow2Handler serves the raw disk as sparse qcow2 image, so only the clusters containing data are transferred
+func qcow2Handler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		img, err := newQcow2Image(f)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error reading %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(img.Size(), 10))
+		n, err := img.WriteTo(w)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
 func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCmFunc func() (*corev1.ConfigMap, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -706,10 +758,59 @@ func fileHandler(file string) http.Handler {
 			return
 		}
 		defer f.Close()
+		if r.URL.Query().Has(extentsQueryParam) {
+			extentsHandler(w, r, f)
+			return
+		}
 		http.ServeContent(w, r, "disk.img", time.Time{}, f)
 	})
 }
 
+// diskExtents lists the ranges of a raw disk containing data, the rest of the disk reads as zeros.
+// Together with range requests it allows clients to only download the allocated data of the disk.
+type diskExtents struct {
+	Size    int64        `json:"size"`
+	Extents []diskExtent `json:"extents"`
+}
+
+type diskExtent struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+func extentsHandler(w http.ResponseWriter, r *http.Request, f *os.File) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		log.Log.Reason(err).Errorf("error getting size of %s", f.Name())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	extents := diskExtents{
+		Size:    size,
+		Extents: []diskExtent{},
+	}
+	for _, extent := range dataExtents(f, size) {
+		extents.Extents = append(extents.Extents, diskExtent{
+			Offset: extent[0],
+			Length: extent[1] - extent[0],
+		})
+	}
+	data, err := json.Marshal(extents)
+	if err != nil {
+		log.Log.Reason(err).Error("error marshalling extents")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		log.Log.Reason(err).Error("error writing response body")
+	}
+}
+
 func getToken(tokenFile string) (string, error) {
 	content, err := os.ReadFile(tokenFile)
 	if err != nil {
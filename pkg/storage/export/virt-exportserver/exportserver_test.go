@@ -63,9 +63,15 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		OvaHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -125,6 +131,11 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -180,6 +191,11 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -232,6 +248,11 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -283,6 +304,11 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -295,6 +321,28 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	DescribeTable("should handle OVA URI", func(uri, token string, expectedStatus int) {
+		es := newTestServer("foo")
+		es.Paths = &export.ServerPaths{OVAURI: "/ova/virtualmachine.ova"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		client := http.Client{}
+		req, err := http.NewRequest("GET", httpServer.URL+uri, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("internal", "/internal/ova/virtualmachine.ova", "foo", http.StatusOK),
+		Entry("external", "/external/ova/virtualmachine.ova", "foo", http.StatusOK),
+		Entry("bad token", "/internal/ova/virtualmachine.ova", "bar", http.StatusUnauthorized),
+	)
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -0,0 +1,511 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+const (
+	ovfEnvelopeNamespace = "http://schemas.dmtf.org/ovf/envelope/1"
+	ovfRasdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	ovfVssdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+	ovfQcow2Format       = "http://www.gnome.org/~markmc/qcow-image-format.html"
+
+	// CIM resource types of the virtual hardware items
+	ovfResourceProcessor       = 3
+	ovfResourceMemory          = 4
+	ovfResourceSCSIController  = 6
+	ovfResourceEthernetAdapter = 10
+	ovfResourceDiskDrive       = 17
+	ovfResourceOtherStorage    = 20
+
+	ovfDescriptorName = "virtualmachine.ovf"
+	tarBlockSize      = 512
+	mebibyte          = 1024 * 1024
+)
+
+type ovfEnvelope struct {
+	XMLName        xml.Name          `xml:"Envelope"`
+	Xmlns          string            `xml:"xmlns,attr"`
+	XmlnsOvf       string            `xml:"xmlns:ovf,attr"`
+	XmlnsRasd      string            `xml:"xmlns:rasd,attr"`
+	XmlnsVssd      string            `xml:"xmlns:vssd,attr"`
+	References     []ovfFile         `xml:"References>File"`
+	DiskSection    ovfDiskSection    `xml:"DiskSection"`
+	NetworkSection ovfNetworkSection `xml:"NetworkSection"`
+	VirtualSystem  ovfVirtualSystem  `xml:"VirtualSystem"`
+}
+
+type ovfFile struct {
+	ID   string `xml:"ovf:id,attr"`
+	Href string `xml:"ovf:href,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type ovfDiskSection struct {
+	Info  string    `xml:"Info"`
+	Disks []ovfDisk `xml:"Disk"`
+}
+
+type ovfDisk struct {
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+}
+
+type ovfNetworkSection struct {
+	Info     string       `xml:"Info"`
+	Networks []ovfNetwork `xml:"Network"`
+}
+
+type ovfNetwork struct {
+	Name        string `xml:"ovf:name,attr"`
+	Description string `xml:"Description"`
+}
+
+type ovfVirtualSystem struct {
+	ID       string                    `xml:"ovf:id,attr"`
+	Info     string                    `xml:"Info"`
+	Name     string                    `xml:"Name"`
+	Hardware ovfVirtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type ovfVirtualHardwareSection struct {
+	Info   string    `xml:"Info"`
+	System ovfSystem `xml:"System"`
+	Items  []ovfItem `xml:"Item"`
+}
+
+type ovfSystem struct {
+	ElementName             string `xml:"vssd:ElementName"`
+	InstanceID              int    `xml:"vssd:InstanceID"`
+	VirtualSystemIdentifier string `xml:"vssd:VirtualSystemIdentifier"`
+	VirtualSystemType       string `xml:"vssd:VirtualSystemType"`
+}
+
+// ovfItem is a virtual hardware item, the CIM schema requires the elements in alphabetical order
+type ovfItem struct {
+	Address             string `xml:"rasd:Address,omitempty"`
+	AddressOnParent     string `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits     string `xml:"rasd:AllocationUnits,omitempty"`
+	AutomaticAllocation *bool  `xml:"rasd:AutomaticAllocation,omitempty"`
+	Connection          string `xml:"rasd:Connection,omitempty"`
+	Description         string `xml:"rasd:Description,omitempty"`
+	ElementName         string `xml:"rasd:ElementName"`
+	HostResource        string `xml:"rasd:HostResource,omitempty"`
+	InstanceID          int    `xml:"rasd:InstanceID"`
+	Parent              int    `xml:"rasd:Parent,omitempty"`
+	ResourceSubType     string `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType        int    `xml:"rasd:ResourceType"`
+	VirtualQuantity     int64  `xml:"rasd:VirtualQuantity,omitempty"`
+}
+
+// ovaDisk is an exported volume of the VM added to the OVA
+type ovaDisk struct {
+	name  string
+	bus   virtv1.DiskBus
+	file  *os.File
+	image *qcow2Image
+}
+
+// fileName of the disk in the OVA, the file names of USTAR archives are limited to 100 characters
+// so they do not include the VM name
+func (d *ovaDisk) fileName() string {
+	return fmt.Sprintf("disk-%s.qcow2", d.name)
+}
+
+// ovaHandler serves the exported VM as an OVA, a tar archive with the OVF descriptor
+// of the VM followed by its disks in qcow2 format
+func ovaHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		vm := getExpandedVM()
+		if vm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		disks, err := openOvaDisks(vm, vi)
+		defer func() {
+			for _, disk := range disks {
+				disk.file.Close()
+			}
+		}()
+		if err != nil {
+			log.Log.Reason(err).Error("error reading the disks of the VM")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		descriptor, err := xml.MarshalIndent(newOvfEnvelope(vm, disks), "", "  ")
+		if err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		descriptor = append([]byte(xml.Header), descriptor...)
+
+		// the OVF descriptor has to be the first file of the OVA
+		size, err := tarEntrySize(ovfDescriptorName, int64(len(descriptor)))
+		if err != nil {
+			log.Log.Reason(err).Error("error calculating the OVA size")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		for _, disk := range disks {
+			diskSize, err := tarEntrySize(disk.fileName(), disk.image.Size())
+			if err != nil {
+				log.Log.Reason(err).Error("error calculating the OVA size")
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			size += diskSize
+		}
+		// the end of the archive is marked by two empty blocks
+		size += 2 * tarBlockSize
+
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", vm.Name+".ova"))
+
+		tw := tar.NewWriter(w)
+		if err := writeTarEntry(tw, ovfDescriptorName, int64(len(descriptor))); err != nil {
+			log.Log.Reason(err).Error("error writing OVF descriptor")
+			return
+		}
+		if _, err := tw.Write(descriptor); err != nil {
+			log.Log.Reason(err).Error("error writing OVF descriptor")
+			return
+		}
+		for _, disk := range disks {
+			if err := writeTarEntry(tw, disk.fileName(), disk.image.Size()); err != nil {
+				log.Log.Reason(err).Errorf("error writing disk %s", disk.name)
+				return
+			}
+			n, err := disk.image.WriteTo(tw)
+			if err != nil {
+				log.Log.Reason(err).Errorf("error writing disk %s", disk.name)
+				return
+			}
+			log.Log.Infof("Wrote %d bytes of disk %s\n", n, disk.name)
+		}
+		if err := tw.Close(); err != nil {
+			log.Log.Reason(err).Error("error closing OVA")
+		}
+	})
+}
+
+// tarEntrySize returns the size of a file in the OVA, including its headers. The headers are written to
+// count them, as files of 8GiB or more need a PAX extended header on top of the USTAR one.
+func tarEntrySize(name string, size int64) (int64, error) {
+	counter := &byteCounter{}
+	if err := writeTarEntry(tar.NewWriter(counter), name, size); err != nil {
+		return 0, err
+	}
+	return counter.n + divRoundUp(size, tarBlockSize)*tarBlockSize, nil
+}
+
+// writeTarEntry writes the header of a file in the OVA. The OVF specification requires USTAR, which the tar
+// writer uses unless the file is too large for it, in such case the size is stored in a PAX extended header.
+func writeTarEntry(tw *tar.Writer, name string, size int64) error {
+	return tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+	})
+}
+
+// byteCounter counts the bytes written to it
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// openOvaDisks opens the exported volumes of the VM, volumes which are not exported
+// as raw disk images (e.g. container disks or cloud-init) are not part of the OVA
+func openOvaDisks(vm *virtv1.VirtualMachine, vi []export.VolumeInfo) ([]*ovaDisk, error) {
+	if vm.Spec.Template == nil {
+		return nil, nil
+	}
+
+	buses := map[string]virtv1.DiskBus{}
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if disk.Disk != nil {
+			buses[disk.Name] = disk.Disk.Bus
+		}
+	}
+
+	var disks []*ovaDisk
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		claimName := ""
+		if volume.DataVolume != nil {
+			claimName = volume.DataVolume.Name
+		} else if volume.PersistentVolumeClaim != nil {
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		}
+		info := getRawVolumeInfo(vi, claimName)
+		if info == nil {
+			log.Log.V(1).Infof("Volume %s is not exported as disk, skipping", volume.Name)
+			continue
+		}
+
+		diskPath, err := getRawDiskPath(info.Path)
+		if err != nil {
+			return disks, err
+		}
+		f, err := os.Open(diskPath)
+		if err != nil {
+			return disks, err
+		}
+		disk := &ovaDisk{
+			name: volume.Name,
+			bus:  buses[volume.Name],
+			file: f,
+		}
+		disks = append(disks, disk)
+		if disk.image, err = newQcow2Image(f); err != nil {
+			return disks, err
+		}
+	}
+	return disks, nil
+}
+
+func getRawVolumeInfo(vi []export.VolumeInfo, claimName string) *export.VolumeInfo {
+	if claimName == "" {
+		return nil
+	}
+	for i := range vi {
+		if vi[i].RawURI != "" && path.Base(path.Dir(vi[i].RawURI)) == claimName {
+			return &vi[i]
+		}
+	}
+	return nil
+}
+
+func getRawDiskPath(volumePath string) (string, error) {
+	fi, err := os.Stat(volumePath)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return path.Join(volumePath, "disk.img"), nil
+	}
+	return volumePath, nil
+}
+
+func newOvfEnvelope(vm *virtv1.VirtualMachine, disks []*ovaDisk) *ovfEnvelope {
+	envelope := &ovfEnvelope{
+		Xmlns:     ovfEnvelopeNamespace,
+		XmlnsOvf:  ovfEnvelopeNamespace,
+		XmlnsRasd: ovfRasdNamespace,
+		XmlnsVssd: ovfVssdNamespace,
+		DiskSection: ovfDiskSection{
+			Info: "Virtual disk information",
+		},
+		NetworkSection: ovfNetworkSection{
+			Info: "The list of logical networks",
+		},
+		VirtualSystem: ovfVirtualSystem{
+			ID:   vm.Name,
+			Info: "A KubeVirt virtual machine",
+			Name: vm.Name,
+			Hardware: ovfVirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				System: ovfSystem{
+					ElementName:             "Virtual Hardware Family",
+					VirtualSystemIdentifier: vm.Name,
+					VirtualSystemType:       "kubevirt",
+				},
+			},
+		},
+	}
+	if vm.Spec.Template == nil {
+		return envelope
+	}
+	spec := &vm.Spec.Template.Spec
+	virtualHardware := &envelope.VirtualSystem.Hardware
+
+	instanceID := 0
+	addItem := func(item ovfItem) int {
+		instanceID++
+		item.InstanceID = instanceID
+		virtualHardware.Items = append(virtualHardware.Items, item)
+		return instanceID
+	}
+
+	cpus := getOvfCPUs(spec)
+	addItem(ovfItem{
+		AllocationUnits: "hertz * 10^6",
+		Description:     "Number of Virtual CPUs",
+		ElementName:     fmt.Sprintf("%d virtual CPU(s)", cpus),
+		ResourceType:    ovfResourceProcessor,
+		VirtualQuantity: cpus,
+	})
+
+	memory := getOvfMemoryMiB(spec)
+	addItem(ovfItem{
+		AllocationUnits: "byte * 2^20",
+		Description:     "Memory Size",
+		ElementName:     fmt.Sprintf("%dMB of memory", memory),
+		ResourceType:    ovfResourceMemory,
+		VirtualQuantity: memory,
+	})
+
+	// one controller per bus, the disks are attached in the order of the volumes
+	controllers := map[virtv1.DiskBus]int{}
+	attached := map[virtv1.DiskBus]int{}
+	for i, disk := range disks {
+		bus := disk.bus
+		if bus == "" {
+			bus = virtv1.DiskBusVirtio
+		}
+		if _, exists := controllers[bus]; !exists {
+			resourceType, subType := getOvfController(bus)
+			controllers[bus] = addItem(ovfItem{
+				Description:     fmt.Sprintf("%s Controller", bus),
+				ElementName:     fmt.Sprintf("%s controller", bus),
+				ResourceSubType: subType,
+				ResourceType:    resourceType,
+			})
+		}
+
+		fileID := fmt.Sprintf("file%d", i+1)
+		diskID := fmt.Sprintf("vmdisk%d", i+1)
+		envelope.References = append(envelope.References, ovfFile{
+			ID:   fileID,
+			Href: disk.fileName(),
+			Size: disk.image.Size(),
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, ovfDisk{
+			DiskID:                  diskID,
+			FileRef:                 fileID,
+			Capacity:                disk.image.virtualSize,
+			CapacityAllocationUnits: "byte",
+			Format:                  ovfQcow2Format,
+		})
+		addItem(ovfItem{
+			AddressOnParent: strconv.Itoa(attached[bus]),
+			ElementName:     disk.name,
+			HostResource:    "ovf:/disk/" + diskID,
+			Parent:          controllers[bus],
+			ResourceType:    ovfResourceDiskDrive,
+		})
+		attached[bus]++
+	}
+
+	networks := map[string]string{}
+	for _, network := range spec.Networks {
+		switch {
+		case network.Pod != nil:
+			networks[network.Name] = "pod"
+		case network.Multus != nil:
+			networks[network.Name] = network.Multus.NetworkName
+		}
+	}
+	connected := map[string]bool{}
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		connection := networks[iface.Name]
+		if connection == "" {
+			connection = iface.Name
+		}
+		if !connected[connection] {
+			connected[connection] = true
+			envelope.NetworkSection.Networks = append(envelope.NetworkSection.Networks, ovfNetwork{
+				Name:        connection,
+				Description: fmt.Sprintf("The %s network", connection),
+			})
+		}
+		model := iface.Model
+		if model == "" {
+			model = virtv1.VirtIO
+		}
+		automatic := true
+		addItem(ovfItem{
+			Address:             iface.MacAddress,
+			AutomaticAllocation: &automatic,
+			Connection:          connection,
+			ElementName:         iface.Name,
+			ResourceSubType:     model,
+			ResourceType:        ovfResourceEthernetAdapter,
+		})
+	}
+
+	return envelope
+}
+
+func getOvfCPUs(spec *virtv1.VirtualMachineInstanceSpec) int64 {
+	if spec.Domain.CPU != nil {
+		if cpus := hardware.GetNumberOfVCPUs(spec.Domain.CPU); cpus > 0 {
+			return cpus
+		}
+	}
+	if cpus := spec.Domain.Resources.Limits.Cpu().Value(); cpus > 0 {
+		return cpus
+	}
+	if cpus := spec.Domain.Resources.Requests.Cpu().Value(); cpus > 0 {
+		return cpus
+	}
+	return 1
+}
+
+func getOvfMemoryMiB(spec *virtv1.VirtualMachineInstanceSpec) int64 {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return divRoundUp(spec.Domain.Memory.Guest.Value(), mebibyte)
+	}
+	if memory := spec.Domain.Resources.Requests.Memory(); !memory.IsZero() {
+		return divRoundUp(memory.Value(), mebibyte)
+	}
+	return divRoundUp(spec.Domain.Resources.Limits.Memory().Value(), mebibyte)
+}
+
+func getOvfController(bus virtv1.DiskBus) (int, string) {
+	switch bus {
+	case virtv1.DiskBusSCSI:
+		return ovfResourceSCSIController, "virtio-scsi"
+	case virtv1.DiskBusSATA:
+		return ovfResourceOtherStorage, "AHCI"
+	default:
+		return ovfResourceOtherStorage, string(bus)
+	}
+}
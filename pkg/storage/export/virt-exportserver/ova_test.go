@@ -0,0 +1,290 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+var _ = Describe("OVA", func() {
+	newOvaTestVM := func() *virtv1.VirtualMachine {
+		guest := resource.MustParse("2Gi")
+		return &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vm",
+				Namespace: "default",
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Domain: virtv1.DomainSpec{
+							CPU: &virtv1.CPU{
+								Sockets: 2,
+								Cores:   2,
+								Threads: 1,
+							},
+							Memory: &virtv1.Memory{
+								Guest: &guest,
+							},
+							Devices: virtv1.Devices{
+								Disks: []virtv1.Disk{
+									{
+										Name: "rootdisk",
+										DiskDevice: virtv1.DiskDevice{
+											Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio},
+										},
+									},
+									{
+										Name: "datadisk",
+										DiskDevice: virtv1.DiskDevice{
+											Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusSATA},
+										},
+									},
+									{
+										Name: "cloudinit",
+										DiskDevice: virtv1.DiskDevice{
+											Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio},
+										},
+									},
+								},
+								Interfaces: []virtv1.Interface{
+									{
+										Name:       "default",
+										MacAddress: "02:00:00:00:00:01",
+									},
+									{
+										Name:  "secondary",
+										Model: "e1000",
+									},
+								},
+							},
+						},
+						Networks: []virtv1.Network{
+							{
+								Name:          "default",
+								NetworkSource: virtv1.NetworkSource{Pod: &virtv1.PodNetwork{}},
+							},
+							{
+								Name: "secondary",
+								NetworkSource: virtv1.NetworkSource{
+									Multus: &virtv1.MultusNetwork{NetworkName: "bridge-net"},
+								},
+							},
+						},
+						Volumes: []virtv1.Volume{
+							{
+								Name: "rootdisk",
+								VolumeSource: virtv1.VolumeSource{
+									DataVolume: &virtv1.DataVolumeSource{Name: "root-dv"},
+								},
+							},
+							{
+								Name: "datadisk",
+								VolumeSource: virtv1.VolumeSource{
+									PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"},
+									},
+								},
+							},
+							{
+								Name: "cloudinit",
+								VolumeSource: virtv1.VolumeSource{
+									CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	writeVolume := func(dir, claimName string, data []byte) export.VolumeInfo {
+		volumeDir := filepath.Join(dir, claimName)
+		Expect(os.Mkdir(volumeDir, 0755)).To(Succeed())
+		f, err := os.Create(filepath.Join(volumeDir, "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(f.Truncate(4 * qcow2ClusterSize)).To(Succeed())
+		_, err = f.WriteAt(data, qcow2ClusterSize)
+		Expect(err).ToNot(HaveOccurred())
+		return export.VolumeInfo{
+			Path:   volumeDir,
+			RawURI: "/volumes/" + claimName + "/disk.img",
+		}
+	}
+
+	Context("OVF descriptor", func() {
+		It("should describe the virtual hardware of the VM", func() {
+			vm := newOvaTestVM()
+			disks := []*ovaDisk{
+				{name: "rootdisk", bus: virtv1.DiskBusVirtio, image: &qcow2Image{virtualSize: 10 * mebibyte, hostClusters: 5}},
+				{name: "datadisk", bus: virtv1.DiskBusSATA, image: &qcow2Image{virtualSize: 20 * mebibyte, hostClusters: 6}},
+			}
+
+			envelope := newOvfEnvelope(vm, disks)
+			Expect(envelope.VirtualSystem.Name).To(Equal("test-vm"))
+			Expect(envelope.References).To(Equal([]ovfFile{
+				{ID: "file1", Href: "disk-rootdisk.qcow2", Size: 5 * qcow2ClusterSize},
+				{ID: "file2", Href: "disk-datadisk.qcow2", Size: 6 * qcow2ClusterSize},
+			}))
+			Expect(envelope.DiskSection.Disks).To(HaveLen(2))
+			Expect(envelope.DiskSection.Disks[1].Capacity).To(BeEquivalentTo(20 * mebibyte))
+			Expect(envelope.DiskSection.Disks[1].Format).To(Equal(ovfQcow2Format))
+			Expect(envelope.NetworkSection.Networks).To(ConsistOf(
+				ovfNetwork{Name: "pod", Description: "The pod network"},
+				ovfNetwork{Name: "bridge-net", Description: "The bridge-net network"},
+			))
+
+			items := envelope.VirtualSystem.Hardware.Items
+			Expect(items).To(HaveLen(8))
+			Expect(items[0].ResourceType).To(Equal(ovfResourceProcessor))
+			Expect(items[0].VirtualQuantity).To(BeEquivalentTo(4))
+			Expect(items[1].ResourceType).To(Equal(ovfResourceMemory))
+			Expect(items[1].VirtualQuantity).To(BeEquivalentTo(2048))
+
+			Expect(items[2].ResourceType).To(Equal(ovfResourceOtherStorage))
+			Expect(items[3].ResourceType).To(Equal(ovfResourceDiskDrive))
+			Expect(items[3].Parent).To(Equal(items[2].InstanceID))
+			Expect(items[3].HostResource).To(Equal("ovf:/disk/vmdisk1"))
+			Expect(items[4].ResourceSubType).To(Equal("AHCI"))
+			Expect(items[5].Parent).To(Equal(items[4].InstanceID))
+			Expect(items[5].AddressOnParent).To(Equal("0"))
+
+			Expect(items[6].ResourceType).To(Equal(ovfResourceEthernetAdapter))
+			Expect(items[6].Address).To(Equal("02:00:00:00:00:01"))
+			Expect(items[6].Connection).To(Equal("pod"))
+			Expect(items[6].ResourceSubType).To(Equal(virtv1.VirtIO))
+			Expect(items[7].Connection).To(Equal("bridge-net"))
+			Expect(items[7].ResourceSubType).To(Equal("e1000"))
+		})
+
+		It("should fall back to the resources of the VM", func() {
+			vm := newOvaTestVM()
+			vm.Spec.Template.Spec.Domain.CPU = nil
+			vm.Spec.Template.Spec.Domain.Memory = nil
+			vm.Spec.Template.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("3"),
+				k8sv1.ResourceMemory: resource.MustParse("512Mi"),
+			}
+
+			items := newOvfEnvelope(vm, nil).VirtualSystem.Hardware.Items
+			Expect(items[0].VirtualQuantity).To(BeEquivalentTo(3))
+			Expect(items[1].VirtualQuantity).To(BeEquivalentTo(512))
+		})
+	})
+
+	Context("handler", func() {
+		var orgGetExpandedVM func() *virtv1.VirtualMachine
+
+		BeforeEach(func() {
+			orgGetExpandedVM = getExpandedVM
+			getExpandedVM = newOvaTestVM
+		})
+
+		AfterEach(func() {
+			getExpandedVM = orgGetExpandedVM
+		})
+
+		It("should serve the VM as OVA", func() {
+			dir := GinkgoT().TempDir()
+			vi := []export.VolumeInfo{
+				writeVolume(dir, "root-dv", []byte("root")),
+				writeVolume(dir, "data-pvc", []byte("data")),
+			}
+
+			rr := httptest.NewRecorder()
+			ovaHandler(vi).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/ova/virtualmachine.ova", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/x-tar"))
+			Expect(rr.Header().Get("Content-Length")).To(Equal(strconv.Itoa(rr.Body.Len())))
+
+			tr := tar.NewReader(rr.Body)
+			header, err := tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Name).To(Equal(ovfDescriptorName))
+			envelope := &ovfEnvelope{}
+			Expect(xml.NewDecoder(tr).Decode(envelope)).To(Succeed())
+			Expect(envelope.VirtualSystem.Name).To(Equal("test-vm"))
+
+			for _, expected := range []string{"rootdisk", "datadisk"} {
+				header, err = tr.Next()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(header.Name).To(Equal("disk-" + expected + ".qcow2"))
+				image, err := io.ReadAll(tr)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readQcow2(image)).To(HaveLen(4 * qcow2ClusterSize))
+			}
+			_, err = tr.Next()
+			Expect(err).To(Equal(io.EOF))
+		})
+
+		DescribeTable("should count the tar headers of a disk", func(size int64, expectedFormat tar.Format) {
+			buf := &bytes.Buffer{}
+			Expect(writeTarEntry(tar.NewWriter(buf), "disk-rootdisk.qcow2", size)).To(Succeed())
+
+			entrySize, err := tarEntrySize("disk-rootdisk.qcow2", size)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entrySize).To(Equal(int64(buf.Len()) + divRoundUp(size, tarBlockSize)*tarBlockSize))
+
+			header, err := tar.NewReader(buf).Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Size).To(Equal(size))
+			Expect(header.Format).To(Equal(expectedFormat))
+		},
+			Entry("with a USTAR header below 8GiB", int64(4*1024*mebibyte+1), tar.FormatUSTAR),
+			Entry("with a PAX extended header above 8GiB", int64(9*1024*mebibyte), tar.FormatPAX),
+		)
+
+		It("should reject requests other than GET", func() {
+			rr := httptest.NewRecorder()
+			ovaHandler(nil).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/ova/virtualmachine.ova", nil))
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 500 if getExpandedVM returns nil", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			rr := httptest.NewRecorder()
+			ovaHandler(nil).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/ova/virtualmachine.ova", nil))
+			Expect(rr.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
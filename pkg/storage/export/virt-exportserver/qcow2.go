@@ -0,0 +1,311 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	qcow2Magic         = 0x514649fb
+	qcow2Version       = 3
+	qcow2HeaderLength  = 104
+	qcow2ClusterBits   = 16
+	qcow2ClusterSize   = 1 << qcow2ClusterBits
+	qcow2RefcountOrder = 4
+	qcow2OflagCopied   = uint64(1) << 63
+
+	// every L2 table maps one cluster of offsets, every refcount block holds one cluster of 16 bit refcounts
+	qcow2L2Entries       = qcow2ClusterSize / 8
+	qcow2RefcountEntries = qcow2ClusterSize / 2
+)
+
+// qcow2Image converts a raw disk into a sparse qcow2 image while streaming it.
+// The layout of the image is computed upfront from the allocation map the filesystem
+// reports for the disk, without reading it, so the size of the image is known before
+// the first byte is written. Allocated clusters are stored even if they only contain
+// zeros, and disks without hole reporting, like block devices, are stored in full.
+//
+// The image is laid out as header, refcount table, refcount blocks, L1 table,
+// L2 tables and finally the data clusters in guest order.
+type qcow2Image struct {
+	disk        io.ReaderAt
+	virtualSize int64
+
+	// runs of allocated guest clusters, in ascending order
+	dataRuns []clusterRun
+	// number of clusters in dataRuns
+	dataClusters int64
+	// L1 entries pointing to an L2 table, in ascending order
+	l2Tables []int64
+
+	l1Size                int64
+	l1Clusters            int64
+	refcountTableClusters int64
+	refcountBlocks        int64
+	hostClusters          int64
+}
+
+func newQcow2Image(disk *os.File) (*qcow2Image, error) {
+	// Seeking works for both files and block devices
+	virtualSize, err := disk.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	img := &qcow2Image{
+		disk:        disk,
+		virtualSize: virtualSize,
+	}
+	img.scan(disk)
+	img.layout()
+	return img, nil
+}
+
+// Size returns the size of the qcow2 image in bytes
+func (img *qcow2Image) Size() int64 {
+	return img.hostClusters * qcow2ClusterSize
+}
+
+// clusterRun is a range of consecutive guest clusters
+type clusterRun struct {
+	first int64
+	count int64
+}
+
+func (r clusterRun) end() int64 {
+	return r.first + r.count
+}
+
+// scan maps the data extents of the disk to the clusters containing them
+func (img *qcow2Image) scan(disk *os.File) {
+	for _, extent := range dataExtents(disk, img.virtualSize) {
+		first := extent[0] / qcow2ClusterSize
+		end := divRoundUp(extent[1], qcow2ClusterSize)
+		if n := len(img.dataRuns); n > 0 && img.dataRuns[n-1].end() >= first {
+			// extents sharing a cluster are merged
+			last := &img.dataRuns[n-1]
+			if end > last.end() {
+				img.dataClusters += end - last.end()
+				last.count = end - last.first
+			}
+			continue
+		}
+		img.dataRuns = append(img.dataRuns, clusterRun{first: first, count: end - first})
+		img.dataClusters += end - first
+	}
+}
+
+// dataExtents returns the ranges of the disk containing data, holes are skipped
+// where the filesystem reports them
+func dataExtents(disk *os.File, size int64) [][2]int64 {
+	var extents [][2]int64
+	for offset := int64(0); offset < size; {
+		start, err := disk.Seek(offset, unix.SEEK_DATA)
+		if errors.Is(err, syscall.ENXIO) {
+			// no data after offset
+			break
+		}
+		if err != nil {
+			// holes are not supported, the rest of the disk is scanned
+			return append(extents, [2]int64{offset, size})
+		}
+		end, err := disk.Seek(start, unix.SEEK_HOLE)
+		if err != nil {
+			return append(extents, [2]int64{start, size})
+		}
+		extents = append(extents, [2]int64{start, end})
+		offset = end
+	}
+	return extents
+}
+
+func divRoundUp(n, d int64) int64 {
+	return (n + d - 1) / d
+}
+
+func (img *qcow2Image) layout() {
+	img.l1Size = divRoundUp(divRoundUp(img.virtualSize, qcow2ClusterSize), qcow2L2Entries)
+	img.l1Clusters = max(divRoundUp(img.l1Size*8, qcow2ClusterSize), 1)
+	for _, run := range img.dataRuns {
+		for l1Index := run.first / qcow2L2Entries; l1Index <= (run.end()-1)/qcow2L2Entries; l1Index++ {
+			if len(img.l2Tables) == 0 || img.l2Tables[len(img.l2Tables)-1] != l1Index {
+				img.l2Tables = append(img.l2Tables, l1Index)
+			}
+		}
+	}
+
+	// the refcount blocks also have to cover the refcount table and themselves
+	fixed := 1 + img.l1Clusters + int64(len(img.l2Tables)) + img.dataClusters
+	img.refcountTableClusters, img.refcountBlocks = 1, 1
+	for {
+		total := fixed + img.refcountTableClusters + img.refcountBlocks
+		blocks := divRoundUp(total, qcow2RefcountEntries)
+		tableClusters := divRoundUp(blocks*8, qcow2ClusterSize)
+		if blocks == img.refcountBlocks && tableClusters == img.refcountTableClusters {
+			img.hostClusters = total
+			return
+		}
+		img.refcountBlocks, img.refcountTableClusters = blocks, tableClusters
+	}
+}
+
+func (img *qcow2Image) refcountTableOffset() int64 {
+	return qcow2ClusterSize
+}
+
+func (img *qcow2Image) refcountBlocksOffset() int64 {
+	return img.refcountTableOffset() + img.refcountTableClusters*qcow2ClusterSize
+}
+
+func (img *qcow2Image) l1TableOffset() int64 {
+	return img.refcountBlocksOffset() + img.refcountBlocks*qcow2ClusterSize
+}
+
+func (img *qcow2Image) l2TablesOffset() int64 {
+	return img.l1TableOffset() + img.l1Clusters*qcow2ClusterSize
+}
+
+func (img *qcow2Image) dataOffset() int64 {
+	return img.l2TablesOffset() + int64(len(img.l2Tables))*qcow2ClusterSize
+}
+
+// WriteTo streams the qcow2 image to w
+func (img *qcow2Image) WriteTo(w io.Writer) (int64, error) {
+	cw := &clusterWriter{w: w, buf: make([]byte, qcow2ClusterSize)}
+
+	cw.write(func(buf []byte) {
+		binary.BigEndian.PutUint32(buf[0:], qcow2Magic)
+		binary.BigEndian.PutUint32(buf[4:], qcow2Version)
+		binary.BigEndian.PutUint32(buf[20:], qcow2ClusterBits)
+		binary.BigEndian.PutUint64(buf[24:], uint64(img.virtualSize))
+		binary.BigEndian.PutUint32(buf[36:], uint32(img.l1Size))
+		binary.BigEndian.PutUint64(buf[40:], uint64(img.l1TableOffset()))
+		binary.BigEndian.PutUint64(buf[48:], uint64(img.refcountTableOffset()))
+		binary.BigEndian.PutUint32(buf[56:], uint32(img.refcountTableClusters))
+		binary.BigEndian.PutUint32(buf[96:], qcow2RefcountOrder)
+		binary.BigEndian.PutUint32(buf[100:], qcow2HeaderLength)
+	})
+
+	// refcount table
+	for c := int64(0); c < img.refcountTableClusters; c++ {
+		cw.write(func(buf []byte) {
+			for i := int64(0); i < qcow2L2Entries; i++ {
+				block := c*qcow2L2Entries + i
+				if block >= img.refcountBlocks {
+					break
+				}
+				binary.BigEndian.PutUint64(buf[i*8:], uint64(img.refcountBlocksOffset()+block*qcow2ClusterSize))
+			}
+		})
+	}
+
+	// refcount blocks, every cluster of the image is used exactly once
+	for b := int64(0); b < img.refcountBlocks; b++ {
+		cw.write(func(buf []byte) {
+			for i := int64(0); i < qcow2RefcountEntries; i++ {
+				if b*qcow2RefcountEntries+i >= img.hostClusters {
+					break
+				}
+				binary.BigEndian.PutUint16(buf[i*2:], 1)
+			}
+		})
+	}
+
+	// L1 table
+	for c := int64(0); c < img.l1Clusters; c++ {
+		cw.write(func(buf []byte) {
+			for table, l1Index := range img.l2Tables {
+				if l1Index/qcow2L2Entries != c {
+					continue
+				}
+				offset := img.l2TablesOffset() + int64(table)*qcow2ClusterSize
+				binary.BigEndian.PutUint64(buf[(l1Index%qcow2L2Entries)*8:], uint64(offset)|qcow2OflagCopied)
+			}
+		})
+	}
+
+	// L2 tables, the data clusters are stored in guest order
+	run, next, stored := 0, int64(0), int64(0)
+	for _, l1Index := range img.l2Tables {
+		cw.write(func(buf []byte) {
+			for ; run < len(img.dataRuns); run++ {
+				if next < img.dataRuns[run].first {
+					next = img.dataRuns[run].first
+				}
+				for ; next < img.dataRuns[run].end() && next/qcow2L2Entries == l1Index; next++ {
+					offset := img.dataOffset() + stored*qcow2ClusterSize
+					binary.BigEndian.PutUint64(buf[(next%qcow2L2Entries)*8:], uint64(offset)|qcow2OflagCopied)
+					stored++
+				}
+				if next < img.dataRuns[run].end() {
+					// the rest of the run belongs to the next L2 table
+					return
+				}
+			}
+		})
+	}
+
+	for _, run := range img.dataRuns {
+		for cluster := run.first; cluster < run.end() && cw.err == nil; cluster++ {
+			n, err := img.disk.ReadAt(cw.buf, cluster*qcow2ClusterSize)
+			if err != nil && err != io.EOF {
+				return cw.n, err
+			}
+			// the last cluster of the disk may be partial
+			clear(cw.buf[n:])
+			cw.flush()
+		}
+	}
+
+	return cw.n, cw.err
+}
+
+// clusterWriter writes whole clusters and keeps the first error
+type clusterWriter struct {
+	w   io.Writer
+	buf []byte
+	n   int64
+	err error
+}
+
+func (cw *clusterWriter) write(fill func(buf []byte)) {
+	if cw.err != nil {
+		return
+	}
+	clear(cw.buf)
+	fill(cw.buf)
+	cw.flush()
+}
+
+func (cw *clusterWriter) flush() {
+	if cw.err != nil {
+		return
+	}
+	n, err := cw.w.Write(cw.buf)
+	cw.n += int64(n)
+	cw.err = err
+}
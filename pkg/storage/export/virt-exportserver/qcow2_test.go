@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2 reads the guest data of a qcow2 image generated by qcow2Image
+func readQcow2(image []byte) []byte {
+	Expect(binary.BigEndian.Uint32(image[0:])).To(BeEquivalentTo(qcow2Magic))
+	Expect(binary.BigEndian.Uint32(image[4:])).To(BeEquivalentTo(qcow2Version))
+	Expect(binary.BigEndian.Uint32(image[20:])).To(BeEquivalentTo(qcow2ClusterBits))
+	Expect(binary.BigEndian.Uint32(image[96:])).To(BeEquivalentTo(qcow2RefcountOrder))
+	Expect(binary.BigEndian.Uint32(image[100:])).To(BeEquivalentTo(qcow2HeaderLength))
+
+	virtualSize := int64(binary.BigEndian.Uint64(image[24:]))
+	l1Size := int64(binary.BigEndian.Uint32(image[36:]))
+	l1Offset := int64(binary.BigEndian.Uint64(image[40:]))
+	refcountTableOffset := int64(binary.BigEndian.Uint64(image[48:]))
+
+	// every cluster of the image has to be referenced exactly once
+	hostClusters := int64(len(image)) / qcow2ClusterSize
+	refcountBlock := int64(binary.BigEndian.Uint64(image[refcountTableOffset:]))
+	for c := int64(0); c < hostClusters; c++ {
+		Expect(binary.BigEndian.Uint16(image[refcountBlock+c*2:])).To(BeEquivalentTo(1))
+	}
+
+	data := make([]byte, virtualSize)
+	for l1Index := int64(0); l1Index < l1Size; l1Index++ {
+		l2Offset := int64(binary.BigEndian.Uint64(image[l1Offset+l1Index*8:]) &^ qcow2OflagCopied)
+		if l2Offset == 0 {
+			continue
+		}
+		for l2Index := int64(0); l2Index < qcow2L2Entries; l2Index++ {
+			dataOffset := int64(binary.BigEndian.Uint64(image[l2Offset+l2Index*8:]) &^ qcow2OflagCopied)
+			if dataOffset == 0 {
+				continue
+			}
+			guestOffset := (l1Index*qcow2L2Entries + l2Index) * qcow2ClusterSize
+			copy(data[guestOffset:], image[dataOffset:dataOffset+qcow2ClusterSize])
+		}
+	}
+	return data
+}
+
+var _ = Describe("qcow2 image", func() {
+	var diskPath string
+
+	BeforeEach(func() {
+		diskPath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+	})
+
+	writeDisk := func(size int64, data map[int64][]byte) {
+		f, err := os.Create(diskPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(f.Truncate(size)).To(Succeed())
+		for offset, d := range data {
+			_, err := f.WriteAt(d, offset)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	convert := func() (*qcow2Image, []byte) {
+		f, err := os.Open(diskPath)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		img, err := newQcow2Image(f)
+		Expect(err).ToNot(HaveOccurred())
+
+		var out bytes.Buffer
+		n, err := img.WriteTo(&out)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(img.Size()))
+		Expect(int64(out.Len())).To(Equal(img.Size()))
+		return img, out.Bytes()
+	}
+
+	It("should convert an empty disk", func() {
+		writeDisk(10*qcow2ClusterSize, nil)
+
+		img, out := convert()
+		Expect(img.dataRuns).To(BeEmpty())
+		Expect(readQcow2(out)).To(Equal(make([]byte, 10*qcow2ClusterSize)))
+	})
+
+	It("should only store the allocated clusters", func() {
+		// the disk spans two L2 tables and ends with a partial cluster
+		size := int64(qcow2L2Entries+3)*qcow2ClusterSize + 1000
+		data := map[int64][]byte{
+			0:                      bytes.Repeat([]byte{1}, 10),
+			5*qcow2ClusterSize - 4: bytes.Repeat([]byte{2}, 8),
+			int64(qcow2L2Entries+1) * qcow2ClusterSize: bytes.Repeat([]byte{3}, qcow2ClusterSize),
+			size - 10: bytes.Repeat([]byte{4}, 10),
+		}
+		writeDisk(size, data)
+		// a cluster which was written with zeros is allocated, and stored
+		f, err := os.OpenFile(diskPath, os.O_WRONLY, 0)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteAt(make([]byte, qcow2ClusterSize), 7*qcow2ClusterSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		img, out := convert()
+		if img.dataClusters == divRoundUp(size, qcow2ClusterSize) {
+			Skip("the filesystem does not report holes")
+		}
+		Expect(img.dataRuns).To(Equal([]clusterRun{
+			{first: 0, count: 1},
+			{first: 4, count: 2},
+			{first: 7, count: 1},
+			{first: qcow2L2Entries + 1, count: 1},
+			{first: qcow2L2Entries + 3, count: 1},
+		}))
+		Expect(img.dataClusters).To(BeEquivalentTo(6))
+		Expect(img.l2Tables).To(Equal([]int64{0, 1}))
+
+		expected, err := os.ReadFile(diskPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes.Equal(readQcow2(out), expected)).To(BeTrue())
+	})
+
+	Context("handlers", func() {
+		BeforeEach(func() {
+			writeDisk(8*qcow2ClusterSize, map[int64][]byte{
+				2 * qcow2ClusterSize: bytes.Repeat([]byte{1}, 100),
+			})
+		})
+
+		It("should serve the disk as qcow2 image", func() {
+			rr := httptest.NewRecorder()
+			qcow2Handler(diskPath).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/volumes/v1/disk.qcow2", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Length")).To(Equal(strconv.Itoa(rr.Body.Len())))
+
+			expected, err := os.ReadFile(diskPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readQcow2(rr.Body.Bytes())).To(Equal(expected))
+		})
+
+		It("should return 500 if the disk does not exist", func() {
+			rr := httptest.NewRecorder()
+			qcow2Handler(diskPath+".missing").ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/volumes/v1/disk.qcow2", nil))
+			Expect(rr.Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		DescribeTable("should reject requests other than GET", func(handler func(string) http.Handler, uri string) {
+			rr := httptest.NewRecorder()
+			handler(diskPath).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, uri, nil))
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("qcow2", qcow2Handler, "/volumes/v1/disk.qcow2"),
+			Entry("extents", fileHandler, "/volumes/v1/disk.img?extents"),
+		)
+
+		It("should list the extents of the raw disk", func() {
+			rr := httptest.NewRecorder()
+			fileHandler(diskPath).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/volumes/v1/disk.img?extents", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			extents := &diskExtents{}
+			Expect(json.Unmarshal(rr.Body.Bytes(), extents)).To(Succeed())
+			Expect(extents.Size).To(BeEquivalentTo(8 * qcow2ClusterSize))
+			// filesystems without hole support report the whole disk as data
+			Expect(extents.Extents).ToNot(BeEmpty())
+			covered := false
+			for _, extent := range extents.Extents {
+				if extent.Offset <= 2*qcow2ClusterSize && extent.Offset+extent.Length >= 2*qcow2ClusterSize+100 {
+					covered = true
+				}
+			}
+			Expect(covered).To(BeTrue())
+		})
+
+		It("should serve ranges of the raw disk", func() {
+			req := httptest.NewRequest(http.MethodGet, "/volumes/v1/disk.img", nil)
+			req.Header.Set("Range", "bytes=131072-131171")
+			rr := httptest.NewRecorder()
+			fileHandler(diskPath).ServeHTTP(rr, req)
+			Expect(rr.Code).To(Equal(http.StatusPartialContent))
+			Expect(rr.Body.Bytes()).To(Equal(bytes.Repeat([]byte{1}, 100)))
+		})
+	})
+})
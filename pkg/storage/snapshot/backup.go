@@ -0,0 +1,535 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	typesutil "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	vmBackupFinalizer = "snapshot.kubevirt.io/vmbackup-protection"
+
+	backupRetryInterval = 5 * time.Second
+
+	backupStartedEvent   = "VirtualMachineBackupStarted"
+	backupSucceededEvent = "VirtualMachineBackupSucceeded"
+	backupFailedEvent    = "VirtualMachineBackupFailed"
+
+	backupSourceNotRunning = "Source VMI is not running"
+	backupInProgressOnVMI  = "Another backup is in progress on the source VMI"
+	backupTargetNotReady   = "Waiting for the backup target to be attached"
+)
+
+// backupCheckpointName is unique for every VirtualMachineBackup so a deleted
+// and recreated backup never collides with a checkpoint left in the domain
+func backupCheckpointName(vmBackup *snapshotv1.VirtualMachineBackup) string {
+	return fmt.Sprintf("%s-%s", vmBackup.Name, vmBackup.UID)
+}
+
+func vmBackupFinished(vmBackup *snapshotv1.VirtualMachineBackup) bool {
+	return vmBackup.Status != nil && (vmBackup.Status.Phase == snapshotv1.Succeeded || vmBackup.Status.Phase == snapshotv1.Failed)
+}
+
+func vmBackupDeadlineExceeded(vmBackup *snapshotv1.VirtualMachineBackup) bool {
+	return timeUntilBackupDeadline(vmBackup) < 0
+}
+
+func timeUntilBackupDeadline(vmBackup *snapshotv1.VirtualMachineBackup) time.Duration {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if vmBackup.Spec.FailureDeadline != nil {
+		failureDeadline = vmBackup.Spec.FailureDeadline.Duration
+	}
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return failureDeadline
+	}
+	return time.Until(vmBackup.CreationTimestamp.Add(failureDeadline))
+}
+
+func updateBackupCondition(b *snapshotv1.VirtualMachineBackup, c snapshotv1.Condition) {
+	b.Status.Conditions = updateCondition(b.Status.Conditions, c)
+}
+
+func (ctrl *VMBackupController) updateVMBackup(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineBackup %s/%s", vmBackup.Namespace, vmBackup.Name)
+
+	if vmBackup.DeletionTimestamp != nil {
+		return ctrl.cleanupVMBackup(vmBackup)
+	}
+	if vmBackupFinished(vmBackup) {
+		return ctrl.releaseBackupTarget(vmBackup)
+	}
+
+	vmBackup, err := ctrl.addBackupFinalizer(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+
+	vmBackupOut := vmBackup.DeepCopy()
+	if vmBackupOut.Status == nil {
+		vmBackupOut.Status = &snapshotv1.VirtualMachineBackupStatus{
+			Phase:        snapshotv1.InProgress,
+			CreationTime: currentTime(),
+			Type:         snapshotv1.FullBackup,
+		}
+		if vmBackup.Spec.IncrementalFrom != nil {
+			vmBackupOut.Status.Type = snapshotv1.IncrementalBackup
+		}
+		updateBackupCondition(vmBackupOut, newProgressingCondition(corev1.ConditionTrue, "Initializing backup"))
+		updateBackupCondition(vmBackupOut, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+		return 0, ctrl.updateBackupStatus(vmBackup, vmBackupOut)
+	}
+
+	retry, err := ctrl.reconcileBackup(vmBackupOut)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ctrl.updateBackupStatus(vmBackup, vmBackupOut); err != nil {
+		return 0, err
+	}
+	return retry, nil
+}
+
+// cleanupVMBackup aborts a backup still running on the VMI and detaches its target before the backup goes away
+func (ctrl *VMBackupController) cleanupVMBackup(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	if !controller.HasFinalizer(vmBackup, vmBackupFinalizer) {
+		return 0, nil
+	}
+
+	stopped, err := ctrl.stopRunningBackup(vmBackup)
+	if err != nil || stopped {
+		return backupRetryInterval, err
+	}
+	retry, err := ctrl.releaseBackupTarget(vmBackup)
+	if err != nil || retry != 0 {
+		return retry, err
+	}
+
+	_, err = ctrl.removeBackupFinalizer(vmBackup)
+	return 0, err
+}
+
+// stopRunningBackup stops the backup if it is still running on the VMI, and reports whether it did
+func (ctrl *VMBackupController) stopRunningBackup(vmBackup *snapshotv1.VirtualMachineBackup) (bool, error) {
+	vmi, err := ctrl.getBackupVMI(vmBackup)
+	if err != nil || vmi == nil || !backupRunningOnVMI(vmBackup, vmi) {
+		return false, err
+	}
+
+	options := &kubevirtv1.VirtualMachineInstanceBackupOptions{
+		BackupName: backupCheckpointName(vmBackup),
+		Mode:       vmi.Status.BackupState.Mode,
+		Stop:       true,
+	}
+	return true, ctrl.Client.VirtualMachineInstance(vmi.Namespace).Backup(context.Background(), vmi.Name, options)
+}
+
+func backupRunningOnVMI(vmBackup *snapshotv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) bool {
+	backupState := vmi.Status.BackupState
+	return backupState != nil && backupState.BackupName == backupCheckpointName(vmBackup) && !backupState.Completed
+}
+
+// releaseBackupTarget detaches the target claim from the VMI once the backup is no longer written to it
+func (ctrl *VMBackupController) releaseBackupTarget(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	if vmBackup.Spec.TargetClaimName == nil {
+		return 0, nil
+	}
+	vmi, err := ctrl.getBackupVMI(vmBackup)
+	if err != nil || vmi == nil {
+		return 0, err
+	}
+	if backupRunningOnVMI(vmBackup, vmi) {
+		return backupRetryInterval, nil
+	}
+	claimName := *vmBackup.Spec.TargetClaimName
+	if !hasBackupTargetVolume(vmi, claimName) {
+		return 0, nil
+	}
+	inUse, err := ctrl.backupTargetInUse(vmBackup, claimName)
+	if err != nil || inUse {
+		return 0, err
+	}
+
+	var volumes []kubevirtv1.Volume
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != claimName {
+			volumes = append(volumes, volume)
+		}
+	}
+	return 0, ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+// backupTargetInUse reports whether another unfinished backup of the same VM writes to the claim
+func (ctrl *VMBackupController) backupTargetInUse(vmBackup *snapshotv1.VirtualMachineBackup, claimName string) (bool, error) {
+	objs, err := ctrl.VMBackupInformer.GetIndexer().ByIndex("vm", cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil {
+		return false, err
+	}
+	for _, obj := range objs {
+		other := obj.(*snapshotv1.VirtualMachineBackup)
+		if other.UID == vmBackup.UID || other.DeletionTimestamp != nil || vmBackupFinished(other) {
+			continue
+		}
+		if other.Spec.TargetClaimName != nil && *other.Spec.TargetClaimName == claimName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// attachBackupTarget hotplugs the target claim into the VMI and reports whether it is mounted in virt-launcher
+func (ctrl *VMBackupController) attachBackupTarget(vmi *kubevirtv1.VirtualMachineInstance, claimName string) (bool, error) {
+	if hasBackupTargetVolume(vmi, claimName) {
+		for _, volumeStatus := range vmi.Status.VolumeStatus {
+			if volumeStatus.Name == claimName {
+				return volumeStatus.Phase == kubevirtv1.HotplugVolumeMounted, nil
+			}
+		}
+		return false, nil
+	}
+
+	volumes := make([]kubevirtv1.Volume, 0, len(vmi.Spec.Volumes)+1)
+	volumes = append(volumes, vmi.Spec.Volumes...)
+	volumes = append(volumes, kubevirtv1.Volume{
+		Name: claimName,
+		VolumeSource: kubevirtv1.VolumeSource{
+			BackupTarget: &kubevirtv1.BackupTargetVolumeSource{
+				PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+					},
+					Hotpluggable: true,
+				},
+			},
+		},
+	})
+	return false, ctrl.patchVMIVolumes(vmi, volumes)
+}
+
+func (ctrl *VMBackupController) patchVMIVolumes(vmi *kubevirtv1.VirtualMachineInstance, volumes []kubevirtv1.Volume) error {
+	patchset := patch.New(
+		patch.WithTest("/spec/volumes", vmi.Spec.Volumes),
+	)
+	if len(vmi.Spec.Volumes) > 0 {
+		patchset.AddOption(patch.WithReplace("/spec/volumes", volumes))
+	} else {
+		patchset.AddOption(patch.WithAdd("/spec/volumes", volumes))
+	}
+
+	patchBytes, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func hasBackupTargetVolume(vmi *kubevirtv1.VirtualMachineInstance, claimName string) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == claimName {
+			return volume.BackupTarget != nil
+		}
+	}
+	return false
+}
+
+func backupTargetConflicts(vmi *kubevirtv1.VirtualMachineInstance, claimName string) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == claimName || typesutil.PVCNameFromVirtVolume(&volume) == claimName {
+			return volume.BackupTarget == nil
+		}
+	}
+	return false
+}
+
+func (ctrl *VMBackupController) addBackupFinalizer(vmBackup *snapshotv1.VirtualMachineBackup) (*snapshotv1.VirtualMachineBackup, error) {
+	if controller.HasFinalizer(vmBackup, vmBackupFinalizer) {
+		return vmBackup, nil
+	}
+
+	cpy := vmBackup.DeepCopy()
+	controller.AddFinalizer(cpy, vmBackupFinalizer)
+
+	patch, err := generateFinalizerPatch(vmBackup.Finalizers, cpy.Finalizers)
+	if err != nil {
+		return vmBackup, err
+	}
+
+	return ctrl.Client.VirtualMachineBackup(cpy.Namespace).Patch(context.Background(), cpy.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+}
+
+func (ctrl *VMBackupController) removeBackupFinalizer(vmBackup *snapshotv1.VirtualMachineBackup) (*snapshotv1.VirtualMachineBackup, error) {
+	if !controller.HasFinalizer(vmBackup, vmBackupFinalizer) {
+		return vmBackup, nil
+	}
+
+	cpy := vmBackup.DeepCopy()
+	controller.RemoveFinalizer(cpy, vmBackupFinalizer)
+
+	patch, err := generateFinalizerPatch(vmBackup.Finalizers, cpy.Finalizers)
+	if err != nil {
+		return vmBackup, err
+	}
+
+	return ctrl.Client.VirtualMachineBackup(cpy.Namespace).Patch(context.Background(), cpy.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+}
+
+func (ctrl *VMBackupController) reconcileBackup(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	if vmBackupDeadlineExceeded(vmBackup) {
+		if _, err := ctrl.stopRunningBackup(vmBackup); err != nil {
+			return 0, err
+		}
+		ctrl.failBackup(vmBackup, "Failed to finish the backup within the failureDeadline")
+		return 0, nil
+	}
+
+	if vmBackup.Spec.Source.APIGroup == nil ||
+		*vmBackup.Spec.Source.APIGroup != core.GroupName ||
+		vmBackup.Spec.Source.Kind != "VirtualMachine" {
+		ctrl.failBackup(vmBackup, fmt.Sprintf("Unsupported backup source %s", vmBackup.Spec.Source.Kind))
+		return 0, nil
+	}
+
+	vm, err := ctrl.getBackupVM(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+	if vm == nil {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Source does not exist"))
+		return backupRetryInterval, nil
+	}
+	vmBackup.Status.SourceUID = &vm.UID
+
+	vmi, err := ctrl.getBackupVMI(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+	if vmi == nil || !vmi.IsRunning() {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, backupSourceNotRunning))
+		return backupRetryInterval, nil
+	}
+
+	checkpointName := backupCheckpointName(vmBackup)
+	backupState := vmi.Status.BackupState
+	if backupState != nil && backupState.BackupName == checkpointName {
+		if vmBackup.Spec.Stop {
+			if _, err := ctrl.stopRunningBackup(vmBackup); err != nil {
+				return 0, err
+			}
+		}
+		ctrl.syncBackupState(vmBackup, backupState)
+		if vmBackupFinished(vmBackup) {
+			return 0, nil
+		}
+		return backupRetryInterval, nil
+	}
+	if vmBackup.Spec.Stop {
+		ctrl.failBackup(vmBackup, "Backup was stopped before it started")
+		return 0, nil
+	}
+	if backupState != nil && !backupState.Completed {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, backupInProgressOnVMI))
+		return backupRetryInterval, nil
+	}
+
+	options := &kubevirtv1.VirtualMachineInstanceBackupOptions{
+		BackupName: checkpointName,
+		Mode:       kubevirtv1.BackupModePush,
+	}
+	if vmBackup.Spec.Mode != nil {
+		options.Mode = *vmBackup.Spec.Mode
+	}
+	if vmBackup.Spec.IncrementalFrom != nil {
+		base, reason, err := ctrl.getBaseCheckpoint(vmBackup, vmi)
+		if err != nil {
+			return 0, err
+		}
+		if reason != "" {
+			ctrl.failBackup(vmBackup, reason)
+			return 0, nil
+		}
+		options.IncrementalFrom = pointer.P(base)
+		vmBackup.Status.BaseCheckpointName = base
+	}
+	if options.Mode == kubevirtv1.BackupModePush {
+		if vmBackup.Spec.TargetClaimName == nil || *vmBackup.Spec.TargetClaimName == "" {
+			ctrl.failBackup(vmBackup, "A target claim is required for Push mode backups")
+			return 0, nil
+		}
+		claimName := *vmBackup.Spec.TargetClaimName
+		if backupTargetConflicts(vmi, claimName) {
+			ctrl.failBackup(vmBackup, fmt.Sprintf("Target claim %s is already used by the VMI", claimName))
+			return 0, nil
+		}
+		ready, err := ctrl.attachBackupTarget(vmi, claimName)
+		if err != nil {
+			return 0, err
+		}
+		if !ready {
+			updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, backupTargetNotReady))
+			return backupRetryInterval, nil
+		}
+		options.TargetVolume = claimName
+	}
+
+	if err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Backup(context.Background(), vmi.Name, options); err != nil {
+		vmBackup.Status.Error = &snapshotv1.Error{
+			Time:    currentTime(),
+			Message: pointer.P(err.Error()),
+		}
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Failed to start backup"))
+		return backupRetryInterval, nil
+	}
+
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, backupStartedEvent, "Started backup %s of VMI %s", checkpointName, vmi.Name)
+	vmBackup.Status.Error = nil
+	updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionTrue, "Backup started"))
+	return backupRetryInterval, nil
+}
+
+// getBaseCheckpoint returns the checkpoint of the backup an incremental backup is based on,
+// or the reason why the incremental backup cannot be taken
+func (ctrl *VMBackupController) getBaseCheckpoint(vmBackup *snapshotv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) (string, string, error) {
+	baseName := *vmBackup.Spec.IncrementalFrom
+	obj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, baseName))
+	if err != nil {
+		return "", "", err
+	}
+	if !exists {
+		return "", fmt.Sprintf("Base backup %s does not exist", baseName), nil
+	}
+	base := obj.(*snapshotv1.VirtualMachineBackup)
+	if base.Spec.Source.Kind != vmBackup.Spec.Source.Kind || base.Spec.Source.Name != vmBackup.Spec.Source.Name {
+		return "", fmt.Sprintf("Base backup %s has a different source", baseName), nil
+	}
+	if base.Status == nil || base.Status.Phase != snapshotv1.Succeeded || base.Status.CheckpointName == "" {
+		return "", fmt.Sprintf("Base backup %s did not succeed", baseName), nil
+	}
+	// checkpoints are kept in the domain definition, they are gone once the VMI is recreated
+	if base.Status.CreationTime == nil || base.Status.CreationTime.Before(&vmi.CreationTimestamp) {
+		return "", fmt.Sprintf("Checkpoint of base backup %s is not available on the running VMI, a full backup is required", baseName), nil
+	}
+	newer, err := ctrl.newerSucceededBackup(base)
+	if err != nil {
+		return "", "", err
+	}
+	// only the checkpoint of the last succeeded backup is kept in the domain
+	if newer != "" {
+		return "", fmt.Sprintf("Checkpoint of base backup %s was removed after backup %s succeeded, only the latest backup can be the base", baseName, newer), nil
+	}
+	return base.Status.CheckpointName, "", nil
+}
+
+// newerSucceededBackup returns the name of a succeeded backup of the same VM taken after the given one
+func (ctrl *VMBackupController) newerSucceededBackup(base *snapshotv1.VirtualMachineBackup) (string, error) {
+	objs, err := ctrl.VMBackupInformer.GetIndexer().ByIndex("vm", cacheKeyFunc(base.Namespace, base.Spec.Source.Name))
+	if err != nil {
+		return "", err
+	}
+	for _, obj := range objs {
+		other := obj.(*snapshotv1.VirtualMachineBackup)
+		if other.UID == base.UID || other.Status == nil || other.Status.Phase != snapshotv1.Succeeded ||
+			other.Status.CreationTime == nil {
+			continue
+		}
+		if base.Status.CreationTime.Before(other.Status.CreationTime) {
+			return other.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (ctrl *VMBackupController) syncBackupState(vmBackup *snapshotv1.VirtualMachineBackup, backupState *kubevirtv1.VirtualMachineInstanceBackupState) {
+	vmBackup.Status.Endpoint = backupState.Endpoint
+	vmBackup.Status.Volumes = backupState.Volumes
+	if !backupState.Completed {
+		updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionTrue, "Backup in progress"))
+		return
+	}
+
+	if backupState.Failed {
+		ctrl.failBackup(vmBackup, backupState.FailureReason)
+		return
+	}
+
+	vmBackup.Status.Phase = snapshotv1.Succeeded
+	vmBackup.Status.CheckpointName = backupState.CheckpointName
+	vmBackup.Status.CompletionTime = backupState.EndTimestamp
+	if vmBackup.Status.CompletionTime == nil {
+		vmBackup.Status.CompletionTime = currentTime()
+	}
+	vmBackup.Status.Error = nil
+	updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateBackupCondition(vmBackup, newReadyCondition(corev1.ConditionTrue, "Ready"))
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, backupSucceededEvent, "Successfully completed VirtualMachineBackup %s", vmBackup.Name)
+}
+
+func (ctrl *VMBackupController) failBackup(vmBackup *snapshotv1.VirtualMachineBackup, reason string) {
+	vmBackup.Status.Phase = snapshotv1.Failed
+	vmBackup.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(reason),
+	}
+	updateBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	updateBackupCondition(vmBackup, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	updateBackupCondition(vmBackup, newFailureCondition(corev1.ConditionTrue, reason))
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeWarning, backupFailedEvent, "VirtualMachineBackup %s failed: %s", vmBackup.Name, reason)
+}
+
+func (ctrl *VMBackupController) updateBackupStatus(vmBackup, vmBackupOut *snapshotv1.VirtualMachineBackup) error {
+	if equality.Semantic.DeepEqual(vmBackup.Status, vmBackupOut.Status) {
+		return nil
+	}
+	return ctrl.vmBackupStatusUpdater.UpdateStatus(vmBackupOut)
+}
+
+func (ctrl *VMBackupController) getBackupVM(vmBackup *snapshotv1.VirtualMachineBackup) (*kubevirtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
+}
+
+func (ctrl *VMBackupController) getBackupVMI(vmBackup *snapshotv1.VirtualMachineBackup) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*kubevirtv1.VirtualMachineInstance).DeepCopy(), nil
+}
@@ -0,0 +1,535 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	kvtesting "kubevirt.io/client-go/testing"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Backup controller", func() {
+	const (
+		vmName     = "testvm"
+		backupName = "backup"
+		claimName  = "backup-target"
+	)
+
+	var (
+		timeStamp = metav1.Time{Time: time.Now().Truncate(time.Second)}
+
+		vmBackupInformer cache.SharedIndexInformer
+		vmInformer       cache.SharedIndexInformer
+		vmiInformer      cache.SharedIndexInformer
+
+		controller     *VMBackupController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &timeStamp
+	}
+
+	createBackup := func(name string) *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				UID:               types.UID(name + "-uid"),
+				CreationTimestamp: timeStamp,
+			},
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(kubevirtv1.GroupVersion.Group),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+		}
+	}
+
+	createInProgressBackup := func(name string) *snapshotv1.VirtualMachineBackup {
+		b := createBackup(name)
+		b.Status = &snapshotv1.VirtualMachineBackupStatus{
+			Phase:        snapshotv1.InProgress,
+			CreationTime: timeFunc(),
+			Type:         snapshotv1.FullBackup,
+		}
+		return b
+	}
+
+	createRunningVMI := func() *kubevirtv1.VirtualMachineInstance {
+		return &kubevirtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              vmName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.Time{Time: timeStamp.Add(-time.Hour)},
+			},
+			Status: kubevirtv1.VirtualMachineInstanceStatus{
+				Phase: kubevirtv1.Running,
+			},
+		}
+	}
+
+	addBackup := func(b *snapshotv1.VirtualMachineBackup) {
+		Expect(vmBackupInformer.GetStore().Add(b)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineBackups(b.Namespace).Create(context.Background(), b, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getBackup := func(name string) *snapshotv1.VirtualMachineBackup {
+		b, err := kubevirtClient.SnapshotV1beta1().VirtualMachineBackups(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return b
+	}
+
+	addVMI := func(vmi *kubevirtv1.VirtualMachineInstance) {
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		_, err := kubevirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getVMI := func() *kubevirtv1.VirtualMachineInstance {
+		vmi, err := kubevirtClient.KubevirtV1().VirtualMachineInstances(testNamespace).Get(context.Background(), vmName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi
+	}
+
+	withBackupTarget := func(vmi *kubevirtv1.VirtualMachineInstance, phase kubevirtv1.VolumePhase) *kubevirtv1.VirtualMachineInstance {
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, kubevirtv1.Volume{
+			Name: claimName,
+			VolumeSource: kubevirtv1.VolumeSource{
+				BackupTarget: &kubevirtv1.BackupTargetVolumeSource{
+					PersistentVolumeClaimVolumeSource: kubevirtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+						Hotpluggable:                      true,
+					},
+				},
+			},
+		})
+		vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, kubevirtv1.VolumeStatus{Name: claimName, Phase: phase})
+		return vmi
+	}
+
+	expectVMIBackup := func() **kubevirtv1.VirtualMachineInstanceBackupOptions {
+		var options *kubevirtv1.VirtualMachineInstanceBackupOptions
+		kubevirtClient.Fake.PrependReactor("put", "virtualmachineinstances/backup", func(action testing.Action) (bool, runtime.Object, error) {
+			put, ok := action.(kvtesting.PutAction[*kubevirtv1.VirtualMachineInstanceBackupOptions])
+			Expect(ok).To(BeTrue())
+			Expect(put.GetName()).To(Equal(vmName))
+			options = put.GetOptions()
+			return true, nil, nil
+		})
+		return &options
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		vmBackupInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineBackup{}, virtcontroller.GetVirtualMachineBackupInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachineInstance{})
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMBackupController{
+			Client:           virtClient,
+			VMBackupInformer: vmBackupInformer,
+			VMInformer:       vmInformer,
+			VMIInformer:      vmiInformer,
+			Recorder:         recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineBackups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachineInstances(testNamespace)).AnyTimes()
+
+		currentTime = timeFunc
+
+		vm := &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmName,
+				Namespace: testNamespace,
+				UID:       "vm-uid",
+			},
+		}
+		Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+	})
+
+	It("should initialize the status of a new backup", func() {
+		addBackup(createBackup(backupName))
+
+		_, err := controller.updateVMBackup(createBackup(backupName))
+		Expect(err).ToNot(HaveOccurred())
+
+		b := getBackup(backupName)
+		Expect(b.Status).ToNot(BeNil())
+		Expect(b.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(b.Status.Type).To(Equal(snapshotv1.FullBackup))
+		Expect(b.Status.CreationTime).To(Equal(timeFunc()))
+	})
+
+	It("should wait for the source VMI to run", func() {
+		b := createInProgressBackup(backupName)
+		addBackup(b)
+
+		retry, err := controller.updateVMBackup(b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(backupRetryInterval))
+
+		b = getBackup(backupName)
+		Expect(b.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(b.Status.Conditions).To(ContainElement(HaveField("Reason", backupSourceNotRunning)))
+	})
+
+	It("should request a backup with a unique checkpoint name from the VMI", func() {
+		b := createInProgressBackup(backupName)
+		b.Spec.Mode = pointer.P(kubevirtv1.BackupModePull)
+		addBackup(b)
+		Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+		options := expectVMIBackup()
+
+		_, err := controller.updateVMBackup(b)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(*options).To(Equal(&kubevirtv1.VirtualMachineInstanceBackupOptions{
+			BackupName: backupCheckpointName(b),
+			Mode:       kubevirtv1.BackupModePull,
+		}))
+		Expect(recorder.Events).To(Receive(ContainSubstring(backupStartedEvent)))
+	})
+
+	It("should not start a backup while another one is in progress on the VMI", func() {
+		b := createInProgressBackup(backupName)
+		addBackup(b)
+		vmi := createRunningVMI()
+		vmi.Status.BackupState = &kubevirtv1.VirtualMachineInstanceBackupState{BackupName: "other"}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		options := expectVMIBackup()
+
+		_, err := controller.updateVMBackup(b)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(*options).To(BeNil())
+		Expect(getBackup(backupName).Status.Conditions).To(ContainElement(HaveField("Reason", backupInProgressOnVMI)))
+	})
+
+	It("should succeed once the VMI reports the backup as completed", func() {
+		b := createInProgressBackup(backupName)
+		addBackup(b)
+		vmi := createRunningVMI()
+		vmi.Status.BackupState = &kubevirtv1.VirtualMachineInstanceBackupState{
+			BackupName:     backupCheckpointName(b),
+			CheckpointName: backupCheckpointName(b),
+			Completed:      true,
+			EndTimestamp:   timeFunc(),
+			Volumes: []kubevirtv1.VirtualMachineInstanceBackupVolume{
+				{VolumeName: "disk0", TargetFile: "/backups/disk0.qcow2"},
+			},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		retry, err := controller.updateVMBackup(b)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeZero())
+
+		b = getBackup(backupName)
+		Expect(b.Status.Phase).To(Equal(snapshotv1.Succeeded))
+		Expect(b.Status.CheckpointName).To(Equal(backupCheckpointName(b)))
+		Expect(b.Status.Volumes).To(Equal(vmi.Status.BackupState.Volumes))
+		Expect(recorder.Events).To(Receive(ContainSubstring(backupSucceededEvent)))
+	})
+
+	It("should fail when the VMI reports a failed backup", func() {
+		b := createInProgressBackup(backupName)
+		addBackup(b)
+		vmi := createRunningVMI()
+		vmi.Status.BackupState = &kubevirtv1.VirtualMachineInstanceBackupState{
+			BackupName:    backupCheckpointName(b),
+			Completed:     true,
+			Failed:        true,
+			FailureReason: "disk full",
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		_, err := controller.updateVMBackup(b)
+		Expect(err).ToNot(HaveOccurred())
+
+		b = getBackup(backupName)
+		Expect(b.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*b.Status.Error.Message).To(Equal("disk full"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(backupFailedEvent)))
+	})
+
+	Context("incremental backup", func() {
+		const baseName = "base"
+
+		var base *snapshotv1.VirtualMachineBackup
+
+		BeforeEach(func() {
+			base = createBackup(baseName)
+			base.Status = &snapshotv1.VirtualMachineBackupStatus{
+				Phase:          snapshotv1.Succeeded,
+				CreationTime:   timeFunc(),
+				CheckpointName: backupCheckpointName(base),
+			}
+		})
+
+		createIncrementalBackup := func() *snapshotv1.VirtualMachineBackup {
+			b := createInProgressBackup(backupName)
+			b.Spec.Mode = pointer.P(kubevirtv1.BackupModePull)
+			b.Spec.IncrementalFrom = pointer.P(baseName)
+			b.Status.Type = snapshotv1.IncrementalBackup
+			return b
+		}
+
+		It("should be based on the checkpoint of the base backup", func() {
+			addBackup(base)
+			b := createIncrementalBackup()
+			addBackup(b)
+			Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect((*options).IncrementalFrom).To(Equal(pointer.P(backupCheckpointName(base))))
+			Expect(getBackup(backupName).Status.BaseCheckpointName).To(Equal(backupCheckpointName(base)))
+		})
+
+		DescribeTable("should fail", func(updateBase func(*snapshotv1.VirtualMachineBackup), addBase bool) {
+			if updateBase != nil {
+				updateBase(base)
+			}
+			if addBase {
+				addBackup(base)
+			}
+			b := createIncrementalBackup()
+			addBackup(b)
+			Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*options).To(BeNil())
+			Expect(getBackup(backupName).Status.Phase).To(Equal(snapshotv1.Failed))
+		},
+			Entry("when the base backup does not exist", nil, false),
+			Entry("when the base backup has a different source", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Source.Name = "othervm"
+			}, true),
+			Entry("when the base backup did not succeed", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Status.Phase = snapshotv1.Failed
+			}, true),
+			Entry("when the base backup was taken before the VMI started", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Status.CreationTime = &metav1.Time{Time: timeStamp.Add(-2 * time.Hour)}
+			}, true),
+		)
+
+		It("should fail when a newer backup succeeded after the base backup", func() {
+			base.Status.CreationTime = &metav1.Time{Time: timeStamp.Add(-time.Minute)}
+			addBackup(base)
+			newer := createBackup("newer")
+			newer.Status = &snapshotv1.VirtualMachineBackupStatus{
+				Phase:          snapshotv1.Succeeded,
+				CreationTime:   timeFunc(),
+				CheckpointName: backupCheckpointName(newer),
+			}
+			addBackup(newer)
+			b := createIncrementalBackup()
+			addBackup(b)
+			Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*options).To(BeNil())
+			Expect(getBackup(backupName).Status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(*getBackup(backupName).Status.Error.Message).To(ContainSubstring("only the latest backup can be the base"))
+		})
+	})
+
+	Context("push mode", func() {
+		createPushBackup := func() *snapshotv1.VirtualMachineBackup {
+			b := createInProgressBackup(backupName)
+			b.Spec.TargetClaimName = pointer.P(claimName)
+			return b
+		}
+
+		It("should fail without a target claim", func() {
+			b := createInProgressBackup(backupName)
+			addBackup(b)
+			Expect(vmiInformer.GetStore().Add(createRunningVMI())).To(Succeed())
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*options).To(BeNil())
+			Expect(getBackup(backupName).Status.Phase).To(Equal(snapshotv1.Failed))
+		})
+
+		It("should hotplug the target claim into the VMI before starting the backup", func() {
+			b := createPushBackup()
+			addBackup(b)
+			addVMI(createRunningVMI())
+			options := expectVMIBackup()
+
+			retry, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(backupRetryInterval))
+
+			Expect(*options).To(BeNil())
+			Expect(getVMI().Spec.Volumes).To(ConsistOf(HaveField("BackupTarget.ClaimName", claimName)))
+			Expect(getBackup(backupName).Status.Conditions).To(ContainElement(HaveField("Reason", backupTargetNotReady)))
+		})
+
+		It("should write the backup to the target volume once it is mounted", func() {
+			b := createPushBackup()
+			addBackup(b)
+			addVMI(withBackupTarget(createRunningVMI(), kubevirtv1.HotplugVolumeMounted))
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*options).To(Equal(&kubevirtv1.VirtualMachineInstanceBackupOptions{
+				BackupName:   backupCheckpointName(b),
+				Mode:         kubevirtv1.BackupModePush,
+				TargetVolume: claimName,
+			}))
+		})
+
+		It("should detach the target claim once the backup finished", func() {
+			b := createPushBackup()
+			b.Status.Phase = snapshotv1.Succeeded
+			addBackup(b)
+			vmi := withBackupTarget(createRunningVMI(), kubevirtv1.HotplugVolumeMounted)
+			vmi.Status.BackupState = &kubevirtv1.VirtualMachineInstanceBackupState{BackupName: backupCheckpointName(b), Completed: true}
+			addVMI(vmi)
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getVMI().Spec.Volumes).To(BeEmpty())
+		})
+
+		It("should keep the target claim attached while another backup writes to it", func() {
+			b := createPushBackup()
+			b.Status.Phase = snapshotv1.Succeeded
+			addBackup(b)
+			other := createPushBackup()
+			other.Name = "other"
+			other.UID = "other-uid"
+			addBackup(other)
+			addVMI(withBackupTarget(createRunningVMI(), kubevirtv1.HotplugVolumeMounted))
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getVMI().Spec.Volumes).To(HaveLen(1))
+		})
+	})
+
+	Context("stop", func() {
+		createRunningBackup := func() (*snapshotv1.VirtualMachineBackup, *kubevirtv1.VirtualMachineInstance) {
+			b := createInProgressBackup(backupName)
+			b.Spec.Mode = pointer.P(kubevirtv1.BackupModePull)
+			b.Finalizers = []string{vmBackupFinalizer}
+			vmi := createRunningVMI()
+			vmi.Status.BackupState = &kubevirtv1.VirtualMachineInstanceBackupState{
+				BackupName: backupCheckpointName(b),
+				Mode:       kubevirtv1.BackupModePull,
+			}
+			return b, vmi
+		}
+
+		It("should stop a running backup on request of its client", func() {
+			b, vmi := createRunningBackup()
+			b.Spec.Stop = true
+			addBackup(b)
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect((*options).Stop).To(BeTrue())
+			Expect(getBackup(backupName).Status.Phase).To(Equal(snapshotv1.InProgress))
+		})
+
+		It("should abort a running backup when it is deleted", func() {
+			b, vmi := createRunningBackup()
+			b.DeletionTimestamp = &timeStamp
+			addBackup(b)
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+			options := expectVMIBackup()
+
+			retry, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retry).To(Equal(backupRetryInterval))
+
+			Expect((*options).Stop).To(BeTrue())
+			Expect(getBackup(backupName).Finalizers).To(ContainElement(vmBackupFinalizer))
+		})
+
+		It("should release a deleted backup once its job ended", func() {
+			b, vmi := createRunningBackup()
+			b.DeletionTimestamp = &timeStamp
+			addBackup(b)
+			vmi.Status.BackupState.Completed = true
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+			options := expectVMIBackup()
+
+			_, err := controller.updateVMBackup(b)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*options).To(BeNil())
+			Expect(getBackup(backupName).Finalizers).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/api/core"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	groupRestoreCompleteEvent   = "VirtualMachineGroupRestoreComplete"
+	groupRestoreFailedEvent     = "VirtualMachineGroupRestoreFailed"
+	groupRestoreWaitingSnapshot = "Waiting for the group snapshot to be ready"
+	groupRestoreInProgress      = "Restoring the members"
+	groupRestoreMemberFailed    = "Restore of a member failed"
+	groupRestoreMemberTaken     = "Restore of a member already exists outside of the group"
+	groupRestoreComplete        = "Operation complete"
+	groupRestoreFailed          = "Operation failed"
+)
+
+func groupMemberRestoreName(groupRestore *snapshotv1.VirtualMachineGroupRestore, vmName string) string {
+	return fmt.Sprintf("%s-%s", groupRestore.Name, vmName)
+}
+
+func groupRestoreCompleted(groupRestore *snapshotv1.VirtualMachineGroupRestore) bool {
+	return groupRestore.Status != nil && groupRestore.Status.Complete != nil && *groupRestore.Status.Complete
+}
+
+// groupRestoreFailedTerminally is true once a member restore failed, failed member restores are not retried
+func groupRestoreFailedTerminally(groupRestore *snapshotv1.VirtualMachineGroupRestore) bool {
+	return groupRestore.Status != nil && hasConditionType(groupRestore.Status.Conditions, snapshotv1.ConditionFailure)
+}
+
+func updateGroupRestoreCondition(gr *snapshotv1.VirtualMachineGroupRestore, c snapshotv1.Condition) {
+	gr.Status.Conditions = updateCondition(gr.Status.Conditions, c)
+}
+
+func (ctrl *VMGroupSnapshotController) updateVMGroupRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineGroupRestore %s/%s", groupRestore.Namespace, groupRestore.Name)
+
+	if groupRestore.DeletionTimestamp != nil || groupRestoreCompleted(groupRestore) || groupRestoreFailedTerminally(groupRestore) {
+		return 0, nil
+	}
+
+	groupRestoreOut := groupRestore.DeepCopy()
+	if groupRestoreOut.Status == nil {
+		groupRestoreOut.Status = &snapshotv1.VirtualMachineGroupRestoreStatus{
+			Complete: pointer.P(false),
+		}
+	}
+
+	if err := ctrl.reconcileGroupRestore(groupRestoreOut); err != nil {
+		return 0, err
+	}
+
+	if !equality.Semantic.DeepEqual(groupRestore.Status, groupRestoreOut.Status) {
+		if err := ctrl.vmGroupRestoreStatusUpdater.UpdateStatus(groupRestoreOut); err != nil {
+			return 0, err
+		}
+	}
+	return 0, nil
+}
+
+func (ctrl *VMGroupSnapshotController) reconcileGroupRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore) error {
+	if len(groupRestore.Status.Members) == 0 {
+		groupSnapshot, err := ctrl.getGroupSnapshot(groupRestore.Namespace, groupRestore.Spec.VirtualMachineGroupSnapshotName)
+		if err != nil {
+			return err
+		}
+		if groupSnapshot == nil || groupSnapshot.Status == nil ||
+			groupSnapshot.Status.ReadyToUse == nil || !*groupSnapshot.Status.ReadyToUse {
+			updateGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionFalse, groupRestoreWaitingSnapshot))
+			updateGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionFalse, groupRestoreWaitingSnapshot))
+			return nil
+		}
+
+		// every member is restored from its snapshot in the group
+		for _, member := range groupSnapshot.Status.Members {
+			vmRestore := newGroupMemberRestore(groupRestore, member)
+			owned, err := ctrl.createMemberRestore(groupRestore, vmRestore)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				ctrl.failGroupRestore(groupRestore, fmt.Sprintf("%s: %s", groupRestoreMemberTaken, vmRestore.Name))
+				return nil
+			}
+			groupRestore.Status.Members = append(groupRestore.Status.Members, snapshotv1.VirtualMachineGroupRestoreMember{
+				VirtualMachineName:        member.VirtualMachineName,
+				VirtualMachineRestoreName: vmRestore.Name,
+				Complete:                  pointer.P(false),
+			})
+		}
+	}
+
+	complete := true
+	failure := ""
+	for i := range groupRestore.Status.Members {
+		member := &groupRestore.Status.Members[i]
+		vmRestore, err := ctrl.getMemberRestore(groupRestore.Namespace, member.VirtualMachineRestoreName)
+		if err != nil {
+			return err
+		}
+		if vmRestore == nil || vmRestore.Status == nil {
+			complete = false
+			continue
+		}
+
+		member.Complete = vmRestore.Status.Complete
+		if member.Complete == nil || !*member.Complete {
+			complete = false
+		}
+		for _, c := range vmRestore.Status.Conditions {
+			if c.Type == snapshotv1.ConditionFailure && c.Status == corev1.ConditionTrue && failure == "" {
+				failure = fmt.Sprintf("%s: %s: %s", groupRestoreMemberFailed, member.VirtualMachineRestoreName, c.Reason)
+			}
+		}
+	}
+
+	if failure != "" {
+		ctrl.failGroupRestore(groupRestore, failure)
+		return nil
+	}
+
+	if !complete {
+		updateGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionTrue, groupRestoreInProgress))
+		updateGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionFalse, groupRestoreInProgress))
+		return nil
+	}
+
+	groupRestore.Status.Complete = pointer.P(true)
+	groupRestore.Status.RestoreTime = currentTime()
+	updateGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionFalse, groupRestoreComplete))
+	updateGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionTrue, groupRestoreComplete))
+	ctrl.Recorder.Eventf(groupRestore, corev1.EventTypeNormal, groupRestoreCompleteEvent, "Restored %d VirtualMachines", len(groupRestore.Status.Members))
+	return nil
+}
+
+// createMemberRestore creates the restore of a member, an already existing restore with the
+// same name is only taken over when it is controlled by the group restore
+func (ctrl *VMGroupSnapshotController) createMemberRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore, vmRestore *snapshotv1.VirtualMachineRestore) (bool, error) {
+	client := ctrl.Client.VirtualMachineRestore(vmRestore.Namespace)
+	_, err := client.Create(context.Background(), vmRestore, metav1.CreateOptions{})
+	if err == nil {
+		return true, nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	existing, err := client.Get(context.Background(), vmRestore.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return metav1.IsControlledBy(existing, groupRestore), nil
+}
+
+func (ctrl *VMGroupSnapshotController) failGroupRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore, reason string) {
+	updateGroupRestoreCondition(groupRestore, newFailureCondition(corev1.ConditionTrue, reason))
+	updateGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionFalse, groupRestoreFailed))
+	updateGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionFalse, groupRestoreFailed))
+	ctrl.Recorder.Event(groupRestore, corev1.EventTypeWarning, groupRestoreFailedEvent, reason)
+}
+
+func newGroupMemberRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore, member snapshotv1.VirtualMachineGroupSnapshotMember) *snapshotv1.VirtualMachineRestore {
+	return &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      groupMemberRestoreName(groupRestore, member.VirtualMachineName),
+			Namespace: groupRestore.Namespace,
+			Labels: map[string]string{
+				snapshotv1.VirtualMachineGroupRestoreLabel: groupRestore.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(groupRestore, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineGroupRestore")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			VirtualMachineSnapshotName: member.VirtualMachineSnapshotName,
+			TargetReadinessPolicy:      groupRestore.Spec.TargetReadinessPolicy,
+		},
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) getGroupSnapshot(namespace, name string) (*snapshotv1.VirtualMachineGroupSnapshot, error) {
+	obj, exists, err := ctrl.VMGroupSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*snapshotv1.VirtualMachineGroupSnapshot), nil
+}
+
+func (ctrl *VMGroupSnapshotController) getMemberRestore(namespace, name string) (*snapshotv1.VirtualMachineRestore, error) {
+	obj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*snapshotv1.VirtualMachineRestore), nil
+}
@@ -0,0 +1,290 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Group restore controller", func() {
+	const (
+		groupSnapshotName = "shop"
+		groupRestoreName  = "shop-restore"
+	)
+
+	var (
+		now = metav1.Time{Time: time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)}
+
+		vmGroupSnapshotInformer cache.SharedIndexInformer
+		vmGroupRestoreInformer  cache.SharedIndexInformer
+		vmRestoreInformer       cache.SharedIndexInformer
+
+		controller     *VMGroupSnapshotController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &now
+	}
+
+	createGroupSnapshot := func(ready bool) *snapshotv1.VirtualMachineGroupSnapshot {
+		gs := &snapshotv1.VirtualMachineGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupSnapshotName,
+				Namespace: testNamespace,
+			},
+			Status: &snapshotv1.VirtualMachineGroupSnapshotStatus{
+				ReadyToUse: pointer.P(ready),
+			},
+		}
+		for _, vmName := range []string{"vm-a", "vm-b"} {
+			gs.Status.Members = append(gs.Status.Members, snapshotv1.VirtualMachineGroupSnapshotMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: groupMemberSnapshotName(gs, vmName),
+			})
+		}
+		return gs
+	}
+
+	createGroupRestore := func() *snapshotv1.VirtualMachineGroupRestore {
+		return &snapshotv1.VirtualMachineGroupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupRestoreName,
+				Namespace: testNamespace,
+				UID:       "group-restore-uid",
+			},
+			Spec: snapshotv1.VirtualMachineGroupRestoreSpec{
+				VirtualMachineGroupSnapshotName: groupSnapshotName,
+				TargetReadinessPolicy:           pointer.P(snapshotv1.VirtualMachineRestoreStopTarget),
+			},
+		}
+	}
+
+	// createStartedGroupRestore returns a group restore whose member restores were already created
+	createStartedGroupRestore := func() *snapshotv1.VirtualMachineGroupRestore {
+		gr := createGroupRestore()
+		gr.Status = &snapshotv1.VirtualMachineGroupRestoreStatus{
+			Complete: pointer.P(false),
+		}
+		for _, member := range createGroupSnapshot(true).Status.Members {
+			gr.Status.Members = append(gr.Status.Members, snapshotv1.VirtualMachineGroupRestoreMember{
+				VirtualMachineName:        member.VirtualMachineName,
+				VirtualMachineRestoreName: groupMemberRestoreName(gr, member.VirtualMachineName),
+				Complete:                  pointer.P(false),
+			})
+		}
+		return gr
+	}
+
+	createMemberRestore := func(gr *snapshotv1.VirtualMachineGroupRestore, vmName string, complete bool) *snapshotv1.VirtualMachineRestore {
+		vmRestore := newGroupMemberRestore(gr, snapshotv1.VirtualMachineGroupSnapshotMember{
+			VirtualMachineName:         vmName,
+			VirtualMachineSnapshotName: groupMemberSnapshotName(createGroupSnapshot(true), vmName),
+		})
+		vmRestore.Status = &snapshotv1.VirtualMachineRestoreStatus{
+			Complete: pointer.P(complete),
+		}
+		return vmRestore
+	}
+
+	addGroupRestore := func(gr *snapshotv1.VirtualMachineGroupRestore) {
+		Expect(vmGroupRestoreInformer.GetStore().Add(gr)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineGroupRestores(gr.Namespace).Create(context.Background(), gr, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addRestore := func(r *snapshotv1.VirtualMachineRestore) {
+		Expect(vmRestoreInformer.GetStore().Add(r)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(r.Namespace).Create(context.Background(), r, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getGroupRestore := func() *snapshotv1.VirtualMachineGroupRestore {
+		gr, err := kubevirtClient.SnapshotV1beta1().VirtualMachineGroupRestores(testNamespace).Get(context.Background(), groupRestoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return gr
+	}
+
+	listRestores := func() []snapshotv1.VirtualMachineRestore {
+		l, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return l.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		vmGroupSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
+		vmGroupRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupRestore{})
+		vmSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachine{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachineInstance{})
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMGroupSnapshotController{
+			Client:                  virtClient,
+			VMGroupSnapshotInformer: vmGroupSnapshotInformer,
+			VMGroupRestoreInformer:  vmGroupRestoreInformer,
+			VMSnapshotInformer:      vmSnapshotInformer,
+			VMRestoreInformer:       vmRestoreInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineGroupRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineGroupRestores(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace)).AnyTimes()
+
+		currentTime = timeFunc
+	})
+
+	It("should wait for the group snapshot to be ready", func() {
+		Expect(vmGroupSnapshotInformer.GetStore().Add(createGroupSnapshot(false))).To(Succeed())
+		gr := createGroupRestore()
+		addGroupRestore(gr)
+
+		_, err := controller.updateVMGroupRestore(gr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listRestores()).To(BeEmpty())
+
+		gr = getGroupRestore()
+		Expect(gr.Status.Complete).To(HaveValue(BeFalse()))
+		Expect(gr.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionReady),
+			HaveField("Reason", groupRestoreWaitingSnapshot),
+		)))
+	})
+
+	It("should restore every member from its snapshot", func() {
+		Expect(vmGroupSnapshotInformer.GetStore().Add(createGroupSnapshot(true))).To(Succeed())
+		gr := createGroupRestore()
+		addGroupRestore(gr)
+
+		_, err := controller.updateVMGroupRestore(gr)
+		Expect(err).ToNot(HaveOccurred())
+
+		restores := listRestores()
+		Expect(restores).To(HaveLen(2))
+		for _, vmRestore := range restores {
+			Expect(vmRestore.Labels).To(HaveKeyWithValue(snapshotv1.VirtualMachineGroupRestoreLabel, groupRestoreName))
+			Expect(vmRestore.OwnerReferences).To(ConsistOf(HaveField("UID", gr.UID)))
+			Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(groupSnapshotName + "-" + vmRestore.Spec.Target.Name))
+			Expect(vmRestore.Spec.TargetReadinessPolicy).To(Equal(gr.Spec.TargetReadinessPolicy))
+		}
+
+		gr = getGroupRestore()
+		Expect(gr.Status.Members).To(HaveLen(2))
+		Expect(gr.Status.Complete).To(HaveValue(BeFalse()))
+	})
+
+	It("should fail when a member restore with the same name does not belong to the group", func() {
+		Expect(vmGroupSnapshotInformer.GetStore().Add(createGroupSnapshot(true))).To(Succeed())
+		gr := createGroupRestore()
+		addGroupRestore(gr)
+		vmRestore := createMemberRestore(gr, "vm-a", false)
+		vmRestore.OwnerReferences = nil
+		addRestore(vmRestore)
+
+		_, err := controller.updateVMGroupRestore(gr)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupRestoreFailedEvent)
+
+		gr = getGroupRestore()
+		Expect(gr.Status.Members).To(BeEmpty())
+		Expect(gr.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionFailure),
+			HaveField("Status", corev1.ConditionTrue),
+			HaveField("Reason", groupRestoreMemberTaken+": "+vmRestore.Name),
+		)))
+		Expect(groupRestoreFailedTerminally(gr)).To(BeTrue())
+	})
+
+	It("should complete once all the members are restored", func() {
+		gr := createStartedGroupRestore()
+		addGroupRestore(gr)
+		addRestore(createMemberRestore(gr, "vm-a", true))
+		addRestore(createMemberRestore(gr, "vm-b", true))
+
+		_, err := controller.updateVMGroupRestore(gr)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupRestoreCompleteEvent)
+
+		gr = getGroupRestore()
+		Expect(gr.Status.Complete).To(HaveValue(BeTrue()))
+		Expect(gr.Status.RestoreTime).To(Equal(timeFunc()))
+		Expect(gr.Status.Members).To(HaveEach(HaveField("Complete", HaveValue(BeTrue()))))
+	})
+
+	It("should fail once a member failed", func() {
+		gr := createStartedGroupRestore()
+		addGroupRestore(gr)
+		addRestore(createMemberRestore(gr, "vm-a", true))
+		vmRestore := createMemberRestore(gr, "vm-b", false)
+		vmRestore.Status.Conditions = []snapshotv1.Condition{
+			newFailureCondition(corev1.ConditionTrue, "volume restore failed"),
+		}
+		addRestore(vmRestore)
+
+		_, err := controller.updateVMGroupRestore(gr)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupRestoreFailedEvent)
+
+		gr = getGroupRestore()
+		Expect(gr.Status.Complete).To(HaveValue(BeFalse()))
+		Expect(gr.Status.Conditions).To(ContainElements(
+			And(
+				HaveField("Type", snapshotv1.ConditionFailure),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", ContainSubstring("volume restore failed")),
+			),
+			And(
+				HaveField("Type", snapshotv1.ConditionProgressing),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", groupRestoreFailed),
+			),
+		))
+		Expect(groupRestoreFailedTerminally(gr)).To(BeTrue())
+	})
+})
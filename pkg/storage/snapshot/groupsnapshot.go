@@ -0,0 +1,553 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	launcherapi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	vmGroupSnapshotFinalizer = "snapshot.kubevirt.io/vmgroupsnapshot-protection"
+
+	// groupSnapshotFrozenAnnotation is set on the member snapshots once the group snapshot
+	// froze all its members, the volumes of the members are snapshotted only afterwards
+	groupSnapshotFrozenAnnotation = "snapshot.kubevirt.io/group-snapshot-frozen"
+
+	groupSnapshotStartedEvent  = "VirtualMachineGroupSnapshotStarted"
+	groupSnapshotFrozenEvent   = "VirtualMachineGroupSnapshotFrozen"
+	groupSnapshotCreatedEvent  = "VirtualMachineGroupSnapshotCreated"
+	groupSnapshotFailedEvent   = "VirtualMachineGroupSnapshotFailed"
+	groupSnapshotNoMembers     = "No VirtualMachines match the selector"
+	groupSnapshotInvalid       = "Invalid selector"
+	groupSnapshotWaitingLock   = "Waiting for the members to be locked"
+	groupSnapshotWaitingVolume = "Waiting for the volumes of the members to be snapshotted"
+	groupSnapshotFreezeFailed  = "Failed freezing the members"
+	groupSnapshotMemberFailed  = "Snapshot of a member failed"
+	groupSnapshotMemberMissing = "Snapshot of a member no longer exists"
+	groupSnapshotMemberTaken   = "Snapshot of a member already exists outside of the group"
+	groupSnapshotDeadline      = "Group snapshot deadline exceeded"
+	groupSnapshotComplete      = "Operation complete"
+	groupFreezeRetryInterval   = 5 * time.Second
+)
+
+func groupSnapshotMember(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	_, ok := vmSnapshot.Labels[snapshotv1.VirtualMachineGroupSnapshotLabel]
+	return ok
+}
+
+func waitingForGroupFreeze(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	_, frozen := vmSnapshot.Annotations[groupSnapshotFrozenAnnotation]
+	return groupSnapshotMember(vmSnapshot) && !frozen
+}
+
+func groupMemberSnapshotName(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, vmName string) string {
+	return fmt.Sprintf("%s-%s", groupSnapshot.Name, vmName)
+}
+
+func getGroupFailureDeadline(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) time.Duration {
+	if groupSnapshot.Spec.FailureDeadline != nil {
+		return groupSnapshot.Spec.FailureDeadline.Duration
+	}
+	return snapshotv1.DefaultFailureDeadline
+}
+
+// getGroupFreezeTimeout bounds how long the members stay frozen should the group snapshot never thaw them,
+// it falls back to the default deadline when the group snapshot has none
+func getGroupFreezeTimeout(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) time.Duration {
+	if failureDeadline := getGroupFailureDeadline(groupSnapshot); failureDeadline != 0 {
+		return failureDeadline
+	}
+	return snapshotv1.DefaultFailureDeadline
+}
+
+func timeUntilGroupDeadline(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) time.Duration {
+	failureDeadline := getGroupFailureDeadline(groupSnapshot)
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return 0
+	}
+	return groupSnapshot.CreationTimestamp.Add(failureDeadline).Sub(currentTime().Time)
+}
+
+func updateGroupSnapshotCondition(gs *snapshotv1.VirtualMachineGroupSnapshot, c snapshotv1.Condition) {
+	gs.Status.Conditions = updateCondition(gs.Status.Conditions, c)
+}
+
+func (ctrl *VMGroupSnapshotController) updateVMGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineGroupSnapshot %s/%s", groupSnapshot.Namespace, groupSnapshot.Name)
+
+	// the member snapshots are garbage collected with the group snapshot
+	if groupSnapshot.DeletionTimestamp != nil {
+		return 0, ctrl.cleanupVMGroupSnapshot(groupSnapshot)
+	}
+
+	groupSnapshot, err := ctrl.addGroupSnapshotFinalizer(groupSnapshot)
+	if err != nil {
+		return 0, err
+	}
+
+	groupSnapshotOut := groupSnapshot.DeepCopy()
+	if groupSnapshotOut.Status == nil {
+		groupSnapshotOut.Status = &snapshotv1.VirtualMachineGroupSnapshotStatus{
+			ReadyToUse: pointer.P(false),
+		}
+	}
+
+	retry, err := ctrl.reconcileGroupSnapshot(groupSnapshotOut)
+	if err != nil {
+		return 0, err
+	}
+
+	if !equality.Semantic.DeepEqual(groupSnapshot.Status, groupSnapshotOut.Status) {
+		if err := ctrl.vmGroupSnapshotStatusUpdater.UpdateStatus(groupSnapshotOut); err != nil {
+			return 0, err
+		}
+	}
+	return retry, nil
+}
+
+func (ctrl *VMGroupSnapshotController) reconcileGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) (time.Duration, error) {
+	if groupSnapshot.Status.Phase == snapshotv1.Failed {
+		return 0, nil
+	}
+
+	if groupSnapshot.Status.Phase == snapshotv1.PhaseUnset {
+		if err := ctrl.selectMembers(groupSnapshot); err != nil {
+			return 0, err
+		}
+		if groupSnapshot.Status.Phase == snapshotv1.Failed {
+			return 0, nil
+		}
+	}
+
+	snapshots, err := ctrl.getMemberSnapshots(groupSnapshot)
+	if err != nil {
+		return 0, err
+	}
+
+	failure := ""
+	for i := range groupSnapshot.Status.Members {
+		member := &groupSnapshot.Status.Members[i]
+		vmSnapshot := snapshots[member.VirtualMachineSnapshotName]
+		if vmSnapshot == nil || vmSnapshot.Status == nil {
+			member.Phase = snapshotv1.PhaseUnset
+			member.ReadyToUse = pointer.P(false)
+			continue
+		}
+		member.Phase = vmSnapshot.Status.Phase
+		member.ReadyToUse = vmSnapshot.Status.ReadyToUse
+		if member.Phase == snapshotv1.Failed && failure == "" {
+			failure = fmt.Sprintf("%s: %s", groupSnapshotMemberFailed, member.VirtualMachineSnapshotName)
+		}
+	}
+
+	if groupSnapshot.Status.CreationTime == nil {
+		if failure == "" && timeUntilGroupDeadline(groupSnapshot) < 0 {
+			failure = groupSnapshotDeadline
+		}
+		if failure != "" {
+			return 0, ctrl.failGroupSnapshot(groupSnapshot, failure)
+		}
+
+		retry, err := ctrl.takeGroupSnapshot(groupSnapshot, snapshots)
+		if err != nil || groupSnapshot.Status.CreationTime == nil {
+			return retry, err
+		}
+	}
+
+	aggregateGroupSnapshot(groupSnapshot, snapshots)
+	return 0, nil
+}
+
+// selectMembers determines the VMs of the group and creates their snapshots
+func (ctrl *VMGroupSnapshotController) selectMembers(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) error {
+	selector, err := metav1.LabelSelectorAsSelector(&groupSnapshot.Spec.Selector)
+	if err != nil {
+		return ctrl.failGroupSnapshot(groupSnapshot, fmt.Sprintf("%s: %v", groupSnapshotInvalid, err))
+	}
+
+	vms, err := ctrl.getSelectedVMs(groupSnapshot.Namespace, selector)
+	if err != nil {
+		return err
+	}
+	if len(vms) == 0 {
+		return ctrl.failGroupSnapshot(groupSnapshot, groupSnapshotNoMembers)
+	}
+
+	for _, vm := range vms {
+		vmSnapshot := newGroupMemberSnapshot(groupSnapshot, vm.Name)
+		owned, err := ctrl.createMemberSnapshot(groupSnapshot, vmSnapshot)
+		if err != nil {
+			return err
+		}
+		if !owned {
+			return ctrl.failGroupSnapshot(groupSnapshot, fmt.Sprintf("%s: %s", groupSnapshotMemberTaken, vmSnapshot.Name))
+		}
+		groupSnapshot.Status.Members = append(groupSnapshot.Status.Members, snapshotv1.VirtualMachineGroupSnapshotMember{
+			VirtualMachineName:         vm.Name,
+			VirtualMachineSnapshotName: vmSnapshot.Name,
+		})
+	}
+
+	groupSnapshot.Status.Phase = snapshotv1.InProgress
+	ctrl.Recorder.Eventf(groupSnapshot, corev1.EventTypeNormal, groupSnapshotStartedEvent, "Snapshotting %d VirtualMachines", len(vms))
+	return nil
+}
+
+// createMemberSnapshot creates the snapshot of a member, an already existing snapshot with the
+// same name is only taken over when it is controlled by the group snapshot
+func (ctrl *VMGroupSnapshotController) createMemberSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	client := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace)
+	_, err := client.Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if err == nil {
+		return true, nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	existing, err := client.Get(context.Background(), vmSnapshot.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return metav1.IsControlledBy(existing, groupSnapshot), nil
+}
+
+func newGroupMemberSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, vmName string) *snapshotv1.VirtualMachineSnapshot {
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      groupMemberSnapshotName(groupSnapshot, vmName),
+			Namespace: groupSnapshot.Namespace,
+			Labels: map[string]string{
+				snapshotv1.VirtualMachineGroupSnapshotLabel: groupSnapshot.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(groupSnapshot, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineGroupSnapshot")),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vmName,
+			},
+			DeletionPolicy:  groupSnapshot.Spec.DeletionPolicy,
+			FailureDeadline: groupSnapshot.Spec.FailureDeadline,
+		},
+	}
+}
+
+// takeGroupSnapshot freezes all the members once they are locked, lets their volumes be snapshotted
+// and thaws them once all the volume snapshots are created
+func (ctrl *VMGroupSnapshotController) takeGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, snapshots map[string]*snapshotv1.VirtualMachineSnapshot) (time.Duration, error) {
+	locked, frozen, created := true, true, true
+	for _, member := range groupSnapshot.Status.Members {
+		vmSnapshot := snapshots[member.VirtualMachineSnapshotName]
+		if vmSnapshot == nil {
+			// the informer has not seen the new snapshot yet
+			locked, frozen, created = false, false, false
+			break
+		}
+		// the content is only created once the source is locked
+		if vmSnapshot.Status == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+			locked = false
+		}
+		if waitingForGroupFreeze(vmSnapshot) {
+			frozen = false
+		}
+		if vmSnapshot.Status == nil || vmSnapshot.Status.CreationTime == nil {
+			created = false
+		}
+	}
+
+	updateGroupSnapshotCondition(groupSnapshot, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	switch {
+	case !locked:
+		updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionTrue, groupSnapshotWaitingLock))
+	case !frozen:
+		if err := ctrl.freezeMembers(groupSnapshot, snapshots); err != nil {
+			log.Log.Object(groupSnapshot).Reason(err).Error(groupSnapshotFreezeFailed)
+			groupSnapshot.Status.Error = &snapshotv1.Error{
+				Time:    currentTime(),
+				Message: pointer.P(fmt.Sprintf("%s: %v", groupSnapshotFreezeFailed, err)),
+			}
+			updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionFalse, groupSnapshotFreezeFailed))
+			// the members frozen so far are thawed before trying again
+			if err := ctrl.thawMembers(groupSnapshot); err != nil {
+				return 0, err
+			}
+			return groupFreezeRetryInterval, nil
+		}
+		groupSnapshot.Status.Error = nil
+		ctrl.Recorder.Eventf(groupSnapshot, corev1.EventTypeNormal, groupSnapshotFrozenEvent, "Froze %d VirtualMachines", len(groupSnapshot.Status.Members))
+		updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionTrue, groupSnapshotWaitingVolume))
+	case !created:
+		updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionTrue, groupSnapshotWaitingVolume))
+	default:
+		if err := ctrl.thawMembers(groupSnapshot); err != nil {
+			return 0, err
+		}
+		groupSnapshot.Status.CreationTime = currentTime()
+		ctrl.Recorder.Eventf(groupSnapshot, corev1.EventTypeNormal, groupSnapshotCreatedEvent, "Snapshotted %d VirtualMachines", len(groupSnapshot.Status.Members))
+		return 0, nil
+	}
+
+	return timeUntilGroupDeadline(groupSnapshot), nil
+}
+
+// freezeMembers freezes the guest filesystems of all the running members before
+// marking their snapshots as frozen, members without guest agent are not frozen
+func (ctrl *VMGroupSnapshotController) freezeMembers(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, snapshots map[string]*snapshotv1.VirtualMachineSnapshot) error {
+	for _, member := range groupSnapshot.Status.Members {
+		vmi, err := ctrl.getRunningMemberVMI(groupSnapshot.Namespace, member.VirtualMachineName)
+		if err != nil {
+			return err
+		}
+		if vmi == nil || vmi.Status.FSFreezeStatus == launcherapi.FSFrozen {
+			continue
+		}
+		if !controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected) {
+			log.Log.Warningf("Guest agent does not exist and VM %s is running. Snapshoting without freezing FS. This can result in inconsistent snapshot!", vmi.Name)
+			continue
+		}
+
+		log.Log.V(3).Infof("Freezing vm %s file system before taking the group snapshot", vmi.Name)
+		err = ctrl.Client.VirtualMachineInstance(vmi.Namespace).Freeze(context.Background(), vmi.Name, getGroupFreezeTimeout(groupSnapshot))
+		if err != nil {
+			return fmt.Errorf("%s %s: %v", failedFreezeMsg, vmi.Name, err)
+		}
+	}
+
+	for _, member := range groupSnapshot.Status.Members {
+		if err := ctrl.markMemberFrozen(snapshots[member.VirtualMachineSnapshotName]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctrl *VMGroupSnapshotController) markMemberFrozen(vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
+	if !waitingForGroupFreeze(vmSnapshot) {
+		return nil
+	}
+
+	annotationPatch := patch.New()
+	if len(vmSnapshot.Annotations) == 0 {
+		annotationPatch.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{groupSnapshotFrozenAnnotation: "true"}))
+	} else {
+		annotationPatch.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(groupSnapshotFrozenAnnotation), "true"))
+	}
+	payload, err := annotationPatch.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Patch(context.Background(), vmSnapshot.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	return err
+}
+
+func (ctrl *VMGroupSnapshotController) thawMembers(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) error {
+	for _, member := range groupSnapshot.Status.Members {
+		vmi, err := ctrl.getRunningMemberVMI(groupSnapshot.Namespace, member.VirtualMachineName)
+		if err != nil {
+			return err
+		}
+		if vmi == nil || !controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected) {
+			continue
+		}
+
+		log.Log.V(3).Infof("Unfreezing vm %s file system after taking the group snapshot", vmi.Name)
+		if err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Unfreeze(context.Background(), vmi.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupVMGroupSnapshot thaws the members of a group snapshot deleted before it was taken
+func (ctrl *VMGroupSnapshotController) cleanupVMGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) error {
+	if !controller.HasFinalizer(groupSnapshot, vmGroupSnapshotFinalizer) {
+		return nil
+	}
+
+	// failed group snapshots thawed their members already
+	if groupSnapshot.Status != nil && groupSnapshot.Status.CreationTime == nil && groupSnapshot.Status.Phase != snapshotv1.Failed {
+		if err := ctrl.thawMembers(groupSnapshot); err != nil {
+			return err
+		}
+	}
+
+	_, err := ctrl.removeGroupSnapshotFinalizer(groupSnapshot)
+	return err
+}
+
+func (ctrl *VMGroupSnapshotController) addGroupSnapshotFinalizer(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) (*snapshotv1.VirtualMachineGroupSnapshot, error) {
+	if controller.HasFinalizer(groupSnapshot, vmGroupSnapshotFinalizer) {
+		return groupSnapshot, nil
+	}
+
+	cpy := groupSnapshot.DeepCopy()
+	controller.AddFinalizer(cpy, vmGroupSnapshotFinalizer)
+
+	patch, err := generateFinalizerPatch(groupSnapshot.Finalizers, cpy.Finalizers)
+	if err != nil {
+		return groupSnapshot, err
+	}
+
+	return ctrl.Client.VirtualMachineGroupSnapshot(cpy.Namespace).Patch(context.Background(), cpy.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+}
+
+func (ctrl *VMGroupSnapshotController) removeGroupSnapshotFinalizer(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) (*snapshotv1.VirtualMachineGroupSnapshot, error) {
+	if !controller.HasFinalizer(groupSnapshot, vmGroupSnapshotFinalizer) {
+		return groupSnapshot, nil
+	}
+
+	cpy := groupSnapshot.DeepCopy()
+	controller.RemoveFinalizer(cpy, vmGroupSnapshotFinalizer)
+
+	patch, err := generateFinalizerPatch(groupSnapshot.Finalizers, cpy.Finalizers)
+	if err != nil {
+		return groupSnapshot, err
+	}
+
+	return ctrl.Client.VirtualMachineGroupSnapshot(cpy.Namespace).Patch(context.Background(), cpy.Name, types.JSONPatchType, patch, metav1.PatchOptions{})
+}
+
+func (ctrl *VMGroupSnapshotController) failGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, reason string) error {
+	if err := ctrl.thawMembers(groupSnapshot); err != nil {
+		return err
+	}
+
+	ctrl.Recorder.Event(groupSnapshot, corev1.EventTypeWarning, groupSnapshotFailedEvent, reason)
+	groupSnapshot.Status.Phase = snapshotv1.Failed
+	groupSnapshot.Status.ReadyToUse = pointer.P(false)
+	groupSnapshot.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(reason),
+	}
+	updateGroupSnapshotCondition(groupSnapshot, newFailureCondition(corev1.ConditionTrue, reason))
+	updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	updateGroupSnapshotCondition(groupSnapshot, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	return nil
+}
+
+// aggregateGroupSnapshot derives the state of a taken group snapshot from its members
+func aggregateGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, snapshots map[string]*snapshotv1.VirtualMachineSnapshot) {
+	succeeded, ready := true, true
+	errorMessage := ""
+	for _, member := range groupSnapshot.Status.Members {
+		if snapshots[member.VirtualMachineSnapshotName] == nil && errorMessage == "" {
+			errorMessage = fmt.Sprintf("%s: %s", groupSnapshotMemberMissing, member.VirtualMachineSnapshotName)
+		}
+		if member.Phase != snapshotv1.Succeeded {
+			succeeded = false
+		}
+		if member.ReadyToUse == nil || !*member.ReadyToUse {
+			ready = false
+		}
+	}
+
+	if succeeded {
+		groupSnapshot.Status.Phase = snapshotv1.Succeeded
+		updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionFalse, groupSnapshotComplete))
+	}
+
+	groupSnapshot.Status.Error = nil
+	if errorMessage != "" {
+		ready = false
+		groupSnapshot.Status.Error = &snapshotv1.Error{
+			Time:    currentTime(),
+			Message: pointer.P(errorMessage),
+		}
+	}
+
+	groupSnapshot.Status.ReadyToUse = pointer.P(ready)
+	if ready {
+		updateGroupSnapshotCondition(groupSnapshot, newReadyCondition(corev1.ConditionTrue, "Ready"))
+	} else {
+		updateGroupSnapshotCondition(groupSnapshot, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) getSelectedVMs(namespace string, selector labels.Selector) ([]*kubevirtv1.VirtualMachine, error) {
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+			vms = append(vms, vm)
+		}
+	}
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+	return vms, nil
+}
+
+func (ctrl *VMGroupSnapshotController) getMemberSnapshots(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) (map[string]*snapshotv1.VirtualMachineSnapshot, error) {
+	snapshots := map[string]*snapshotv1.VirtualMachineSnapshot{}
+	for _, member := range groupSnapshot.Status.Members {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(groupSnapshot.Namespace, member.VirtualMachineSnapshotName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			snapshots[member.VirtualMachineSnapshotName] = obj.(*snapshotv1.VirtualMachineSnapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+func (ctrl *VMGroupSnapshotController) getRunningMemberVMI(namespace, vmName string) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(namespace, vmName))
+	if err != nil || !exists {
+		return nil, err
+	}
+	vmi := obj.(*kubevirtv1.VirtualMachineInstance)
+	if !vmi.IsRunning() {
+		return nil, nil
+	}
+	return vmi, nil
+}
@@ -0,0 +1,272 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/status"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+// VMGroupSnapshotController takes consistent snapshots of groups of VMs and restores them
+type VMGroupSnapshotController struct {
+	Client kubecli.KubevirtClient
+
+	VMGroupSnapshotInformer cache.SharedIndexInformer
+	VMGroupRestoreInformer  cache.SharedIndexInformer
+	VMSnapshotInformer      cache.SharedIndexInformer
+	VMRestoreInformer       cache.SharedIndexInformer
+	VMInformer              cache.SharedIndexInformer
+	VMIInformer             cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmGroupSnapshotQueue workqueue.TypedRateLimitingInterface[string]
+	vmGroupRestoreQueue  workqueue.TypedRateLimitingInterface[string]
+
+	vmGroupSnapshotStatusUpdater *status.VMGroupSnapshotStatusUpdater
+	vmGroupRestoreStatusUpdater  *status.VMGroupRestoreStatusUpdater
+}
+
+// Init initializes the group snapshot controller
+func (ctrl *VMGroupSnapshotController) Init() error {
+	ctrl.vmGroupSnapshotQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-group-snapshot"},
+	)
+	ctrl.vmGroupRestoreQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-group-restore"},
+	)
+
+	_, err := ctrl.VMGroupSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMGroupSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMGroupSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMGroupSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMGroupRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMGroupRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMGroupRestore(newObj) },
+			DeleteFunc: ctrl.handleVMGroupRestore,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleGroupMemberVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleGroupMemberVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleGroupMemberVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleGroupMemberVMRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleGroupMemberVMRestore(newObj) },
+			DeleteFunc: ctrl.handleGroupMemberVMRestore,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	ctrl.vmGroupSnapshotStatusUpdater = status.NewVMGroupSnapshotStatusUpdater(ctrl.Client)
+	ctrl.vmGroupRestoreStatusUpdater = status.NewVMGroupRestoreStatusUpdater(ctrl.Client)
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMGroupSnapshotController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmGroupSnapshotQueue.ShutDown()
+	defer ctrl.vmGroupRestoreQueue.ShutDown()
+
+	log.Log.Info("Starting group snapshot controller.")
+	defer log.Log.Info("Shutting down group snapshot controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMGroupSnapshotInformer.HasSynced,
+		ctrl.VMGroupRestoreInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMRestoreInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmGroupSnapshotWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmGroupRestoreWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMGroupSnapshotController) vmGroupSnapshotWorker() {
+	for ctrl.processVMGroupSnapshotWorkItem() {
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) vmGroupRestoreWorker() {
+	for ctrl.processVMGroupRestoreWorkItem() {
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) processVMGroupSnapshotWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmGroupSnapshotQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmGroupSnapshot worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMGroupSnapshotInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		groupSnapshot, ok := storeObj.(*snapshotv1.VirtualMachineGroupSnapshot)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMGroupSnapshot(groupSnapshot.DeepCopy())
+	})
+}
+
+func (ctrl *VMGroupSnapshotController) processVMGroupRestoreWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmGroupRestoreQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmGroupRestore worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMGroupRestoreInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		groupRestore, ok := storeObj.(*snapshotv1.VirtualMachineGroupRestore)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMGroupRestore(groupRestore.DeepCopy())
+	})
+}
+
+func (ctrl *VMGroupSnapshotController) handleVMGroupSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if groupSnapshot, ok := obj.(*snapshotv1.VirtualMachineGroupSnapshot); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(groupSnapshot)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, groupSnapshot)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmGroupSnapshotQueue.Add(objName)
+
+		// group restores wait for the group snapshot to be ready
+		objs, err := ctrl.VMGroupRestoreInformer.GetIndexer().ByIndex(cache.NamespaceIndex, groupSnapshot.Namespace)
+		if err != nil {
+			log.Log.Errorf("Failed to list VirtualMachineGroupRestores: %v", err)
+			return
+		}
+		for _, obj := range objs {
+			groupRestore := obj.(*snapshotv1.VirtualMachineGroupRestore)
+			if groupRestore.Spec.VirtualMachineGroupSnapshotName == groupSnapshot.Name {
+				ctrl.vmGroupRestoreQueue.Add(cacheKeyFunc(groupRestore.Namespace, groupRestore.Name))
+			}
+		}
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) handleVMGroupRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if groupRestore, ok := obj.(*snapshotv1.VirtualMachineGroupRestore); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(groupRestore)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, groupRestore)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmGroupRestoreQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) handleGroupMemberVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		groupSnapshotName, ok := vmSnapshot.Labels[snapshotv1.VirtualMachineGroupSnapshotLabel]
+		if !ok {
+			return
+		}
+
+		ctrl.vmGroupSnapshotQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, groupSnapshotName))
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) handleGroupMemberVMRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmRestore, ok := obj.(*snapshotv1.VirtualMachineRestore); ok {
+		groupRestoreName, ok := vmRestore.Labels[snapshotv1.VirtualMachineGroupRestoreLabel]
+		if !ok {
+			return
+		}
+
+		ctrl.vmGroupRestoreQueue.Add(cacheKeyFunc(vmRestore.Namespace, groupRestoreName))
+	}
+}
@@ -0,0 +1,454 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Group snapshot controller", func() {
+	const groupSnapshotName = "shop"
+
+	var (
+		now = metav1.Time{Time: time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)}
+
+		vmGroupSnapshotInformer cache.SharedIndexInformer
+		vmSnapshotInformer      cache.SharedIndexInformer
+		vmInformer              cache.SharedIndexInformer
+		vmiInformer             cache.SharedIndexInformer
+
+		controller     *VMGroupSnapshotController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+		vmiInterface   *kubecli.MockVirtualMachineInstanceInterface
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &now
+	}
+
+	createGroupSnapshot := func() *snapshotv1.VirtualMachineGroupSnapshot {
+		return &snapshotv1.VirtualMachineGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              groupSnapshotName,
+				Namespace:         testNamespace,
+				UID:               "group-uid",
+				CreationTimestamp: metav1.Time{Time: now.Add(-30 * time.Second)},
+			},
+			Spec: snapshotv1.VirtualMachineGroupSnapshotSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "shop"},
+				},
+				FailureDeadline: &metav1.Duration{Duration: time.Minute},
+			},
+		}
+	}
+
+	createVM := func(name string, selected bool) *kubevirtv1.VirtualMachine {
+		vm := &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+		}
+		if selected {
+			vm.Labels = map[string]string{"app": "shop"}
+		}
+		return vm
+	}
+
+	createRunningVMI := func(name string) *kubevirtv1.VirtualMachineInstance {
+		return &kubevirtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Status: kubevirtv1.VirtualMachineInstanceStatus{
+				Phase: kubevirtv1.Running,
+				Conditions: []kubevirtv1.VirtualMachineInstanceCondition{
+					{
+						Type:   kubevirtv1.VirtualMachineInstanceAgentConnected,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
+
+	// createStartedGroupSnapshot returns a group snapshot whose members were already created
+	createStartedGroupSnapshot := func() *snapshotv1.VirtualMachineGroupSnapshot {
+		gs := createGroupSnapshot()
+		gs.Status = &snapshotv1.VirtualMachineGroupSnapshotStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.P(false),
+		}
+		for _, vmName := range []string{"vm-a", "vm-b"} {
+			gs.Status.Members = append(gs.Status.Members, snapshotv1.VirtualMachineGroupSnapshotMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: groupMemberSnapshotName(gs, vmName),
+			})
+		}
+		return gs
+	}
+
+	createMemberSnapshot := func(gs *snapshotv1.VirtualMachineGroupSnapshot, vmName string, locked, frozen, created bool) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := newGroupMemberSnapshot(gs, vmName)
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.P(false),
+		}
+		if locked {
+			vmSnapshot.Status.VirtualMachineSnapshotContentName = pointer.P(fmt.Sprintf("vmsnapshot-content-%s", vmName))
+		}
+		if frozen {
+			vmSnapshot.Annotations = map[string]string{groupSnapshotFrozenAnnotation: "true"}
+		}
+		if created {
+			vmSnapshot.Status.CreationTime = timeFunc()
+		}
+		return vmSnapshot
+	}
+
+	addGroupSnapshot := func(gs *snapshotv1.VirtualMachineGroupSnapshot) {
+		Expect(vmGroupSnapshotInformer.GetStore().Add(gs)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineGroupSnapshots(gs.Namespace).Create(context.Background(), gs, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addSnapshot := func(s *snapshotv1.VirtualMachineSnapshot) {
+		Expect(vmSnapshotInformer.GetStore().Add(s)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(s.Namespace).Create(context.Background(), s, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getGroupSnapshot := func() *snapshotv1.VirtualMachineGroupSnapshot {
+		gs, err := kubevirtClient.SnapshotV1beta1().VirtualMachineGroupSnapshots(testNamespace).Get(context.Background(), groupSnapshotName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return gs
+	}
+
+	getSnapshot := func(name string) *snapshotv1.VirtualMachineSnapshot {
+		s, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return s
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		vmGroupSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
+		vmGroupRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupRestore{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachineInstance{})
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMGroupSnapshotController{
+			Client:                  virtClient,
+			VMGroupSnapshotInformer: vmGroupSnapshotInformer,
+			VMGroupRestoreInformer:  vmGroupRestoreInformer,
+			VMSnapshotInformer:      vmSnapshotInformer,
+			VMRestoreInformer:       vmRestoreInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineGroupSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineGroupSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+
+		currentTime = timeFunc
+
+		Expect(vmInformer.GetStore().Add(createVM("vm-a", true))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createVM("vm-b", true))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createVM("vm-other", false))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI("vm-a"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createRunningVMI("vm-b"))).To(Succeed())
+	})
+
+	It("should snapshot every selected VM", func() {
+		gs := createGroupSnapshot()
+		addGroupSnapshot(gs)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotStartedEvent)
+
+		for _, vmName := range []string{"vm-a", "vm-b"} {
+			vmSnapshot := getSnapshot(groupMemberSnapshotName(gs, vmName))
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.VirtualMachineGroupSnapshotLabel, groupSnapshotName))
+			Expect(vmSnapshot.OwnerReferences).To(ConsistOf(HaveField("UID", gs.UID)))
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+			Expect(vmSnapshot.Spec.FailureDeadline).To(Equal(gs.Spec.FailureDeadline))
+		}
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(gs.Status.Members).To(HaveLen(2))
+		Expect(gs.Status.Members[0].VirtualMachineName).To(Equal("vm-a"))
+		Expect(gs.Status.Members[1].VirtualMachineName).To(Equal("vm-b"))
+	})
+
+	It("should take over the member snapshots it already created", func() {
+		gs := createGroupSnapshot()
+		addGroupSnapshot(gs)
+		addSnapshot(newGroupMemberSnapshot(gs, "vm-a"))
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotStartedEvent)
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(gs.Status.Members).To(HaveLen(2))
+	})
+
+	It("should fail when a member snapshot with the same name does not belong to the group", func() {
+		gs := createGroupSnapshot()
+		addGroupSnapshot(gs)
+		vmSnapshot := newGroupMemberSnapshot(gs, "vm-b")
+		vmSnapshot.OwnerReferences = nil
+		addSnapshot(vmSnapshot)
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotFailedEvent)
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*gs.Status.Error.Message).To(Equal(groupSnapshotMemberTaken + ": " + vmSnapshot.Name))
+		Expect(getSnapshot(vmSnapshot.Name).OwnerReferences).To(BeEmpty())
+	})
+
+	It("should fail when no VM matches the selector", func() {
+		gs := createGroupSnapshot()
+		gs.Spec.Selector.MatchLabels = map[string]string{"app": "none"}
+		addGroupSnapshot(gs)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotFailedEvent)
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*gs.Status.Error.Message).To(Equal(groupSnapshotNoMembers))
+	})
+
+	It("should wait for all the members to be locked before freezing", func() {
+		gs := createStartedGroupSnapshot()
+		addGroupSnapshot(gs)
+		addSnapshot(createMemberSnapshot(gs, "vm-a", true, false, false))
+		addSnapshot(createMemberSnapshot(gs, "vm-b", false, false, false))
+
+		retry, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(30 * time.Second))
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionProgressing),
+			HaveField("Reason", groupSnapshotWaitingLock),
+		)))
+	})
+
+	It("should freeze all the members together once they are locked", func() {
+		gs := createStartedGroupSnapshot()
+		addGroupSnapshot(gs)
+		addSnapshot(createMemberSnapshot(gs, "vm-a", true, false, false))
+		addSnapshot(createMemberSnapshot(gs, "vm-b", true, false, false))
+
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", time.Minute).Return(nil)
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm-b", time.Minute).Return(nil)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotFrozenEvent)
+
+		for _, vmName := range []string{"vm-a", "vm-b"} {
+			vmSnapshot := getSnapshot(groupMemberSnapshotName(gs, vmName))
+			Expect(vmSnapshot.Annotations).To(HaveKeyWithValue(groupSnapshotFrozenAnnotation, "true"))
+		}
+	})
+
+	It("should freeze with the default deadline when the group snapshot has no deadline", func() {
+		gs := createStartedGroupSnapshot()
+		gs.Spec.FailureDeadline = &metav1.Duration{}
+		addGroupSnapshot(gs)
+		addSnapshot(createMemberSnapshot(gs, "vm-a", true, false, false))
+		addSnapshot(createMemberSnapshot(gs, "vm-b", true, false, false))
+
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", snapshotv1.DefaultFailureDeadline).Return(nil)
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm-b", snapshotv1.DefaultFailureDeadline).Return(nil)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotFrozenEvent)
+	})
+
+	It("should thaw the members when it is deleted before the snapshot was taken", func() {
+		gs := createStartedGroupSnapshot()
+		gs.Finalizers = []string{vmGroupSnapshotFinalizer}
+		gs.DeletionTimestamp = timeFunc()
+		addGroupSnapshot(gs)
+
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil)
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-b").Return(nil)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(getGroupSnapshot().Finalizers).To(BeEmpty())
+	})
+
+	It("should thaw the members and retry when freezing fails", func() {
+		gs := createStartedGroupSnapshot()
+		addGroupSnapshot(gs)
+		addSnapshot(createMemberSnapshot(gs, "vm-a", true, false, false))
+		addSnapshot(createMemberSnapshot(gs, "vm-b", true, false, false))
+
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", time.Minute).Return(nil)
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm-b", time.Minute).Return(fmt.Errorf("agent busy"))
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil)
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-b").Return(nil)
+
+		retry, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(groupFreezeRetryInterval))
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(*gs.Status.Error.Message).To(ContainSubstring("agent busy"))
+		Expect(getSnapshot(groupMemberSnapshotName(gs, "vm-a")).Annotations).ToNot(HaveKey(groupSnapshotFrozenAnnotation))
+	})
+
+	It("should thaw the members once the volumes of all of them are snapshotted", func() {
+		gs := createStartedGroupSnapshot()
+		addGroupSnapshot(gs)
+		addSnapshot(createMemberSnapshot(gs, "vm-a", true, true, true))
+		addSnapshot(createMemberSnapshot(gs, "vm-b", true, true, true))
+
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil)
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-b").Return(nil)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotCreatedEvent)
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.CreationTime).To(Equal(timeFunc()))
+		Expect(gs.Status.ReadyToUse).To(HaveValue(BeFalse()))
+	})
+
+	DescribeTable("should thaw the members and fail", func(update func(*snapshotv1.VirtualMachineGroupSnapshot, *snapshotv1.VirtualMachineSnapshot), reason string) {
+		gs := createStartedGroupSnapshot()
+		vmSnapshot := createMemberSnapshot(gs, "vm-b", true, true, false)
+		update(gs, vmSnapshot)
+		addGroupSnapshot(gs)
+		addSnapshot(createMemberSnapshot(gs, "vm-a", true, true, true))
+		addSnapshot(vmSnapshot)
+
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil)
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-b").Return(nil)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+		testutils.ExpectEvent(recorder, groupSnapshotFailedEvent)
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*gs.Status.Error.Message).To(ContainSubstring(reason))
+		Expect(gs.Status.CreationTime).To(BeNil())
+	},
+		Entry("when a member failed", func(_ *snapshotv1.VirtualMachineGroupSnapshot, vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+			vmSnapshot.Status.Phase = snapshotv1.Failed
+		}, groupSnapshotMemberFailed),
+		Entry("when the deadline is exceeded", func(gs *snapshotv1.VirtualMachineGroupSnapshot, _ *snapshotv1.VirtualMachineSnapshot) {
+			gs.CreationTimestamp = metav1.Time{Time: now.Add(-2 * time.Minute)}
+		}, groupSnapshotDeadline),
+	)
+
+	It("should be ready to use once all the members are", func() {
+		gs := createStartedGroupSnapshot()
+		gs.Status.CreationTime = timeFunc()
+		addGroupSnapshot(gs)
+		for _, vmName := range []string{"vm-a", "vm-b"} {
+			vmSnapshot := createMemberSnapshot(gs, vmName, true, true, true)
+			vmSnapshot.Status.Phase = snapshotv1.Succeeded
+			vmSnapshot.Status.ReadyToUse = pointer.P(true)
+			addSnapshot(vmSnapshot)
+		}
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.Phase).To(Equal(snapshotv1.Succeeded))
+		Expect(gs.Status.ReadyToUse).To(HaveValue(BeTrue()))
+		Expect(gs.Status.Members).To(HaveEach(And(
+			HaveField("Phase", snapshotv1.Succeeded),
+			HaveField("ReadyToUse", HaveValue(BeTrue())),
+		)))
+	})
+
+	It("should not be ready to use when a member snapshot is gone", func() {
+		gs := createStartedGroupSnapshot()
+		gs.Status.CreationTime = timeFunc()
+		addGroupSnapshot(gs)
+		vmSnapshot := createMemberSnapshot(gs, "vm-a", true, true, true)
+		vmSnapshot.Status.Phase = snapshotv1.Succeeded
+		vmSnapshot.Status.ReadyToUse = pointer.P(true)
+		addSnapshot(vmSnapshot)
+
+		_, err := controller.updateVMGroupSnapshot(gs)
+		Expect(err).ToNot(HaveOccurred())
+
+		gs = getGroupSnapshot()
+		Expect(gs.Status.ReadyToUse).To(HaveValue(BeFalse()))
+		Expect(*gs.Status.Error.Message).To(ContainSubstring(groupSnapshotMemberMissing))
+	})
+})
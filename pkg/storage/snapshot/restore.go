@@ -83,6 +83,7 @@ var (
 	restoreTargetNotReady           = "Restore target not ready"
 	restoredFailed                  = "Operation failed"
 	errorRestoreToExistingTarget    = "restore source and restore target are different but restore target already exists"
+	errorVolumeRestoreTarget        = "volume restore target must be the existing source of the snapshot"
 )
 
 type restoreTarget interface {
@@ -139,6 +140,29 @@ func vmRestoreDeleting(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore != nil && vmRestore.DeletionTimestamp != nil
 }
 
+func isVolumeRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return len(vmRestore.Spec.Volumes) > 0
+}
+
+// volumeRestoreToNewPVCs returns true if the restored volumes are only created as new PVCs,
+// in that case the target is neither stopped nor modified
+func volumeRestoreToNewPVCs(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return isVolumeRestore(vmRestore) &&
+		(vmRestore.Spec.VolumeRestoreMode == nil || *vmRestore.Spec.VolumeRestoreMode == snapshotv1.VolumeRestoreModeNewPVC)
+}
+
+func volumeSelectedForRestore(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) bool {
+	if !isVolumeRestore(vmRestore) {
+		return true
+	}
+	for _, name := range vmRestore.Spec.Volumes {
+		if name == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
 func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.VirtualMachineRestore) (time.Duration, error) {
 	logger := log.Log.Object(vmRestoreIn)
 	logger.V(1).Infof("Updating VirtualMachineRestore")
@@ -208,6 +232,13 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, ctrl.doUpdateError(vmRestoreIn, fmt.Errorf(errorRestoreToExistingTarget))
 	}
 
+	// A volume restore only replaces or adds volumes,
+	// the rest of the spec has to come from the source
+	if isVolumeRestore(vmRestoreOut) && (!target.Exists() || sourceAndTargetAreDifferent(target, vmSnapshot)) {
+		logger.Error(errorVolumeRestoreTarget)
+		return 0, ctrl.doUpdateError(vmRestoreIn, fmt.Errorf(errorVolumeRestoreTarget))
+	}
+
 	err = target.UpdateRestoreInProgress()
 	if err != nil {
 		return 0, err
@@ -378,9 +409,15 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
+	for _, volumeName := range vmRestore.Spec.Volumes {
+		if _, err := getRestoreVolumeBackup(volumeName, content); err != nil {
+			return false, err
+		}
+	}
+
 	var restores []snapshotv1.VolumeRestore
 	for _, vb := range content.Spec.VolumeBackups {
-		if noRestore.Has(vb.VolumeName) {
+		if noRestore.Has(vb.VolumeName) || !volumeSelectedForRestore(vmRestore, vb.VolumeName) {
 			continue
 		}
 
@@ -490,7 +527,7 @@ func (t *vmRestoreTarget) UpdateDoneRestore() error {
 }
 
 func (t *vmRestoreTarget) UpdateRestoreInProgress() error {
-	if !t.Exists() || hasLastRestoreAnnotation(t.vmRestore, t.vm) {
+	if !t.Exists() || hasLastRestoreAnnotation(t.vmRestore, t.vm) || volumeRestoreToNewPVCs(t.vmRestore) {
 		return nil
 	}
 
@@ -524,7 +561,8 @@ func (t *vmRestoreTarget) Stop() error {
 }
 
 func (t *vmRestoreTarget) Ready() (bool, error) {
-	if !t.Exists() {
+	// restoring volumes into new PVCs does not touch the target, it can keep running
+	if !t.Exists() || volumeRestoreToNewPVCs(t.vmRestore) {
 		return true, nil
 	}
 
@@ -541,7 +579,7 @@ func (t *vmRestoreTarget) Ready() (bool, error) {
 }
 
 func (t *vmRestoreTarget) Reconcile() (bool, error) {
-	if t.Exists() && hasLastRestoreAnnotation(t.vmRestore, t.vm) {
+	if volumeRestoreToNewPVCs(t.vmRestore) || (t.Exists() && hasLastRestoreAnnotation(t.vmRestore, t.vm)) {
 		return false, nil
 	}
 	snapshotVM, err := t.getSnapshotVM()
@@ -553,6 +591,17 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 		return updated, err
 	}
 
+	if isVolumeRestore(t.vmRestore) {
+		restoredVM, err := t.generateVolumeRestoredVMSpec(snapshotVM)
+		if err != nil {
+			return false, err
+		}
+		if updated, err := t.reconcileDataVolumes(restoredVM); updated || err != nil {
+			return updated, err
+		}
+		return t.reconcileSpec(restoredVM)
+	}
+
 	restoredVM, err := t.generateRestoredVMSpec(snapshotVM)
 	if err != nil {
 		return false, err
@@ -863,16 +912,94 @@ func (t *vmRestoreTarget) generateRestoredVMSpec(snapshotVM *snapshotv1.VirtualM
 	return newVM, nil
 }
 
+// generateVolumeRestoredVMSpec replaces the restored volumes in the current target spec,
+// the templates of replaced DataVolumes are dropped and the DataVolumes deleted
+func (t *vmRestoreTarget) generateVolumeRestoredVMSpec(snapshotVM *snapshotv1.VirtualMachine) (*kubevirtv1.VirtualMachine, error) {
+	log.Log.Object(t.vmRestore).V(3).Info("generating volume restored VM spec")
+
+	newVM := t.vm.DeepCopy()
+	for _, vr := range t.vmRestore.Status.Restores {
+		volumeIndex := -1
+		for i, v := range newVM.Spec.Template.Spec.Volumes {
+			if v.Name == vr.VolumeName {
+				volumeIndex = i
+				break
+			}
+		}
+		if volumeIndex < 0 {
+			return nil, fmt.Errorf("volume %s not found in target %s", vr.VolumeName, newVM.Name)
+		}
+
+		volume := &newVM.Spec.Template.Spec.Volumes[volumeIndex]
+		if volume.DataVolume != nil {
+			if templateIndex := findVMDVTemplateIndex(volume.DataVolume.Name, newVM); templateIndex >= 0 {
+				newVM.Spec.DataVolumeTemplates = append(newVM.Spec.DataVolumeTemplates[:templateIndex], newVM.Spec.DataVolumeTemplates[templateIndex+1:]...)
+			}
+		}
+
+		if vr.DataVolumeName == nil {
+			*volume = kubevirtv1.Volume{
+				Name: vr.VolumeName,
+				VolumeSource: kubevirtv1.VolumeSource{
+					PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: vr.PersistentVolumeClaimName,
+						},
+					},
+				},
+			}
+			continue
+		}
+
+		templateIndex := -1
+		for _, v := range snapshotVM.Spec.Template.Spec.Volumes {
+			if v.Name == vr.VolumeName && v.DataVolume != nil {
+				templateIndex = findDVTemplateIndex(v.DataVolume.Name, snapshotVM)
+				break
+			}
+		}
+		if templateIndex < 0 {
+			return nil, fmt.Errorf("DataVolumeTemplate for volume %s not found in snapshot", vr.VolumeName)
+		}
+
+		dv := snapshotVM.Spec.DataVolumeTemplates[templateIndex].DeepCopy()
+		dv.Name = *vr.DataVolumeName
+		newVM.Spec.DataVolumeTemplates = append(newVM.Spec.DataVolumeTemplates, *dv)
+		*volume = kubevirtv1.Volume{
+			Name: vr.VolumeName,
+			VolumeSource: kubevirtv1.VolumeSource{
+				DataVolume: &kubevirtv1.DataVolumeSource{
+					Name: *vr.DataVolumeName,
+				},
+			},
+		}
+	}
+
+	setLastRestoreAnnotation(t.vmRestore, newVM)
+
+	return newVM, nil
+}
+
 func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Reconcile new VM spec")
 
 	var err error
+	// a volume restore keeps the instancetype and preference of the target
+	if isVolumeRestore(t.vmRestore) {
+		restoredVM, err = t.controller.Client.VirtualMachine(restoredVM.Namespace).Update(context.Background(), restoredVM, metav1.UpdateOptions{})
+		if err != nil {
+			return false, err
+		}
+		t.UpdateTarget(restoredVM)
+		return true, nil
+	}
+
 	if err = t.restoreInstancetypeControllerRevisions(restoredVM); err != nil {
 		return false, err
 	}
 
 	if !t.Exists() {
-		restoredVM, err = patchVM(restoredVM, t.vmRestore.Spec.Patches)
+		restoredVM, err = PatchVM(restoredVM, t.vmRestore.Spec.Patches)
 		if err != nil {
 			return false, fmt.Errorf("error patching VM %s: %v", restoredVM.Name, err)
 		}
@@ -904,6 +1031,15 @@ func findDVTemplateIndex(dvName string, vm *snapshotv1.VirtualMachine) int {
 	return templateIndex
 }
 
+func findVMDVTemplateIndex(dvName string, vm *kubevirtv1.VirtualMachine) int {
+	for i, dvt := range vm.Spec.DataVolumeTemplates {
+		if dvName == dvt.Name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (t *vmRestoreTarget) updatePVCPopulatedForAnnotation(pvc *corev1.PersistentVolumeClaim, dvName string) error {
 	updatePVC := pvc.DeepCopy()
 	if updatePVC.Annotations[populatedForPVCAnnotation] != dvName {
@@ -1256,7 +1392,8 @@ func (ctrl *VMRestoreController) getVM(namespace, name string) (vm *kubevirtv1.V
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
 }
 
-func patchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
+// PatchVM applies the JSON patches of a restore or clone to the VirtualMachine
+func PatchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
 	if len(patches) == 0 {
 		return vm, nil
 	}
@@ -1368,7 +1505,10 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	if err != nil {
 		return err
 	}
-	target.Own(pvc)
+	// PVCs restored next to the target are not used by it and must outlive it
+	if !volumeRestoreToNewPVCs(vmRestore) {
+		target.Own(pvc)
+	}
 
 	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if err != nil {
@@ -1303,6 +1303,164 @@ var _ = Describe("Restore controller", func() {
 					Expect(err).ShouldNot(HaveOccurred())
 					Expect(res).To(BeTrue())
 				})
+
+				It("should only replace the restored volumes for an in place volume restore", func() {
+					r.Spec.Volumes = []string{diskName}
+					r.Spec.VolumeRestoreMode = pointer.P(snapshotv1.VolumeRestoreModeInPlace)
+					vm = createModifiedVM()
+					vm.Status.RestoreInProgress = &vmRestoreName
+					targetVM.UpdateTarget(vm)
+					addRestoreVolumes(true, cdiv1.Succeeded)
+					vmRestoreSource.Add(r)
+					addVM(vm)
+
+					updatedVM := vm.DeepCopy()
+					updatedVM.ResourceVersion = "1"
+					updatedVM.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+					restoredTemplate := updatedVM.Spec.DataVolumeTemplates[0].DeepCopy()
+					restoredTemplate.Name = "restore-uid-disk1"
+					updatedVM.Spec.DataVolumeTemplates = []kubevirtv1.DataVolumeTemplateSpec{*restoredTemplate}
+					updatedVM.Spec.Template.Spec.Volumes[0].DataVolume.Name = "restore-uid-disk1"
+					updateVMCalls := expectVMUpdate(kubevirtClient, updatedVM)
+
+					res, err := targetVM.Reconcile()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).To(BeTrue())
+					Expect(*updateVMCalls).To(Equal(1))
+				})
+
+				It("should not modify the target when restoring volumes into new PVCs", func() {
+					r.Spec.Volumes = []string{diskName}
+
+					res, err := targetVM.Reconcile()
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(res).To(BeFalse())
+					Expect(kubevirtClient.Actions()).To(BeEmpty())
+				})
+			})
+
+			Context("volume restore", func() {
+				addExtraPVCVolume := func(vm *kubevirtv1.VirtualMachine) {
+					pvcs := createPVCsForVM(vm)
+					pvcs = append(pvcs, corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: vm.Namespace,
+							Name:      "extra-pvc",
+						},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							VolumeName:       "volume2",
+							StorageClassName: &storageClass.Name,
+						},
+					})
+					vm.Spec.Template.Spec.Domain.Devices.Disks = append(vm.Spec.Template.Spec.Domain.Devices.Disks, kubevirtv1.Disk{
+						Name: "disk2",
+						DiskDevice: kubevirtv1.DiskDevice{
+							Disk: &kubevirtv1.DiskTarget{
+								Bus: kubevirtv1.DiskBusVirtio,
+							},
+						},
+					})
+					vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, kubevirtv1.Volume{
+						Name: "disk2",
+						VolumeSource: kubevirtv1.VolumeSource{
+							PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{ClaimName: "extra-pvc"}},
+						},
+					})
+					vmSnapshotContentSource.Delete(sc)
+					sc = createVirtualMachineSnapshotContent(s, vm, pvcs)
+					sc.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						CreationTime: timeFunc(),
+						ReadyToUse:   pointer.P(true),
+					}
+					vmSnapshotContentSource.Add(sc)
+				}
+
+				It("should only create VolumeRestores for the selected volumes", func() {
+					vm := createModifiedVM()
+					addExtraPVCVolume(vm)
+					vmSource.Add(vm)
+					syncCaches(stop)
+
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{"disk2"}
+					target, err := controller.getTarget(r)
+					Expect(err).ToNot(HaveOccurred())
+
+					updated, err := controller.reconcileVolumeRestores(r, target, s)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(updated).To(BeTrue())
+					Expect(r.Status.Restores).To(Equal([]snapshotv1.VolumeRestore{
+						{
+							VolumeName:                "disk2",
+							PersistentVolumeClaimName: "restore-uid-disk2",
+							VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-disk2",
+						},
+					}))
+				})
+
+				It("should error if a selected volume is not in the snapshot", func() {
+					vmSource.Add(createModifiedVM())
+					syncCaches(stop)
+
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{"missing"}
+					target, err := controller.getTarget(r)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = controller.reconcileVolumeRestores(r, target, s)
+					Expect(err).To(MatchError("volume backup for volume missing not found"))
+				})
+
+				It("should error if the target is not the source of the snapshot", func() {
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{diskName}
+					r.Spec.Target.Name = newVMName
+
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionFalse, errorVolumeRestoreTarget),
+							newReadyCondition(corev1.ConditionFalse, errorVolumeRestoreTarget),
+						},
+					}
+
+					vmSource.Add(createModifiedVM())
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					testutils.ExpectEvent(recorder, "VirtualMachineRestoreError")
+					Expect(*updateStatusCalls).To(Equal(1))
+				})
+
+				It("should neither wait for nor lock a running target when restoring into new PVCs", func() {
+					r := createRestoreWithOwner()
+					r.Spec.Volumes = []string{diskName}
+					vm := createModifiedVM()
+
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: pointer.P(false),
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+					}
+					addInitialVolumeRestores(rc)
+
+					vmSource.Add(vm)
+					vmiSource.Add(createVMI(vm))
+					updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					Expect(*updateStatusCalls).To(Equal(1))
+					for _, action := range kubevirtClient.Actions() {
+						Expect(action.GetResource().Resource).ToNot(Equal("virtualmachines"))
+					}
+				})
 			})
 
 			Context("target VM is different than source VM", func() {
@@ -0,0 +1,403 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/cron"
+)
+
+const (
+	scheduleRunEvent         = "VirtualMachineSnapshotScheduleRun"
+	scheduleFailedEvent      = "VirtualMachineSnapshotScheduleFailed"
+	scheduleSnapshotTimeFmt  = "20060102-1504"
+	scheduleQuiesceNoAgent   = "Guest agent is not connected, the guest filesystems cannot be frozen"
+	scheduleQuiesceFailed    = "Failed to freeze the guest filesystems, the snapshot was deleted"
+	scheduleSnapshotFailed   = "Snapshot failed"
+	scheduleInvalidSchedule  = "Invalid schedule"
+	scheduleInvalidSelector  = "Invalid selector"
+	scheduleNoUpcomingRun    = "Schedule has no upcoming run"
+	scheduleDisabledReason   = "Schedule disabled"
+	scheduleActiveReason     = "Schedule active"
+	scheduleFailuresReason   = "Last run had failures"
+	scheduleNoFailuresReason = "No failures"
+)
+
+func scheduledSnapshotName(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, runTime time.Time) string {
+	return fmt.Sprintf("%s-%s-%s", schedule.Name, vmName, runTime.UTC().Format(scheduleSnapshotTimeFmt))
+}
+
+func scheduleDisabled(schedule *snapshotv1.VirtualMachineSnapshotSchedule) bool {
+	return schedule.Spec.Disabled != nil && *schedule.Spec.Disabled
+}
+
+func quiesceRequired(schedule *snapshotv1.VirtualMachineSnapshotSchedule) bool {
+	return schedule.Spec.Quiesce != nil && *schedule.Spec.Quiesce == snapshotv1.QuiesceRequired
+}
+
+func updateScheduleCondition(s *snapshotv1.VirtualMachineSnapshotSchedule, c snapshotv1.Condition) {
+	s.Status.Conditions = updateCondition(s.Status.Conditions, c)
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleOut := schedule.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	retry, err := ctrl.reconcileSchedule(scheduleOut)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ctrl.updateScheduleStatus(schedule, scheduleOut); err != nil {
+		return 0, err
+	}
+	return retry, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) reconcileSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	cronSchedule, err := cron.Parse(schedule.Spec.Schedule)
+	if err != nil {
+		ctrl.invalidSchedule(schedule, scheduleInvalidSchedule, err)
+		return 0, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		ctrl.invalidSchedule(schedule, scheduleInvalidSelector, err)
+		return 0, nil
+	}
+	schedule.Status.Error = nil
+
+	var retry time.Duration
+	now := currentTime()
+	if scheduleDisabled(schedule) {
+		schedule.Status.NextRunTime = nil
+		updateScheduleCondition(schedule, newReadyCondition(corev1.ConditionFalse, scheduleDisabledReason))
+	} else {
+		lastRun := schedule.CreationTimestamp.Time
+		if schedule.Status.LastRunTime != nil {
+			lastRun = schedule.Status.LastRunTime.Time
+		}
+
+		next := cronSchedule.Next(lastRun)
+		if !next.IsZero() && !next.After(now.Time) {
+			// runs missed while the controller was down are not caught up, only the latest is taken
+			if err := ctrl.runSchedule(schedule, selector, now); err != nil {
+				return 0, err
+			}
+			next = cronSchedule.Next(now.Time)
+		}
+
+		if next.IsZero() {
+			schedule.Status.NextRunTime = nil
+			schedule.Status.Error = &snapshotv1.Error{
+				Time:    now,
+				Message: pointer.P(scheduleNoUpcomingRun),
+			}
+			updateScheduleCondition(schedule, newReadyCondition(corev1.ConditionFalse, scheduleNoUpcomingRun))
+		} else {
+			schedule.Status.NextRunTime = &metav1.Time{Time: next}
+			updateScheduleCondition(schedule, newReadyCondition(corev1.ConditionTrue, scheduleActiveReason))
+			retry = next.Sub(now.Time)
+		}
+	}
+
+	expiry, err := ctrl.pruneSnapshots(schedule, now)
+	if err != nil {
+		return 0, err
+	}
+	if expiry > 0 && (retry == 0 || expiry < retry) {
+		retry = expiry
+	}
+
+	if len(schedule.Status.Failures) > 0 {
+		updateScheduleCondition(schedule, newFailureCondition(corev1.ConditionTrue, scheduleFailuresReason))
+	} else {
+		updateScheduleCondition(schedule, newFailureCondition(corev1.ConditionFalse, scheduleNoFailuresReason))
+	}
+
+	return retry, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) invalidSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule, reason string, err error) {
+	message := fmt.Sprintf("%s: %v", reason, err)
+	if schedule.Status.Error == nil || schedule.Status.Error.Message == nil || *schedule.Status.Error.Message != message {
+		ctrl.Recorder.Event(schedule, corev1.EventTypeWarning, scheduleFailedEvent, message)
+	}
+	schedule.Status.NextRunTime = nil
+	schedule.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(message),
+	}
+	updateScheduleCondition(schedule, newReadyCondition(corev1.ConditionFalse, reason))
+}
+
+// runSchedule takes a snapshot of every selected VM, the failures of the previous run are replaced
+func (ctrl *VMSnapshotScheduleController) runSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule, selector labels.Selector, now *metav1.Time) error {
+	vms, err := ctrl.getScheduledVMs(schedule.Namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	var failures []snapshotv1.SnapshotScheduleFailure
+	created := 0
+	for _, vm := range vms {
+		if quiesceRequired(schedule) {
+			agentConnected, err := ctrl.guestAgentConnected(vm)
+			if err != nil {
+				return err
+			}
+			if !agentConnected {
+				failures = append(failures, snapshotv1.SnapshotScheduleFailure{
+					VirtualMachineName: vm.Name,
+					Message:            scheduleQuiesceNoAgent,
+				})
+				continue
+			}
+		}
+
+		vmSnapshot := newScheduledSnapshot(schedule, vm.Name, now.Time)
+		_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			failures = append(failures, snapshotv1.SnapshotScheduleFailure{
+				VirtualMachineName: vm.Name,
+				Message:            fmt.Sprintf("Failed to create snapshot %s: %v", vmSnapshot.Name, err),
+			})
+			continue
+		}
+		created++
+	}
+
+	schedule.Status.LastRunTime = now
+	schedule.Status.Failures = failures
+
+	ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, scheduleRunEvent, "Created %d snapshots", created)
+	if len(failures) > 0 {
+		ctrl.Recorder.Eventf(schedule, corev1.EventTypeWarning, scheduleFailedEvent, "Failed to snapshot %d VirtualMachines", len(failures))
+	}
+	return nil
+}
+
+func newScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, runTime time.Time) *snapshotv1.VirtualMachineSnapshot {
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledSnapshotName(schedule, vmName, runTime),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotv1.VirtualMachineSnapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vmName,
+			},
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+}
+
+// pruneSnapshots deletes the snapshots exceeding the retention policy and reports the failed snapshots
+// of the last run. It returns the time until the next snapshot expires, zero if none expires.
+func (ctrl *VMSnapshotScheduleController) pruneSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, now *metav1.Time) (time.Duration, error) {
+	snapshots, err := ctrl.getScheduleSnapshots(schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	var lastRun time.Time
+	if schedule.Status.LastRunTime != nil {
+		lastRun = schedule.Status.LastRunTime.Truncate(time.Second)
+	}
+
+	// failures of the run itself are kept, failures of the snapshots are derived from their current state
+	var failures []snapshotv1.SnapshotScheduleFailure
+	for _, failure := range schedule.Status.Failures {
+		if failure.SnapshotName == "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	var toDelete []*snapshotv1.VirtualMachineSnapshot
+	succeeded := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, vmSnapshot := range snapshots {
+		if vmSnapshot.DeletionTimestamp != nil || vmSnapshot.Status == nil {
+			continue
+		}
+		fromLastRun := !vmSnapshot.CreationTimestamp.Time.Before(lastRun)
+
+		switch {
+		case vmSnapshot.Status.Phase == snapshotv1.Failed:
+			if fromLastRun {
+				failures = append(failures, snapshotFailure(vmSnapshot, scheduleSnapshotFailed))
+			} else {
+				toDelete = append(toDelete, vmSnapshot)
+			}
+		case vmSnapshot.Status.Phase == snapshotv1.Succeeded && quiesceRequired(schedule) && quiesceFailed(vmSnapshot):
+			toDelete = append(toDelete, vmSnapshot)
+			if fromLastRun {
+				failures = append(failures, snapshotFailure(vmSnapshot, scheduleQuiesceFailed))
+			}
+		case vmSnapshot.Status.Phase == snapshotv1.Succeeded:
+			succeeded[vmSnapshot.Spec.Source.Name] = append(succeeded[vmSnapshot.Spec.Source.Name], vmSnapshot)
+		}
+	}
+	schedule.Status.Failures = failures
+
+	var nextExpiry time.Duration
+	retention := schedule.Spec.Retention
+	for _, vmSnapshots := range succeeded {
+		if retention == nil {
+			break
+		}
+		sort.Slice(vmSnapshots, func(i, j int) bool {
+			return vmSnapshots[j].CreationTimestamp.Before(&vmSnapshots[i].CreationTimestamp)
+		})
+		for i, vmSnapshot := range vmSnapshots {
+			if retention.MaxCount != nil && int32(i) >= *retention.MaxCount {
+				toDelete = append(toDelete, vmSnapshot)
+				continue
+			}
+			if retention.MaxAge != nil {
+				expiry := vmSnapshot.CreationTimestamp.Add(retention.MaxAge.Duration).Sub(now.Time)
+				if expiry <= 0 {
+					toDelete = append(toDelete, vmSnapshot)
+					continue
+				}
+				if nextExpiry == 0 || expiry < nextExpiry {
+					nextExpiry = expiry
+				}
+			}
+		}
+	}
+
+	for _, vmSnapshot := range toDelete {
+		log.Log.Object(schedule).Infof("Deleting VirtualMachineSnapshot %s", vmSnapshot.Name)
+		err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return 0, err
+		}
+	}
+
+	return nextExpiry, nil
+}
+
+func quiesceFailed(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	for _, indication := range vmSnapshot.Status.Indications {
+		if indication == snapshotv1.VMSnapshotQuiesceFailedIndication {
+			return true
+		}
+	}
+	return false
+}
+
+func snapshotFailure(vmSnapshot *snapshotv1.VirtualMachineSnapshot, reason string) snapshotv1.SnapshotScheduleFailure {
+	message := reason
+	if vmSnapshot.Status.Error != nil && vmSnapshot.Status.Error.Message != nil {
+		message = fmt.Sprintf("%s: %s", reason, *vmSnapshot.Status.Error.Message)
+	}
+	return snapshotv1.SnapshotScheduleFailure{
+		VirtualMachineName: vmSnapshot.Spec.Source.Name,
+		SnapshotName:       vmSnapshot.Name,
+		Message:            message,
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateScheduleStatus(schedule, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(schedule.Status, scheduleOut.Status) {
+		return nil
+	}
+	return ctrl.vmSnapshotScheduleStatusUpdater.UpdateStatus(scheduleOut)
+}
+
+func (ctrl *VMSnapshotScheduleController) getScheduledVMs(namespace string, selector labels.Selector) ([]*kubevirtv1.VirtualMachine, error) {
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+			vms = append(vms, vm)
+		}
+	}
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+	return vms, nil
+}
+
+// guestAgentConnected returns true if the guest filesystems of the VM can be frozen,
+// a stopped VM has nothing to freeze
+func (ctrl *VMSnapshotScheduleController) guestAgentConnected(vm *kubevirtv1.VirtualMachine) (bool, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vm.Namespace, vm.Name))
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+	vmi := obj.(*kubevirtv1.VirtualMachineInstance)
+	if !vmi.IsRunning() {
+		return true, nil
+	}
+	return controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected), nil
+}
+
+func (ctrl *VMSnapshotScheduleController) getScheduleSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range objs {
+		snapshots = append(snapshots, obj.(*snapshotv1.VirtualMachineSnapshot))
+	}
+	return snapshots, nil
+}
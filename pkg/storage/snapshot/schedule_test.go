@@ -0,0 +1,370 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const scheduleName = "nightly"
+
+	var (
+		// 10:30, the hourly schedule last ran at 10:00 and runs next at 11:00
+		now = metav1.Time{Time: time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)}
+
+		vmSnapshotScheduleInformer cache.SharedIndexInformer
+		vmSnapshotInformer         cache.SharedIndexInformer
+		vmInformer                 cache.SharedIndexInformer
+		vmiInformer                cache.SharedIndexInformer
+
+		controller     *VMSnapshotScheduleController
+		recorder       *record.FakeRecorder
+		kubevirtClient *kubevirtfake.Clientset
+	)
+
+	timeFunc := func() *metav1.Time {
+		return &now
+	}
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				UID:               "schedule-uid",
+				CreationTimestamp: metav1.Time{Time: now.Add(-45 * time.Minute)},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "@hourly",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+			},
+		}
+	}
+
+	createVM := func(name string, selected bool) *kubevirtv1.VirtualMachine {
+		vm := &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+		}
+		if selected {
+			vm.Labels = map[string]string{"backup": "true"}
+		}
+		return vm
+	}
+
+	createScheduledSnapshot := func(vmName string, created time.Time, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := newScheduledSnapshot(createSchedule(), vmName, created)
+		vmSnapshot.CreationTimestamp = metav1.Time{Time: created}
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase: phase,
+		}
+		return vmSnapshot
+	}
+
+	addSchedule := func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+		Expect(vmSnapshotScheduleInformer.GetStore().Add(s)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(s.Namespace).Create(context.Background(), s, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addSnapshot := func(s *snapshotv1.VirtualMachineSnapshot) {
+		Expect(vmSnapshotInformer.GetStore().Add(s)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(s.Namespace).Create(context.Background(), s, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		s, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return s
+	}
+
+	listSnapshots := func() []snapshotv1.VirtualMachineSnapshot {
+		l, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return l.Items
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		vmSnapshotScheduleInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&kubevirtv1.VirtualMachineInstance{})
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+
+		currentTime = timeFunc
+
+		Expect(vmInformer.GetStore().Add(createVM("vm-a", true))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createVM("vm-b", true))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createVM("vm-other", false))).To(Succeed())
+	})
+
+	It("should snapshot the selected VMs when the schedule is due", func() {
+		s := createSchedule()
+		addSchedule(s)
+
+		retry, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(30 * time.Minute))
+
+		snapshots := listSnapshots()
+		Expect(snapshots).To(HaveLen(2))
+		for _, vmSnapshot := range snapshots {
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.VirtualMachineSnapshotScheduleLabel, scheduleName))
+			Expect(vmSnapshot.Spec.Source.Name).To(BeElementOf("vm-a", "vm-b"))
+		}
+		Expect(snapshots).To(ContainElement(HaveField("Name", "nightly-vm-a-20250115-1030")))
+
+		s = getSchedule()
+		Expect(s.Status.LastRunTime).To(Equal(timeFunc()))
+		Expect(s.Status.NextRunTime.Time).To(Equal(time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC)))
+		Expect(s.Status.Failures).To(BeEmpty())
+		Expect(s.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionReady),
+			HaveField("Status", corev1.ConditionTrue),
+		)))
+		testutils.ExpectEvent(recorder, scheduleRunEvent)
+	})
+
+	It("should not snapshot before the next run", func() {
+		s := createSchedule()
+		s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastRunTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		}
+		addSchedule(s)
+
+		retry, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(30 * time.Minute))
+		Expect(listSnapshots()).To(BeEmpty())
+
+		s = getSchedule()
+		Expect(s.Status.NextRunTime.Time).To(Equal(time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC)))
+	})
+
+	It("should report an invalid schedule", func() {
+		s := createSchedule()
+		s.Spec.Schedule = "61 * * * *"
+		addSchedule(s)
+
+		_, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listSnapshots()).To(BeEmpty())
+
+		s = getSchedule()
+		Expect(s.Status.Error).ToNot(BeNil())
+		Expect(*s.Status.Error.Message).To(ContainSubstring(scheduleInvalidSchedule))
+		Expect(s.Status.NextRunTime).To(BeNil())
+		Expect(s.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionReady),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", scheduleInvalidSchedule),
+		)))
+		testutils.ExpectEvent(recorder, scheduleFailedEvent)
+	})
+
+	It("should not snapshot when disabled", func() {
+		s := createSchedule()
+		s.Spec.Disabled = pointer.P(true)
+		addSchedule(s)
+
+		_, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listSnapshots()).To(BeEmpty())
+
+		s = getSchedule()
+		Expect(s.Status.NextRunTime).To(BeNil())
+		Expect(s.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionReady),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", scheduleDisabledReason),
+		)))
+	})
+
+	It("should report a failure when quiesce is required and the guest agent is not connected", func() {
+		s := createSchedule()
+		s.Spec.Quiesce = pointer.P(snapshotv1.QuiesceRequired)
+		addSchedule(s)
+
+		vmi := &kubevirtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vm-a",
+				Namespace: testNamespace,
+			},
+			Status: kubevirtv1.VirtualMachineInstanceStatus{
+				Phase: kubevirtv1.Running,
+			},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+
+		_, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+
+		snapshots := listSnapshots()
+		Expect(snapshots).To(HaveLen(1))
+		Expect(snapshots[0].Spec.Source.Name).To(Equal("vm-b"))
+
+		s = getSchedule()
+		Expect(s.Status.Failures).To(ConsistOf(snapshotv1.SnapshotScheduleFailure{
+			VirtualMachineName: "vm-a",
+			Message:            scheduleQuiesceNoAgent,
+		}))
+		Expect(s.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionFailure),
+			HaveField("Status", corev1.ConditionTrue),
+		)))
+		testutils.ExpectEvents(recorder, scheduleRunEvent, scheduleFailedEvent)
+	})
+
+	It("should delete the snapshots exceeding maxCount", func() {
+		s := createSchedule()
+		s.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{MaxCount: pointer.P(int32(2))}
+		s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastRunTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		}
+		addSchedule(s)
+
+		for i := 1; i <= 3; i++ {
+			addSnapshot(createScheduledSnapshot("vm-a", now.Add(-time.Duration(i)*time.Hour+30*time.Minute), snapshotv1.Succeeded))
+		}
+
+		_, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+
+		snapshots := listSnapshots()
+		Expect(snapshots).To(HaveLen(2))
+		Expect(snapshots).ToNot(ContainElement(HaveField("Name", "nightly-vm-a-20250115-0800")))
+	})
+
+	It("should delete the snapshots older than maxAge and requeue on the next expiry", func() {
+		s := createSchedule()
+		s.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{MaxAge: &metav1.Duration{Duration: 2 * time.Hour}}
+		s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastRunTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		}
+		addSchedule(s)
+
+		addSnapshot(createScheduledSnapshot("vm-a", now.Add(-30*time.Minute), snapshotv1.Succeeded))
+		addSnapshot(createScheduledSnapshot("vm-a", now.Add(-110*time.Minute), snapshotv1.Succeeded))
+		addSnapshot(createScheduledSnapshot("vm-a", now.Add(-150*time.Minute), snapshotv1.Succeeded))
+
+		retry, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(10 * time.Minute))
+
+		snapshots := listSnapshots()
+		Expect(snapshots).To(HaveLen(2))
+		Expect(snapshots).ToNot(ContainElement(HaveField("Name", "nightly-vm-a-20250115-0800")))
+	})
+
+	It("should report the failed snapshots of the last run and delete older ones", func() {
+		s := createSchedule()
+		s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastRunTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		}
+		addSchedule(s)
+
+		failed := createScheduledSnapshot("vm-a", now.Add(-30*time.Minute), snapshotv1.Failed)
+		failed.Status.Error = &snapshotv1.Error{Message: pointer.P("deadline exceeded")}
+		addSnapshot(failed)
+		addSnapshot(createScheduledSnapshot("vm-b", now.Add(-90*time.Minute), snapshotv1.Failed))
+
+		_, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+
+		snapshots := listSnapshots()
+		Expect(snapshots).To(HaveLen(1))
+		Expect(snapshots[0].Name).To(Equal(failed.Name))
+
+		s = getSchedule()
+		Expect(s.Status.Failures).To(ConsistOf(snapshotv1.SnapshotScheduleFailure{
+			VirtualMachineName: "vm-a",
+			SnapshotName:       failed.Name,
+			Message:            scheduleSnapshotFailed + ": deadline exceeded",
+		}))
+	})
+
+	It("should delete snapshots which failed to quiesce when quiesce is required", func() {
+		s := createSchedule()
+		s.Spec.Quiesce = pointer.P(snapshotv1.QuiesceRequired)
+		s.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+			LastRunTime: &metav1.Time{Time: now.Add(-30 * time.Minute)},
+		}
+		addSchedule(s)
+
+		vmSnapshot := createScheduledSnapshot("vm-a", now.Add(-30*time.Minute), snapshotv1.Succeeded)
+		vmSnapshot.Status.Indications = []snapshotv1.Indication{snapshotv1.VMSnapshotQuiesceFailedIndication}
+		addSnapshot(vmSnapshot)
+
+		_, err := controller.updateVMSnapshotSchedule(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listSnapshots()).To(BeEmpty())
+
+		s = getSchedule()
+		Expect(s.Status.Failures).To(ConsistOf(snapshotv1.SnapshotScheduleFailure{
+			VirtualMachineName: "vm-a",
+			SnapshotName:       vmSnapshot.Name,
+			Message:            scheduleQuiesceFailed,
+		}))
+	})
+})
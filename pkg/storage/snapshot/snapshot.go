@@ -364,6 +364,15 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		contentCpy.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{}
 	}
 
+	if vmSnapshot != nil && waitingForGroupFreeze(vmSnapshot) {
+		// the volumes are snapshotted once the group snapshot froze all its members
+		log.Log.V(3).Infof("Content %s/%s waiting for the group snapshot to freeze its members", content.Namespace, content.Name)
+		if contentCpy.Status.ReadyToUse == nil {
+			contentCpy.Status.ReadyToUse = pointer.P(false)
+		}
+		return 0, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+	}
+
 	contentCreated := vmSnapshotContentCreated(content)
 
 	for _, volumeBackup := range content.Spec.VolumeBackups {
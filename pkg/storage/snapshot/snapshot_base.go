@@ -437,6 +437,11 @@ func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 		}
 		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
 		ctrl.vmSnapshotQueue.Add(objName)
+
+		// the content of a group snapshot member waits for the group to freeze the members
+		if groupSnapshotMember(vmSnapshot) && vmSnapshot.Status != nil && vmSnapshot.Status.VirtualMachineSnapshotContentName != nil {
+			ctrl.vmSnapshotContentQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName))
+		}
 	}
 }
 
@@ -423,7 +423,8 @@ func (s *vmSnapshotSource) Freeze() error {
 	if !s.Locked() {
 		return fmt.Errorf("attempting to freeze unlocked VM")
 	}
-	if s.Frozen() {
+	if s.Frozen() || groupSnapshotMember(s.snapshot) {
+		// the members of a group snapshot are frozen together by the group snapshot
 		return nil
 	}
 
@@ -450,7 +451,7 @@ func (s *vmSnapshotSource) Freeze() error {
 }
 
 func (s *vmSnapshotSource) Unfreeze() error {
-	if !s.Locked() || !s.GuestAgent() {
+	if !s.Locked() || !s.GuestAgent() || groupSnapshotMember(s.snapshot) {
 		return nil
 	}
 
@@ -101,6 +101,34 @@ func (u *updater) updateUnstructured(obj runtime.Object) (oldStatus interface{},
 			return nil, nil, err
 		}
 		return oldObj.Status, newObj.Status, nil
+	case *snapshotv1.VirtualMachineBackup:
+		oldObj := obj.(*snapshotv1.VirtualMachineBackup)
+		newObj, err := u.cli.VirtualMachineBackup(a.GetNamespace()).Update(context.Background(), oldObj, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return oldObj.Status, newObj.Status, nil
+	case *snapshotv1.VirtualMachineSnapshotSchedule:
+		oldObj := obj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		newObj, err := u.cli.VirtualMachineSnapshotSchedule(a.GetNamespace()).Update(context.Background(), oldObj, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return oldObj.Status, newObj.Status, nil
+	case *snapshotv1.VirtualMachineGroupSnapshot:
+		oldObj := obj.(*snapshotv1.VirtualMachineGroupSnapshot)
+		newObj, err := u.cli.VirtualMachineGroupSnapshot(a.GetNamespace()).Update(context.Background(), oldObj, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return oldObj.Status, newObj.Status, nil
+	case *snapshotv1.VirtualMachineGroupRestore:
+		oldObj := obj.(*snapshotv1.VirtualMachineGroupRestore)
+		newObj, err := u.cli.VirtualMachineGroupRestore(a.GetNamespace()).Update(context.Background(), oldObj, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return oldObj.Status, newObj.Status, nil
 	default:
 		panic(unknownObj)
 	}
@@ -120,6 +148,18 @@ func (u *updater) updateStatusUnstructured(obj runtime.Object) (err error) {
 	case *snapshotv1.VirtualMachineRestore:
 		oldObj := obj.(*snapshotv1.VirtualMachineRestore)
 		_, err = u.cli.VirtualMachineRestore(oldObj.Namespace).UpdateStatus(context.Background(), oldObj, metav1.UpdateOptions{})
+	case *snapshotv1.VirtualMachineBackup:
+		oldObj := obj.(*snapshotv1.VirtualMachineBackup)
+		_, err = u.cli.VirtualMachineBackup(oldObj.Namespace).UpdateStatus(context.Background(), oldObj, metav1.UpdateOptions{})
+	case *snapshotv1.VirtualMachineSnapshotSchedule:
+		oldObj := obj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		_, err = u.cli.VirtualMachineSnapshotSchedule(oldObj.Namespace).UpdateStatus(context.Background(), oldObj, metav1.UpdateOptions{})
+	case *snapshotv1.VirtualMachineGroupSnapshot:
+		oldObj := obj.(*snapshotv1.VirtualMachineGroupSnapshot)
+		_, err = u.cli.VirtualMachineGroupSnapshot(oldObj.Namespace).UpdateStatus(context.Background(), oldObj, metav1.UpdateOptions{})
+	case *snapshotv1.VirtualMachineGroupRestore:
+		oldObj := obj.(*snapshotv1.VirtualMachineGroupRestore)
+		_, err = u.cli.VirtualMachineGroupRestore(oldObj.Namespace).UpdateStatus(context.Background(), oldObj, metav1.UpdateOptions{})
 	default:
 		panic(unknownObj)
 	}
@@ -210,3 +250,75 @@ func NewVMRestoreStatusUpdater(cli kubecli.KubevirtClient) *VMRestoreStatusUpdat
 		},
 	}
 }
+
+type VMBackupStatusUpdater struct {
+	updater
+}
+
+func (v *VMBackupStatusUpdater) UpdateStatus(vmBackup *snapshotv1.VirtualMachineBackup) error {
+	return v.update(vmBackup)
+}
+
+func NewVMBackupStatusUpdater(cli kubecli.KubevirtClient) *VMBackupStatusUpdater {
+	return &VMBackupStatusUpdater{
+		updater: updater{
+			lock:        sync.Mutex{},
+			subresource: true,
+			cli:         cli,
+		},
+	}
+}
+
+type VMSnapshotScheduleStatusUpdater struct {
+	updater
+}
+
+func (v *VMSnapshotScheduleStatusUpdater) UpdateStatus(vmSnapshotSchedule *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	return v.update(vmSnapshotSchedule)
+}
+
+func NewVMSnapshotScheduleStatusUpdater(cli kubecli.KubevirtClient) *VMSnapshotScheduleStatusUpdater {
+	return &VMSnapshotScheduleStatusUpdater{
+		updater: updater{
+			lock:        sync.Mutex{},
+			subresource: true,
+			cli:         cli,
+		},
+	}
+}
+
+type VMGroupSnapshotStatusUpdater struct {
+	updater
+}
+
+func (v *VMGroupSnapshotStatusUpdater) UpdateStatus(vmGroupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) error {
+	return v.update(vmGroupSnapshot)
+}
+
+func NewVMGroupSnapshotStatusUpdater(cli kubecli.KubevirtClient) *VMGroupSnapshotStatusUpdater {
+	return &VMGroupSnapshotStatusUpdater{
+		updater: updater{
+			lock:        sync.Mutex{},
+			subresource: true,
+			cli:         cli,
+		},
+	}
+}
+
+type VMGroupRestoreStatusUpdater struct {
+	updater
+}
+
+func (v *VMGroupRestoreStatusUpdater) UpdateStatus(vmGroupRestore *snapshotv1.VirtualMachineGroupRestore) error {
+	return v.update(vmGroupRestore)
+}
+
+func NewVMGroupRestoreStatusUpdater(cli kubecli.KubevirtClient) *VMGroupRestoreStatusUpdater {
+	return &VMGroupRestoreStatusUpdater{
+		updater: updater{
+			lock:        sync.Mutex{},
+			subresource: true,
+			cli:         cli,
+		},
+	}
+}
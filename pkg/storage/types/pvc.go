@@ -115,6 +115,8 @@ func PVCNameFromVirtVolume(volume *virtv1.Volume) string {
 		return volume.PersistentVolumeClaim.ClaimName
 	} else if volume.MemoryDump != nil {
 		return volume.MemoryDump.ClaimName
+	} else if volume.BackupTarget != nil {
+		return volume.BackupTarget.ClaimName
 	}
 
 	return ""
@@ -274,6 +276,9 @@ func IsHotplugVolume(vol *virtv1.Volume) bool {
 	if volSrc.MemoryDump != nil && volSrc.MemoryDump.PersistentVolumeClaimVolumeSource.Hotpluggable {
 		return true
 	}
+	if volSrc.BackupTarget != nil && volSrc.BackupTarget.PersistentVolumeClaimVolumeSource.Hotpluggable {
+		return true
+	}
 
 	return false
 }
@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cron
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+	// Wednesday
+	from := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	DescribeTable("should compute the next activation", func(expr string, expected time.Time) {
+		s, err := Parse(expr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(from)).To(Equal(expected))
+	},
+		Entry("every minute", "* * * * *", time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC)),
+		Entry("minute step", "*/15 * * * *", time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC)),
+		Entry("hourly macro", "@hourly", time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC)),
+		Entry("daily at a given time", "0 2 * * *", time.Date(2025, time.January, 16, 2, 0, 0, 0, time.UTC)),
+		Entry("hour list", "0 6,12,18 * * *", time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)),
+		Entry("hour range with step", "0 0-23/6 * * *", time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC)),
+		Entry("start with step", "0 8/5 * * *", time.Date(2025, time.January, 15, 13, 0, 0, 0, time.UTC)),
+		Entry("day of week by name", "0 0 * * sat", time.Date(2025, time.January, 18, 0, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 0 * * 7", time.Date(2025, time.January, 19, 0, 0, 0, 0, time.UTC)),
+		Entry("monthly macro", "@monthly", time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("month by name", "0 0 1 jun *", time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("leap day", "0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 20 * mon", time.Date(2025, time.January, 20, 0, 0, 0, 0, time.UTC)),
+		Entry("yearly wraps the year", "@yearly", time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	It("should not return the given time itself", func() {
+		s, err := Parse("30 10 * * *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(from)).To(Equal(from.AddDate(0, 0, 1)))
+	})
+
+	It("should return the zero time when the schedule never matches", func() {
+		s, err := Parse("0 0 30 2 *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Next(from)).To(BeZero())
+	})
+
+	DescribeTable("should reject invalid expressions", func(expr string) {
+		_, err := Parse(expr)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("minute out of range", "60 * * * *"),
+		Entry("day of month out of range", "0 0 0 * *"),
+		Entry("unknown name", "0 0 * foo *"),
+		Entry("inverted range", "0 5-1 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("unknown macro", "@sometimes"),
+	)
+})
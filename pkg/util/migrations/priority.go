@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// GetMigrationPriority returns the priority of a migration.
+// Migrations without an explicit priority get one according to the component which created them.
+func GetMigrationPriority(migration *v1.VirtualMachineInstanceMigration) v1.MigrationPriority {
+	if migration.Spec.Priority != nil {
+		return *migration.Spec.Priority
+	}
+	if metav1.HasAnnotation(migration.ObjectMeta, v1.EvacuationMigrationAnnotation) {
+		return v1.MigrationPrioritySystemCritical
+	}
+	if metav1.HasAnnotation(migration.ObjectMeta, v1.WorkloadUpdateMigrationAnnotation) {
+		return v1.MigrationPrioritySystemMaintenance
+	}
+	return v1.MigrationPriorityUserTriggered
+}
+
+// PriorityRank orders migration priorities, a higher rank is more urgent
+func PriorityRank(priority v1.MigrationPriority) int {
+	switch priority {
+	case v1.MigrationPrioritySystemCritical:
+		return 2
+	case v1.MigrationPrioritySystemMaintenance:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// StartsBefore returns true if the pending migration a has to be started before the pending migration b.
+// Migrations are ordered by priority first and by creation time second.
+func StartsBefore(a, b *v1.VirtualMachineInstanceMigration) bool {
+	rankA, rankB := PriorityRank(GetMigrationPriority(a)), PriorityRank(GetMigrationPriority(b))
+	if rankA != rankB {
+		return rankA > rankB
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+}
+
+// ValidatePriority validates the priority of a migration
+func ValidatePriority(field *k8sfield.Path, priority *v1.MigrationPriority) []metav1.StatusCause {
+	if priority == nil {
+		return nil
+	}
+	switch *priority {
+	case v1.MigrationPrioritySystemCritical, v1.MigrationPriorityUserTriggered, v1.MigrationPrioritySystemMaintenance:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("priority %q is not supported, must be one of %s, %s or %s", *priority,
+			v1.MigrationPrioritySystemCritical, v1.MigrationPriorityUserTriggered, v1.MigrationPrioritySystemMaintenance),
+		Field: field.String(),
+	}}
+}
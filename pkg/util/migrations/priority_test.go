@@ -0,0 +1,88 @@
+package migrations_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+var _ = Describe("Migration priority", func() {
+	newMigration := func(name string, created time.Time, priority *v1.MigrationPriority, annotations ...string) *v1.VirtualMachineInstanceMigration {
+		migration := &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         metav1.NamespaceDefault,
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations:       map[string]string{},
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{Priority: priority},
+		}
+		for _, annotation := range annotations {
+			migration.Annotations[annotation] = ""
+		}
+		return migration
+	}
+
+	now := time.Now()
+
+	DescribeTable("should be", func(migration *v1.VirtualMachineInstanceMigration, expectedPriority v1.MigrationPriority) {
+		Expect(migrations.GetMigrationPriority(migration)).To(Equal(expectedPriority))
+	},
+		Entry("user-triggered by default", newMigration("m", now, nil), v1.MigrationPriorityUserTriggered),
+		Entry("system-critical for an evacuation",
+			newMigration("m", now, nil, v1.EvacuationMigrationAnnotation), v1.MigrationPrioritySystemCritical),
+		Entry("system-maintenance for a workload update",
+			newMigration("m", now, nil, v1.WorkloadUpdateMigrationAnnotation), v1.MigrationPrioritySystemMaintenance),
+		Entry("the explicit priority over the annotations",
+			newMigration("m", now, pointer.P(v1.MigrationPrioritySystemMaintenance), v1.EvacuationMigrationAnnotation),
+			v1.MigrationPrioritySystemMaintenance),
+	)
+
+	It("should rank the priorities", func() {
+		Expect(migrations.PriorityRank(v1.MigrationPrioritySystemCritical)).To(
+			BeNumerically(">", migrations.PriorityRank(v1.MigrationPriorityUserTriggered)))
+		Expect(migrations.PriorityRank(v1.MigrationPriorityUserTriggered)).To(
+			BeNumerically(">", migrations.PriorityRank(v1.MigrationPrioritySystemMaintenance)))
+	})
+
+	DescribeTable("should start first", func(a, b *v1.VirtualMachineInstanceMigration) {
+		Expect(migrations.StartsBefore(a, b)).To(BeTrue())
+		Expect(migrations.StartsBefore(b, a)).To(BeFalse())
+	},
+		Entry("the migration with the higher priority",
+			newMigration("b", now, pointer.P(v1.MigrationPrioritySystemCritical)),
+			newMigration("a", now.Add(-time.Minute), nil)),
+		Entry("the older migration on the same priority",
+			newMigration("b", now.Add(-time.Minute), nil),
+			newMigration("a", now, nil)),
+		Entry("the migration with the lower name on the same priority and creation time",
+			newMigration("a", now, nil),
+			newMigration("b", now, nil)),
+	)
+
+	It("should accept the supported priorities", func() {
+		field := k8sfield.NewPath("spec", "priority")
+		Expect(migrations.ValidatePriority(field, nil)).To(BeEmpty())
+		for _, priority := range []v1.MigrationPriority{
+			v1.MigrationPrioritySystemCritical, v1.MigrationPriorityUserTriggered, v1.MigrationPrioritySystemMaintenance,
+		} {
+			Expect(migrations.ValidatePriority(field, &priority)).To(BeEmpty())
+		}
+	})
+
+	It("should reject an unsupported priority", func() {
+		causes := migrations.ValidatePriority(k8sfield.NewPath("spec", "priority"), pointer.P(v1.MigrationPriority("urgent")))
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+		Expect(causes[0].Field).To(Equal("spec.priority"))
+	})
+})
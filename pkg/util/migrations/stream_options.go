@@ -0,0 +1,149 @@
+package migrations
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	maxParallelStreams   = 255
+	maxZlibCompressLevel = 9
+	maxZstdCompressLevel = 20
+)
+
+// ValidateStreamOptions validates the compression, parallel streams and zero-copy options of a migration configuration.
+// The options are shared by the cluster wide migration configuration and the migration policies.
+func ValidateStreamOptions(field *k8sfield.Path, compression *v1.MigrationCompression, parallelStreams *uint32, zeroCopy *bool) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if parallelStreams != nil && (*parallelStreams == 0 || *parallelStreams > maxParallelStreams) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("must be between 1 and %d", maxParallelStreams),
+			Field:   field.Child("parallelStreams").String(),
+		})
+	}
+
+	if compression != nil {
+		causes = append(causes, validateCompression(field.Child("compression"), compression, parallelStreams != nil)...)
+	}
+
+	if zeroCopy != nil && *zeroCopy {
+		if parallelStreams == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "zero-copy requires parallelStreams to be set",
+				Field:   field.Child("zeroCopy").String(),
+			})
+		}
+		if compression != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "zero-copy cannot be used along with compression",
+				Field:   field.Child("zeroCopy").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// ValidateZeroPageDetection validates the zero page detection method. As the multifd method falls back to the legacy
+// one when the migration does not use parallel connections, it does not depend on the other stream options.
+func ValidateZeroPageDetection(field *k8sfield.Path, zeroPageDetection *v1.MigrationZeroPageDetection) []metav1.StatusCause {
+	if zeroPageDetection == nil {
+		return nil
+	}
+
+	switch *zeroPageDetection {
+	case v1.MigrationZeroPageDetectionNone, v1.MigrationZeroPageDetectionLegacy, v1.MigrationZeroPageDetectionMultifd:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type: metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("zero page detection %q is not supported, supported methods: %s, %s, %s", *zeroPageDetection,
+			v1.MigrationZeroPageDetectionNone, v1.MigrationZeroPageDetectionLegacy, v1.MigrationZeroPageDetectionMultifd),
+		Field: field.Child("zeroPageDetection").String(),
+	}}
+}
+
+// DropInvalidStreamOptions validates the stream options of an effective migration configuration, which merges the
+// options of a migration policy into the cluster wide ones. As each of them is validated on its own, their merge
+// may still be invalid, e.g. zero-copy set by a policy along with a cluster wide compression.
+// In such case all the stream options are dropped, and the causes are returned.
+func DropInvalidStreamOptions(migrationConfiguration *v1.MigrationConfiguration) []metav1.StatusCause {
+	causes := ValidateStreamOptions(k8sfield.NewPath("migrationConfiguration"),
+		migrationConfiguration.Compression, migrationConfiguration.ParallelStreams, migrationConfiguration.ZeroCopy)
+	if len(causes) > 0 {
+		migrationConfiguration.Compression = nil
+		migrationConfiguration.ParallelStreams = nil
+		migrationConfiguration.ZeroCopy = nil
+	}
+	return causes
+}
+
+func validateCompression(field *k8sfield.Path, compression *v1.MigrationCompression, parallel bool) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	maxLevel := 0
+	switch compression.Method {
+	case v1.MigrationCompressionXBZRLE:
+	case v1.MigrationCompressionZlib:
+		maxLevel = maxZlibCompressLevel
+	case v1.MigrationCompressionZstd:
+		maxLevel = maxZstdCompressLevel
+	default:
+		return append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("compression method %q is not supported, supported methods: %s, %s, %s", compression.Method,
+				v1.MigrationCompressionXBZRLE, v1.MigrationCompressionZlib, v1.MigrationCompressionZstd),
+			Field: field.Child("method").String(),
+		})
+	}
+
+	if compression.Method != v1.MigrationCompressionXBZRLE && !parallel {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("compression method %q requires parallelStreams to be set", compression.Method),
+			Field:   field.Child("method").String(),
+		})
+	}
+
+	if compression.Level != nil {
+		if compression.Method == v1.MigrationCompressionXBZRLE {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("compression level is not supported by the %q method", compression.Method),
+				Field:   field.Child("level").String(),
+			})
+		} else if *compression.Level < 0 || int(*compression.Level) > maxLevel {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("compression level of the %q method must be between 0 and %d", compression.Method, maxLevel),
+				Field:   field.Child("level").String(),
+			})
+		}
+	}
+
+	if compression.XBZRLECacheSize != nil {
+		if compression.Method != v1.MigrationCompressionXBZRLE {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("cache size is not supported by the %q method", compression.Method),
+				Field:   field.Child("xbzrleCacheSize").String(),
+			})
+		} else if compression.XBZRLECacheSize.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be positive",
+				Field:   field.Child("xbzrleCacheSize").String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -0,0 +1,108 @@
+package migrations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+var _ = Describe("Migration stream options", func() {
+	field := k8sfield.NewPath("spec")
+
+	DescribeTable("should accept", func(compression *v1.MigrationCompression, parallelStreams *uint32, zeroCopy *bool) {
+		Expect(migrations.ValidateStreamOptions(field, compression, parallelStreams, zeroCopy)).To(BeEmpty())
+	},
+		Entry("no options", nil, nil, nil),
+		Entry("parallel streams", nil, pointer.P(uint32(4)), nil),
+		Entry("XBZRLE compression without parallel streams",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE}, nil, nil),
+		Entry("XBZRLE compression with a cache size",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, XBZRLECacheSize: pointer.P(resource.MustParse("64Mi"))}, nil, nil),
+		Entry("zlib compression with parallel streams",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(9))}, pointer.P(uint32(2)), nil),
+		Entry("zstd compression with parallel streams",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(20))}, pointer.P(uint32(2)), nil),
+		Entry("zero-copy with parallel streams", nil, pointer.P(uint32(255)), pointer.P(true)),
+		Entry("disabled zero-copy without parallel streams", nil, nil, pointer.P(false)),
+	)
+
+	DescribeTable("should reject", func(compression *v1.MigrationCompression, parallelStreams *uint32, zeroCopy *bool, expectedField string) {
+		causes := migrations.ValidateStreamOptions(field, compression, parallelStreams, zeroCopy)
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("zero parallel streams", nil, pointer.P(uint32(0)), nil, "spec.parallelStreams"),
+		Entry("too many parallel streams", nil, pointer.P(uint32(256)), nil, "spec.parallelStreams"),
+		Entry("an unsupported compression method",
+			&v1.MigrationCompression{Method: "lz4"}, pointer.P(uint32(2)), nil, "spec.compression.method"),
+		Entry("zstd compression without parallel streams",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionZstd}, nil, nil, "spec.compression.method"),
+		Entry("a zlib compression level out of range",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(10))}, pointer.P(uint32(2)), nil, "spec.compression.level"),
+		Entry("a negative compression level",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(-1))}, pointer.P(uint32(2)), nil, "spec.compression.level"),
+		Entry("a compression level with XBZRLE",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, Level: pointer.P(int32(1))}, nil, nil, "spec.compression.level"),
+		Entry("a cache size with zstd",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionZstd, XBZRLECacheSize: pointer.P(resource.MustParse("64Mi"))}, pointer.P(uint32(2)), nil, "spec.compression.xbzrleCacheSize"),
+		Entry("a zero cache size",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, XBZRLECacheSize: pointer.P(resource.MustParse("0"))}, nil, nil, "spec.compression.xbzrleCacheSize"),
+		Entry("zero-copy without parallel streams", nil, nil, pointer.P(true), "spec.zeroCopy"),
+		Entry("zero-copy along with compression",
+			&v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE}, pointer.P(uint32(2)), pointer.P(true), "spec.zeroCopy"),
+	)
+
+	DescribeTable("should accept the zero page detection", func(zeroPageDetection *v1.MigrationZeroPageDetection) {
+		Expect(migrations.ValidateZeroPageDetection(field, zeroPageDetection)).To(BeEmpty())
+	},
+		Entry("when it is not set", nil),
+		Entry("none", pointer.P(v1.MigrationZeroPageDetectionNone)),
+		Entry("legacy", pointer.P(v1.MigrationZeroPageDetectionLegacy)),
+		Entry("multifd", pointer.P(v1.MigrationZeroPageDetectionMultifd)),
+	)
+
+	It("should reject an unsupported zero page detection", func() {
+		causes := migrations.ValidateZeroPageDetection(field, pointer.P(v1.MigrationZeroPageDetection("sparse")))
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+		Expect(causes[0].Field).To(Equal("spec.zeroPageDetection"))
+	})
+
+	Context("on a merged migration configuration", func() {
+		It("should keep valid stream options", func() {
+			conf := &v1.MigrationConfiguration{
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZstd},
+				ParallelStreams: pointer.P(uint32(4)),
+			}
+			Expect(migrations.DropInvalidStreamOptions(conf)).To(BeEmpty())
+			Expect(conf.Compression).To(Equal(&v1.MigrationCompression{Method: v1.MigrationCompressionZstd}))
+			Expect(conf.ParallelStreams).To(Equal(pointer.P(uint32(4))))
+		})
+
+		It("should drop all the stream options when they are invalid together", func() {
+			conf := &v1.MigrationConfiguration{
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE},
+				ParallelStreams: pointer.P(uint32(4)),
+				ZeroCopy:        pointer.P(true),
+				AllowPostCopy:   pointer.P(true),
+			}
+			Expect(migrations.DropInvalidStreamOptions(conf)).To(ConsistOf(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "zero-copy cannot be used along with compression",
+				Field:   "migrationConfiguration.zeroCopy",
+			}))
+			Expect(conf.Compression).To(BeNil())
+			Expect(conf.ParallelStreams).To(BeNil())
+			Expect(conf.ZeroCopy).To(BeNil())
+			Expect(conf.AllowPostCopy).To(Equal(pointer.P(true)))
+		})
+	})
+})
@@ -125,6 +125,10 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+	vmgsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinegroupsnapshots")
+	vmgrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinegrouprestores")
+	vmbGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinebackups")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +150,26 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmgsGVR, &snapshotv1.VirtualMachineGroupSnapshot{}, "VirtualMachineGroupSnapshot", &snapshotv1.VirtualMachineGroupSnapshotList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmgrGVR, &snapshotv1.VirtualMachineGroupRestore{}, "VirtualMachineGroupRestore", &snapshotv1.VirtualMachineGroupRestoreList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmbGVR, &snapshotv1.VirtualMachineBackup{}, "VirtualMachineBackup", &snapshotv1.VirtualMachineBackupList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -663,11 +687,13 @@ func SubResourcePath(subResource string) string {
 }
 
 const (
-	PortParamName     = "port"
-	TLSParamName      = "tls"
-	PortPath          = "/{port}"
-	ProtocolParamName = "protocol"
-	ProtocolPath      = "/{protocol}"
+	PortParamName      = "port"
+	TLSParamName       = "tls"
+	PortPath           = "/{port}"
+	ProtocolParamName  = "protocol"
+	ProtocolPath       = "/{protocol}"
+	InterfaceParamName = "interface"
+	FilterParamName    = "filter"
 )
 
 func PortForwardPortParameter(ws *restful.WebService) *restful.Parameter {
@@ -684,6 +710,14 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(PortParamName, "The port which the VSOCK application listens to.").DataType("integer").Required(true)
 }
 
+func PcapInterfaceParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(InterfaceParamName, "The name of the VirtualMachineInstance interface to capture on.").Required(true)
+}
+
+func PcapFilterParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(FilterParamName, "An optional BPF filter in tcpdump -ddd format.").Required(false)
+}
+
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	vmiBackupMigrating = "VMI is currently migrating"
+)
+
+func (app *SubresourceAPIApp) BackupVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.IncrementalBackupEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.IncrementalBackupGate)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: backup parameters are required"), response)
+		return
+	}
+
+	opts := &v1.VirtualMachineInstanceBackupOptions{}
+	if err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err != nil && err != io.EOF {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if err := validateBackupOptions(opts); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	// the body was consumed while validating, hand the decoded options over to virt-handler
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiBackupMigrating))
+		}
+		if opts.TargetVolume != "" && !hasBackupTargetVolume(vmi, opts.TargetVolume) {
+			return errors.NewBadRequest(fmt.Sprintf("VMI has no backup target volume %s", opts.TargetVolume))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.BackupURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
+func validateBackupOptions(opts *v1.VirtualMachineInstanceBackupOptions) error {
+	if opts.BackupName == "" {
+		return fmt.Errorf("backupName is required")
+	}
+	switch opts.Mode {
+	case "":
+		opts.Mode = v1.BackupModePush
+	case v1.BackupModePush, v1.BackupModePull:
+	default:
+		return fmt.Errorf("unsupported backup mode %q", opts.Mode)
+	}
+	if opts.IncrementalFrom != nil && *opts.IncrementalFrom == opts.BackupName {
+		return fmt.Errorf("a backup cannot be incremental from itself")
+	}
+	if opts.Mode == v1.BackupModePush && !opts.Stop && opts.TargetVolume == "" {
+		return fmt.Errorf("targetVolume is required for push mode backups")
+	}
+	return nil
+}
+
+func hasBackupTargetVolume(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName {
+			return volume.BackupTarget != nil
+		}
+	}
+	return false
+}
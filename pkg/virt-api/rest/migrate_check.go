@@ -0,0 +1,367 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+)
+
+// MigrateCheckRequestHandler evaluates whether a VMI could be live migrated and to which nodes, without creating a migration.
+func (app *SubresourceAPIApp) MigrateCheckRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	nodes, err := app.virtCli.CoreV1().Nodes().List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to list nodes: %v", err)), response)
+		return
+	}
+
+	var persistentStatePVC *k8sv1.PersistentVolumeClaim
+	if pvcName := backendstorage.CurrentPVCName(vmi); pvcName != "" {
+		pvc, statusErr := app.fetchPersistentVolumeClaim(pvcName, namespace)
+		if statusErr != nil && !errors.IsNotFound(statusErr) {
+			writeError(statusErr, response)
+			return
+		}
+		persistentStatePVC = pvc
+	}
+
+	response.WriteEntity(checkVMIMigration(vmi, nodes.Items, persistentStatePVC))
+}
+
+func checkVMIMigration(vmi *v1.VirtualMachineInstance, nodes []k8sv1.Node, persistentStatePVC *k8sv1.PersistentVolumeClaim) *v1.VirtualMachineInstanceMigrationCheck {
+	check := &v1.VirtualMachineInstanceMigrationCheck{
+		Reasons: vmiMigrationBlockers(vmi, persistentStatePVC),
+	}
+
+	var sourceNode *k8sv1.Node
+	for i := range nodes {
+		if nodes[i].Name == vmi.Status.NodeName {
+			sourceNode = &nodes[i]
+			break
+		}
+	}
+
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Name == vmi.Status.NodeName {
+			continue
+		}
+		reasons := nodeMigrationBlockers(vmi, sourceNode, node)
+		check.Nodes = append(check.Nodes, v1.NodeMigrationCheck{
+			Name:       node.Name,
+			Compatible: len(reasons) == 0,
+			Reasons:    reasons,
+		})
+		if len(reasons) == 0 && len(check.Reasons) == 0 {
+			check.Migratable = true
+		}
+	}
+
+	if len(check.Nodes) == 0 {
+		check.Reasons = append(check.Reasons, "there is no node other than the source node")
+	}
+
+	return check
+}
+
+func vmiMigrationBlockers(vmi *v1.VirtualMachineInstance, persistentStatePVC *k8sv1.PersistentVolumeClaim) []string {
+	var reasons []string
+
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == v1.VirtualMachineInstanceIsMigratable && condition.Status == k8sv1.ConditionFalse {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", condition.Reason, condition.Message))
+		}
+	}
+
+	if migrationState := vmi.Status.MigrationState; migrationState != nil && !migrationState.Completed && !migrationState.Failed {
+		reasons = append(reasons, fmt.Sprintf("migration %s is already in progress", migrationState.MigrationUID))
+	}
+
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume == nil || volumeStatus.PersistentVolumeClaimInfo == nil {
+			continue
+		}
+		if !hasAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes, k8sv1.ReadWriteMany) {
+			reasons = append(reasons, fmt.Sprintf("hotplugged volume %s is backed by PVC %s which is not %s",
+				volumeStatus.Name, volumeStatus.PersistentVolumeClaimInfo.ClaimName, k8sv1.ReadWriteMany))
+		}
+	}
+
+	if backendstorage.IsBackendStorageNeededForVMI(&vmi.Spec) {
+		switch {
+		case persistentStatePVC == nil:
+			reasons = append(reasons, "the persistent state PVC was not found")
+		case persistentStatePVC.Status.Phase != k8sv1.ClaimBound:
+			reasons = append(reasons, fmt.Sprintf("the persistent state PVC %s is not bound", persistentStatePVC.Name))
+		}
+	}
+
+	return reasons
+}
+
+func nodeMigrationBlockers(vmi *v1.VirtualMachineInstance, sourceNode, node *k8sv1.Node) []string {
+	var reasons []string
+
+	if node.Labels[v1.NodeSchedulable] != "true" {
+		reasons = append(reasons, "node is not schedulable for virtual machines")
+	}
+	if node.Spec.Unschedulable {
+		reasons = append(reasons, "node is cordoned")
+	}
+
+	for key, value := range vmi.Spec.NodeSelector {
+		if nodeValue, exists := node.Labels[key]; !exists || nodeValue != value {
+			reasons = append(reasons, fmt.Sprintf("node does not match the node selector %s=%s", key, value))
+		}
+	}
+
+	if !matchesRequiredNodeAffinity(vmi.Spec.Affinity, node) {
+		reasons = append(reasons, "node does not match the required node affinity")
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == k8sv1.TaintEffectPreferNoSchedule || isTaintTolerated(vmi.Spec.Tolerations, taint) {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("node has the untolerated taint %s", taint.ToString()))
+	}
+
+	reasons = append(reasons, resourceMigrationBlockers(vmi, node)...)
+	reasons = append(reasons, cpuMigrationBlockers(vmi, sourceNode, node)...)
+
+	for _, resourceName := range hostDeviceResourceNames(vmi) {
+		if quantity, exists := node.Status.Allocatable[k8sv1.ResourceName(resourceName)]; !exists || quantity.IsZero() {
+			reasons = append(reasons, fmt.Sprintf("node does not provide the host device resource %s", resourceName))
+		}
+	}
+
+	return reasons
+}
+
+// matchesRequiredNodeAffinity evaluates the required node affinity the same way the scheduler does:
+// the node has to match any of the terms, and a term matches when all of its requirements are met.
+func matchesRequiredNodeAffinity(affinity *k8sv1.Affinity, node *k8sv1.Node) bool {
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		if matchesNodeSelectorTerm(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesNodeSelectorTerm(term k8sv1.NodeSelectorTerm, node *k8sv1.Node) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false
+	}
+	if len(term.MatchExpressions) > 0 {
+		selector, err := nodeSelectorRequirementsAsSelector(term.MatchExpressions)
+		if err != nil || !selector.Matches(labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	for _, requirement := range term.MatchFields {
+		if !matchesNodeFieldRequirement(requirement, node) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesNodeFieldRequirement supports metadata.name with the In and NotIn operators, as the scheduler does
+func matchesNodeFieldRequirement(requirement k8sv1.NodeSelectorRequirement, node *k8sv1.Node) bool {
+	if requirement.Key != k8smetav1.ObjectNameField {
+		return false
+	}
+	switch requirement.Operator {
+	case k8sv1.NodeSelectorOpIn:
+		return slices.Contains(requirement.Values, node.Name)
+	case k8sv1.NodeSelectorOpNotIn:
+		return !slices.Contains(requirement.Values, node.Name)
+	}
+	return false
+}
+
+func nodeSelectorRequirementsAsSelector(requirements []k8sv1.NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.NewSelector()
+	for _, requirement := range requirements {
+		var operator selection.Operator
+		switch requirement.Operator {
+		case k8sv1.NodeSelectorOpIn:
+			operator = selection.In
+		case k8sv1.NodeSelectorOpNotIn:
+			operator = selection.NotIn
+		case k8sv1.NodeSelectorOpExists:
+			operator = selection.Exists
+		case k8sv1.NodeSelectorOpDoesNotExist:
+			operator = selection.DoesNotExist
+		case k8sv1.NodeSelectorOpGt:
+			operator = selection.GreaterThan
+		case k8sv1.NodeSelectorOpLt:
+			operator = selection.LessThan
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", requirement.Operator)
+		}
+		labelRequirement, err := labels.NewRequirement(requirement.Key, operator, requirement.Values)
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*labelRequirement)
+	}
+	return selector, nil
+}
+
+// resourceMigrationBlockers compares the resource requests of the VMI with the allocatable resources of the node.
+// The overhead of the target pod is not accounted for, so a compatible node may still be too small.
+func resourceMigrationBlockers(vmi *v1.VirtualMachineInstance, node *k8sv1.Node) []string {
+	var resourceNames []string
+	for resourceName := range vmi.Spec.Domain.Resources.Requests {
+		resourceNames = append(resourceNames, string(resourceName))
+	}
+	sort.Strings(resourceNames)
+
+	var reasons []string
+	for _, resourceName := range resourceNames {
+		request := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceName(resourceName)]
+		allocatable := node.Status.Allocatable[k8sv1.ResourceName(resourceName)]
+		if allocatable.Cmp(request) < 0 {
+			reasons = append(reasons, fmt.Sprintf("node does not have enough allocatable %s: requested %s, allocatable %s",
+				resourceName, request.String(), allocatable.String()))
+		}
+	}
+	return reasons
+}
+
+// cpuMigrationBlockers compares the CPU model and features required by the VMI with the node-labeller data of the node
+func cpuMigrationBlockers(vmi *v1.VirtualMachineInstance, sourceNode, node *k8sv1.Node) []string {
+	cpu := vmi.Spec.Domain.CPU
+	if cpu == nil || cpu.Model == "" {
+		return nil
+	}
+
+	var requiredLabels []string
+	switch cpu.Model {
+	case v1.CPUModeHostPassthrough:
+		sourceModel := hostCPUModel(sourceNode)
+		if sourceModel != "" && hostCPUModel(node) != sourceModel {
+			return []string{fmt.Sprintf("host-passthrough CPU requires the host CPU model %s", sourceModel)}
+		}
+		return nil
+	case v1.CPUModeHostModel:
+		if sourceNode == nil {
+			return nil
+		}
+		if sourceModel := hostCPUModel(sourceNode); sourceModel != "" {
+			requiredLabels = append(requiredLabels, v1.SupportedHostModelMigrationCPU+sourceModel)
+		}
+		for key := range sourceNode.Labels {
+			if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+				requiredLabels = append(requiredLabels, v1.CPUFeatureLabel+strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel))
+			}
+		}
+	default:
+		requiredLabels = append(requiredLabels, v1.CPUModelLabel+cpu.Model)
+	}
+
+	for _, feature := range cpu.Features {
+		if feature.Policy == "" || feature.Policy == "require" {
+			requiredLabels = append(requiredLabels, v1.CPUFeatureLabel+feature.Name)
+		}
+	}
+
+	var reasons []string
+	for _, label := range requiredLabels {
+		if node.Labels[label] != "true" {
+			reasons = append(reasons, fmt.Sprintf("node does not support the CPU label %s", label))
+		}
+	}
+	return reasons
+}
+
+func hostCPUModel(node *k8sv1.Node) string {
+	if node == nil {
+		return ""
+	}
+	for key := range node.Labels {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			return strings.TrimPrefix(key, v1.HostModelCPULabel)
+		}
+	}
+	return ""
+}
+
+func hostDeviceResourceNames(vmi *v1.VirtualMachineInstance) []string {
+	var resourceNames []string
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		resourceNames = append(resourceNames, gpu.DeviceName)
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		resourceNames = append(resourceNames, hostDevice.DeviceName)
+	}
+	return resourceNames
+}
+
+func isTaintTolerated(tolerations []k8sv1.Toleration, taint *k8sv1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAccessMode(accessModes []k8sv1.PersistentVolumeAccessMode, accessMode k8sv1.PersistentVolumeAccessMode) bool {
+	for _, mode := range accessModes {
+		if mode == accessMode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,304 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+)
+
+var _ = Describe("Migrate check", func() {
+	const (
+		sourceNodeName = "source"
+		targetNodeName = "target"
+	)
+
+	newNode := func(name string, labels map[string]string) k8sv1.Node {
+		node := k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1.NodeSchedulable: "true"},
+			},
+		}
+		for key, value := range labels {
+			node.Labels[key] = value
+		}
+		return node
+	}
+
+	newRunningVMI := func(opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		status := libvmistatus.WithStatus(libvmistatus.New(
+			libvmistatus.WithPhase(v1.Running),
+			libvmistatus.WithNodeName(sourceNodeName),
+		))
+		return libvmi.New(append([]libvmi.Option{status}, opts...)...)
+	}
+
+	It("should report a migratable VMI with the verdict of every node but the source", func() {
+		cordoned := newNode("cordoned", nil)
+		cordoned.Spec.Unschedulable = true
+		nodes := []k8sv1.Node{newNode(sourceNodeName, nil), newNode(targetNodeName, nil), cordoned}
+
+		check := checkVMIMigration(newRunningVMI(), nodes, nil)
+
+		Expect(check.Migratable).To(BeTrue())
+		Expect(check.Reasons).To(BeEmpty())
+		Expect(check.Nodes).To(ConsistOf(
+			v1.NodeMigrationCheck{Name: targetNodeName, Compatible: true},
+			v1.NodeMigrationCheck{Name: "cordoned", Compatible: false, Reasons: []string{"node is cordoned"}},
+		))
+	})
+
+	It("should not be migratable without a compatible node", func() {
+		check := checkVMIMigration(newRunningVMI(), []k8sv1.Node{newNode(sourceNodeName, nil)}, nil)
+
+		Expect(check.Migratable).To(BeFalse())
+		Expect(check.Reasons).To(ConsistOf("there is no node other than the source node"))
+	})
+
+	DescribeTable("should report VMI level blockers", func(vmi *v1.VirtualMachineInstance, pvc *k8sv1.PersistentVolumeClaim, expectedReason string) {
+		nodes := []k8sv1.Node{newNode(sourceNodeName, nil), newNode(targetNodeName, nil)}
+
+		check := checkVMIMigration(vmi, nodes, pvc)
+
+		Expect(check.Migratable).To(BeFalse())
+		Expect(check.Reasons).To(ConsistOf(expectedReason))
+		Expect(check.Nodes).To(ConsistOf(v1.NodeMigrationCheck{Name: targetNodeName, Compatible: true}))
+	},
+		Entry("with a non migratable condition",
+			newRunningVMI(func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:    v1.VirtualMachineInstanceIsMigratable,
+					Status:  k8sv1.ConditionFalse,
+					Reason:  v1.VirtualMachineInstanceReasonDisksNotMigratable,
+					Message: "disk is not shared",
+				})
+			}),
+			nil,
+			v1.VirtualMachineInstanceReasonDisksNotMigratable+": disk is not shared",
+		),
+		Entry("with a migration in progress",
+			newRunningVMI(func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "123"}
+			}),
+			nil,
+			"migration 123 is already in progress",
+		),
+		Entry("with a hotplugged volume that is not ReadWriteMany",
+			newRunningVMI(func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+					Name:          "hotplug",
+					HotplugVolume: &v1.HotplugVolumeStatus{},
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						ClaimName:   "hotplug-pvc",
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					},
+				})
+			}),
+			nil,
+			"hotplugged volume hotplug is backed by PVC hotplug-pvc which is not ReadWriteMany",
+		),
+		Entry("with a missing persistent state PVC",
+			newRunningVMI(libvmi.WithTPM(true)),
+			nil,
+			"the persistent state PVC was not found",
+		),
+		Entry("with an unbound persistent state PVC",
+			newRunningVMI(libvmi.WithTPM(true)),
+			&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "persistent-state-for-testvmi"},
+				Status:     k8sv1.PersistentVolumeClaimStatus{Phase: k8sv1.ClaimPending},
+			},
+			"the persistent state PVC persistent-state-for-testvmi is not bound",
+		),
+	)
+
+	It("should accept a hotplugged ReadWriteMany volume and a bound persistent state PVC", func() {
+		vmi := newRunningVMI(libvmi.WithTPM(true), func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, v1.VolumeStatus{
+				Name:          "hotplug",
+				HotplugVolume: &v1.HotplugVolumeStatus{},
+				PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+					ClaimName:   "hotplug-pvc",
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+				},
+			})
+		})
+		pvc := &k8sv1.PersistentVolumeClaim{Status: k8sv1.PersistentVolumeClaimStatus{Phase: k8sv1.ClaimBound}}
+
+		check := checkVMIMigration(vmi, []k8sv1.Node{newNode(targetNodeName, nil)}, pvc)
+
+		Expect(check.Migratable).To(BeTrue())
+		Expect(check.Reasons).To(BeEmpty())
+	})
+
+	DescribeTable("should evaluate the target node", func(vmi *v1.VirtualMachineInstance, sourceLabels map[string]string, target k8sv1.Node, expectedReasons ...string) {
+		nodes := []k8sv1.Node{newNode(sourceNodeName, sourceLabels), target}
+
+		check := checkVMIMigration(vmi, nodes, nil)
+
+		Expect(check.Nodes).To(HaveLen(1))
+		Expect(check.Nodes[0].Name).To(Equal(target.Name))
+		Expect(check.Nodes[0].Reasons).To(ConsistOf(expectedReasons))
+		Expect(check.Nodes[0].Compatible).To(Equal(len(expectedReasons) == 0))
+		Expect(check.Migratable).To(Equal(len(expectedReasons) == 0))
+	},
+		Entry("when it is not schedulable for virtual machines",
+			newRunningVMI(), nil,
+			newNode(targetNodeName, map[string]string{v1.NodeSchedulable: "false"}),
+			"node is not schedulable for virtual machines",
+		),
+		Entry("when it does not match the node selector",
+			newRunningVMI(libvmi.WithNodeSelector("zone", "a")), nil,
+			newNode(targetNodeName, map[string]string{"zone": "b"}),
+			"node does not match the node selector zone=a",
+		),
+		Entry("when it matches the node selector",
+			newRunningVMI(libvmi.WithNodeSelector("zone", "a")), nil,
+			newNode(targetNodeName, map[string]string{"zone": "a"}),
+		),
+		Entry("when it does not match the required node affinity",
+			newRunningVMI(libvmi.WithNodeAffinityForLabel("zone", "a")), nil,
+			newNode(targetNodeName, map[string]string{"zone": "b"}),
+			"node does not match the required node affinity",
+		),
+		Entry("when it matches any term of the required node affinity",
+			newRunningVMI(withRequiredNodeAffinity(
+				k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{
+					{Key: "zone", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"a"}},
+				}},
+				k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{
+					{Key: "zone", Operator: k8sv1.NodeSelectorOpExists},
+					{Key: "gpu", Operator: k8sv1.NodeSelectorOpDoesNotExist},
+				}},
+			)), nil,
+			newNode(targetNodeName, map[string]string{"zone": "b"}),
+		),
+		Entry("when it is excluded by a node name field of the required node affinity",
+			newRunningVMI(withRequiredNodeAffinity(
+				k8sv1.NodeSelectorTerm{MatchFields: []k8sv1.NodeSelectorRequirement{
+					{Key: metav1.ObjectNameField, Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{targetNodeName}},
+				}},
+			)), nil,
+			newNode(targetNodeName, nil),
+			"node does not match the required node affinity",
+		),
+		Entry("when it does not have enough allocatable resources",
+			newRunningVMI(libvmi.WithResourceMemory("8Gi"), libvmi.WithResourceCPU("2")), nil,
+			withAllocatableResources(newNode(targetNodeName, nil), k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("4"),
+				k8sv1.ResourceMemory: resource.MustParse("4Gi"),
+			}),
+			"node does not have enough allocatable memory: requested 8Gi, allocatable 4Gi",
+		),
+		Entry("when it has enough allocatable resources",
+			newRunningVMI(libvmi.WithResourceMemory("8Gi"), libvmi.WithResourceCPU("2")), nil,
+			withAllocatableResources(newNode(targetNodeName, nil), k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("4"),
+				k8sv1.ResourceMemory: resource.MustParse("16Gi"),
+			}),
+		),
+		Entry("when it has an untolerated taint",
+			newRunningVMI(), nil,
+			withTaints(newNode(targetNodeName, nil), k8sv1.Taint{Key: "maintenance", Effect: k8sv1.TaintEffectNoSchedule}),
+			"node has the untolerated taint maintenance:NoSchedule",
+		),
+		Entry("when it has a tolerated taint",
+			newRunningVMI(libvmi.WithToleration(k8sv1.Toleration{Key: "maintenance", Operator: k8sv1.TolerationOpExists})), nil,
+			withTaints(newNode(targetNodeName, nil), k8sv1.Taint{Key: "maintenance", Effect: k8sv1.TaintEffectNoSchedule}),
+		),
+		Entry("when it has a PreferNoSchedule taint",
+			newRunningVMI(), nil,
+			withTaints(newNode(targetNodeName, nil), k8sv1.Taint{Key: "maintenance", Effect: k8sv1.TaintEffectPreferNoSchedule}),
+		),
+		Entry("when it does not support the named CPU model and a required feature",
+			newRunningVMI(libvmi.WithCPUModel("Haswell"), libvmi.WithCPUFeature("vmx", "require"), libvmi.WithCPUFeature("pcid", "disable")), nil,
+			newNode(targetNodeName, map[string]string{v1.CPUModelLabel + "Skylake": "true"}),
+			"node does not support the CPU label "+v1.CPUModelLabel+"Haswell",
+			"node does not support the CPU label "+v1.CPUFeatureLabel+"vmx",
+		),
+		Entry("when it supports the named CPU model and the required feature",
+			newRunningVMI(libvmi.WithCPUModel("Haswell"), libvmi.WithCPUFeature("vmx", "require")), nil,
+			newNode(targetNodeName, map[string]string{v1.CPUModelLabel + "Haswell": "true", v1.CPUFeatureLabel + "vmx": "true"}),
+		),
+		Entry("when it cannot host the host-model CPU of the source",
+			newRunningVMI(libvmi.WithCPUModel(v1.CPUModeHostModel)),
+			map[string]string{v1.HostModelCPULabel + "Skylake": "true", v1.HostModelRequiredFeaturesLabel + "vmx": "true"},
+			newNode(targetNodeName, map[string]string{v1.SupportedHostModelMigrationCPU + "Haswell": "true"}),
+			"node does not support the CPU label "+v1.SupportedHostModelMigrationCPU+"Skylake",
+			"node does not support the CPU label "+v1.CPUFeatureLabel+"vmx",
+		),
+		Entry("when it can host the host-model CPU of the source",
+			newRunningVMI(libvmi.WithCPUModel(v1.CPUModeHostModel)),
+			map[string]string{v1.HostModelCPULabel + "Skylake": "true", v1.HostModelRequiredFeaturesLabel + "vmx": "true"},
+			newNode(targetNodeName, map[string]string{v1.SupportedHostModelMigrationCPU + "Skylake": "true", v1.CPUFeatureLabel + "vmx": "true"}),
+		),
+		Entry("when it has a different host CPU for host-passthrough",
+			newRunningVMI(libvmi.WithCPUModel(v1.CPUModeHostPassthrough)),
+			map[string]string{v1.HostModelCPULabel + "Skylake": "true"},
+			newNode(targetNodeName, map[string]string{v1.HostModelCPULabel + "Haswell": "true"}),
+			"host-passthrough CPU requires the host CPU model Skylake",
+		),
+		Entry("when it does not provide a host device",
+			newRunningVMI(func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "dev", DeviceName: "vendor.com/device"}}
+				vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu", DeviceName: "vendor.com/gpu"}}
+			}), nil,
+			withAllocatable(newNode(targetNodeName, nil), "vendor.com/gpu"),
+			"node does not provide the host device resource vendor.com/device",
+		),
+	)
+})
+
+func withTaints(node k8sv1.Node, taints ...k8sv1.Taint) k8sv1.Node {
+	node.Spec.Taints = append(node.Spec.Taints, taints...)
+	return node
+}
+
+func withAllocatable(node k8sv1.Node, resourceNames ...string) k8sv1.Node {
+	node.Status.Allocatable = k8sv1.ResourceList{}
+	for _, resourceName := range resourceNames {
+		node.Status.Allocatable[k8sv1.ResourceName(resourceName)] = resource.MustParse("1")
+	}
+	return node
+}
+
+func withAllocatableResources(node k8sv1.Node, resources k8sv1.ResourceList) k8sv1.Node {
+	node.Status.Allocatable = resources
+	return node
+}
+
+func withRequiredNodeAffinity(terms ...k8sv1.NodeSelectorTerm) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.Affinity = &k8sv1.Affinity{
+			NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{NodeSelectorTerms: terms},
+			},
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+func (app *SubresourceAPIApp) NBDRequestHandler(request *restful.Request, response *restful.Response) {
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		validateVMIForNBD,
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.NBDURI(vmi)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForNBD(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if !vmi.IsRunning() {
+		return errors.NewBadRequest(vmiNotRunning)
+	}
+	backupState := vmi.Status.BackupState
+	if backupState == nil || backupState.Mode != v1.BackupModePull || backupState.Completed {
+		return errors.NewBadRequest("VMI has no running Pull mode backup")
+	}
+	return nil
+}
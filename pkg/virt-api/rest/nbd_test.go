@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("NBD Subresource api", func() {
+	newVMI := func(phase v1.VirtualMachineInstancePhase, backupState *v1.VirtualMachineInstanceBackupState) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: phase, BackupState: backupState},
+		}
+	}
+
+	It("should allow connecting to a running Pull mode backup", func() {
+		backupState := &v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePull}
+		Expect(validateVMIForNBD(newVMI(v1.Running, backupState))).To(BeNil())
+	})
+
+	DescribeTable("should reject the request", func(phase v1.VirtualMachineInstancePhase, backupState *v1.VirtualMachineInstanceBackupState) {
+		statusErr := validateVMIForNBD(newVMI(phase, backupState))
+		Expect(statusErr).ToNot(BeNil())
+		Expect(statusErr.ErrStatus.Code).To(Equal(int32(http.StatusBadRequest)))
+	},
+		Entry("when the VMI is not running", v1.Scheduled, &v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePull}),
+		Entry("when the VMI has no backup", v1.Running, nil),
+		Entry("when the backup is a Push mode backup", v1.Running, &v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePush}),
+		Entry("when the backup completed", v1.Running, &v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePull, Completed: true}),
+	)
+})
@@ -0,0 +1,64 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+func (app *SubresourceAPIApp) PcapRequestHandler(request *restful.Request, response *restful.Response) {
+	interfaceName := request.QueryParameter(definitions.InterfaceParamName)
+	filter := request.QueryParameter(definitions.FilterParamName)
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		validateVMIForPcap(interfaceName),
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.PcapURI(vmi, interfaceName, filter)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForPcap(interfaceName string) func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	return func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if interfaceName == "" {
+			return errors.NewBadRequest("interface query parameter is required")
+		}
+		if !vmi.IsRunning() {
+			return errors.NewBadRequest(vmiNotRunning)
+		}
+		for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+			if iface.Name == interfaceName {
+				return nil
+			}
+		}
+		return errors.NewBadRequest(fmt.Sprintf("interface %s not found on VirtualMachineInstance %s", interfaceName, vmi.Name))
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Pcap Subresource api", func() {
+	newVMI := func(phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}, {Name: "blue"}}
+		return vmi
+	}
+
+	It("should allow capturing on an existing interface of a running VMI", func() {
+		Expect(validateVMIForPcap("blue")(newVMI(v1.Running))).To(BeNil())
+	})
+
+	DescribeTable("should reject the request", func(interfaceName string, phase v1.VirtualMachineInstancePhase) {
+		statusErr := validateVMIForPcap(interfaceName)(newVMI(phase))
+		Expect(statusErr).ToNot(BeNil())
+		Expect(statusErr.ErrStatus.Code).To(Equal(int32(http.StatusBadRequest)))
+	},
+		Entry("when the interface is not specified", "", v1.Running),
+		Entry("when the VMI is not running", "default", v1.Scheduled),
+		Entry("when the interface does not exist", "red", v1.Running),
+	)
+})
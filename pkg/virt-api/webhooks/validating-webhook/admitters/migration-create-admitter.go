@@ -25,30 +25,46 @@ import (
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 
+	"kubevirt.io/api/core"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt"
 
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
+// The reserved migration priorities are granted by RBAC rules allowing the "use" verb on the migrationpriorities
+// resource of the kubevirt.io group, with the priorities as resource names. The cluster-admin ClusterRole allows it.
+const (
+	migrationPrioritiesResource = "migrationpriorities"
+	useVerb                     = "use"
+)
+
 type MigrationCreateAdmitter struct {
-	virtClient    kubevirt.Interface
-	clusterConfig *virtconfig.ClusterConfig
+	virtClient              kubevirt.Interface
+	sarClient               authorizationv1.SubjectAccessReviewInterface
+	clusterConfig           *virtconfig.ClusterConfig
+	kubeVirtServiceAccounts map[string]struct{}
 }
 
-func NewMigrationCreateAdmitter(virtClient kubevirt.Interface, clusterConfig *virtconfig.ClusterConfig) *MigrationCreateAdmitter {
+func NewMigrationCreateAdmitter(virtClient kubevirt.Interface, sarClient authorizationv1.SubjectAccessReviewInterface, clusterConfig *virtconfig.ClusterConfig, kubeVirtServiceAccounts map[string]struct{}) *MigrationCreateAdmitter {
 	return &MigrationCreateAdmitter{
-		virtClient:    virtClient,
-		clusterConfig: clusterConfig,
+		virtClient:              virtClient,
+		sarClient:               sarClient,
+		clusterConfig:           clusterConfig,
+		kubeVirtServiceAccounts: kubeVirtServiceAccounts,
 	}
 }
 
@@ -100,6 +116,14 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes, err = admitter.authorizeMigrationPriority(ctx, k8sfield.NewPath("spec", "priority"), migration, ar.Request.UserInfo)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	vmi, err := admitter.virtClient.KubevirtV1().VirtualMachineInstances(migration.Namespace).Get(ctx, migration.Spec.VMIName, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -156,6 +180,53 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 	return &reviewResponse
 }
 
+// authorizeMigrationPriority rejects the reserved priorities when the user is neither a KubeVirt component nor allowed
+// to use them. The priority is also implied by the evacuation and workload update annotations, so the effective one
+// is authorized.
+func (admitter *MigrationCreateAdmitter) authorizeMigrationPriority(ctx context.Context, field *k8sfield.Path, migration *v1.VirtualMachineInstanceMigration, userInfo authenticationv1.UserInfo) ([]metav1.StatusCause, error) {
+	priority := migrations.GetMigrationPriority(migration)
+	if priority != v1.MigrationPrioritySystemCritical && priority != v1.MigrationPrioritySystemMaintenance {
+		return nil, nil
+	}
+	if _, isKubeVirtServiceAccount := admitter.kubeVirtServiceAccounts[userInfo.Username]; isKubeVirtServiceAccount {
+		return nil, nil
+	}
+
+	var extra map[string]authv1.ExtraValue
+	if len(userInfo.Extra) > 0 {
+		extra = make(map[string]authv1.ExtraValue, len(userInfo.Extra))
+		for k, v := range userInfo.Extra {
+			extra[k] = authv1.ExtraValue(v)
+		}
+	}
+	sar, err := admitter.sarClient.Create(ctx, &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			Extra:  extra,
+			UID:    userInfo.UID,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Namespace: migration.Namespace,
+				Verb:      useVerb,
+				Group:     core.GroupName,
+				Resource:  migrationPrioritiesResource,
+				Name:      string(priority),
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !sar.Status.Allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeForbidden,
+			Message: fmt.Sprintf("priority %q is reserved, user %s is not allowed to %s %s/%s", priority, userInfo.Username, useVerb, migrationPrioritiesResource, priority),
+			Field:   field.String(),
+		}}, nil
+	}
+	return nil, nil
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -194,5 +265,7 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	causes = append(causes, migrations.ValidatePriority(field.Child("priority"), spec.Priority)...)
+
 	return causes
 }
@@ -26,9 +26,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
@@ -62,6 +66,20 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 		},
 	}
 	config, _, kvStore := testutils.NewFakeClusterConfigUsingKV(kv)
+	kubeVirtServiceAccounts := webhooks.KubeVirtServiceAccounts(kv.Namespace)
+
+	// The admin user is bound to a role which allows to use the reserved migration priorities,
+	// e.g. through the cluster-admin ClusterRole
+	k8sClient := k8sfake.NewSimpleClientset()
+	k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+		attributes := sar.Spec.ResourceAttributes
+		sar.Status.Allowed = sar.Spec.User == "admin" && attributes.Verb == "use" &&
+			attributes.Group == "kubevirt.io" && attributes.Resource == "migrationpriorities" &&
+			(attributes.Name == string(v1.MigrationPrioritySystemCritical) || attributes.Name == string(v1.MigrationPrioritySystemMaintenance))
+		return true, sar, nil
+	})
+	sarClient := k8sClient.AuthorizationV1().SubjectAccessReviews()
 
 	enableFeatureGate := func(featureGate string) {
 		kvConfig := kv.DeepCopy()
@@ -91,7 +109,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 
 		migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
 		virtClient := kubevirtfake.NewSimpleClientset(vmi, inFlightMigration)
-		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 		ar, err := newAdmissionReviewForVMIMCreation(migration)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -104,7 +122,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			migration := createMigration("default", testMigrationName, "")
 
 			virtClient := kubevirtfake.NewSimpleClientset()
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -119,7 +137,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 
 			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -127,6 +145,57 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("should validate the Migration priority on create", func(priority v1.MigrationPriority, annotation string, userInfo authenticationv1.UserInfo, expectAllow bool) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+
+			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
+			if priority != "" {
+				migration.Spec.Priority = &priority
+			}
+			if annotation != "" {
+				migration.Annotations = map[string]string{annotation: ""}
+			}
+			virtClient := kubevirtfake.NewSimpleClientset(vmi)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo = userInfo
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(expectAllow))
+			if !expectAllow {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.priority"))
+			}
+		},
+			Entry("accept user-triggered from a user",
+				v1.MigrationPriorityUserTriggered, "", authenticationv1.UserInfo{Username: "user"}, true),
+			Entry("reject system-critical from a user",
+				v1.MigrationPrioritySystemCritical, "", authenticationv1.UserInfo{Username: "user"}, false),
+			Entry("reject system-maintenance from a user",
+				v1.MigrationPrioritySystemMaintenance, "", authenticationv1.UserInfo{Username: "user"}, false),
+			Entry("reject the evacuation annotation from a user",
+				v1.MigrationPriority(""), v1.EvacuationMigrationAnnotation, authenticationv1.UserInfo{Username: "user"}, false),
+			Entry("reject the workload update annotation from a user",
+				v1.MigrationPriority(""), v1.WorkloadUpdateMigrationAnnotation, authenticationv1.UserInfo{Username: "user"}, false),
+			Entry("accept user-triggered along with the evacuation annotation from a user",
+				v1.MigrationPriorityUserTriggered, v1.EvacuationMigrationAnnotation, authenticationv1.UserInfo{Username: "user"}, true),
+			Entry("accept system-critical from the controller",
+				v1.MigrationPrioritySystemCritical, "", authenticationv1.UserInfo{Username: "system:serviceaccount:kubevirt:kubevirt-controller"}, true),
+			Entry("accept the evacuation annotation from the controller",
+				v1.MigrationPriority(""), v1.EvacuationMigrationAnnotation, authenticationv1.UserInfo{Username: "system:serviceaccount:kubevirt:kubevirt-controller"}, true),
+			Entry("accept system-maintenance from a user allowed to use it",
+				v1.MigrationPrioritySystemMaintenance, "", authenticationv1.UserInfo{Username: "admin"}, true),
+			Entry("accept the evacuation annotation from a user allowed to use system-critical",
+				v1.MigrationPriority(""), v1.EvacuationMigrationAnnotation, authenticationv1.UserInfo{Username: "admin"}, true),
+			Entry("reject system-critical from a user of a group without the permission",
+				v1.MigrationPrioritySystemCritical, "", authenticationv1.UserInfo{Username: "user", Groups: []string{"system:authenticated"}}, false),
+			Entry("reject system-critical from a service account of another namespace",
+				v1.MigrationPrioritySystemCritical, "", authenticationv1.UserInfo{Username: "system:serviceaccount:tenant:kubevirt-controller"}, false),
+			Entry("reject an unknown priority from the controller",
+				v1.MigrationPriority("urgent"), "", authenticationv1.UserInfo{Username: "system:serviceaccount:kubevirt:kubevirt-controller"}, false),
+		)
+
 		It("should accept Migration spec on create when previous VMI migration completed", func() {
 			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
 			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
@@ -137,7 +206,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 
 			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -151,7 +220,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 
 			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -177,7 +246,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 
 			migration := createMigration(vmi.Namespace, testMigrationName, vmi.Name)
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
@@ -207,13 +276,13 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), config).Admit,
+				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), sarClient, config, kubeVirtServiceAccounts).Admit,
 			),
 			Entry("Migration update",
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), config).Admit,
+				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), sarClient, config, kubeVirtServiceAccounts).Admit,
 			),
 		)
 	})
@@ -230,7 +299,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			if featureGateEnabled {
 				enableFeatureGate(featuregate.DecentralizedLiveMigration)
 			}
-			admitter := admitters.NewMigrationCreateAdmitter(virtClient, config)
+			admitter := admitters.NewMigrationCreateAdmitter(virtClient, sarClient, config, kubeVirtServiceAccounts)
 			resp := admitter.Admit(context.Background(), ar)
 			Expect(resp.Allowed).To(Equal(featureGateEnabled && expectAllow))
 			if !featureGateEnabled {
@@ -25,6 +25,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -64,6 +65,52 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("zero ParallelStreams",
+			migrationsv1.MigrationPolicySpec{ParallelStreams: pointer.P(uint32(0))},
+		),
+
+		Entry("unsupported compression method",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: "lz4"}},
+		),
+
+		Entry("zstd compression without ParallelStreams",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd}},
+		),
+
+		Entry("out of range zlib compression level",
+			migrationsv1.MigrationPolicySpec{
+				ParallelStreams: pointer.P(uint32(4)),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(10))},
+			},
+		),
+
+		Entry("xbzrle compression with a level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, Level: pointer.P(int32(1))}},
+		),
+
+		Entry("xbzrle cache size with zstd compression",
+			migrationsv1.MigrationPolicySpec{
+				ParallelStreams: pointer.P(uint32(4)),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, XBZRLECacheSize: pointer.P(resource.MustParse("64Mi"))},
+			},
+		),
+
+		Entry("ZeroCopy without ParallelStreams",
+			migrationsv1.MigrationPolicySpec{ZeroCopy: pointer.P(true)},
+		),
+
+		Entry("ZeroCopy with compression",
+			migrationsv1.MigrationPolicySpec{
+				ParallelStreams: pointer.P(uint32(4)),
+				ZeroCopy:        pointer.P(true),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE},
+			},
+		),
+
+		Entry("unsupported ZeroPageDetection",
+			migrationsv1.MigrationPolicySpec{ZeroPageDetection: pointer.P(v1.MigrationZeroPageDetection("sparse"))},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -93,6 +140,25 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
+
+		Entry("zstd compression with ParallelStreams",
+			migrationsv1.MigrationPolicySpec{
+				ParallelStreams: pointer.P(uint32(4)),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(3))},
+			},
+		),
+
+		Entry("xbzrle compression with a cache size",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, XBZRLECacheSize: pointer.P(resource.MustParse("64Mi"))}},
+		),
+
+		Entry("ZeroCopy with ParallelStreams",
+			migrationsv1.MigrationPolicySpec{ParallelStreams: pointer.P(uint32(8)), ZeroCopy: pointer.P(true)},
+		),
+
+		Entry("multifd ZeroPageDetection without ParallelStreams",
+			migrationsv1.MigrationPolicySpec{ZeroPageDetection: pointer.P(v1.MigrationZeroPageDetectionMultifd)},
+		),
 	)
 })
 
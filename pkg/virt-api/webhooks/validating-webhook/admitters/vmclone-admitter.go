@@ -28,12 +28,16 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
+	"kubevirt.io/api/core"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -116,6 +120,16 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if ar.Request.Operation == admissionv1.Create {
+		causes, err = authorizeTargetNamespace(ctx, admitter.Client, vmClone, ar.Request.UserInfo)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		if len(causes) > 0 {
+			return webhookutils.ToAdmissionResponse(causes)
+		}
+	}
+
 	reviewResponse := admissionv1.AdmissionResponse{
 		Allowed: true,
 	}
@@ -246,6 +260,71 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+// authorizeTargetNamespace checks that the user may clone into another namespace. As for a CDI cross-namespace
+// clone, the user needs permission to use the clone namespace as a clone source and to create the target.
+func authorizeTargetNamespace(ctx context.Context, client kubecli.KubevirtClient, vmClone *clone.VirtualMachineClone, userInfo authenticationv1.UserInfo) ([]metav1.StatusCause, error) {
+	if vmClone.Spec.TargetNamespace == nil || *vmClone.Spec.TargetNamespace == "" || *vmClone.Spec.TargetNamespace == vmClone.Namespace {
+		return nil, nil
+	}
+	targetNamespace := *vmClone.Spec.TargetNamespace
+	targetField := k8sfield.NewPath("spec").Child("targetNamespace").String()
+	var targetName string
+	if vmClone.Spec.Target != nil {
+		targetName = vmClone.Spec.Target.Name
+	}
+
+	createSar := func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+		return client.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	}
+
+	allowed, reason, err := cdiv1.CanUserCloneSnapshot(createSar, vmClone.Namespace, vmClone.Spec.Source.Name, targetNamespace, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: reason,
+			Field:   targetField,
+		}}, nil
+	}
+
+	var extra map[string]authv1.ExtraValue
+	if len(userInfo.Extra) > 0 {
+		extra = make(map[string]authv1.ExtraValue, len(userInfo.Extra))
+		for k, v := range userInfo.Extra {
+			extra[k] = authv1.ExtraValue(v)
+		}
+	}
+	sar, err := createSar(&authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			Extra:  extra,
+			UID:    userInfo.UID,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Namespace: targetNamespace,
+				Verb:      "create",
+				Group:     core.GroupName,
+				Resource:  "virtualmachines",
+				Name:      targetName,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !sar.Status.Allowed {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("User %s has insufficient permissions to create VirtualMachines in clone target namespace %s", userInfo.Username, targetNamespace),
+			Field:   targetField,
+		}}, nil
+	}
+
+	return nil, nil
+}
+
 func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,10 +33,13 @@ import (
 
 	"go.uber.org/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
 	clonebase "kubevirt.io/api/clone"
@@ -436,6 +440,89 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	Context("target namespace", func() {
+		const targetNamespace = "target-namespace"
+
+		var (
+			sars            []*authv1.SubjectAccessReview
+			deniedResources []string
+		)
+
+		admitAsUser := func() *admissionv1.AdmissionResponse {
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.UserInfo = authenticationv1.UserInfo{
+				Username: "tenant",
+				Groups:   []string{"tenants"},
+			}
+			return admitter.Admit(context.Background(), ar)
+		}
+
+		BeforeEach(func() {
+			enableFeatureGate("Snapshot")
+			sars = nil
+			deniedResources = nil
+
+			k8sClient := k8sfake.NewSimpleClientset()
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				sars = append(sars, sar)
+				sar.Status.Allowed = !slices.Contains(deniedResources, sar.Spec.ResourceAttributes.Resource)
+				return true, sar, nil
+			})
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+		})
+
+		DescribeTable("should not authorize a clone within its namespace", func(namespace *string) {
+			vmClone.Spec.TargetNamespace = namespace
+			Expect(admitAsUser().Allowed).To(BeTrue())
+			Expect(sars).To(BeEmpty())
+		},
+			Entry("without target namespace", nil),
+			Entry("with empty target namespace", pointer.P("")),
+			Entry("with the clone namespace", pointer.P(metav1.NamespaceDefault)),
+		)
+
+		It("should allow a user with clone source permission and VirtualMachine create permission in the target namespace", func() {
+			vmClone.Spec.TargetNamespace = pointer.P(targetNamespace)
+			Expect(admitAsUser().Allowed).To(BeTrue())
+
+			Expect(sars).To(HaveLen(2))
+			for _, sar := range sars {
+				Expect(sar.Spec.User).To(Equal("tenant"))
+				Expect(sar.Spec.Groups).To(ConsistOf("tenants"))
+			}
+			Expect(*sars[0].Spec.ResourceAttributes).To(Equal(authv1.ResourceAttributes{
+				Namespace:   metav1.NamespaceDefault,
+				Verb:        "create",
+				Group:       "cdi.kubevirt.io",
+				Resource:    "datavolumes",
+				Subresource: "source",
+				Name:        vmClone.Spec.Source.Name,
+			}))
+			Expect(*sars[1].Spec.ResourceAttributes).To(Equal(authv1.ResourceAttributes{
+				Namespace: targetNamespace,
+				Verb:      "create",
+				Group:     core.GroupName,
+				Resource:  "virtualmachines",
+				Name:      vmClone.Spec.Target.Name,
+			}))
+		})
+
+		DescribeTable("should reject a user without permission", func(expectedMessage string, resources ...string) {
+			vmClone.Spec.TargetNamespace = pointer.P(targetNamespace)
+			deniedResources = resources
+
+			resp := admitAsUser()
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+			Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(expectedMessage))
+		},
+			Entry("to clone from the clone namespace", "insufficient permissions in clone source namespace default", "datavolumes", "pods"),
+			Entry("to create VirtualMachines in the target namespace", "insufficient permissions to create VirtualMachines in clone target namespace target-namespace", "virtualmachines"),
+		)
+	})
+
 })
 
 func createCloneAdmissionReview(vmClone *clone.VirtualMachineClone) *admissionv1.AdmissionReview {
@@ -304,7 +304,7 @@ func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v
 
 	// Validate that volumes match disks and filesystems correctly
 	for idx, volume := range spec.Volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil || volume.BackupTarget != nil {
 			continue
 		}
 		if _, matchingDiskExists := diskAndFilesystemNames[volume.Name]; !matchingDiskExists {
@@ -1712,6 +1712,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
 		}
+		if volume.BackupTarget != nil {
+			volumeSourceSetCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -1725,6 +1728,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 		if volume.CloudInitNoCloud != nil || volume.CloudInitConfigDrive != nil {
 			var userDataSecretRef, networkDataSecretRef *k8sv1.LocalObjectReference
 			var dataSourceType, userData, userDataBase64, networkData, networkDataBase64 string
+			var generateNetworkData *bool
 			if volume.CloudInitNoCloud != nil {
 				dataSourceType = "cloudInitNoCloud"
 				userDataSecretRef = volume.CloudInitNoCloud.UserDataSecretRef
@@ -1733,6 +1737,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				networkDataSecretRef = volume.CloudInitNoCloud.NetworkDataSecretRef
 				networkDataBase64 = volume.CloudInitNoCloud.NetworkDataBase64
 				networkData = volume.CloudInitNoCloud.NetworkData
+				generateNetworkData = volume.CloudInitNoCloud.GenerateNetworkData
 			} else if volume.CloudInitConfigDrive != nil {
 				dataSourceType = "cloudInitConfigDrive"
 				userDataSecretRef = volume.CloudInitConfigDrive.UserDataSecretRef
@@ -1741,6 +1746,7 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				networkDataSecretRef = volume.CloudInitConfigDrive.NetworkDataSecretRef
 				networkDataBase64 = volume.CloudInitConfigDrive.NetworkDataBase64
 				networkData = volume.CloudInitConfigDrive.NetworkData
+				generateNetworkData = volume.CloudInitConfigDrive.GenerateNetworkData
 			}
 
 			userDataLen := 0
@@ -1803,6 +1809,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 				networkDataSourceCount++
 				networkDataLen = len(networkData)
 			}
+			if generateNetworkData != nil && *generateNetworkData {
+				networkDataSourceCount++
+			}
 
 			if networkDataSourceCount > 1 {
 				causes = append(causes, metav1.StatusCause{
@@ -489,6 +489,30 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeVolumesWithBackupTargetVol := func(total int, index int) []v1.Volume {
+		res := makeVolumes()
+		for i := 0; i < total; i++ {
+			if i != index {
+				res = append(res, makeVolumes(i)...)
+				continue
+			}
+			res = append(res, v1.Volume{
+				Name: fmt.Sprintf("volume-name-%d", index),
+				VolumeSource: v1.VolumeSource{
+					BackupTarget: &v1.BackupTargetVolumeSource{
+						PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: fmt.Sprintf("volume-name-%d", index),
+							},
+							Hotpluggable: true,
+						},
+					},
+				},
+			})
+		}
+		return res
+	}
+
 	makeInvalidVolumes := func(total int, indexes ...int) []v1.Volume {
 		res := make([]v1.Volume, 0)
 		for i := 0; i < total; i++ {
@@ -819,6 +843,14 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeFilesystems(),
 			makeStatus(0, 0),
 			makeExpected("number of disks and filesystems (1) does not equal the number of volumes (2)", "")),
+		Entry("Should accept if backup target volume exists without matching disk",
+			makeVolumesWithBackupTargetVol(3, 2),
+			makeVolumes(0, 1),
+			makeDisks(0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(3, 1),
+			nil),
 	)
 
 	Context("with filesystem devices", func() {
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -131,6 +132,9 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	causes = append(causes, validateVMPoolUpdateStrategy(field.Child("updateStrategy"), spec.UpdateStrategy)...)
+	causes = append(causes, validateVMPoolScaleInStrategy(field.Child("scaleInStrategy"), spec.ScaleInStrategy)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -150,3 +154,113 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolUpdateStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if strategy == nil {
+		return causes
+	}
+
+	switch strategy.Type {
+	case "", poolv1.VirtualMachinePoolProactiveUpdateStrategyType:
+	case poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType:
+		if strategy.ProactiveMethod != "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("proactiveMethod is only supported with the %s update strategy", poolv1.VirtualMachinePoolProactiveUpdateStrategyType),
+				Field:   field.Child("proactiveMethod").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported update strategy type %s", strategy.Type),
+			Field:   field.Child("type").String(),
+		})
+	}
+
+	switch strategy.ProactiveMethod {
+	case "", poolv1.VirtualMachinePoolProactiveUpdateRestart, poolv1.VirtualMachinePoolProactiveUpdateRecreate:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported proactive update method %s", strategy.ProactiveMethod),
+			Field:   field.Child("proactiveMethod").String(),
+		})
+	}
+
+	if rollingUpdate := strategy.RollingUpdate; rollingUpdate != nil {
+		maxUnavailable, unavailableCauses := validateIntOrPercent(field.Child("rollingUpdate", "maxUnavailable"), rollingUpdate.MaxUnavailable)
+		causes = append(causes, unavailableCauses...)
+		maxSurge, surgeCauses := validateIntOrPercent(field.Child("rollingUpdate", "maxSurge"), rollingUpdate.MaxSurge)
+		causes = append(causes, surgeCauses...)
+
+		if len(unavailableCauses) == 0 && len(surgeCauses) == 0 &&
+			rollingUpdate.MaxUnavailable != nil && maxUnavailable == 0 && maxSurge == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxUnavailable may not be 0 when maxSurge is 0",
+				Field:   field.Child("rollingUpdate", "maxUnavailable").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateVMPoolScaleInStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolScaleInStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if strategy == nil {
+		return causes
+	}
+
+	switch strategy.SelectionPolicy {
+	case "", poolv1.VirtualMachinePoolScaleInRandom, poolv1.VirtualMachinePoolScaleInPreferStopped,
+		poolv1.VirtualMachinePoolScaleInPreferNotReady, poolv1.VirtualMachinePoolScaleInHighestOrdinal:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported scale-in selection policy %s", strategy.SelectionPolicy),
+			Field:   field.Child("selectionPolicy").String(),
+		})
+	}
+
+	switch strategy.VolumePolicy {
+	case "", poolv1.VirtualMachinePoolScaleInDeleteVolumes, poolv1.VirtualMachinePoolScaleInRetainVolumes:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported scale-in volume policy %s", strategy.VolumePolicy),
+			Field:   field.Child("volumePolicy").String(),
+		})
+	}
+
+	return causes
+}
+
+// validateIntOrPercent returns the value scaled to 100 replicas, so that zero values can be detected
+func validateIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) (int, []metav1.StatusCause) {
+	if value == nil {
+		return 0, nil
+	}
+
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.String(),
+		}}
+	}
+	if scaled < 0 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than or equal to 0", field.String()),
+			Field:   field.String(),
+		}}
+	}
+
+	return scaled, nil
+}
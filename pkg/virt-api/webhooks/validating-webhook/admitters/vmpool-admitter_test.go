@@ -28,11 +28,13 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -137,8 +139,9 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.selector",
 		}),
 	)
-	It("should accept valid vm spec", func() {
-		pool := &poolv1.VirtualMachinePool{
+
+	newValidPool := func() *poolv1.VirtualMachinePool {
+		return &poolv1.VirtualMachinePool{
 			Spec: poolv1.VirtualMachinePoolSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"match": "me"},
@@ -166,6 +169,9 @@ var _ = Describe("Validating Pool Admitter", func() {
 				},
 			},
 		}
+	}
+
+	admit := func(pool *poolv1.VirtualMachinePool) *admissionv1.AdmissionResponse {
 		poolBytes, _ := json.Marshal(&pool)
 
 		ar := &admissionv1.AdmissionReview{
@@ -177,7 +183,102 @@ var _ = Describe("Validating Pool Admitter", func() {
 			},
 		}
 
-		resp := poolAdmitter.Admit(context.Background(), ar)
+		return poolAdmitter.Admit(context.Background(), ar)
+	}
+
+	It("should accept valid vm spec", func() {
+		resp := admit(newValidPool())
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should accept valid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+
+		resp := admit(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("with empty strategy", &poolv1.VirtualMachinePoolUpdateStrategy{}),
+		Entry("with opportunistic updates", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+		}),
+		Entry("with proactive recreate and surge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type:            poolv1.VirtualMachinePoolProactiveUpdateStrategyType,
+			ProactiveMethod: poolv1.VirtualMachinePoolProactiveUpdateRecreate,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+				MaxSurge:       pointer.P(intstr.FromString("50%")),
+			},
+		}),
+		Entry("with percentage of unavailable VMs", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("10%")),
+			},
+		}),
+	)
+
+	DescribeTable("should reject invalid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, field string) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+
+		resp := admit(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with unknown type", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: "Unknown",
+		}, "spec.updateStrategy.type"),
+		Entry("with unknown proactive method", &poolv1.VirtualMachinePoolUpdateStrategy{
+			ProactiveMethod: "Unknown",
+		}, "spec.updateStrategy.proactiveMethod"),
+		Entry("with proactive method on opportunistic updates", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type:            poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+			ProactiveMethod: poolv1.VirtualMachinePoolProactiveUpdateRecreate,
+		}, "spec.updateStrategy.proactiveMethod"),
+		Entry("with invalid maxUnavailable", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("one")),
+			},
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("with negative maxSurge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			ProactiveMethod: poolv1.VirtualMachinePoolProactiveUpdateRecreate,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxSurge: pointer.P(intstr.FromInt32(-1)),
+			},
+		}, "spec.updateStrategy.rollingUpdate.maxSurge"),
+		Entry("with zero maxUnavailable and no surge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+			},
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+	)
+
+	It("should accept a valid scale-in strategy", func() {
+		pool := newValidPool()
+		pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
+			SelectionPolicy: poolv1.VirtualMachinePoolScaleInHighestOrdinal,
+			VolumePolicy:    poolv1.VirtualMachinePoolScaleInRetainVolumes,
+		}
+
+		resp := admit(pool)
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should reject invalid scale-in strategy", func(strategy *poolv1.VirtualMachinePoolScaleInStrategy, field string) {
+		pool := newValidPool()
+		pool.Spec.ScaleInStrategy = strategy
+
+		resp := admit(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with unknown selection policy", &poolv1.VirtualMachinePoolScaleInStrategy{
+			SelectionPolicy: "Unknown",
+		}, "spec.scaleInStrategy.selectionPolicy"),
+		Entry("with unknown volume policy", &poolv1.VirtualMachinePoolScaleInStrategy{
+			VolumePolicy: "Unknown",
+		}, "spec.scaleInStrategy.volumePolicy"),
+	)
 })
@@ -224,6 +224,10 @@ func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort
 	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		if iface.Ports != nil {
 			for _, port := range iface.Ports {
+				// Port ranges and excluded ports have no container port representation.
+				if port.EndPort != nil || port.Exclude {
+					continue
+				}
 				if port.Protocol == "" {
 					port.Protocol = "TCP"
 				}
@@ -12,6 +12,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
@@ -207,6 +208,16 @@ var _ = Describe("Container spec renderer", func() {
 					Equal(vmPortToContainerPort(ports[i])))
 			}
 		})
+
+		It("the container should not feature port ranges and excluded ports", func() {
+			specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithPorts(
+				vmiWithInterfaceWithPortAllowList("not-relevant",
+					v1.Port{Port: 8000, EndPort: pointer.P(int32(8080))},
+					v1.Port{Port: 22, Exclude: true},
+					v1.Port{Name: "http", Port: 80},
+				)))
+			Expect(specRenderer.Render(exampleCommand).Ports).To(ConsistOf(vmPortToContainerPort(v1.Port{Name: "http", Port: 80})))
+		})
 	})
 
 	Context("container command and arguments", func() {
@@ -38,6 +38,8 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 
 	clonecontroller "kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/firewall"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/ipam"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
@@ -70,6 +72,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
 
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	ipamv1 "kubevirt.io/api/ipam/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
@@ -172,6 +175,11 @@ type VirtControllerApp struct {
 	poolController *pool.Controller
 	poolInformer   cache.SharedIndexInformer
 
+	ipamController *ipam.Controller
+	ipPoolInformer cache.SharedIndexInformer
+
+	firewallController *firewall.Controller
+
 	vmController *vm.Controller
 	vmInformer   cache.SharedIndexInformer
 
@@ -194,6 +202,9 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	backupController             *snapshot.VMBackupController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
+	groupSnapshotController      *snapshot.VMGroupSnapshotController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -201,6 +212,10 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmBackupInformer             cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmGroupSnapshotInformer      cache.SharedIndexInformer
+	vmGroupRestoreInformer       cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -245,6 +260,8 @@ type VirtControllerApp struct {
 	vmiControllerThreads              int
 	rsControllerThreads               int
 	poolControllerThreads             int
+	ipamControllerThreads             int
+	firewallControllerThreads         int
 	vmControllerThreads               int
 	migrationControllerThreads        int
 	evacuationControllerThreads       int
@@ -253,6 +270,9 @@ type VirtControllerApp struct {
 	exportControllerThreads           int
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	backupControllerThreads           int
+	snapshotScheduleControllerThreads int
+	groupSnapshotControllerThreads    int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
 
@@ -274,6 +294,7 @@ func init() {
 	utilruntime.Must(snapshotv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(exportv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(poolv1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(ipamv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(clone.AddToScheme(scheme.Scheme))
 }
 
@@ -366,6 +387,7 @@ func Execute() {
 
 	app.rsInformer = app.informerFactory.VMIReplicaSet()
 	app.poolInformer = app.informerFactory.VMPool()
+	app.ipPoolInformer = app.informerFactory.IPPool()
 
 	app.persistentVolumeClaimInformer = app.informerFactory.PersistentVolumeClaim()
 	app.persistentVolumeClaimCache = app.persistentVolumeClaimInformer.GetStore()
@@ -382,6 +404,10 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmGroupSnapshotInformer = app.informerFactory.VirtualMachineGroupSnapshot()
+	app.vmGroupRestoreInformer = app.informerFactory.VirtualMachineGroupRestore()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -460,11 +486,16 @@ func Execute() {
 	app.initCommon()
 	app.initReplicaSet()
 	app.initPool()
+	app.initIPAM()
+	app.initFirewall()
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initBackupController()
+	app.initSnapshotScheduleController()
+	app.initGroupSnapshotController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -576,6 +607,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
+		go vca.ipamController.Run(vca.ipamControllerThreads, stop)
+		go vca.firewallController.Run(vca.firewallControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go func() {
@@ -588,6 +621,21 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.backupController.Run(vca.backupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the backup controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.groupSnapshotController.Run(vca.groupSnapshotControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the group snapshot controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -733,6 +781,27 @@ func (vca *VirtControllerApp) initPool() {
 	}
 }
 
+func (vca *VirtControllerApp) initIPAM() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "ipam-controller")
+	vca.ipamController, err = ipam.NewController(vca.clientSet,
+		vca.vmiInformer,
+		vca.vmInformer,
+		vca.ipPoolInformer,
+		recorder)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initFirewall() {
+	var err error
+	vca.firewallController, err = firewall.NewController(vca.clientSet, vca.vmiInformer)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initVirtualMachines() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
@@ -863,6 +932,52 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initBackupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-controller")
+	vca.backupController = &snapshot.VMBackupController{
+		Client:           vca.clientSet,
+		VMBackupInformer: vca.vmBackupInformer,
+		VMInformer:       vca.vmInformer,
+		VMIInformer:      vca.vmiInformer,
+		Recorder:         recorder,
+	}
+	if err := vca.backupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		VMIInformer:                vca.vmiInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initGroupSnapshotController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "group-snapshot-controller")
+	vca.groupSnapshotController = &snapshot.VMGroupSnapshotController{
+		Client:                  vca.clientSet,
+		VMGroupSnapshotInformer: vca.vmGroupSnapshotInformer,
+		VMGroupRestoreInformer:  vca.vmGroupRestoreInformer,
+		VMSnapshotInformer:      vca.vmSnapshotInformer,
+		VMRestoreInformer:       vca.vmRestoreInformer,
+		VMInformer:              vca.vmInformer,
+		VMIInformer:             vca.vmiInformer,
+		Recorder:                recorder,
+	}
+	if err := vca.groupSnapshotController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -902,7 +1017,7 @@ func (vca *VirtControllerApp) initCloneController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "clone-controller")
 	vca.vmCloneController, err = clonecontroller.NewVmCloneController(
-		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, vca.dataVolumeInformer, recorder,
 	)
 	if err != nil {
 		panic(err)
@@ -976,6 +1091,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.poolControllerThreads, "pool-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for pool controller")
 
+	flag.IntVar(&vca.ipamControllerThreads, "ipam-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for ipam controller")
+
+	flag.IntVar(&vca.firewallControllerThreads, "firewall-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for firewall controller")
+
 	flag.IntVar(&vca.vmControllerThreads, "vm-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for vm controller")
 
@@ -997,6 +1118,15 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for backup controller")
+
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.groupSnapshotControllerThreads, "group-snapshot-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for group snapshot controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
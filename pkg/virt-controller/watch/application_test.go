@@ -114,6 +114,10 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmBackupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmGroupSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
+		vmGroupRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupRestore{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
@@ -215,6 +219,34 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.backupController = &snapshot.VMBackupController{
+			Client:           virtClient,
+			VMBackupInformer: vmBackupInformer,
+			VMInformer:       vmInformer,
+			VMIInformer:      vmiInformer,
+			Recorder:         recorder,
+		}
+		_ = app.backupController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.groupSnapshotController = &snapshot.VMGroupSnapshotController{
+			Client:                  virtClient,
+			VMGroupSnapshotInformer: vmGroupSnapshotInformer,
+			VMGroupRestoreInformer:  vmGroupRestoreInformer,
+			VMSnapshotInformer:      vmSnapshotInformer,
+			VMRestoreInformer:       vmRestoreInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+		}
+		_ = app.groupSnapshotController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -252,6 +284,7 @@ var _ = Describe("Application", func() {
 			vmInformer,
 			vmSnapshotContentInformer,
 			pvcInformer,
+			dataVolumeInformer,
 			recorder,
 		)
 
@@ -34,6 +34,7 @@ import (
 	k6tv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
@@ -96,14 +97,15 @@ func (ctrl *VMCloneController) execute(key string) error {
 	}
 
 	if vmClone.Status.Phase == clone.Succeeded {
-		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName))
+		targetNamespace := getTargetNamespace(vmClone)
+		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", targetNamespace, *vmClone.Status.TargetName))
 		if err != nil {
 			return err
 		}
 
 		if !vmExists {
 			if vmClone.DeletionTimestamp == nil {
-				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", vmClone.Namespace, *vmClone.Status.TargetName)
+				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", targetNamespace, *vmClone.Status.TargetName)
 				return ctrl.client.VirtualMachineClone(vmClone.Namespace).Delete(context.Background(), vmClone.Name, v1.DeleteOptions{})
 			}
 			// nothing to process for a vm clone that's being deleted
@@ -229,6 +231,10 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 			}
 		}
 
+		if isCrossNamespaceClone(vmClone) {
+			return ctrl.createTargetVMFromSnapshot(vmClone, vmCloneInfo.snapshot, syncInfo)
+		}
+
 		if vmClone.Status.RestoreName == nil {
 			vm, err := ctrl.getVmFromSnapshot(vmCloneInfo.snapshot)
 			if err != nil {
@@ -275,6 +281,17 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 					return syncInfo
 				}
 			}
+		} else if isCrossNamespaceClone(vmClone) && vmCloneInfo.sourceType == sourceTypeVM && vmClone.Status.SnapshotName != nil {
+			// The snapshot is kept until CDI finished cloning its VolumeSnapshots
+			syncInfo = ctrl.verifyTargetPVCsBound(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() || !syncInfo.pvcBound {
+				return syncInfo
+			}
+
+			syncInfo = ctrl.cleanupSnapshot(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
 		}
 
 	default:
@@ -564,6 +581,101 @@ func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clone.VirtualMachine
 	return syncInfo
 }
 
+// createTargetVMFromSnapshot creates the target VM of a cross namespace clone. No restore is involved, so once the
+// VM is created the clone continues as if the restore was ready.
+// The webhook authorized the user who created the clone to clone the snapshot into the target namespace, hence the
+// DataVolumes are created here before the VM. The VM controller adopts them through the DataVolumeTemplates of the
+// VM, instead of authorizing their creation against a ServiceAccount of the target namespace.
+func (ctrl *VMCloneController) createTargetVMFromSnapshot(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, syncInfo syncInfoType) syncInfoType {
+	content, err := ctrl.getSnapshotContent(snapshot)
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot get snapshot content for clone %s: %v", vmClone.Name, err))
+		return syncInfo
+	}
+
+	sourceVM := &k6tv1.VirtualMachine{
+		ObjectMeta: *content.Spec.Source.VirtualMachine.ObjectMeta.DeepCopy(),
+		Spec:       *content.Spec.Source.VirtualMachine.Spec.DeepCopy(),
+	}
+	sourceVM.Namespace = snapshot.Namespace
+
+	targetVM, err := generatePatchedTargetVM(vmClone, sourceVM, content.Spec.VolumeBackups)
+	if err != nil {
+		retErr := fmt.Errorf("error generating target VM for clone %s: %v", vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(TargetVMCreationFailed), retErr.Error())
+		syncInfo.setError(retErr)
+		return syncInfo
+	}
+
+	dataVolumesExist, err := ctrl.createTargetDataVolumes(vmClone, targetVM)
+	if err != nil {
+		retErr := fmt.Errorf("failed creating DataVolumes of target VM %s/%s for clone %s: %v", targetVM.Namespace, targetVM.Name, vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(TargetVMCreationFailed), retErr.Error())
+		syncInfo.setError(retErr)
+		return syncInfo
+	}
+	if !dataVolumesExist {
+		log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("waiting for the DataVolumes of target VM %s/%s", targetVM.Namespace, targetVM.Name)
+		return syncInfo
+	}
+
+	log.Log.Object(vmClone).Infof("creating target VM %s/%s for clone %s", targetVM.Namespace, targetVM.Name, vmClone.Name)
+	_, err = ctrl.client.VirtualMachine(targetVM.Namespace).Create(context.Background(), targetVM, v1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		retErr := fmt.Errorf("failed creating target VM %s/%s for clone %s: %v", targetVM.Namespace, targetVM.Name, vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(TargetVMCreationFailed), retErr.Error())
+		syncInfo.setError(retErr)
+		return syncInfo
+	}
+
+	syncInfo.restoreReady = true
+	syncInfo.targetVMName = targetVM.Name
+
+	return syncInfo
+}
+
+// createTargetDataVolumes creates the missing DataVolumes of the target VM, and returns whether all of them exist.
+// The VM is only created once they are all in the cache, so that the VM controller adopts them instead of creating them.
+func (ctrl *VMCloneController) createTargetDataVolumes(vmClone *clone.VirtualMachineClone, targetVM *k6tv1.VirtualMachine) (bool, error) {
+	cloneKey := getKey(vmClone.Name, vmClone.Namespace)
+	allExist := true
+	for _, template := range targetVM.Spec.DataVolumeTemplates {
+		obj, exists, err := ctrl.dataVolumeStore.GetByKey(getKey(template.Name, targetVM.Namespace))
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			if dataVolume := obj.(*cdiv1.DataVolume); dataVolume.Annotations[cloneAnnotation] != cloneKey {
+				return false, fmt.Errorf("DataVolume %s/%s already exists and does not belong to the clone", dataVolume.Namespace, dataVolume.Name)
+			}
+			continue
+		}
+
+		allExist = false
+		dataVolume := generateDataVolume(&template, targetVM.Namespace)
+		log.Log.Object(vmClone).Infof("creating DataVolume %s/%s for clone %s", dataVolume.Namespace, dataVolume.Name, vmClone.Name)
+		_, err = ctrl.client.CdiClient().CdiV1beta1().DataVolumes(dataVolume.Namespace).Create(context.Background(), dataVolume, v1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return false, err
+		}
+	}
+	return allExist, nil
+}
+
+func generatePatchedTargetVM(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, volumeBackups []snapshotv1.VolumeBackup) (*k6tv1.VirtualMachine, error) {
+	patches, err := generatePatches(sourceVM, &vmClone.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	targetVM, err := generateTargetVM(vmClone, sourceVM, volumeBackups)
+	if err != nil {
+		return nil, err
+	}
+
+	return virtsnapshot.PatchVM(targetVM, patches)
+}
+
 func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineClone, sourceNamespace string, syncInfo syncInfoType) syncInfoType {
 	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, sourceNamespace))
 	if !exists {
@@ -592,7 +704,7 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineC
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, getTargetNamespace(vmClone)))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 		return syncInfo
@@ -642,6 +754,43 @@ func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone
 
 }
 
+func (ctrl *VMCloneController) verifyTargetPVCsBound(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	targetVMInfo := vmClone.Spec.Target
+	targetNamespace := getTargetNamespace(vmClone)
+
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, targetNamespace))
+	if !exists {
+		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
+		return syncInfo
+	} else if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetVMInfo.Name, vmClone.Name, err))
+		return syncInfo
+	}
+
+	targetVM := obj.(*k6tv1.VirtualMachine)
+	for _, dvTemplate := range targetVM.Spec.DataVolumeTemplates {
+		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(dvTemplate.Name, targetNamespace))
+		if !exists {
+			syncInfo.setError(fmt.Errorf("PVC %s is not created yet for clone %s", dvTemplate.Name, vmClone.Name))
+			return syncInfo
+		} else if err != nil {
+			syncInfo.setError(fmt.Errorf("error getting PVC %s from cache for clone %s: %v", dvTemplate.Name, vmClone.Name, err))
+			return syncInfo
+		}
+
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.Status.Phase != corev1.ClaimBound {
+			log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("pvc %s for clone %s is not bound yet", pvc.Name, vmClone.Name)
+			return syncInfo
+		}
+	}
+
+	ctrl.logAndRecord(vmClone, PVCBound, fmt.Sprintf("all PVC for clone %s are bound", vmClone.Name))
+	syncInfo.pvcBound = true
+
+	return syncInfo
+}
+
 func (ctrl *VMCloneController) cleanupSnapshot(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	err := ctrl.client.VirtualMachineSnapshot(vmClone.Namespace).Delete(context.Background(), *vmClone.Status.SnapshotName, v1.DeleteOptions{})
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -19,6 +19,7 @@ import (
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 )
@@ -29,13 +30,14 @@ const (
 	defaultVerbosityLevel = 2
 	unknownTypeErrFmt     = "clone controller expected object of type %s but found object of unknown type"
 
-	SnapshotCreated       Event = "SnapshotCreated"
-	SnapshotReady         Event = "SnapshotReady"
-	RestoreCreated        Event = "RestoreCreated"
-	RestoreCreationFailed Event = "RestoreCreationFailed"
-	RestoreReady          Event = "RestoreReady"
-	TargetVMCreated       Event = "TargetVMCreated"
-	PVCBound              Event = "PVCBound"
+	SnapshotCreated        Event = "SnapshotCreated"
+	SnapshotReady          Event = "SnapshotReady"
+	RestoreCreated         Event = "RestoreCreated"
+	RestoreCreationFailed  Event = "RestoreCreationFailed"
+	RestoreReady           Event = "RestoreReady"
+	TargetVMCreated        Event = "TargetVMCreated"
+	TargetVMCreationFailed Event = "TargetVMCreationFailed"
+	PVCBound               Event = "PVCBound"
 
 	SnapshotDeleted                 Event = "SnapshotDeleted"
 	SnapshotContentInvalid          Event = "SnapshotContentInvalid"
@@ -61,13 +63,14 @@ type VMCloneController struct {
 	vmStore              cache.Store
 	snapshotContentStore cache.Store
 	pvcStore             cache.Store
+	dataVolumeStore      cache.Store
 	recorder             record.EventRecorder
 
 	vmCloneQueue workqueue.TypedRateLimitingInterface[string]
 	hasSynced    func() bool
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer, dataVolumeInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:               client,
 		vmCloneIndexer:       vmCloneInformer.GetIndexer(),
@@ -76,6 +79,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		vmStore:              vmInformer.GetStore(),
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
+		dataVolumeStore:      dataVolumeInformer.GetStore(),
 		recorder:             recorder,
 		vmCloneQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
@@ -85,7 +89,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 
 	ctrl.hasSynced = func() bool {
 		return vmCloneInformer.HasSynced() && snapshotInformer.HasSynced() && restoreInformer.HasSynced() &&
-			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced()
+			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced() && dataVolumeInformer.HasSynced()
 	}
 
 	_, err := vmCloneInformer.AddEventHandler(
@@ -136,6 +140,18 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		return nil, err
 	}
 
+	_, err = dataVolumeInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleDataVolume,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleDataVolume(newObj) },
+			DeleteFunc: ctrl.handleDataVolume,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = vmInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleAddedSourceVM,
@@ -257,11 +273,17 @@ func (ctrl *VMCloneController) handlePVC(obj interface{}) {
 		exists      bool
 	)
 
-	if restoreName, exists = pvc.Annotations[snapshot.RestoreNameAnnotation]; !exists {
+	if pvc.Status.Phase != k8scorev1.ClaimBound {
 		return
 	}
 
-	if pvc.Status.Phase != k8scorev1.ClaimBound {
+	// PVCs of cross namespace clones are annotated with the key of the clone
+	if cloneKey, exists := pvc.Annotations[cloneAnnotation]; exists {
+		ctrl.vmCloneQueue.AddRateLimited(cloneKey)
+		return
+	}
+
+	if restoreName, exists = pvc.Annotations[snapshot.RestoreNameAnnotation]; !exists {
 		return
 	}
 
@@ -278,6 +300,23 @@ func (ctrl *VMCloneController) handlePVC(obj interface{}) {
 	}
 }
 
+// handleDataVolume enqueues the clone which created the DataVolume in the target namespace of a cross namespace clone
+func (ctrl *VMCloneController) handleDataVolume(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	dataVolume, ok := obj.(*cdiv1.DataVolume)
+	if !ok {
+		log.Log.Errorf(unknownTypeErrFmt, "datavolume")
+		return
+	}
+
+	if cloneKey, exists := dataVolume.Annotations[cloneAnnotation]; exists {
+		ctrl.vmCloneQueue.AddRateLimited(cloneKey)
+	}
+}
+
 func (ctrl *VMCloneController) handleAddedSourceVM(obj interface{}) {
 	vm, ok := obj.(*virtv1.VirtualMachine)
 	if !ok {
@@ -40,8 +40,10 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
@@ -59,6 +61,7 @@ const (
 	testSnapshotName        = "tmp-snapshot-clone-uid"
 	testSnapshotContentName = "vmsnapshot-content-snapshot-UID"
 	testRestoreName         = "tmp-restore-clone-uid"
+	testTargetNamespace     = "target-namespace"
 )
 
 var _ = Describe("Clone", func() {
@@ -68,6 +71,7 @@ var _ = Describe("Clone", func() {
 		mockQueue  *testutils.MockWorkQueue[string]
 
 		client    *kubevirtfake.Clientset
+		cdiClient *cdifake.Clientset
 		k8sClient *k8sfake.Clientset
 		sourceVM  *virtv1.VirtualMachine
 		vmClone   *clone.VirtualMachineClone
@@ -214,6 +218,7 @@ var _ = Describe("Clone", func() {
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
 		snapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -226,6 +231,7 @@ var _ = Describe("Clone", func() {
 			vmInformer,
 			snapshotContentInformer,
 			pvcInformer,
+			dataVolumeInformer,
 			recorder)
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
@@ -238,6 +244,10 @@ var _ = Describe("Clone", func() {
 		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testTargetNamespace).Return(client.KubevirtV1().VirtualMachines(testTargetNamespace)).AnyTimes()
+
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
 		k8sClient = k8sfake.NewSimpleClientset()
 		k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -250,7 +260,7 @@ var _ = Describe("Clone", func() {
 	sanityExecute := func() {
 		controllertesting.SanityExecute(controller, []cache.Store{
 			controller.vmCloneIndexer, controller.snapshotContentStore, controller.restoreStore, controller.vmStore,
-			controller.snapshotContentStore, controller.pvcStore,
+			controller.snapshotContentStore, controller.pvcStore, controller.dataVolumeStore,
 		}, Default)
 	}
 
@@ -747,6 +757,208 @@ var _ = Describe("Clone", func() {
 		})
 	})
 
+	Context("with target in another namespace", func() {
+		var snapshot *snapshotv1.VirtualMachineSnapshot
+
+		BeforeEach(func() {
+			sourceVM.Spec.Template.Spec.Volumes = append(sourceVM.Spec.Template.Spec.Volumes, virtv1.Volume{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{
+						Name: "testdv",
+					},
+				},
+			})
+			vmClone.Spec.TargetNamespace = pointer.P(testTargetNamespace)
+
+			snapshot = createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+			snapshot.Status.ReadyToUse = pointer.P(true)
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+		})
+
+		newTargetVM := func() *virtv1.VirtualMachine {
+			targetVM := sourceVM.DeepCopy()
+			targetVM.Name = vmClone.Spec.Target.Name
+			targetVM.Namespace = testTargetNamespace
+			targetVM.Spec.DataVolumeTemplates = []virtv1.DataVolumeTemplateSpec{
+				{ObjectMeta: metav1.ObjectMeta{Name: "clone-clone-uid-disk0"}},
+			}
+			return targetVM
+		}
+
+		newSnapshotContent := func() *snapshotv1.VirtualMachineSnapshotContent {
+			content := createVirtualMachineSnapshotContent(sourceVM)
+			content.Spec.VolumeBackups = []snapshotv1.VolumeBackup{
+				{
+					VolumeName: "disk0",
+					PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "testdv"},
+						Spec: k8sv1.PersistentVolumeClaimSpec{
+							AccessModes:      []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+							StorageClassName: pointer.P("rook-ceph-block"),
+						},
+					},
+					VolumeSnapshotName: pointer.P("vmsnapshot-disk0"),
+				},
+			}
+			return content
+		}
+
+		addDataVolume := func(annotations map[string]string) {
+			dataVolume := &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "clone-clone-uid-disk0",
+					Namespace:   testTargetNamespace,
+					Annotations: annotations,
+				},
+			}
+			Expect(controller.dataVolumeStore.Add(dataVolume)).To(Succeed())
+		}
+
+		It("should create the DataVolumes cloned from the snapshot before the target VM", func() {
+			vmClone.Status.Phase = clone.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(newSnapshotContent())
+
+			sanityExecute()
+			expectEvent(SnapshotReady)
+			expectRestoreDoesNotExist()
+
+			dataVolume, err := cdiClient.CdiV1beta1().DataVolumes(testTargetNamespace).Get(context.TODO(), "clone-clone-uid-disk0", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataVolume.Annotations).To(HaveKeyWithValue(cloneAnnotation, metav1.NamespaceDefault+"/"+vmClone.Name))
+			Expect(dataVolume.OwnerReferences).To(BeEmpty())
+			Expect(dataVolume.Spec.Source.Snapshot.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(dataVolume.Spec.Source.Snapshot.Name).To(Equal("vmsnapshot-disk0"))
+
+			_, err = client.KubevirtV1().VirtualMachines(testTargetNamespace).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should fail to create the target VM when one of its DataVolumes belongs to something else", func() {
+			vmClone.Status.Phase = clone.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(newSnapshotContent())
+			addDataVolume(nil)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, string(SnapshotReady))
+			testutils.ExpectEvent(recorder, string(TargetVMCreationFailed))
+
+			_, err := client.KubevirtV1().VirtualMachines(testTargetNamespace).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create the target VM with DataVolumes cloned from the snapshot instead of a restore", func() {
+			vmClone.Status.Phase = clone.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(newSnapshotContent())
+			addDataVolume(map[string]string{cloneAnnotation: metav1.NamespaceDefault + "/" + vmClone.Name})
+
+			sanityExecute()
+			expectEvent(SnapshotReady)
+			expectCloneBeInPhase(clone.CreatingTargetVM)
+			expectRestoreDoesNotExist()
+
+			targetVM, err := client.KubevirtV1().VirtualMachines(testTargetNamespace).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targetVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+			Expect(targetVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+			Expect(targetVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+
+			dvTemplate := targetVM.Spec.DataVolumeTemplates[0]
+			Expect(dvTemplate.Name).To(Equal("clone-clone-uid-disk0"))
+			Expect(dvTemplate.Annotations).To(HaveKeyWithValue(cloneAnnotation, metav1.NamespaceDefault+"/"+vmClone.Name))
+			Expect(dvTemplate.Spec.Source.Snapshot.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(dvTemplate.Spec.Source.Snapshot.Name).To(Equal("vmsnapshot-disk0"))
+			Expect(dvTemplate.Spec.Storage.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+			Expect(dvTemplate.Spec.Storage.StorageClassName).To(HaveValue(Equal("rook-ceph-block")))
+			Expect(targetVM.Spec.Template.Spec.Volumes).To(ContainElement(virtv1.Volume{
+				Name: "disk0",
+				VolumeSource: virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{Name: "clone-clone-uid-disk0"},
+				},
+			}))
+		})
+
+		It("should fail to create the target VM if a volume is not backed up by a VolumeSnapshot", func() {
+			vmClone.Status.Phase = clone.RestoreInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, string(TargetVMCreationFailed))
+			expectCloneBeInPhase(clone.RestoreInProgress)
+		})
+
+		It("should move to Succeeded phase when the target VM exists in the target namespace", func() {
+			vmClone.Status.Phase = clone.CreatingTargetVM
+
+			addVM(sourceVM)
+			addVM(newTargetVM())
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addPVC(createPVCWithName(testTargetNamespace, "clone-clone-uid-disk0", k8sv1.ClaimPending))
+
+			sanityExecute()
+			expectEvent(TargetVMCreated)
+			expectCloneBeInPhase(clone.Succeeded)
+			expectSnapshotExists()
+		})
+
+		It("should delete the snapshot once the PVCs in the target namespace are bound", func() {
+			vmClone.Status.Phase = clone.Succeeded
+			vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+
+			addVM(sourceVM)
+			addVM(newTargetVM())
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addPVC(createPVCWithName(testTargetNamespace, "clone-clone-uid-disk0", k8sv1.ClaimBound))
+
+			sanityExecute()
+			expectEvent(PVCBound)
+			expectSnapshotDoesNotExist()
+
+			updatedClone, err := client.CloneV1beta1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedClone.Status.SnapshotName).To(BeNil())
+		})
+
+		It("should enqueue the clone when a DataVolume of the clone changes", func() {
+			dataVolume := &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "clone-clone-uid-disk0",
+					Namespace:   testTargetNamespace,
+					Annotations: map[string]string{cloneAnnotation: metav1.NamespaceDefault + "/" + vmClone.Name},
+				},
+			}
+
+			controller.handleDataVolume(dataVolume)
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+
+		It("should enqueue the clone when a PVC of the clone is bound", func() {
+			pvc := createPVCWithName(testTargetNamespace, "clone-clone-uid-disk0", k8sv1.ClaimBound)
+			pvc.Annotations = map[string]string{cloneAnnotation: metav1.NamespaceDefault + "/" + vmClone.Name}
+
+			controller.handlePVC(pvc)
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+	})
+
 	Context("generation of target VM", func() {
 		BeforeEach(func() {
 			snapshot := createVirtualMachineSnapshot(sourceVM)
@@ -1208,9 +1420,13 @@ func createVirtualMachineRestore(vm *virtv1.VirtualMachine, snapshotName string,
 }
 
 func createPVC(namespace string, phase k8sv1.PersistentVolumeClaimPhase) *k8sv1.PersistentVolumeClaim {
+	return createPVCWithName(namespace, "restore-pvc", phase)
+}
+
+func createPVCWithName(namespace, name string, phase k8sv1.PersistentVolumeClaimPhase) *k8sv1.PersistentVolumeClaim {
 	return &k8sv1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "restore-pvc",
+			Name:      name,
 			Namespace: namespace,
 			UID:       "pvc-UID",
 		},
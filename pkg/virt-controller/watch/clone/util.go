@@ -17,11 +17,15 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 const (
 	vmKind           = "VirtualMachine"
 	kubevirtApiGroup = "kubevirt.io"
+
+	// cloneAnnotation holds the key of the clone on the DataVolumes of a cross namespace clone
+	cloneAnnotation = "clone.kubevirt.io/clone"
 )
 
 // variable so can be overridden in tests
@@ -68,6 +72,22 @@ func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
 
+func generateDataVolumeName(vmCloneUID types.UID, volumeName string) string {
+	return fmt.Sprintf("clone-%s-%s", string(vmCloneUID), volumeName)
+}
+
+// getTargetNamespace returns the namespace of the target, which defaults to the namespace of the clone
+func getTargetNamespace(vmClone *clone.VirtualMachineClone) string {
+	if targetNamespace := vmClone.Spec.TargetNamespace; targetNamespace != nil && *targetNamespace != "" {
+		return *targetNamespace
+	}
+	return vmClone.Namespace
+}
+
+func isCrossNamespaceClone(vmClone *clone.VirtualMachineClone) bool {
+	return getTargetNamespace(vmClone) != vmClone.Namespace
+}
+
 func isInPhase(vmClone *clone.VirtualMachineClone, phase clone.VirtualMachineClonePhase) bool {
 	return vmClone.Status.Phase == phase
 }
@@ -113,6 +133,121 @@ func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName,
 	}
 }
 
+// generateTargetVM generates a VM in the target namespace from the VM of a snapshot. VirtualMachineRestores can
+// only restore within their namespace, so the volumes are cloned by CDI from the VolumeSnapshots instead.
+func generateTargetVM(vmClone *clone.VirtualMachineClone, sourceVM *v1.VirtualMachine, volumeBackups []snapshotv1.VolumeBackup) (*v1.VirtualMachine, error) {
+	targetName := vmClone.Spec.Target.Name
+	if targetName == "" {
+		targetName = generateVMName(sourceVM.Name)
+	}
+
+	targetVM := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        targetName,
+			Namespace:   getTargetNamespace(vmClone),
+			Labels:      sourceVM.Labels,
+			Annotations: sourceVM.Annotations,
+		},
+		Spec: *sourceVM.Spec.DeepCopy(),
+	}
+	if targetVM.Spec.Running != nil {
+		targetVM.Spec.Running = pointer.P(false)
+	} else {
+		targetVM.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+	}
+	// The revisions are stored in the source namespace, the VM controller creates new ones for the target
+	if targetVM.Spec.Instancetype != nil {
+		targetVM.Spec.Instancetype.RevisionName = ""
+	}
+	if targetVM.Spec.Preference != nil {
+		targetVM.Spec.Preference.RevisionName = ""
+	}
+
+	targetVM.Spec.DataVolumeTemplates = nil
+	if targetVM.Spec.Template == nil {
+		return targetVM, nil
+	}
+
+	var volumes []v1.Volume
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		if volume.MemoryDump != nil {
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			volumes = append(volumes, volume)
+			continue
+		}
+
+		volumeBackup := findVolumeBackup(volumeBackups, volume.Name)
+		if volumeBackup == nil || volumeBackup.VolumeSnapshotName == nil {
+			return nil, fmt.Errorf("volume %s is not backed up by a VolumeSnapshot", volume.Name)
+		}
+
+		hotpluggable := volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.Hotpluggable ||
+			volume.DataVolume != nil && volume.DataVolume.Hotpluggable
+
+		dvName := generateDataVolumeName(vmClone.UID, volume.Name)
+		targetVM.Spec.DataVolumeTemplates = append(targetVM.Spec.DataVolumeTemplates,
+			generateDataVolumeTemplate(vmClone, dvName, sourceVM.Namespace, volumeBackup))
+		volumes = append(volumes, v1.Volume{
+			Name: volume.Name,
+			VolumeSource: v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{
+					Name:         dvName,
+					Hotpluggable: hotpluggable,
+				},
+			},
+		})
+	}
+	targetVM.Spec.Template.Spec.Volumes = volumes
+
+	return targetVM, nil
+}
+
+func generateDataVolumeTemplate(vmClone *clone.VirtualMachineClone, name, snapshotNamespace string, volumeBackup *snapshotv1.VolumeBackup) v1.DataVolumeTemplateSpec {
+	sourcePVC := volumeBackup.PersistentVolumeClaim
+	return v1.DataVolumeTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				cloneAnnotation: getKey(vmClone.Name, vmClone.Namespace),
+			},
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				Snapshot: &cdiv1.DataVolumeSourceSnapshot{
+					Namespace: snapshotNamespace,
+					Name:      *volumeBackup.VolumeSnapshotName,
+				},
+			},
+			Storage: &cdiv1.StorageSpec{
+				AccessModes:      sourcePVC.Spec.AccessModes,
+				VolumeMode:       sourcePVC.Spec.VolumeMode,
+				StorageClassName: sourcePVC.Spec.StorageClassName,
+				Resources:        sourcePVC.Spec.Resources,
+			},
+		},
+	}
+}
+
+func generateDataVolume(template *v1.DataVolumeTemplateSpec, namespace string) *cdiv1.DataVolume {
+	dataVolume := &cdiv1.DataVolume{
+		ObjectMeta: *template.ObjectMeta.DeepCopy(),
+		Spec:       *template.Spec.DeepCopy(),
+	}
+	dataVolume.Namespace = namespace
+	return dataVolume
+}
+
+func findVolumeBackup(volumeBackups []snapshotv1.VolumeBackup, volumeName string) *snapshotv1.VolumeBackup {
+	for i := range volumeBackups {
+		if volumeBackups[i].VolumeName == volumeName {
+			return &volumeBackups[i]
+		}
+	}
+	return nil
+}
+
 func getCloneOwnerReference(cloneName string, cloneUID types.UID) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion:         clone.VirtualMachineCloneKind.GroupVersion().String(),
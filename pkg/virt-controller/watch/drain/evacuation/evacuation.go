@@ -23,6 +23,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 const (
@@ -377,7 +378,8 @@ func GenerateNewMigration(vmiName string, key string) *virtv1.VirtualMachineInst
 			GenerateName: "kubevirt-evacuation-",
 		},
 		Spec: virtv1.VirtualMachineInstanceMigrationSpec{
-			VMIName: vmiName,
+			VMIName:  vmiName,
+			Priority: pointer.P(virtv1.MigrationPrioritySystemCritical),
 		},
 	}
 }
@@ -127,6 +127,7 @@ var _ = Describe("Evacuation", func() {
 			migration := GenerateNewMigration("my-vmi", "somenode")
 			Expect(migration.Spec.VMIName).To(Equal("my-vmi"))
 			Expect(migration.Annotations[v1.EvacuationMigrationAnnotation]).To(Equal("somenode"))
+			Expect(migration.Spec.Priority).To(Equal(pointer.P(v1.MigrationPrioritySystemCritical)))
 		})
 
 	})
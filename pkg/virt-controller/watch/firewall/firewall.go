@@ -0,0 +1,339 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/trace"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
+)
+
+const (
+	defaultAddDelay         = 1 * time.Second
+	firewallPeersStatusPath = "/status/firewallPeers"
+)
+
+var virtControllerFirewallWorkQueueTracer = &traceUtils.Tracer{Threshold: time.Second}
+
+// Controller resolves the peers selected by labels in the firewall rules of the VMI interfaces
+// into the VMI status, where virt-handler picks them up. The peers of a rule are the addresses of
+// the VMIs in the same namespace matching its selector, so they are resolved again whenever a VMI
+// of the namespace comes, goes or changes its labels or addresses.
+type Controller struct {
+	clientset  kubecli.KubevirtClient
+	queue      workqueue.TypedRateLimitingInterface[string]
+	vmiIndexer cache.Indexer
+	hasSynced  func() bool
+}
+
+// NewController creates a new instance of the firewall Controller struct.
+func NewController(clientset kubecli.KubevirtClient, vmiInformer cache.SharedIndexInformer) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-firewall"},
+		),
+		vmiIndexer: vmiInformer.GetIndexer(),
+		hasSynced:  vmiInformer.HasSynced,
+	}
+
+	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVMIHandler,
+		UpdateFunc: c.updateVMIHandler,
+		DeleteFunc: c.deleteVMIHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueVMI(vmi *virtv1.VirtualMachineInstance) {
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to extract key from VMI.")
+		return
+	}
+	c.queue.AddAfter(key, defaultAddDelay)
+}
+
+// enqueueSelectingVMIs enqueues the VMIs of the namespace selecting firewall peers by labels,
+// as their peers may have changed.
+func (c *Controller) enqueueSelectingVMIs(namespace string) {
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the VMIs of namespace %s.", namespace)
+		return
+	}
+	for _, obj := range objs {
+		if vmi := obj.(*virtv1.VirtualMachineInstance); selectsPeers(vmi) {
+			c.enqueueVMI(vmi)
+		}
+	}
+}
+
+func (c *Controller) addVMIHandler(obj interface{}) {
+	c.enqueueSelectingVMIs(obj.(*virtv1.VirtualMachineInstance).Namespace)
+}
+
+func (c *Controller) updateVMIHandler(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+
+	if isPeerCandidate(oldVMI) != isPeerCandidate(currVMI) ||
+		!equality.Semantic.DeepEqual(oldVMI.Labels, currVMI.Labels) ||
+		!slices.Equal(peerAddresses(oldVMI), peerAddresses(currVMI)) {
+		c.enqueueSelectingVMIs(currVMI.Namespace)
+	}
+	if selectsPeers(currVMI) || len(currVMI.Status.FirewallPeers) > 0 {
+		c.enqueueVMI(currVMI)
+	}
+}
+
+func (c *Controller) deleteVMIHandler(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vmi %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	c.enqueueSelectingVMIs(vmi.Namespace)
+}
+
+// Run runs the passed in firewall controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting firewall controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping firewall controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	virtControllerFirewallWorkQueueTracer.StartTrace(key, "virt-controller firewall workqueue", trace.Field{Key: "Workqueue Key", Value: key})
+	defer virtControllerFirewallWorkQueueTracer.StopTrace(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VMI %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VMI %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.vmiIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if !isPeerCandidate(vmi) {
+		return nil
+	}
+
+	peers, err := c.resolvePeers(vmi)
+	if err != nil {
+		return err
+	}
+	return c.syncVMIFirewallPeers(vmi, peers)
+}
+
+func (c *Controller) resolvePeers(vmi *virtv1.VirtualMachineInstance) ([]virtv1.VirtualMachineInstanceFirewallPeers, error) {
+	candidates, err := c.listPeerCandidates(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	var peers []virtv1.VirtualMachineInstanceFirewallPeers
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if !ifaceSelectsPeers(iface) {
+			continue
+		}
+		ingressPeers, err := rulesPeers(iface.Firewall.Ingress, candidates)
+		if err != nil {
+			return nil, err
+		}
+		egressPeers, err := rulesPeers(iface.Firewall.Egress, candidates)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, virtv1.VirtualMachineInstanceFirewallPeers{
+			Name:    iface.Name,
+			Ingress: ingressPeers,
+			Egress:  egressPeers,
+		})
+	}
+	return peers, nil
+}
+
+// listPeerCandidates returns the other VMIs of the namespace which can be selected as peers.
+func (c *Controller) listPeerCandidates(vmi *virtv1.VirtualMachineInstance) ([]*virtv1.VirtualMachineInstance, error) {
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		candidate := obj.(*virtv1.VirtualMachineInstance)
+		if candidate.Name != vmi.Name && isPeerCandidate(candidate) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates, nil
+}
+
+// rulesPeers returns the peers of each allow rule, in the order of the rules, when any
+// of the rules selects its peers by labels.
+func rulesPeers(firewallRules *virtv1.FirewallRules, candidates []*virtv1.VirtualMachineInstance) ([]virtv1.FirewallRulePeers, error) {
+	if !selectsRulePeers(firewallRules) {
+		return nil, nil
+	}
+	peers := make([]virtv1.FirewallRulePeers, len(firewallRules.Allow))
+	for idx, rule := range firewallRules.Allow {
+		if rule.PeerSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(rule.PeerSelector)
+		if err != nil {
+			return nil, err
+		}
+		var addresses []string
+		for _, candidate := range candidates {
+			if selector.Matches(labels.Set(candidate.Labels)) {
+				addresses = append(addresses, peerAddresses(candidate)...)
+			}
+		}
+		slices.Sort(addresses)
+		peers[idx].Addresses = slices.Compact(addresses)
+	}
+	return peers, nil
+}
+
+func isPeerCandidate(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.DeletionTimestamp == nil && !vmi.IsFinal()
+}
+
+// peerAddresses returns the addresses the VMI reports on its interfaces.
+func peerAddresses(vmi *virtv1.VirtualMachineInstance) []string {
+	var addresses []string
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		addresses = append(addresses, ifaceStatus.IPs...)
+		if len(ifaceStatus.IPs) == 0 && ifaceStatus.IP != "" {
+			addresses = append(addresses, ifaceStatus.IP)
+		}
+	}
+	return addresses
+}
+
+func selectsPeers(vmi *virtv1.VirtualMachineInstance) bool {
+	return slices.ContainsFunc(vmi.Spec.Domain.Devices.Interfaces, ifaceSelectsPeers)
+}
+
+func ifaceSelectsPeers(iface virtv1.Interface) bool {
+	return iface.Firewall != nil && (selectsRulePeers(iface.Firewall.Ingress) || selectsRulePeers(iface.Firewall.Egress))
+}
+
+func selectsRulePeers(firewallRules *virtv1.FirewallRules) bool {
+	return firewallRules != nil && slices.ContainsFunc(firewallRules.Allow, func(rule virtv1.FirewallRule) bool {
+		return rule.PeerSelector != nil
+	})
+}
+
+func (c *Controller) syncVMIFirewallPeers(vmi *virtv1.VirtualMachineInstance, peers []virtv1.VirtualMachineInstanceFirewallPeers) error {
+	if equality.Semantic.DeepEqual(peers, vmi.Status.FirewallPeers) {
+		return nil
+	}
+
+	patchSet := patch.New()
+	switch {
+	case len(vmi.Status.FirewallPeers) == 0:
+		patchSet.AddOption(patch.WithAdd(firewallPeersStatusPath, peers))
+	case len(peers) == 0:
+		patchSet.AddOption(
+			patch.WithTest(firewallPeersStatusPath, vmi.Status.FirewallPeers),
+			patch.WithRemove(firewallPeersStatusPath),
+		)
+	default:
+		patchSet.AddOption(
+			patch.WithTest(firewallPeersStatusPath, vmi.Status.FirewallPeers),
+			patch.WithReplace(firewallPeersStatusPath, peers),
+		)
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,210 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package firewall
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const testNetworkName = "blue"
+
+var _ = Describe("Firewall controller", func() {
+	var (
+		controller     *Controller
+		fakeVirtClient *kubevirtfake.Clientset
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+
+		var err error
+		controller, err = NewController(virtClient, vmiInformer)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
+			fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	addVMI := func(vmi *virtv1.VirtualMachineInstance) {
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+	}
+
+	getVMI := func(name string) *virtv1.VirtualMachineInstance {
+		vmi, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi
+	}
+
+	execute := func(name string) {
+		Expect(controller.execute(metav1.NamespaceDefault + "/" + name)).To(Succeed())
+	}
+
+	It("should report the addresses of the VMIs selected by the firewall rules", func() {
+		addVMI(newVMIWithFirewall("vmi1", &virtv1.InterfaceFirewall{
+			Ingress: &virtv1.FirewallRules{Allow: []virtv1.FirewallRule{
+				{Networks: []string{"10.0.0.0/8"}},
+				{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			}},
+			Egress: &virtv1.FirewallRules{Allow: []virtv1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			}},
+		}))
+		addVMI(newPeerVMI("web1", "web", "10.244.0.11", "fd10:244::11"))
+		addVMI(newPeerVMI("web2", "web", "10.244.0.12"))
+		addVMI(newPeerVMI("db", "db", "10.244.0.20"))
+		addVMI(newPeerVMI("other", "other", "10.244.0.30"))
+
+		execute("vmi1")
+
+		Expect(getVMI("vmi1").Status.FirewallPeers).To(ConsistOf(virtv1.VirtualMachineInstanceFirewallPeers{
+			Name: testNetworkName,
+			Ingress: []virtv1.FirewallRulePeers{
+				{},
+				{Addresses: []string{"10.244.0.11", "10.244.0.12", "fd10:244::11"}},
+			},
+			Egress: []virtv1.FirewallRulePeers{
+				{Addresses: []string{"10.244.0.20"}},
+			},
+		}))
+	})
+
+	It("should not select the VMI itself nor the VMIs which are gone", func() {
+		vmi := newVMIWithFirewall("vmi1", &virtv1.InterfaceFirewall{
+			Ingress: &virtv1.FirewallRules{Allow: []virtv1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+			}},
+		})
+		vmi.Labels = map[string]string{"app": "web"}
+		addVMI(vmi)
+		stopped := newPeerVMI("stopped", "web", "10.244.0.11")
+		stopped.Status.Phase = virtv1.Succeeded
+		addVMI(stopped)
+		deleting := newPeerVMI("deleting", "web", "10.244.0.12")
+		deleting.DeletionTimestamp = pointer.P(metav1.Now())
+		addVMI(deleting)
+
+		execute("vmi1")
+
+		Expect(getVMI("vmi1").Status.FirewallPeers).To(ConsistOf(virtv1.VirtualMachineInstanceFirewallPeers{
+			Name:    testNetworkName,
+			Ingress: []virtv1.FirewallRulePeers{{}},
+		}))
+	})
+
+	It("should replace the reported peers when they change", func() {
+		vmi := newVMIWithFirewall("vmi1", &virtv1.InterfaceFirewall{
+			Egress: &virtv1.FirewallRules{Allow: []virtv1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}},
+			}},
+		})
+		vmi.Status.FirewallPeers = []virtv1.VirtualMachineInstanceFirewallPeers{{
+			Name:   testNetworkName,
+			Egress: []virtv1.FirewallRulePeers{{Addresses: []string{"10.244.0.20"}}},
+		}}
+		addVMI(vmi)
+		addVMI(newPeerVMI("db", "db", "10.244.0.21"))
+
+		execute("vmi1")
+
+		Expect(getVMI("vmi1").Status.FirewallPeers).To(ConsistOf(virtv1.VirtualMachineInstanceFirewallPeers{
+			Name:   testNetworkName,
+			Egress: []virtv1.FirewallRulePeers{{Addresses: []string{"10.244.0.21"}}},
+		}))
+	})
+
+	It("should remove the reported peers when the VMI no longer selects peers", func() {
+		vmi := newVMIWithFirewall("vmi1", &virtv1.InterfaceFirewall{})
+		vmi.Status.FirewallPeers = []virtv1.VirtualMachineInstanceFirewallPeers{{
+			Name:   testNetworkName,
+			Egress: []virtv1.FirewallRulePeers{{Addresses: []string{"10.244.0.20"}}},
+		}}
+		addVMI(vmi)
+
+		execute("vmi1")
+
+		Expect(getVMI("vmi1").Status.FirewallPeers).To(BeEmpty())
+	})
+
+	It("should enqueue the VMIs selecting peers when a VMI of the namespace changes its addresses", func() {
+		addVMI(newVMIWithFirewall("vmi1", &virtv1.InterfaceFirewall{
+			Ingress: &virtv1.FirewallRules{Allow: []virtv1.FirewallRule{
+				{PeerSelector: &metav1.LabelSelector{}},
+			}},
+		}))
+		addVMI(newVMIWithFirewall("vmi2", &virtv1.InterfaceFirewall{}))
+		old := newPeerVMI("web", "web", "10.244.0.11")
+		curr := newPeerVMI("web", "web", "10.244.0.12")
+
+		controller.updateVMIHandler(old, curr)
+
+		Eventually(controller.queue.Len).WithTimeout(2 * defaultAddDelay).WithPolling(100 * time.Millisecond).Should(Equal(1))
+		key, _ := controller.queue.Get()
+		Expect(key).To(Equal(metav1.NamespaceDefault + "/vmi1"))
+	})
+})
+
+func newVMIWithFirewall(name string, firewall *virtv1.InterfaceFirewall) *virtv1.VirtualMachineInstance {
+	return libvmi.New(
+		libvmi.WithName(name),
+		libvmi.WithNamespace(metav1.NamespaceDefault),
+		libvmi.WithInterface(virtv1.Interface{
+			Name:                   testNetworkName,
+			InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}},
+			Firewall:               firewall,
+		}),
+		libvmi.WithNetwork(&virtv1.Network{
+			Name:          testNetworkName,
+			NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: "blue-nad"}},
+		}),
+	)
+}
+
+func newPeerVMI(name, app string, ips ...string) *virtv1.VirtualMachineInstance {
+	return libvmi.New(
+		libvmi.WithName(name),
+		libvmi.WithNamespace(metav1.NamespaceDefault),
+		libvmi.WithLabel("app", app),
+		status.WithStatus(status.New(
+			status.WithPhase(virtv1.Running),
+			status.WithInterfaceStatus(virtv1.VirtualMachineInstanceNetworkInterface{Name: testNetworkName, IPs: ips}),
+		)),
+	)
+}
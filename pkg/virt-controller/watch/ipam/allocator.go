@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	ipamv1 "kubevirt.io/api/ipam/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/network/netmachinery"
+)
+
+var errPoolExhausted = errors.New("no free address left in the IP pool")
+
+// allocator hands out the free host addresses of an IP pool subnet.
+// The network and broadcast addresses, the gateway, the excluded ranges
+// and the already allocated addresses are never handed out.
+type allocator struct {
+	subnet   *net.IPNet
+	excluded []*net.IPNet
+	reserved map[string]struct{}
+}
+
+func newAllocator(spec ipamv1.IPPoolSpec, allocations []ipamv1.IPPoolAllocation) (*allocator, error) {
+	_, subnet, err := net.ParseCIDR(spec.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q: %v", spec.CIDR, err)
+	}
+	if subnet.IP.To4() == nil {
+		return nil, fmt.Errorf("CIDR %q is not an IPv4 subnet", spec.CIDR)
+	}
+
+	a := &allocator{subnet: subnet, reserved: map[string]struct{}{}}
+
+	if spec.Gateway != "" {
+		gateway := net.ParseIP(spec.Gateway)
+		if gateway == nil {
+			return nil, fmt.Errorf("invalid gateway %q", spec.Gateway)
+		}
+		a.reserved[gateway.String()] = struct{}{}
+	}
+
+	for _, exclude := range spec.Exclude {
+		excludedNet, err := parseIPOrCIDR(exclude)
+		if err != nil {
+			return nil, err
+		}
+		a.excluded = append(a.excluded, excludedNet)
+	}
+
+	for _, allocation := range allocations {
+		a.reserved[allocation.Address] = struct{}{}
+	}
+	return a, nil
+}
+
+func parseIPOrCIDR(s string) (*net.IPNet, error) {
+	if _, ipNet, err := net.ParseCIDR(s); err == nil {
+		return ipNet, nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid excluded address %q", s)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(net.IPv4len*8, net.IPv4len*8)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(net.IPv6len*8, net.IPv6len*8)}, nil
+}
+
+// allocate returns the lowest free address of the subnet and reserves it.
+func (a *allocator) allocate() (string, error) {
+	ip := make(net.IP, net.IPv4len)
+	copy(ip, a.subnet.IP.To4())
+	broadcast := a.broadcast()
+
+	for netmachinery.NextIP(ip); a.subnet.Contains(ip) && !ip.Equal(broadcast); netmachinery.NextIP(ip) {
+		address := ip.String()
+		if _, reserved := a.reserved[address]; reserved || a.isExcluded(ip) {
+			continue
+		}
+		a.reserved[address] = struct{}{}
+		return address, nil
+	}
+	return "", errPoolExhausted
+}
+
+func (a *allocator) broadcast() net.IP {
+	broadcast := make(net.IP, net.IPv4len)
+	for i, b := range a.subnet.IP.To4() {
+		broadcast[i] = b | ^a.subnet.Mask[len(a.subnet.Mask)-net.IPv4len+i]
+	}
+	return broadcast
+}
+
+func (a *allocator) isExcluded(ip net.IP) bool {
+	for _, excludedNet := range a.excluded {
+		if excludedNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixLength returns the prefix length of the pool subnet.
+func (a *allocator) prefixLength() int {
+	ones, _ := a.subnet.Mask.Size()
+	return ones
+}
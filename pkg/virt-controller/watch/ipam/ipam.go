@@ -0,0 +1,424 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/trace"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	ipamv1 "kubevirt.io/api/ipam/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
+)
+
+const (
+	InvalidIPPoolReason             = "InvalidIPPool"
+	FailedIPAllocationReason        = "FailedIPAllocation"
+	SuccessfulIPAllocationReason    = "SuccessfulIPAllocation"
+	SuccessfulIPReleaseReason       = "SuccessfulIPRelease"
+	FailedUpdateIPAllocationsReason = "FailedUpdateIPAllocations"
+)
+
+const (
+	defaultAddDelay         = 1 * time.Second
+	ipAllocationsStatusPath = "/status/ipAllocations"
+)
+
+var virtControllerIPAMWorkQueueTracer = &traceUtils.Tracer{Threshold: time.Second}
+
+// Controller allocates addresses from IPPools to the bridge-bound interfaces
+// of the VMIs referencing them. Allocations are keyed by the VM name and the
+// network name, so a VM keeps its address across restarts until it is deleted
+// or stops referencing the pool.
+type Controller struct {
+	clientset     kubecli.KubevirtClient
+	queue         workqueue.TypedRateLimitingInterface[string]
+	ipPoolIndexer cache.Indexer
+	vmiIndexer    cache.Indexer
+	vmStore       cache.Store
+	recorder      record.EventRecorder
+	hasSynced     func() bool
+}
+
+// NewController creates a new instance of the IPAM Controller struct.
+func NewController(clientset kubecli.KubevirtClient,
+	vmiInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	ipPoolInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-ipam"},
+		),
+		ipPoolIndexer: ipPoolInformer.GetIndexer(),
+		vmiIndexer:    vmiInformer.GetIndexer(),
+		vmStore:       vmInformer.GetStore(),
+		recorder:      recorder,
+	}
+
+	c.hasSynced = func() bool {
+		return ipPoolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced()
+	}
+
+	_, err := ipPoolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueIPPool,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueIPPool(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVMIHandler,
+		UpdateFunc: c.updateVMIHandler,
+		DeleteFunc: c.deleteVMIHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: c.deleteVMHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueIPPool(obj interface{}) {
+	ipPool := obj.(*ipamv1.IPPool)
+	key, err := controller.KeyFunc(ipPool)
+	if err != nil {
+		log.Log.Object(ipPool).Reason(err).Error("Failed to extract key from IP pool.")
+		return
+	}
+	c.queue.AddAfter(key, defaultAddDelay)
+}
+
+func (c *Controller) enqueueReferencedIPPools(vmi *virtv1.VirtualMachineInstance) {
+	for _, poolName := range referencedIPPools(vmi.Spec.Networks) {
+		c.queue.AddAfter(controller.NamespacedKey(vmi.Namespace, poolName), defaultAddDelay)
+	}
+}
+
+func (c *Controller) addVMIHandler(obj interface{}) {
+	c.enqueueReferencedIPPools(obj.(*virtv1.VirtualMachineInstance))
+}
+
+func (c *Controller) updateVMIHandler(old, curr interface{}) {
+	c.enqueueReferencedIPPools(old.(*virtv1.VirtualMachineInstance))
+	c.enqueueReferencedIPPools(curr.(*virtv1.VirtualMachineInstance))
+}
+
+func (c *Controller) deleteVMIHandler(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vmi %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	c.enqueueReferencedIPPools(vmi)
+}
+
+func (c *Controller) deleteVMHandler(obj interface{}) {
+	vm, ok := obj.(*virtv1.VirtualMachine)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vm, ok = tombstone.Obj.(*virtv1.VirtualMachine)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vm %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+	if vm.Spec.Template == nil {
+		return
+	}
+	for _, poolName := range referencedIPPools(vm.Spec.Template.Spec.Networks) {
+		c.queue.AddAfter(controller.NamespacedKey(vm.Namespace, poolName), defaultAddDelay)
+	}
+}
+
+func referencedIPPools(networks []virtv1.Network) []string {
+	var poolNames []string
+	for _, network := range networks {
+		if network.Multus != nil && network.Multus.IPPool != "" && !slices.Contains(poolNames, network.Multus.IPPool) {
+			poolNames = append(poolNames, network.Multus.IPPool)
+		}
+	}
+	return poolNames
+}
+
+func referencesIPPool(networks []virtv1.Network, networkName, poolName string) bool {
+	for _, network := range networks {
+		if network.Name == networkName {
+			return network.Multus != nil && network.Multus.IPPool == poolName
+		}
+	}
+	return false
+}
+
+// Run runs the passed in IPAM controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting ipam controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping ipam controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	virtControllerIPAMWorkQueueTracer.StartTrace(key, "virt-controller IPAM workqueue", trace.Field{Key: "Workqueue Key", Value: key})
+	defer virtControllerIPAMWorkQueueTracer.StopTrace(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing IP pool %v", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed IP pool %v", key)
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.ipPoolIndexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	ipPool := obj.(*ipamv1.IPPool)
+	if ipPool.DeletionTimestamp != nil {
+		return nil
+	}
+	logger := log.Log.Object(ipPool)
+
+	allocations := c.retainedAllocations(ipPool)
+
+	ipAllocator, err := newAllocator(ipPool.Spec, allocations)
+	if err != nil {
+		logger.Reason(err).Error("Invalid IP pool, will not re-enqueue.")
+		c.recorder.Eventf(ipPool, "Warning", InvalidIPPoolReason, "Invalid IP pool: %v", err)
+		return nil
+	}
+
+	vmis, err := c.listVMIsReferencingIPPool(ipPool)
+	if err != nil {
+		return err
+	}
+
+	for _, vmi := range vmis {
+		for _, network := range vmi.Spec.Networks {
+			if !referencesIPPool(vmi.Spec.Networks, network.Name, ipPool.Name) ||
+				lookupAllocation(allocations, vmi.Name, network.Name) != nil {
+				continue
+			}
+			address, err := ipAllocator.allocate()
+			if err != nil {
+				c.recorder.Eventf(vmi, "Warning", FailedIPAllocationReason,
+					"Failed to allocate an address from IP pool %s for network %s: %v", ipPool.Name, network.Name, err)
+				continue
+			}
+			allocations = append(allocations, ipamv1.IPPoolAllocation{
+				VirtualMachine: vmi.Name,
+				Interface:      network.Name,
+				Address:        address,
+			})
+			c.recorder.Eventf(vmi, "Normal", SuccessfulIPAllocationReason,
+				"Allocated address %s from IP pool %s for network %s", address, ipPool.Name, network.Name)
+		}
+	}
+
+	if !equality.Semantic.DeepEqual(allocations, ipPool.Status.Allocations) {
+		ipPoolCopy := ipPool.DeepCopy()
+		ipPoolCopy.Status.Allocations = allocations
+		if _, err := c.clientset.IPPool(ipPool.Namespace).UpdateStatus(context.Background(), ipPoolCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	for _, vmi := range vmis {
+		if err := c.syncVMIAllocations(vmi, ipPool, ipAllocator.prefixLength(), allocations); err != nil {
+			c.recorder.Eventf(vmi, "Warning", FailedUpdateIPAllocationsReason, "Failed to update IP allocations: %v", err)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// retainedAllocations returns the pool allocations which are still in use.
+// An allocation is released when neither the VM nor the VMI it was made for
+// exist anymore, or when they stopped referencing the pool on that network.
+func (c *Controller) retainedAllocations(ipPool *ipamv1.IPPool) []ipamv1.IPPoolAllocation {
+	var allocations []ipamv1.IPPoolAllocation
+	for _, allocation := range ipPool.Status.Allocations {
+		if c.isAllocationInUse(ipPool, allocation) {
+			allocations = append(allocations, allocation)
+			continue
+		}
+		c.recorder.Eventf(ipPool, "Normal", SuccessfulIPReleaseReason,
+			"Released address %s of %s network %s", allocation.Address, allocation.VirtualMachine, allocation.Interface)
+	}
+	return allocations
+}
+
+func (c *Controller) isAllocationInUse(ipPool *ipamv1.IPPool, allocation ipamv1.IPPoolAllocation) bool {
+	key := controller.NamespacedKey(ipPool.Namespace, allocation.VirtualMachine)
+
+	if obj, exists, _ := c.vmiIndexer.GetByKey(key); exists {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if referencesIPPool(vmi.Spec.Networks, allocation.Interface, ipPool.Name) {
+			return true
+		}
+	}
+	if obj, exists, _ := c.vmStore.GetByKey(key); exists {
+		vm := obj.(*virtv1.VirtualMachine)
+		if vm.Spec.Template != nil && referencesIPPool(vm.Spec.Template.Spec.Networks, allocation.Interface, ipPool.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) listVMIsReferencingIPPool(ipPool *ipamv1.IPPool) ([]*virtv1.VirtualMachineInstance, error) {
+	objs, err := c.vmiIndexer.ByIndex(cache.NamespaceIndex, ipPool.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp == nil && slices.Contains(referencedIPPools(vmi.Spec.Networks), ipPool.Name) {
+			vmis = append(vmis, vmi)
+		}
+	}
+	return vmis, nil
+}
+
+func lookupAllocation(allocations []ipamv1.IPPoolAllocation, vmName, networkName string) *ipamv1.IPPoolAllocation {
+	for i := range allocations {
+		if allocations[i].VirtualMachine == vmName && allocations[i].Interface == networkName {
+			return &allocations[i]
+		}
+	}
+	return nil
+}
+
+// syncVMIAllocations reflects the pool allocations of a VMI in its status,
+// keeping the entries belonging to other pools untouched.
+func (c *Controller) syncVMIAllocations(vmi *virtv1.VirtualMachineInstance, ipPool *ipamv1.IPPool, prefixLength int, allocations []ipamv1.IPPoolAllocation) error {
+	var vmiAllocations []virtv1.VirtualMachineInstanceIPAllocation
+	for _, vmiAllocation := range vmi.Status.IPAllocations {
+		if vmiAllocation.IPPool != ipPool.Name {
+			vmiAllocations = append(vmiAllocations, vmiAllocation)
+		}
+	}
+	for _, network := range vmi.Spec.Networks {
+		if !referencesIPPool(vmi.Spec.Networks, network.Name, ipPool.Name) {
+			continue
+		}
+		allocation := lookupAllocation(allocations, vmi.Name, network.Name)
+		if allocation == nil {
+			continue
+		}
+		vmiAllocations = append(vmiAllocations, virtv1.VirtualMachineInstanceIPAllocation{
+			Name:    network.Name,
+			IPPool:  ipPool.Name,
+			Address: fmt.Sprintf("%s/%d", allocation.Address, prefixLength),
+			Gateway: ipPool.Spec.Gateway,
+		})
+	}
+
+	if equality.Semantic.DeepEqual(vmiAllocations, vmi.Status.IPAllocations) {
+		return nil
+	}
+
+	patchSet := patch.New()
+	if len(vmi.Status.IPAllocations) == 0 {
+		patchSet.AddOption(patch.WithAdd(ipAllocationsStatusPath, vmiAllocations))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest(ipAllocationsStatusPath, vmi.Status.IPAllocations),
+			patch.WithReplace(ipAllocationsStatusPath, vmiAllocations),
+		)
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,236 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ipam
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	ipamv1 "kubevirt.io/api/ipam/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testPoolName    = "test-pool"
+	testNetworkName = "blue"
+)
+
+var _ = Describe("IPAM controller", func() {
+	var (
+		controller     *Controller
+		recorder       *record.FakeRecorder
+		fakeVirtClient *kubevirtfake.Clientset
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		ipPoolInformer, _ := testutils.NewFakeInformerFor(&ipamv1.IPPool{})
+		recorder = record.NewFakeRecorder(100)
+
+		var err error
+		controller, err = NewController(virtClient, vmiInformer, vmInformer, ipPoolInformer, recorder)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(
+			fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().IPPool(metav1.NamespaceDefault).Return(
+			fakeVirtClient.IpamV1alpha1().IPPools(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	addIPPool := func(ipPool *ipamv1.IPPool) {
+		_, err := fakeVirtClient.IpamV1alpha1().IPPools(ipPool.Namespace).Create(context.Background(), ipPool, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.ipPoolIndexer.Add(ipPool)).To(Succeed())
+	}
+
+	addVMI := func(vmi *virtv1.VirtualMachineInstance) {
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+	}
+
+	getIPPool := func() *ipamv1.IPPool {
+		ipPool, err := fakeVirtClient.IpamV1alpha1().IPPools(metav1.NamespaceDefault).Get(context.Background(), testPoolName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return ipPool
+	}
+
+	getVMI := func(name string) *virtv1.VirtualMachineInstance {
+		vmi, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi
+	}
+
+	execute := func() {
+		Expect(controller.execute(metav1.NamespaceDefault + "/" + testPoolName)).To(Succeed())
+	}
+
+	It("should allocate the first free address to a VMI referencing the pool", func() {
+		addIPPool(newIPPool("192.168.10.0/24", "192.168.10.1", "192.168.10.2"))
+		addVMI(newVMIWithIPPool("vmi1"))
+
+		execute()
+
+		Expect(getIPPool().Status.Allocations).To(ConsistOf(ipamv1.IPPoolAllocation{
+			VirtualMachine: "vmi1", Interface: testNetworkName, Address: "192.168.10.3",
+		}))
+		Expect(getVMI("vmi1").Status.IPAllocations).To(ConsistOf(virtv1.VirtualMachineInstanceIPAllocation{
+			Name: testNetworkName, IPPool: testPoolName, Address: "192.168.10.3/24", Gateway: "192.168.10.1",
+		}))
+		Expect(recorder.Events).To(Receive(ContainSubstring(SuccessfulIPAllocationReason)))
+	})
+
+	It("should keep the existing allocation of a VMI", func() {
+		ipPool := newIPPool("192.168.10.0/24", "", "")
+		ipPool.Status.Allocations = []ipamv1.IPPoolAllocation{
+			{VirtualMachine: "vmi1", Interface: testNetworkName, Address: "192.168.10.7"},
+		}
+		addIPPool(ipPool)
+		addVMI(newVMIWithIPPool("vmi1"))
+		addVMI(newVMIWithIPPool("vmi2"))
+
+		execute()
+
+		Expect(getIPPool().Status.Allocations).To(ConsistOf(
+			ipamv1.IPPoolAllocation{VirtualMachine: "vmi1", Interface: testNetworkName, Address: "192.168.10.7"},
+			ipamv1.IPPoolAllocation{VirtualMachine: "vmi2", Interface: testNetworkName, Address: "192.168.10.1"},
+		))
+		Expect(getVMI("vmi1").Status.IPAllocations).To(ConsistOf(virtv1.VirtualMachineInstanceIPAllocation{
+			Name: testNetworkName, IPPool: testPoolName, Address: "192.168.10.7/24",
+		}))
+	})
+
+	It("should release allocations of VMs which no longer exist", func() {
+		ipPool := newIPPool("192.168.10.0/24", "", "")
+		ipPool.Status.Allocations = []ipamv1.IPPoolAllocation{
+			{VirtualMachine: "gone", Interface: testNetworkName, Address: "192.168.10.1"},
+		}
+		addIPPool(ipPool)
+
+		execute()
+
+		Expect(getIPPool().Status.Allocations).To(BeEmpty())
+		Expect(recorder.Events).To(Receive(ContainSubstring(SuccessfulIPReleaseReason)))
+	})
+
+	It("should keep allocations of stopped VMs which still reference the pool", func() {
+		ipPool := newIPPool("192.168.10.0/24", "", "")
+		ipPool.Status.Allocations = []ipamv1.IPPoolAllocation{
+			{VirtualMachine: "stopped", Interface: testNetworkName, Address: "192.168.10.1"},
+		}
+		addIPPool(ipPool)
+		vm := libvmi.NewVirtualMachine(newVMIWithIPPool("stopped"))
+		Expect(controller.vmStore.Add(vm)).To(Succeed())
+
+		execute()
+
+		Expect(getIPPool().Status.Allocations).To(HaveLen(1))
+	})
+
+	It("should emit an event when the pool is exhausted", func() {
+		addIPPool(newIPPool("192.168.10.0/30", "192.168.10.1", "192.168.10.2"))
+		addVMI(newVMIWithIPPool("vmi1"))
+
+		execute()
+
+		Expect(getIPPool().Status.Allocations).To(BeEmpty())
+		Expect(getVMI("vmi1").Status.IPAllocations).To(BeEmpty())
+		Expect(recorder.Events).To(Receive(ContainSubstring(FailedIPAllocationReason)))
+	})
+
+	It("should emit an event and not re-enqueue an invalid pool", func() {
+		addIPPool(newIPPool("not-a-cidr", "", ""))
+		addVMI(newVMIWithIPPool("vmi1"))
+
+		execute()
+
+		Expect(recorder.Events).To(Receive(ContainSubstring(InvalidIPPoolReason)))
+		Expect(getVMI("vmi1").Status.IPAllocations).To(BeEmpty())
+	})
+})
+
+var _ = Describe("IP pool allocator", func() {
+	It("should skip the network, broadcast, gateway and excluded addresses", func() {
+		a, err := newAllocator(ipamv1.IPPoolSpec{
+			CIDR:    "10.0.0.0/29",
+			Gateway: "10.0.0.1",
+			Exclude: []string{"10.0.0.2", "10.0.0.4/31"},
+		}, []ipamv1.IPPoolAllocation{{Address: "10.0.0.3"}})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(a.allocate()).To(Equal("10.0.0.6"))
+		_, err = a.allocate()
+		Expect(err).To(MatchError(errPoolExhausted))
+	})
+
+	DescribeTable("should reject an invalid spec", func(spec ipamv1.IPPoolSpec) {
+		_, err := newAllocator(spec, nil)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("with a malformed CIDR", ipamv1.IPPoolSpec{CIDR: "10.0.0.0"}),
+		Entry("with an IPv6 CIDR", ipamv1.IPPoolSpec{CIDR: "fd00::/64"}),
+		Entry("with a malformed gateway", ipamv1.IPPoolSpec{CIDR: "10.0.0.0/24", Gateway: "10.0.0"}),
+		Entry("with a malformed exclude", ipamv1.IPPoolSpec{CIDR: "10.0.0.0/24", Exclude: []string{"foo"}}),
+	)
+})
+
+func newIPPool(cidr, gateway, exclude string) *ipamv1.IPPool {
+	ipPool := &ipamv1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: testPoolName, Namespace: metav1.NamespaceDefault},
+		Spec:       ipamv1.IPPoolSpec{CIDR: cidr, Gateway: gateway},
+	}
+	if exclude != "" {
+		ipPool.Spec.Exclude = []string{exclude}
+	}
+	return ipPool
+}
+
+func newVMIWithIPPool(name string) *virtv1.VirtualMachineInstance {
+	vmi := libvmi.New(
+		libvmi.WithName(name),
+		libvmi.WithNamespace(metav1.NamespaceDefault),
+		libvmi.WithInterface(virtv1.Interface{
+			Name:                   testNetworkName,
+			InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Bridge: &virtv1.InterfaceBridge{}},
+		}),
+		libvmi.WithNetwork(&virtv1.Network{
+			Name: testNetworkName,
+			NetworkSource: virtv1.NetworkSource{
+				Multus: &virtv1.MultusNetwork{NetworkName: "blue-nad", IPPool: testPoolName},
+			},
+		}),
+	)
+	return vmi
+}
@@ -69,6 +69,7 @@ const (
 	successfulUpdatePodDisruptionBudgetReason = "SuccessfulUpdate"
 	failedUpdatePodDisruptionBudgetReason     = "FailedUpdate"
 	failedGetAttractionPodsFmt                = "failed to get attachment pods: %v"
+	invalidMigrationStreamOptionsReason       = "InvalidMigrationStreamOptions"
 )
 
 // This is the timeout used when a target pod is stuck in
@@ -119,6 +120,9 @@ type Controller struct {
 	clusterConfig        *virtconfig.ClusterConfig
 	hasSynced            func() bool
 
+	// the pending migrations in the order they have to be started
+	pendingMigrations *pendingMigrations
+
 	// the set of cancelled migrations before being handed off to virt-handler.
 	// the map keys are migration keys
 	handOffLock sync.Mutex
@@ -166,6 +170,7 @@ func NewController(templateService services.TemplateService,
 		migrationStartLock:   &sync.Mutex{},
 		clusterConfig:        clusterConfig,
 		handOffMap:           make(map[string]struct{}),
+		pendingMigrations:    newPendingMigrations(),
 
 		unschedulablePendingTimeoutSeconds: defaultUnschedulablePendingTimeoutSeconds,
 		catchAllPendingTimeoutSeconds:      defaultCatchAllPendingTimeoutSeconds,
@@ -494,7 +499,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		if err != nil {
 			return err
 		}
-	} else if podExists && controller.PodIsDown(pod) {
+	} else if podExists && controller.PodIsDown(pod) && !isPreemptedTargetPod(migration, pod) {
 		err := c.interruptMigration(migrationCopy, vmi)
 		if err != nil {
 			return err
@@ -554,6 +559,7 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 		if err != nil {
 			return err
 		}
+		setMigrationProgress(migrationCopy, vmi)
 	}
 
 	if migrationCopy.Status.Phase == virtv1.MigrationFailed {
@@ -582,6 +588,32 @@ func (c *Controller) updateStatus(migration *virtv1.VirtualMachineInstanceMigrat
 	return nil
 }
 
+// isPreemptedTargetPod returns true if the pod is the deleted target pod of a migration which was moved back to pending
+// by a preemption. Its shutdown does not interrupt the migration.
+func isPreemptedTargetPod(migration *virtv1.VirtualMachineInstanceMigration, pod *k8sv1.Pod) bool {
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	return pod.DeletionTimestamp != nil &&
+		migration.Status.Phase == virtv1.MigrationPending &&
+		conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationPreempted)
+}
+
+// setMigrationProgress mirrors the progress of a running migration, as reported by the source node, to the migration object.
+// The full migration state is stored once the migration is final.
+func setMigrationProgress(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) {
+	if migration.IsFinal() ||
+		vmi.Status.MigrationState == nil ||
+		vmi.Status.MigrationState.MigrationUID != migration.UID ||
+		vmi.Status.MigrationState.Progress == nil {
+		return
+	}
+	if migration.Status.MigrationState == nil {
+		migration.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+			MigrationUID: migration.UID,
+		}
+	}
+	migration.Status.MigrationState.Progress = vmi.Status.MigrationState.Progress.DeepCopy()
+}
+
 func (c *Controller) processMigrationPhase(
 	migration, migrationCopy *virtv1.VirtualMachineInstanceMigration,
 	pod, attachmentPod *k8sv1.Pod,
@@ -610,7 +642,7 @@ func (c *Controller) processMigrationPhase(
 			log.Log.Object(migration).Error("Migration object ont eligible for migration because another job is in progress")
 		}
 	case virtv1.MigrationPending:
-		if pod != nil {
+		if pod != nil && pod.DeletionTimestamp == nil {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil {
 					migrationCopy.Status.Phase = virtv1.MigrationScheduling
@@ -630,6 +662,9 @@ func (c *Controller) processMigrationPhase(
 		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
 			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota)
 		}
+		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationPreempted) {
+			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationPreempted)
+		}
 		if controller.IsPodReady(pod) {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil && controller.IsPodReady(attachmentPod) {
@@ -995,6 +1030,17 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
 
+	if causes := migrationsutil.DropInvalidStreamOptions(vmiCopy.Status.MigrationState.MigrationConfiguration); len(causes) > 0 {
+		var messages []string
+		for _, cause := range causes {
+			messages = append(messages, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+		}
+		reason := fmt.Sprintf("the migration stream options are dropped, as the effective migration configuration is invalid: %s",
+			strings.Join(messages, ", "))
+		log.Log.Object(migration).Warning(reason)
+		c.recorder.Event(migration, k8sv1.EventTypeWarning, invalidMigrationStreamOptionsReason, reason)
+	}
+
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
 		if err != nil {
@@ -1100,10 +1146,14 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 	}
 
 	// XXX: Make this configurable, think about limit per node, bandwidth per migration, and so on.
-	if len(runningMigrations) >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) {
+	parallelMigrations := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
+	if len(runningMigrations) >= parallelMigrations {
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel migration count [%d] is currently at the global cluster limit.", vmi.Namespace, vmi.Name, len(runningMigrations))
+		if err := c.preemptLowerPriorityMigration(migration, runningMigrations); err != nil {
+			return err
+		}
 		// The controller is busy with active migrations, mark ourselves as low priority to give more cycles to those
-		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: lowPriority, After: 5 * time.Second}, key)
+		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: pendingQueuePriority(migration), After: 5 * time.Second}, key)
 		return nil
 	}
 
@@ -1118,7 +1168,14 @@ func (c *Controller) handleTargetPodCreation(key string, migration *virtv1.Virtu
 		// XXX: Make this configurable, think about inbound migration limit, bandwidth per migration, and so on.
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations)
 		// The controller is busy with active migrations, mark ourselves as low priority to give more cycles to those
-		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: lowPriority, After: 5 * time.Second}, key)
+		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: pendingQueuePriority(migration), After: 5 * time.Second}, key)
+		return nil
+	}
+
+	freeSlots := parallelMigrations - len(runningMigrations)
+	if startingBefore := c.countPendingMigrationsStartingBefore(migration, runningMigrations, freeSlots); startingBefore >= freeSlots {
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because [%d] pending migrations with a higher priority or an earlier creation time are waiting for the remaining parallel migration slots.", vmi.Namespace, vmi.Name, startingBefore)
+		c.Queue.AddWithOpts(priorityqueue.AddOpts{Priority: pendingQueuePriority(migration), After: 5 * time.Second}, key)
 		return nil
 	}
 
@@ -1560,14 +1617,17 @@ func (c *Controller) listMatchingTargetPods(migration *virtv1.VirtualMachineInst
 }
 
 func (c *Controller) addMigration(obj interface{}) {
+	c.pendingMigrations.update(nil, obj.(*virtv1.VirtualMachineInstanceMigration))
 	c.enqueueMigration(obj)
 }
 
 func (c *Controller) deleteMigration(obj interface{}) {
+	c.pendingMigrations.delete(obj.(*virtv1.VirtualMachineInstanceMigration))
 	c.enqueueMigration(obj)
 }
 
-func (c *Controller) updateMigration(_, curr interface{}) {
+func (c *Controller) updateMigration(old, curr interface{}) {
+	c.pendingMigrations.update(old.(*virtv1.VirtualMachineInstanceMigration), curr.(*virtv1.VirtualMachineInstanceMigration))
 	c.enqueueMigration(curr)
 }
 
@@ -1991,6 +2051,129 @@ func (c *Controller) outboundMigrationsOnNode(node string, runningMigrations []*
 	return sum, nil
 }
 
+// pendingQueuePriority returns the queue priority of a migration which waits for a free migration slot.
+// Waiting migrations stay below active migrations, but are re-processed in the order of their migration priority.
+func pendingQueuePriority(migration *virtv1.VirtualMachineInstanceMigration) int {
+	return lowPriority + migrationsutil.PriorityRank(migrationsutil.GetMigrationPriority(migration))
+}
+
+// countPendingMigrationsStartingBefore counts the pending migrations which have to be started before the given migration,
+// because they have a higher priority or were created earlier. Migrations which cannot start because their source node
+// is at the outbound migration limit are skipped, so that they don't block migrations from other nodes.
+// Counting stops at the given limit, and only the migrations ordered before the given one are visited.
+func (c *Controller) countPendingMigrationsStartingBefore(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+
+	running := map[types.UID]struct{}{}
+	outboundPerNode := map[string]int{}
+	for _, runningMigration := range runningMigrations {
+		running[runningMigration.UID] = struct{}{}
+		if vmi, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(runningMigration.Namespace, runningMigration.Spec.VMIName)); exists {
+			outboundPerNode[vmi.(*virtv1.VirtualMachineInstance).Status.NodeName]++
+		}
+	}
+	outboundLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
+
+	count := 0
+	c.pendingMigrations.forEachStartingBefore(migration, func(pending *virtv1.VirtualMachineInstanceMigration) bool {
+		if _, isRunning := running[pending.UID]; isRunning {
+			return true
+		}
+		obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(pending.Namespace, pending.Spec.VMIName))
+		if !exists {
+			return true
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if !vmi.IsRunning() || outboundPerNode[vmi.Status.NodeName] >= outboundLimit {
+			return true
+		}
+		count++
+		return count < limit
+	})
+	return count
+}
+
+// preemptLowerPriorityMigration makes room for the given migration by deleting the target pod of the migration with the
+// lowest priority whose target pod did not become ready yet. The preempted migration is moved back to the Pending phase
+// before its target pod is deleted, so that it waits for a free migration slot instead of failing.
+func (c *Controller) preemptLowerPriorityMigration(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) error {
+	rank := migrationsutil.PriorityRank(migrationsutil.GetMigrationPriority(migration))
+
+	var victim *virtv1.VirtualMachineInstanceMigration
+	var victimPod *k8sv1.Pod
+	for _, candidate := range runningMigrations {
+		if candidate.DeletionTimestamp != nil ||
+			(candidate.Status.Phase != virtv1.MigrationPending && candidate.Status.Phase != virtv1.MigrationScheduling) ||
+			migrationsutil.PriorityRank(migrationsutil.GetMigrationPriority(candidate)) >= rank {
+			continue
+		}
+		obj, exists, err := c.vmiStore.GetByKey(controller.NamespacedKey(candidate.Namespace, candidate.Spec.VMIName))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		pods, err := c.listMatchingTargetPods(candidate, obj.(*virtv1.VirtualMachineInstance))
+		if err != nil {
+			return err
+		}
+		if len(pods) == 0 || pods[0].DeletionTimestamp != nil {
+			// a slot is already being freed up, don't preempt another migration
+			return nil
+		}
+		if controller.IsPodReady(pods[0]) {
+			continue
+		}
+		if victim == nil || migrationsutil.StartsBefore(victim, candidate) {
+			victim, victimPod = candidate, pods[0]
+		}
+	}
+	if victim == nil {
+		return nil
+	}
+
+	reason := fmt.Sprintf("preempted by migration %s/%s with a higher priority, pod %s/%s is deleted", migration.Namespace, migration.Name, victimPod.Namespace, victimPod.Name)
+	if err := c.requeuePreemptedMigration(victim, reason); err != nil {
+		return fmt.Errorf("failed to move preempted migration %s/%s back to pending: %v", victim.Namespace, victim.Name, err)
+	}
+
+	victimKey := controller.MigrationKey(victim)
+	c.podExpectations.ExpectDeletions(victimKey, []string{controller.PodKey(victimPod)})
+	err := c.clientset.CoreV1().Pods(victimPod.Namespace).Delete(context.Background(), victimPod.Name, v1.DeleteOptions{})
+	if err != nil {
+		c.podExpectations.DeletionObserved(victimKey, controller.PodKey(victimPod))
+		c.recorder.Eventf(victim, k8sv1.EventTypeWarning, controller.FailedDeletePodReason, "Error deleting preempted migration target pod: %v", err)
+		return fmt.Errorf("failed to delete target pod %s/%s of preempted migration: %v", victimPod.Namespace, victimPod.Name, err)
+	}
+
+	log.Log.Object(victim).Infof("Deleted pending migration target pod with uuid %s for migration %s with uuid %s with reason [%s]", string(victimPod.UID), victim.Name, string(victim.UID), reason)
+	c.recorder.Event(victim, k8sv1.EventTypeWarning, controller.MigrationPreemptedReason, reason)
+	return nil
+}
+
+// requeuePreemptedMigration moves a preempted migration back to the Pending phase and marks it as preempted.
+// The condition is removed once the migration is scheduled again.
+func (c *Controller) requeuePreemptedMigration(migration *virtv1.VirtualMachineInstanceMigration, reason string) error {
+	migrationCopy := migration.DeepCopy()
+	migrationCopy.Status.Phase = virtv1.MigrationPending
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	if !conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationPreempted) {
+		migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+			Type:          virtv1.VirtualMachineInstanceMigrationPreempted,
+			Status:        k8sv1.ConditionTrue,
+			LastProbeTime: v1.Now(),
+			Reason:        controller.MigrationPreemptedReason,
+			Message:       reason,
+		})
+	}
+	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
+	_, err := c.clientset.VirtualMachineInstanceMigration(migrationCopy.Namespace).UpdateStatus(context.Background(), migrationCopy, v1.UpdateOptions{})
+	return err
+}
+
 // findRunningMigrations calculates how many migrations are running or in flight to be triggered to running
 // Migrations which are in running phase are added alongside with migrations which are still pending but
 // where we already see a target pod.
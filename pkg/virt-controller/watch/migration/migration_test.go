@@ -59,6 +59,7 @@ import (
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/descheduler"
@@ -322,10 +323,8 @@ var _ = Describe("Migration watcher", func() {
 
 	addMigration := func(migration *virtv1.VirtualMachineInstanceMigration) {
 		Expect(controller.migrationIndexer.Add(migration)).To(Succeed())
-		key, err := virtcontroller.KeyFunc(migration)
-		Expect(err).ToNot(HaveOccurred())
-		mockQueue.Add(key)
-		_, err = virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+		controller.addMigration(migration)
+		_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
 		Expect(err).ToNot(HaveOccurred())
 	}
 
@@ -820,6 +819,161 @@ var _ = Describe("Migration watcher", func() {
 			expectPodDoesNotExist(vmi.Namespace, fmt.Sprintf("testvmi"), "testmigration")
 		})
 
+		Context("with migration priorities", func() {
+			addPendingMigrationsWithTargetPod := func(count int, priority virtv1.MigrationPriority, phase virtv1.VirtualMachineInstanceMigrationPhase) []*k8sv1.Pod {
+				var pods []*k8sv1.Pod
+				for i := 0; i < count; i++ {
+					vmi := newVirtualMachine(fmt.Sprintf("xtestvmi%v", i), virtv1.Running)
+					migration := newMigration(fmt.Sprintf("xtestmigration%v", i), vmi.Name, phase)
+					migration.Spec.Priority = pointer.P(priority)
+					pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending)
+					addNodeNameToVMI(vmi, fmt.Sprintf("node%v", i))
+
+					addMigration(migration)
+					addVirtualMachineInstance(vmi)
+					addPod(pod)
+					pods = append(pods, pod)
+				}
+				return pods
+			}
+
+			DescribeTable("should preempt the newest pending migration with a lower priority at the cluster limit", func(victimPhase virtv1.VirtualMachineInstanceMigrationPhase) {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.Spec.Priority = pointer.P(virtv1.MigrationPrioritySystemCritical)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				pods := addPendingMigrationsWithTargetPod(5, virtv1.MigrationPrioritySystemMaintenance, victimPhase)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.MigrationPreemptedReason)
+				expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+				_, err := kubeClient.CoreV1().Pods(pods[4].Namespace).Get(context.Background(), pods[4].Name, metav1.GetOptions{})
+				Expect(err).To(MatchError(k8serrors.IsNotFound, "k8serrors.IsNotFound"))
+				for _, pod := range pods[:4] {
+					_, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				By("expecting the preempted migration to wait for a free migration slot again")
+				victim, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).Get(context.Background(), "xtestmigration4", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(victim.Status.Phase).To(Equal(virtv1.MigrationPending))
+				expectMigrationCondition(victim.Namespace, victim.Name, virtv1.VirtualMachineInstanceMigrationPreempted)
+			},
+				Entry("when its target pod is being scheduled", virtv1.MigrationScheduling),
+				Entry("when its target pod was just created", virtv1.MigrationPending),
+			)
+
+			It("should keep a preempted migration pending while its target pod is being deleted", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.Status.Conditions = []virtv1.VirtualMachineInstanceMigrationCondition{{
+					Type:   virtv1.VirtualMachineInstanceMigrationPreempted,
+					Status: k8sv1.ConditionTrue,
+				}}
+				pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodFailed)
+				pod.DeletionTimestamp = pointer.P(metav1.Now())
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+				addPod(pod)
+
+				controller.Execute()
+
+				updatedMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedMigration.Status.Phase).To(Equal(virtv1.MigrationPending))
+			})
+
+			It("should not preempt pending migrations with the same priority at the cluster limit", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				pods := addPendingMigrationsWithTargetPod(5, virtv1.MigrationPriorityUserTriggered, virtv1.MigrationScheduling)
+
+				sanityExecute()
+
+				expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+				for _, pod := range pods {
+					_, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			DescribeTable("should start pending migrations in the order of priority", func(priority, otherPriority virtv1.MigrationPriority, expectStart bool) {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.Spec.Priority = pointer.P(priority)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				// Leave a single free migration slot
+				addPendingMigrationsWithTargetPod(4, virtv1.MigrationPriorityUserTriggered, virtv1.MigrationScheduling)
+
+				otherVMI := newVirtualMachine("othervmi", virtv1.Running)
+				otherMigration := newMigration("othermigration", otherVMI.Name, virtv1.MigrationPending)
+				otherMigration.Spec.Priority = pointer.P(otherPriority)
+				otherMigration.CreationTimestamp = metav1.NewTime(migration.CreationTimestamp.Add(-time.Minute))
+				addNodeNameToVMI(otherVMI, "othernode")
+				addMigration(otherMigration)
+				addVirtualMachineInstance(otherVMI)
+
+				sanityExecute()
+
+				if expectStart {
+					testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+					expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+				} else {
+					expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+				}
+			},
+				Entry("and wait for a pending migration with a higher priority", virtv1.MigrationPrioritySystemMaintenance, virtv1.MigrationPrioritySystemCritical, false),
+				Entry("and wait for an older pending migration with the same priority", virtv1.MigrationPriorityUserTriggered, virtv1.MigrationPriorityUserTriggered, false),
+				Entry("and start before an older pending migration with a lower priority", virtv1.MigrationPrioritySystemCritical, virtv1.MigrationPriorityUserTriggered, true),
+			)
+
+			It("should not wait for an older migration which is no longer pending", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				// Leave a single free migration slot
+				addPendingMigrationsWithTargetPod(4, virtv1.MigrationPriorityUserTriggered, virtv1.MigrationScheduling)
+
+				otherVMI := newVirtualMachine("othervmi", virtv1.Running)
+				otherMigration := newMigration("othermigration", otherVMI.Name, virtv1.MigrationPending)
+				otherMigration.CreationTimestamp = metav1.NewTime(migration.CreationTimestamp.Add(-time.Minute))
+				addNodeNameToVMI(otherVMI, "othernode")
+				addMigration(otherMigration)
+				addVirtualMachineInstance(otherVMI)
+
+				failedMigration := otherMigration.DeepCopy()
+				failedMigration.Status.Phase = virtv1.MigrationFailed
+				Expect(controller.migrationIndexer.Update(failedMigration)).To(Succeed())
+				controller.updateMigration(otherMigration, failedMigration)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			})
+		})
+
 		It("should create target pod and not override existing affinity rules", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			antiAffinityTerm := k8sv1.PodAffinityTerm{
@@ -1478,6 +1632,45 @@ var _ = Describe("Migration watcher", func() {
 			expectMigrationRunningState(migration.Namespace, migration.Name)
 		})
 
+		It("should report the progress of a running migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node02")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+
+			progress := &virtv1.MigrationProgress{
+				UpdateTimestamp:               pointer.P(metav1.Now()),
+				DataProcessedBytes:            1024,
+				DataRemainingBytes:            2048,
+				DataTotalBytes:                3072,
+				MemoryDirtyRateBytesPerSecond: 10,
+				ThroughputBytesPerSecond:      100,
+				Iteration:                     3,
+				EstimatedCompletionSeconds:    pointer.P(int64(22)),
+			}
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:      migration.UID,
+				TargetNode:        "node01",
+				SourceNode:        "node02",
+				TargetNodeAddress: "10.10.10.10:1234",
+				StartTimestamp:    pointer.P(metav1.Now()),
+				Progress:          progress,
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addPod(targetPod)
+
+			sanityExecute()
+
+			updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMIM.Status.Phase).To(Equal(virtv1.MigrationRunning))
+			Expect(updatedVMIM.Status.MigrationState).ToNot(BeNil())
+			Expect(updatedVMIM.Status.MigrationState.Progress).To(Equal(progress))
+		})
+
 		It("should transition to completed phase", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			addNodeNameToVMI(vmi, "node02")
@@ -2012,12 +2205,74 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set compression",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.ParallelStreams = pointer.P(uint32(4))
+					p.Compression = &virtv1.MigrationCompression{Method: virtv1.MigrationCompressionZstd, Level: pointer.P(int32(3))}
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Compression).To(Equal(&virtv1.MigrationCompression{Method: virtv1.MigrationCompressionZstd, Level: pointer.P(int32(3))}))
+				},
+				true,
+			),
+			Entry("set parallel streams",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ParallelStreams = pointer.P(uint32(4)) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ParallelStreams).To(PointTo(Equal(uint32(4))))
+				},
+				true,
+			),
+			Entry("allow zero-copy",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.ParallelStreams = pointer.P(uint32(4))
+					p.ZeroCopy = pointer.P(true)
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ZeroCopy).To(PointTo(BeTrue()))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
 				false,
 			),
 		)
+
+		It("should drop the stream options when the policy and the cluster-wide configurations merge is invalid", func() {
+			clusterMigrationConfiguration := getDefaultMigrationConfiguration()
+			clusterMigrationConfiguration.Compression = &virtv1.MigrationCompression{Method: virtv1.MigrationCompressionXBZRLE}
+			setConfig(&virtv1.KubeVirtConfiguration{MigrationConfiguration: clusterMigrationConfiguration})
+
+			vmi = newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+
+			targetPod = newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.ParallelStreams = pointer.P(uint32(4))
+			migrationPolicy.Spec.ZeroCopy = pointer.P(true)
+
+			addMigrationPolicies(*migrationPolicy)
+			addMigration(migration)
+			addPod(targetPod)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, invalidMigrationStreamOptionsReason, virtcontroller.SuccessfulHandOverPodReason)
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration).ToNot(BeNil())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.Compression).To(BeNil())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.ParallelStreams).To(BeNil())
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.ZeroCopy).To(BeNil())
+		})
 	})
 
 	Context("Migration of host-model VMI", func() {
@@ -2346,7 +2601,7 @@ var _ = Describe("Migration watcher", func() {
 			}
 			item, priority, shutdown := controller.Queue.GetWithPriority()
 			Expect(item).To(Equal("default/testmigrationpending"))
-			Expect(priority).To(Equal(-100))
+			Expect(priority).To(Equal(lowPriority + migrationsutil.PriorityRank(virtv1.MigrationPriorityUserTriggered)))
 			Expect(shutdown).To(BeFalse())
 		})
 	})
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// pendingMigrations keeps the migrations which wait for their target pod in the order they have to be started.
+// It is maintained by the migration informer handlers, so that the migrations to start before a given one are
+// found without going over all the unfinished migrations on every sync.
+type pendingMigrations struct {
+	lock       sync.Mutex
+	byUID      map[types.UID]*virtv1.VirtualMachineInstanceMigration
+	migrations []*virtv1.VirtualMachineInstanceMigration
+}
+
+func newPendingMigrations() *pendingMigrations {
+	return &pendingMigrations{
+		byUID: map[types.UID]*virtv1.VirtualMachineInstanceMigration{},
+	}
+}
+
+func isPendingMigration(migration *virtv1.VirtualMachineInstanceMigration) bool {
+	return migration.DeletionTimestamp == nil &&
+		(migration.Status.Phase == virtv1.MigrationPhaseUnset || migration.Status.Phase == virtv1.MigrationPending)
+}
+
+// update replaces the old version of the migration with the current one, which is only kept while it is pending
+func (p *pendingMigrations) update(old, current *virtv1.VirtualMachineInstanceMigration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if old != nil {
+		p.remove(old.UID)
+	}
+	p.remove(current.UID)
+	if isPendingMigration(current) {
+		i := p.position(current)
+		p.migrations = slices.Insert(p.migrations, i, current)
+		p.byUID[current.UID] = current
+	}
+}
+
+func (p *pendingMigrations) delete(migration *virtv1.VirtualMachineInstanceMigration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.remove(migration.UID)
+}
+
+// forEachStartingBefore calls visit, in order, with the pending migrations which have to be started before the
+// given migration, until visit returns false
+func (p *pendingMigrations) forEachStartingBefore(migration *virtv1.VirtualMachineInstanceMigration, visit func(*virtv1.VirtualMachineInstanceMigration) bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, pending := range p.migrations[:p.position(migration)] {
+		if pending.UID != migration.UID && !visit(pending) {
+			return
+		}
+	}
+}
+
+// remove drops the stored version of the migration, which is located by its own ordering key
+func (p *pendingMigrations) remove(uid types.UID) {
+	stored, exists := p.byUID[uid]
+	if !exists {
+		return
+	}
+	delete(p.byUID, uid)
+	if i := p.position(stored); i < len(p.migrations) && p.migrations[i].UID == uid {
+		p.migrations = slices.Delete(p.migrations, i, i+1)
+	}
+}
+
+// position returns the index of the first pending migration which does not have to be started before the given one
+func (p *pendingMigrations) position(migration *virtv1.VirtualMachineInstanceMigration) int {
+	return sort.Search(len(p.migrations), func(i int) bool {
+		return !migrationsutil.StartsBefore(p.migrations[i], migration)
+	})
+}
@@ -1,18 +1,21 @@
 package pool
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
 	"math"
 	"math/rand"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/trace"
@@ -25,6 +28,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -471,13 +475,94 @@ func (c *Controller) listVMsFromNamespace(namespace string) ([]*virtv1.VirtualMa
 	return vms, nil
 }
 
-func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
+func wantedReplicas(pool *poolv1.VirtualMachinePool) int {
 	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
+		return int(*pool.Spec.Replicas)
+	}
+	return 1
+}
+
+func (c *Controller) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	return len(vms) - wantedReplicas(pool)
+}
+
+func getUpdateStrategyType(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolUpdateStrategyType {
+	if pool.Spec.UpdateStrategy == nil || pool.Spec.UpdateStrategy.Type == "" {
+		return poolv1.VirtualMachinePoolProactiveUpdateStrategyType
+	}
+	return pool.Spec.UpdateStrategy.Type
+}
+
+func getProactiveUpdateMethod(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolProactiveUpdateMethod {
+	if pool.Spec.UpdateStrategy == nil || pool.Spec.UpdateStrategy.ProactiveMethod == "" {
+		return poolv1.VirtualMachinePoolProactiveUpdateRestart
+	}
+	return pool.Spec.UpdateStrategy.ProactiveMethod
+}
+
+func isRecreateUpdate(pool *poolv1.VirtualMachinePool) bool {
+	return getUpdateStrategyType(pool) == poolv1.VirtualMachinePoolProactiveUpdateStrategyType &&
+		getProactiveUpdateMethod(pool) == poolv1.VirtualMachinePoolProactiveUpdateRecreate
+}
+
+// rollingUpdateLimits returns how many VMs can be unavailable and how many VMs can be
+// created over the desired number of VMs while the pool is updated. Without a rolling
+// update configuration all VMs can be updated at once.
+func rollingUpdateLimits(pool *poolv1.VirtualMachinePool) (maxUnavailable int, maxSurge int, err error) {
+	replicas := wantedReplicas(pool)
+	if pool.Spec.UpdateStrategy == nil || pool.Spec.UpdateStrategy.RollingUpdate == nil {
+		return replicas, 0, nil
+	}
+	rollingUpdate := pool.Spec.UpdateStrategy.RollingUpdate
+
+	unavailable := intstr.FromString("25%")
+	if rollingUpdate.MaxUnavailable != nil {
+		unavailable = *rollingUpdate.MaxUnavailable
+	}
+	maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(&unavailable, replicas, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if isRecreateUpdate(pool) && rollingUpdate.MaxSurge != nil {
+		maxSurge, err = intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, replicas, true)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	// Like Deployments, make sure the update can always make progress
+	if maxUnavailable == 0 && maxSurge == 0 {
+		maxUnavailable = 1
+	}
+
+	return maxUnavailable, maxSurge, nil
+}
+
+// surgeInUse returns how many VMs above the desired number of VMs are currently
+// replacing outdated VMs during a rolling update with the Recreate method.
+func (c *Controller) surgeInUse(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	if !isRecreateUpdate(pool) {
+		return 0, nil
+	}
+
+	_, maxSurge, err := rollingUpdateLimits(pool)
+	if err != nil || maxSurge == 0 {
+		return 0, err
+	}
+
+	outdated := 0
+	for _, vm := range filterDeletingVMs(vms) {
+		reason, err := c.vmOutdatedReason(pool, vm)
+		if err != nil {
+			return 0, err
+		}
+		if reason != "" {
+			outdated++
+		}
 	}
 
-	return len(vms) - int(wantedReplicas)
+	return min(maxSurge, outdated), nil
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -493,9 +578,7 @@ func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 
 // filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
 func (c *Controller) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
-	return filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
-		return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
-	})
+	return filterVMs(vms, isReadyVM)
 }
 
 func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine) bool) []*virtv1.VirtualMachine {
@@ -510,11 +593,6 @@ func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine)
 
 func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
 
-	poolKey, err := controller.KeyFunc(pool)
-	if err != nil {
-		return err
-	}
-
 	elgibleVMs := filterDeletingVMs(vms)
 
 	// make sure we count already deleting VMs here during scale in.
@@ -526,16 +604,152 @@ func (c *Controller) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virt
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
+	// random delete strategy, the selection policy only reorders VMs it can tell apart
 	rand.Shuffle(len(elgibleVMs), func(i, j int) {
 		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
 	})
+	c.sortByScaleInPreference(pool, elgibleVMs)
+	deleteList := elgibleVMs[0:count]
+
+	if getScaleInVolumePolicy(pool) == poolv1.VirtualMachinePoolScaleInRetainVolumes {
+		for _, vm := range deleteList {
+			if err := c.retainVolumes(vm); err != nil {
+				return err
+			}
+		}
+	}
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
+	return c.deleteVMs(pool, deleteList)
+}
+
+func getScaleInSelectionPolicy(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolScaleInSelectionPolicy {
+	if pool.Spec.ScaleInStrategy == nil || pool.Spec.ScaleInStrategy.SelectionPolicy == "" {
+		return poolv1.VirtualMachinePoolScaleInRandom
+	}
+	return pool.Spec.ScaleInStrategy.SelectionPolicy
+}
+
+func getScaleInVolumePolicy(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolScaleInVolumePolicy {
+	if pool.Spec.ScaleInStrategy == nil || pool.Spec.ScaleInStrategy.VolumePolicy == "" {
+		return poolv1.VirtualMachinePoolScaleInDeleteVolumes
+	}
+	return pool.Spec.ScaleInStrategy.VolumePolicy
+}
+
+// sortByScaleInPreference stable sorts the VMs so that the ones the selection
+// policy of the pool prefers to remove come first.
+func (c *Controller) sortByScaleInPreference(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	var rank func(vm *virtv1.VirtualMachine) int
+
+	switch getScaleInSelectionPolicy(pool) {
+	case poolv1.VirtualMachinePoolScaleInPreferStopped:
+		rank = func(vm *virtv1.VirtualMachine) int {
+			_, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+			if exists {
+				return 1
+			}
+			return 0
+		}
+	case poolv1.VirtualMachinePoolScaleInPreferNotReady:
+		rank = func(vm *virtv1.VirtualMachine) int {
+			if isReadyVM(vm) {
+				return 1
+			}
+			return 0
+		}
+	case poolv1.VirtualMachinePoolScaleInHighestOrdinal:
+		rank = func(vm *virtv1.VirtualMachine) int {
+			index, err := indexFromName(vm.Name)
+			if err != nil {
+				return math.MaxInt
+			}
+			return -index
+		}
+	default:
+		return
+	}
+
+	slices.SortStableFunc(vms, func(a, b *virtv1.VirtualMachine) int {
+		return cmp.Compare(rank(a), rank(b))
+	})
+}
+
+// retainVolumes detaches the DataVolumes and PVCs created from the
+// DataVolumeTemplates of the VM from it, so that they survive its deletion.
+// They are marked as retained from the VM to prevent it from adopting them
+// again while it is deleted.
+func (c *Controller) retainVolumes(vm *virtv1.VirtualMachine) error {
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		dv, err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Get(context.Background(), template.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			if err := retainVolume(vm, dv, func(data []byte) error {
+				_, err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(vm.Namespace).Patch(context.Background(), dv.Name, types.JSONPatchType, data, metav1.PatchOptions{})
+				return err
+			}); err != nil {
+				return err
+			}
+		}
+
+		pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Get(context.Background(), template.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			if err := retainVolume(vm, pvc, func(data []byte) error {
+				_, err := c.clientset.CoreV1().PersistentVolumeClaims(vm.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, data, metav1.PatchOptions{})
+				return err
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func retainVolume(vm *virtv1.VirtualMachine, obj metav1.Object, patchFunc func([]byte) error) error {
+	ownerRefs := obj.GetOwnerReferences()
+	retainedRefs := slices.DeleteFunc(slices.Clone(ownerRefs), func(ref metav1.OwnerReference) bool {
+		return ref.UID == vm.UID
+	})
+	if len(retainedRefs) == len(ownerRefs) {
+		return nil
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/metadata/ownerReferences", ownerRefs),
+		patch.WithReplace("/metadata/ownerReferences", retainedRefs),
+	)
+	if obj.GetAnnotations() == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{virtv1.VirtualMachinePoolRetainedFromAnnotation: string(vm.UID)}))
+	} else {
+		patchSet.AddOption(patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.VirtualMachinePoolRetainedFromAnnotation)), string(vm.UID)))
+	}
+	data, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if err := patchFunc(data); err != nil {
+		return err
+	}
+
+	log.Log.Object(vm).Infof("Retained volume %s/%s of vm", vm.Namespace, obj.GetName())
+	return nil
+}
+
+func (c *Controller) deleteVMs(pool *poolv1.VirtualMachinePool, deleteList []*virtv1.VirtualMachine) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+
 	var wg sync.WaitGroup
 
-	deleteList := elgibleVMs[0:count]
 	c.expectations.ExpectDeletions(poolKey, controller.VirtualMachineKeys(deleteList))
 	wg.Add(len(deleteList))
 	errChan := make(chan error, len(deleteList))
@@ -816,6 +1030,18 @@ func (c *Controller) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtua
 		return nil, true
 	}
 
+	if diff > 0 {
+		// VMs above the desired number of VMs may be replacing outdated VMs
+		surge, err := c.surgeInUse(pool, vms)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("Error during scale in: %v", err), FailedScaleInReason), false
+		}
+		if diff <= surge {
+			return nil, true
+		}
+		diff -= surge
+	}
+
 	maxDiff := int(math.Min(math.Abs(float64(diff)), float64(c.burstReplicas)))
 	if diff < 0 {
 		err := c.scaleOut(pool, maxDiff)
@@ -887,34 +1113,86 @@ func (c *Controller) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vmOutd
 	return nil
 }
 
-func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
-	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
-		go func(idx int) {
-			defer wg.Done()
-			vm := vmUpdatedList[idx]
+// unavailableBudget returns how many more VMs of the pool can become unavailable
+// without violating the maxUnavailable setting of the rolling update.
+func (c *Controller) unavailableBudget(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	maxUnavailable, _, err := rollingUpdateLimits(pool)
+	if err != nil {
+		return 0, err
+	}
 
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
-			if !exists {
-				// no VMI to update
-				return
-			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
-				return
-			}
+	available := 0
+	for _, vm := range c.filterReadyVMs(filterDeletingVMs(vms)) {
+		// VMs whose VMI is already shutting down are about to become unavailable
+		obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+		if exists && obj.(*virtv1.VirtualMachineInstance).DeletionTimestamp != nil {
+			continue
+		}
+		available++
+	}
 
-			updateType, err := c.isOutdatedVMI(vm, vmi)
-			if err != nil {
-				errChan <- err
-				return
+	return available - (wantedReplicas(pool) - maxUnavailable), nil
+}
+
+func isReadyVM(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
+}
+
+type vmiUpdate struct {
+	vm         *virtv1.VirtualMachine
+	vmi        *virtv1.VirtualMachineInstance
+	updateType proactiveUpdateType
+}
+
+func (c *Controller) proactiveUpdate(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmUpdatedList []*virtv1.VirtualMachine) error {
+	budget, err := c.unavailableBudget(pool, vms)
+	if err != nil {
+		return err
+	}
 
+	var updates []vmiUpdate
+	for _, vm := range vmUpdatedList {
+		vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+		obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
+		if !exists {
+			// no VMI to update
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil {
+			// ignore VMIs which are already deleting
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(vm, vmi)
+		if err != nil {
+			return err
+		}
+
+		if updateType == proactiveUpdateTypeRestart && isReadyVM(vm) {
+			// restarting a ready VM makes it unavailable
+			if budget <= 0 {
+				log.Log.Object(pool).V(4).Infof("Postponing restart of vm %s/%s, too many unavailable VMs in pool", vm.Namespace, vm.Name)
+				continue
 			}
-			switch updateType {
+			budget--
+		}
+
+		if updateType != proactiveUpdateTypeNone {
+			updates = append(updates, vmiUpdate{vm: vm, vmi: vmi, updateType: updateType})
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(len(updates))
+	errChan := make(chan error, len(updates))
+	for i := 0; i < len(updates); i++ {
+		go func(idx int) {
+			defer wg.Done()
+			vm := updates[idx].vm
+			vmi := updates[idx].vmi
+
+			switch updates[idx].updateType {
 			case proactiveUpdateTypeRestart:
 				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, v1.DeleteOptions{})
 				if err != nil {
@@ -1062,34 +1340,92 @@ func (c *Controller) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Virtua
 	return proactiveUpdateTypePatchRevisionLabel, nil
 }
 
-func (c *Controller) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
-
+// vmOutdatedReason returns why the VM does not match the current VM template of the
+// pool, or an empty string if it is up-to-date.
+func (c *Controller) vmOutdatedReason(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (string, error) {
 	if vm.Labels == nil {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to missing labels ", vm.Namespace, vm.Name)
-		return true, nil
+		return "missing labels", nil
 	}
 
 	revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
 	if !exists {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to missing revision labels ", vm.Namespace, vm.Name)
-		return true, nil
+		return "missing revision labels", nil
 	}
 
 	oldPoolSpec, exists, err := c.getControllerRevision(pool.Namespace, revisionName)
 	if err != nil {
-		return true, err
+		return "", err
 	} else if !exists {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to missing revision", vm.Namespace, vm.Name)
-		return true, nil
+		return "missing revision", nil
 	}
 
 	if !equality.Semantic.DeepEqual(oldPoolSpec.VirtualMachineTemplate, pool.Spec.VirtualMachineTemplate) {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due out of date spec", vm.Namespace, vm.Name)
+		return "out of date spec", nil
+	}
+
+	return "", nil
+}
+
+func (c *Controller) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
+	reason, err := c.vmOutdatedReason(pool, vm)
+	if err != nil {
+		return true, err
+	}
+
+	if reason != "" {
+		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to %s", vm.Namespace, vm.Name, reason)
 		return true, nil
 	}
 
 	return false, nil
+}
+
+// recreateOutdatedVMs deletes outdated VMs within the limits of the rolling update,
+// scale out replaces them with VMs created from the current VM template. With maxSurge
+// replacements are created before the outdated VMs are deleted.
+func (c *Controller) recreateOutdatedVMs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmOutdatedList []*virtv1.VirtualMachine) error {
+	vmOutdatedList = filterDeletingVMs(vmOutdatedList)
+	if len(vmOutdatedList) == 0 {
+		return nil
+	}
 
+	_, maxSurge, err := rollingUpdateLimits(pool)
+	if err != nil {
+		return err
+	}
+
+	if surge := min(wantedReplicas(pool)+maxSurge-len(vms), len(vmOutdatedList), int(c.burstReplicas)); surge > 0 {
+		if err := c.scaleOut(pool, surge); err != nil {
+			return err
+		}
+	}
+
+	budget, err := c.unavailableBudget(pool, vms)
+	if err != nil {
+		return err
+	}
+
+	deleteList := []*virtv1.VirtualMachine{}
+	for _, vm := range vmOutdatedList {
+		if len(deleteList) >= int(c.burstReplicas) {
+			break
+		}
+		// deleting a VM which is not ready does not reduce the availability of the pool
+		if isReadyVM(vm) {
+			if budget <= 0 {
+				continue
+			}
+			budget--
+		}
+		deleteList = append(deleteList, vm)
+	}
+
+	if len(deleteList) == 0 {
+		return nil
+	}
+
+	log.Log.Object(pool).Infof("Recreating %d outdated VMs of pool", len(deleteList))
+	return c.deleteVMs(pool, deleteList)
 }
 
 func (c *Controller) pruneUnusedRevisions(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) common.SyncError {
@@ -1161,14 +1497,21 @@ func (c *Controller) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.Virtu
 		}
 	}
 
-	err := c.opportunisticUpdate(pool, vmOutdatedList)
+	var err error
+	if isRecreateUpdate(pool) {
+		err = c.recreateOutdatedVMs(pool, vms, vmOutdatedList)
+	} else {
+		err = c.opportunisticUpdate(pool, vmOutdatedList)
+	}
 	if err != nil {
 		return common.NewSyncError(fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason), false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
-	if err != nil {
-		return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
+	if getUpdateStrategyType(pool) == poolv1.VirtualMachinePoolProactiveUpdateStrategyType {
+		err = c.proactiveUpdate(pool, vms, vmUpdatedList)
+		if err != nil {
+			return common.NewSyncError(fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason), false
+		}
 	}
 
 	vmUpdateStable := false
@@ -1255,6 +1598,7 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	c.updateRevisionStatus(pool, vms)
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		_, err := c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
@@ -1267,6 +1611,47 @@ func (c *Controller) updateStatus(origPool *poolv1.VirtualMachinePool, vms []*vi
 
 }
 
+// updateRevisionStatus tracks the revisions of the pool like a Deployment: UpdateRevision
+// is the revision up-to-date VMs are at, CurrentRevision the one of the VMs which still
+// have to be updated.
+func (c *Controller) updateRevisionStatus(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	var updatedRevisions, outdatedRevisions []string
+
+	activeVMs := filterDeletingVMs(vms)
+	for _, vm := range activeVMs {
+		reason, err := c.vmOutdatedReason(pool, vm)
+		if err != nil {
+			log.Log.Object(pool).Reason(err).Errorf("Failed to detect whether vm %s/%s is up-to-date", vm.Namespace, vm.Name)
+			return
+		}
+
+		revisionName := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+		if reason == "" {
+			updatedRevisions = append(updatedRevisions, revisionName)
+		} else if revisionName != "" {
+			outdatedRevisions = append(outdatedRevisions, revisionName)
+		}
+	}
+
+	pool.Status.UpdatedReplicas = int32(len(updatedRevisions))
+	pool.Status.UpdateRevision = pickRevision(pool.Status.UpdateRevision, updatedRevisions)
+
+	if len(updatedRevisions) == len(activeVMs) {
+		pool.Status.CurrentRevision = pool.Status.UpdateRevision
+	} else {
+		pool.Status.CurrentRevision = pickRevision(pool.Status.CurrentRevision, outdatedRevisions)
+	}
+}
+
+// pickRevision keeps the current revision as long as VMs use it, otherwise it picks
+// one of the given revisions in a stable way.
+func pickRevision(current string, revisions []string) string {
+	if len(revisions) == 0 || slices.Contains(revisions, current) {
+		return current
+	}
+	return slices.Min(revisions)
+}
+
 func (c *Controller) execute(key string) error {
 	logger := log.DefaultLogger()
 
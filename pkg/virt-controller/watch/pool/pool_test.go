@@ -19,9 +19,11 @@
 package pool
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -31,6 +33,8 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -40,8 +44,10 @@ import (
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	"kubevirt.io/client-go/testing"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
@@ -94,6 +100,7 @@ var _ = Describe("Pool", func() {
 		var mockQueue *testutils.MockWorkQueue[string]
 		var fakeVirtClient *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
+		var cdiClient *cdifake.Clientset
 
 		addCR := func(cr *appsv1.ControllerRevision) {
 			controller.revisionIndexer.Add(cr)
@@ -167,6 +174,10 @@ var _ = Describe("Pool", func() {
 				return true, nil, nil
 			})
 			virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+			cdiClient = cdifake.NewSimpleClientset()
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 		})
 
 		addPool := func(pool *poolv1.VirtualMachinePool) {
@@ -268,6 +279,7 @@ var _ = Describe("Pool", func() {
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
 			poolRevision := createPoolRevision(pool)
+			setUpdatedStatus(pool, poolRevision.Name)
 
 			pool.Generation = 123
 			newPoolRevision := createPoolRevision(pool)
@@ -336,6 +348,7 @@ var _ = Describe("Pool", func() {
 			vm = injectPoolRevisionLabelsIntoVM(vm, newPoolRevision.Name)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
 			markVmAsReady(vm)
+			setUpdatedStatus(pool, newPoolRevision.Name)
 
 			vmi := api.NewMinimalVMI(vm.Name)
 			vmi.Spec = vm.Spec.Template.Spec
@@ -380,6 +393,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			setUpdatedStatus(pool, poolRevision.Name)
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -400,6 +414,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			setUpdatedStatus(pool, poolRevision.Name)
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -713,6 +728,463 @@ var _ = Describe("Pool", func() {
 			Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(3))
 		})
 
+		Context("with update strategy", func() {
+			var (
+				pool               *poolv1.VirtualMachinePool
+				templateVM         *v1.VirtualMachine
+				oldPoolRevision    *appsv1.ControllerRevision
+				newPoolRevision    *appsv1.ControllerRevision
+				expectedPoolStatus poolv1.VirtualMachinePoolStatus
+			)
+
+			newPoolVM := func(index int, revisionName string, ready bool) *v1.VirtualMachine {
+				vm := templateVM.DeepCopy()
+				vm.Name = fmt.Sprintf("%s-%d", pool.Name, index)
+				vm.Spec = *indexVMSpec(&pool.Spec, index)
+				vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
+				if ready {
+					markVmAsReady(vm)
+				}
+				return vm
+			}
+
+			newPoolVMI := func(vm *v1.VirtualMachine, revisionName string) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI(vm.Name)
+				vmi.Namespace = vm.Namespace
+				vmi.Spec = vm.Spec.Template.Spec
+				vmi.Labels = maps.Clone(vm.Spec.Template.ObjectMeta.Labels)
+				vmi.Labels[v1.VirtualMachinePoolRevisionName] = revisionName
+				vmi.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion:         v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+					Kind:               v1.VirtualMachineGroupVersionKind.Kind,
+					Name:               vm.ObjectMeta.Name,
+					UID:                vm.ObjectMeta.UID,
+					Controller:         pointer.P(true),
+					BlockOwnerDeletion: pointer.P(true),
+				}}
+				return vmi
+			}
+
+			expectPoolStatusUpdate := func() {
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.UpdatedReplicas).To(Equal(expectedPoolStatus.UpdatedReplicas))
+					Expect(updateObj.Status.CurrentRevision).To(Equal(expectedPoolStatus.CurrentRevision))
+					Expect(updateObj.Status.UpdateRevision).To(Equal(expectedPoolStatus.UpdateRevision))
+					return true, update.GetObject(), nil
+				})
+			}
+
+			BeforeEach(func() {
+				pool, templateVM = DefaultPool(3)
+				oldPoolRevision = createPoolRevision(pool)
+
+				pool.Generation = 2
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				newPoolRevision = createPoolRevision(pool)
+
+				Expect(controller.revisionIndexer.Add(oldPoolRevision)).To(Succeed())
+				Expect(controller.revisionIndexer.Add(newPoolRevision)).To(Succeed())
+
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+				expectPoolStatusUpdate()
+			})
+
+			It("should only update VMs with the opportunistic update strategy", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+				}
+				addPool(pool)
+				for i := 0; i < 3; i++ {
+					vm := newPoolVM(i, oldPoolRevision.Name, true)
+					addVM(vm)
+					addVMI(newPoolVMI(vm, oldPoolRevision.Name))
+				}
+
+				expectVMUpdate(newPoolRevision.Name)
+				expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+					UpdatedReplicas: 0,
+					CurrentRevision: oldPoolRevision.Name,
+				}
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "update", "virtualmachines")).To(HaveLen(3))
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should not proactively update VMIs with the opportunistic update strategy", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+				}
+				addPool(pool)
+				for i := 0; i < 3; i++ {
+					vm := newPoolVM(i, newPoolRevision.Name, true)
+					addVM(vm)
+					addVMI(newPoolVMI(vm, oldPoolRevision.Name))
+				}
+
+				expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+					UpdatedReplicas: 3,
+					CurrentRevision: newPoolRevision.Name,
+					UpdateRevision:  newPoolRevision.Name,
+				}
+
+				sanityExecute()
+
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			DescribeTable("should restart outdated VMIs within the maxUnavailable limit", func(rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate, notReadyVMs int, expectedRestarts int) {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: rollingUpdate,
+				}
+				addPool(pool)
+				for i := 0; i < 3; i++ {
+					vm := newPoolVM(i, newPoolRevision.Name, i >= notReadyVMs)
+					addVM(vm)
+					addVMI(newPoolVMI(vm, oldPoolRevision.Name))
+				}
+
+				expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+					UpdatedReplicas: 3,
+					CurrentRevision: newPoolRevision.Name,
+					UpdateRevision:  newPoolRevision.Name,
+				}
+
+				sanityExecute()
+
+				for x := 0; x < expectedRestarts; x++ {
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+				}
+				Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachineinstances")).To(HaveLen(expectedRestarts))
+			},
+				Entry("all at once without rolling update", nil, 0, 3),
+				Entry("one at a time with maxUnavailable 1",
+					&poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: pointer.P(intstr.FromInt32(1))}, 0, 1),
+				Entry("one at a time with maxUnavailable percentage rounded down to zero",
+					&poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: pointer.P(intstr.FromString("10%"))}, 0, 1),
+				Entry("only not ready VMs when the pool is already at maxUnavailable",
+					&poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: pointer.P(intstr.FromInt32(1))}, 1, 1),
+			)
+
+			It("should track the revisions of partially updated pools", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+				}
+				addPool(pool)
+				addVM(newPoolVM(0, newPoolRevision.Name, true))
+				addVM(newPoolVM(1, oldPoolRevision.Name, true))
+				addVM(newPoolVM(2, oldPoolRevision.Name, true))
+
+				expectVMUpdate(newPoolRevision.Name)
+				expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+					UpdatedReplicas: 1,
+					CurrentRevision: oldPoolRevision.Name,
+					UpdateRevision:  newPoolRevision.Name,
+				}
+
+				sanityExecute()
+			})
+
+			Context("and the Recreate method", func() {
+				BeforeEach(func() {
+					pool.Spec.Replicas = pointer.P(int32(2))
+					pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+						Type:            poolv1.VirtualMachinePoolProactiveUpdateStrategyType,
+						ProactiveMethod: poolv1.VirtualMachinePoolProactiveUpdateRecreate,
+						RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+							MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+							MaxSurge:       pointer.P(intstr.FromInt32(1)),
+						},
+					}
+				})
+
+				It("should create a surge VM before deleting outdated VMs", func() {
+					addPool(pool)
+					addVM(newPoolVM(0, oldPoolRevision.Name, true))
+					addVM(newPoolVM(1, oldPoolRevision.Name, true))
+
+					expectVMCreationWithValidation(Equal(fmt.Sprintf("%s-2", pool.Name)), func(vm *v1.VirtualMachine) {
+						defer GinkgoRecover()
+						Expect(vm.Labels).To(HaveKeyWithValue(v1.VirtualMachinePoolRevisionName, newPoolRevision.Name))
+					})
+					expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+						UpdatedReplicas: 0,
+						CurrentRevision: oldPoolRevision.Name,
+					}
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulCreateVirtualMachineReason)
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(HaveLen(1))
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+				})
+
+				It("should delete an outdated VM once the surge VM is ready", func() {
+					addPool(pool)
+					addVM(newPoolVM(0, oldPoolRevision.Name, true))
+					addVM(newPoolVM(1, oldPoolRevision.Name, true))
+					addVM(newPoolVM(2, newPoolRevision.Name, true))
+
+					expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+						UpdatedReplicas: 1,
+						CurrentRevision: oldPoolRevision.Name,
+						UpdateRevision:  newPoolRevision.Name,
+					}
+
+					sanityExecute()
+
+					testutils.ExpectEvent(recorder, common.SuccessfulDeleteVirtualMachineReason)
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(HaveLen(1))
+				})
+
+				It("should not delete outdated VMs while the surge VM is not ready", func() {
+					addPool(pool)
+					addVM(newPoolVM(0, oldPoolRevision.Name, true))
+					addVM(newPoolVM(1, oldPoolRevision.Name, true))
+					addVM(newPoolVM(2, newPoolRevision.Name, false))
+
+					expectedPoolStatus = poolv1.VirtualMachinePoolStatus{
+						UpdatedReplicas: 1,
+						CurrentRevision: oldPoolRevision.Name,
+						UpdateRevision:  newPoolRevision.Name,
+					}
+
+					sanityExecute()
+
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "create", "virtualmachines")).To(BeEmpty())
+					Expect(testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines")).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("with scale-in strategy", func() {
+			var (
+				pool       *poolv1.VirtualMachinePool
+				templateVM *v1.VirtualMachine
+			)
+
+			newPoolVM := func(index int) *v1.VirtualMachine {
+				vm := templateVM.DeepCopy()
+				vm.Name = fmt.Sprintf("%s-%d", pool.Name, index)
+				vm.UID = k8stypes.UID(fmt.Sprintf("vm-uid-%d", index))
+				vm.Spec = *indexVMSpec(&pool.Spec, index)
+				return vm
+			}
+
+			deletedVMNames := func() []string {
+				var names []string
+				for _, action := range testing.FilterActions(&fakeVirtClient.Fake, "delete", "virtualmachines") {
+					names = append(names, action.(k8stesting.DeleteAction).GetName())
+				}
+				return names
+			}
+
+			BeforeEach(func() {
+				pool, templateVM = DefaultPool(0)
+
+				fakeVirtClient.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(k8stesting.UpdateAction).GetObject(), nil
+				})
+				fakeVirtClient.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+			})
+
+			DescribeTable("should select the VMs to remove", func(policy poolv1.VirtualMachinePoolScaleInSelectionPolicy, replicas int32, runningVMs, notReadyVMs []int, expectedDeletions []string) {
+				pool.Spec.Replicas = pointer.P(replicas)
+				pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
+					SelectionPolicy: policy,
+				}
+				addPool(pool)
+				for i := 0; i < 5; i++ {
+					vm := newPoolVM(i)
+					if !slices.Contains(notReadyVMs, i) {
+						markVmAsReady(vm)
+					}
+					addVM(vm)
+					if slices.Contains(runningVMs, i) {
+						vmi := api.NewMinimalVMI(vm.Name)
+						vmi.Namespace = vm.Namespace
+						addVMI(vmi)
+					}
+				}
+
+				sanityExecute()
+
+				Expect(deletedVMNames()).To(ConsistOf(expectedDeletions))
+			},
+				Entry("with the highest ordinal first", poolv1.VirtualMachinePoolScaleInHighestOrdinal,
+					int32(2), nil, nil, []string{"my-pool-2", "my-pool-3", "my-pool-4"}),
+				Entry("with stopped VMs first", poolv1.VirtualMachinePoolScaleInPreferStopped,
+					int32(3), []int{0, 1, 3}, nil, []string{"my-pool-2", "my-pool-4"}),
+				Entry("with not ready VMs first", poolv1.VirtualMachinePoolScaleInPreferNotReady,
+					int32(4), nil, []int{1}, []string{"my-pool-1"}),
+			)
+
+			It("should sort VMs without an ordinal last with the highest ordinal first", func() {
+				pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
+					SelectionPolicy: poolv1.VirtualMachinePoolScaleInHighestOrdinal,
+				}
+				var vms []*v1.VirtualMachine
+				for _, name := range []string{"my-pool-custom", "my-pool-1", "my-pool-3", "my-pool-0"} {
+					vms = append(vms, &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: name}})
+				}
+
+				controller.sortByScaleInPreference(pool, vms)
+
+				var names []string
+				for _, vm := range vms {
+					names = append(names, vm.Name)
+				}
+				Expect(names).To(Equal([]string{"my-pool-3", "my-pool-1", "my-pool-0", "my-pool-custom"}))
+			})
+
+			Context("and retained volumes", func() {
+				var vm *v1.VirtualMachine
+
+				vmOwnerRef := func() metav1.OwnerReference {
+					return metav1.OwnerReference{
+						APIVersion:         v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+						Kind:               v1.VirtualMachineGroupVersionKind.Kind,
+						Name:               vm.Name,
+						UID:                vm.UID,
+						Controller:         pointer.P(true),
+						BlockOwnerDeletion: pointer.P(true),
+					}
+				}
+
+				BeforeEach(func() {
+					pool.Spec.VirtualMachineTemplate.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+						ObjectMeta: metav1.ObjectMeta{Name: "disk"},
+					}}
+					pool.Spec.ScaleInStrategy = &poolv1.VirtualMachinePoolScaleInStrategy{
+						VolumePolicy: poolv1.VirtualMachinePoolScaleInRetainVolumes,
+					}
+					vm = newPoolVM(0)
+					Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("disk-0"))
+				})
+
+				It("should detach the DataVolume of removed VMs before deleting them", func() {
+					dv := &cdiv1.DataVolume{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "disk-0",
+							Namespace:       vm.Namespace,
+							OwnerReferences: []metav1.OwnerReference{vmOwnerRef()},
+						},
+					}
+					Expect(cdiClient.Tracker().Add(dv)).To(Succeed())
+					// The PVC is owned by the DataVolume and must stay untouched
+					pvc := &k8sv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "disk-0",
+							Namespace: vm.Namespace,
+							OwnerReferences: []metav1.OwnerReference{{
+								Kind: "DataVolume",
+								Name: dv.Name,
+								UID:  "dv-uid",
+							}},
+						},
+					}
+					k8sClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						return true, pvc, nil
+					})
+
+					addPool(pool)
+					addVM(vm)
+
+					sanityExecute()
+
+					Expect(deletedVMNames()).To(ConsistOf(vm.Name))
+					retained, err := cdiClient.CdiV1beta1().DataVolumes(vm.Namespace).Get(context.Background(), dv.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(retained.OwnerReferences).To(BeEmpty())
+					Expect(retained.Annotations).To(HaveKeyWithValue(v1.VirtualMachinePoolRetainedFromAnnotation, string(vm.UID)))
+					Expect(testing.FilterActions(&k8sClient.Fake, "patch", "persistentvolumeclaims")).To(BeEmpty())
+				})
+
+				It("should detach PVCs owned by removed VMs", func() {
+					pvc := &k8sv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:            "disk-0",
+							Namespace:       vm.Namespace,
+							OwnerReferences: []metav1.OwnerReference{vmOwnerRef()},
+						},
+					}
+					k8sClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						return true, pvc, nil
+					})
+					k8sClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						patchAction := action.(k8stesting.PatchAction)
+						Expect(patchAction.GetName()).To(Equal(pvc.Name))
+						Expect(string(patchAction.GetPatch())).To(ContainSubstring(`"op":"replace","path":"/metadata/ownerReferences","value":[]`))
+						Expect(string(patchAction.GetPatch())).To(ContainSubstring(string(vm.UID)))
+						return true, pvc, nil
+					})
+
+					addPool(pool)
+					addVM(vm)
+
+					sanityExecute()
+
+					Expect(deletedVMNames()).To(ConsistOf(vm.Name))
+					Expect(testing.FilterActions(&k8sClient.Fake, "patch", "persistentvolumeclaims")).To(HaveLen(1))
+				})
+
+				It("should not delete VMs whose volumes could not be retained", func() {
+					k8sClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+						return true, nil, fmt.Errorf("failure")
+					})
+
+					addPool(pool)
+					addVM(vm)
+
+					sanityExecute()
+
+					Expect(deletedVMNames()).To(BeEmpty())
+					testutils.ExpectEvent(recorder, FailedScaleInReason)
+				})
+			})
+		})
+
+		DescribeTable("should calculate rolling update limits", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, expectedMaxUnavailable, expectedMaxSurge int) {
+			pool, _ := DefaultPool(10)
+			pool.Spec.UpdateStrategy = strategy
+
+			maxUnavailable, maxSurge, err := rollingUpdateLimits(pool)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(maxUnavailable).To(Equal(expectedMaxUnavailable))
+			Expect(maxSurge).To(Equal(expectedMaxSurge))
+		},
+			Entry("all VMs without update strategy", nil, 10, 0),
+			Entry("all VMs without rolling update", &poolv1.VirtualMachinePoolUpdateStrategy{}, 10, 0),
+			Entry("25% rounded down by default", &poolv1.VirtualMachinePoolUpdateStrategy{
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{},
+			}, 2, 0),
+			Entry("no surge with the Restart method", &poolv1.VirtualMachinePoolUpdateStrategy{
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+					MaxSurge:       pointer.P(intstr.FromInt32(2)),
+				},
+			}, 1, 0),
+			Entry("surge rounded up with the Recreate method", &poolv1.VirtualMachinePoolUpdateStrategy{
+				ProactiveMethod: poolv1.VirtualMachinePoolProactiveUpdateRecreate,
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+					MaxSurge:       pointer.P(intstr.FromString("15%")),
+				},
+			}, 0, 2),
+		)
+
 		DescribeTable("should respect name generation settings", func(appendIndex *bool) {
 			const (
 				cmName     = "configmap"
@@ -814,6 +1286,12 @@ func DefaultPool(replicas int32) (*poolv1.VirtualMachinePool, *v1.VirtualMachine
 	return pool, vm.DeepCopy()
 }
 
+func setUpdatedStatus(pool *poolv1.VirtualMachinePool, revisionName string) {
+	pool.Status.UpdatedReplicas = pool.Status.Replicas
+	pool.Status.CurrentRevision = revisionName
+	pool.Status.UpdateRevision = revisionName
+}
+
 func markVmAsReady(vm *v1.VirtualMachine) {
 	virtcontroller.NewVirtualMachineConditionManager().UpdateCondition(vm, &v1.VirtualMachineCondition{Type: v1.VirtualMachineReady, Status: k8sv1.ConditionTrue})
 }
@@ -825,7 +825,9 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 	for _, volume := range vmi.Spec.Volumes {
 		hotpluggableVol := (volume.VolumeSource.PersistentVolumeClaim != nil &&
 			volume.VolumeSource.PersistentVolumeClaim.Hotpluggable) ||
-			(volume.VolumeSource.DataVolume != nil && volume.VolumeSource.DataVolume.Hotpluggable)
+			(volume.VolumeSource.DataVolume != nil && volume.VolumeSource.DataVolume.Hotpluggable) ||
+			// backup targets are only hotplugged into the VMI for the duration of a backup
+			volume.VolumeSource.BackupTarget != nil
 		_, ok := volsVM[volume.Name]
 		if !ok && hotpluggableVol {
 			hotplugOp = true
@@ -0,0 +1,87 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package workloadupdater
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/cron"
+)
+
+// maintenanceWindowState reports whether automated workload updates are allowed at now
+// and when the next maintenance window opens. Without configured windows updates are always allowed.
+func maintenanceWindowState(windows []virtv1.WorkloadUpdateMaintenanceWindow, now time.Time) (open bool, next time.Time, err error) {
+	if len(windows) == 0 {
+		return true, time.Time{}, nil
+	}
+
+	for _, window := range windows {
+		schedule, err := cron.Parse(window.Schedule)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+
+		// the window is open if it was activated within the last duration
+		if activation := schedule.Next(now.Add(-window.Duration.Duration)); !activation.IsZero() && !activation.After(now) {
+			open = true
+		}
+		if activation := schedule.Next(now); !activation.IsZero() && (next.IsZero() || activation.Before(next)) {
+			next = activation
+		}
+	}
+
+	return open, next, nil
+}
+
+type exclusionFilter struct {
+	namespaces map[string]bool
+	selector   labels.Selector
+}
+
+func newExclusionFilter(strategy *virtv1.KubeVirtWorkloadUpdateStrategy) (*exclusionFilter, error) {
+	filter := &exclusionFilter{
+		namespaces: make(map[string]bool, len(strategy.ExcludedNamespaces)),
+		selector:   labels.Nothing(),
+	}
+
+	for _, namespace := range strategy.ExcludedNamespaces {
+		filter.namespaces[namespace] = true
+	}
+
+	if strategy.ExcludedSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(strategy.ExcludedSelector)
+		if err != nil {
+			return nil, err
+		}
+		filter.selector = selector
+	}
+
+	return filter, nil
+}
+
+// isExcluded returns true if the VMI opted out of automated workload updates
+func (f *exclusionFilter) isExcluded(vmi *virtv1.VirtualMachineInstance) bool {
+	return f.namespaces[vmi.Namespace] || f.selector.Matches(labels.Set(vmi.Labels))
+}
@@ -12,6 +12,7 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -28,6 +29,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
@@ -83,8 +85,11 @@ type updateData struct {
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
 
 	numActiveMigrations int
+	numExcludedVMIs     int
 }
 
+var currentTime = time.Now
+
 func NewWorkloadUpdateController(
 	launcherImage string,
 	vmiInformer cache.SharedIndexInformer,
@@ -361,7 +366,7 @@ func (c *WorkloadUpdateController) shouldAbortMigration(vmi *virtv1.VirtualMachi
 	return numMig > 0
 }
 
-func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
+func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt, exclusion *exclusionFilter, maintenanceWindowOpen bool) *updateData {
 	data := &updateData{}
 
 	lookup := make(map[string]bool)
@@ -402,6 +407,17 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 
 		data.allOutdatedVMIs = append(data.allOutdatedVMIs, vmi)
 
+		// VMIs which are only outdated are left alone when excluded
+		// or outside of the maintenance windows
+		if !c.doesRequireMigration(vmi) {
+			if exclusion.isExcluded(vmi) {
+				data.numExcludedVMIs++
+				continue
+			} else if !maintenanceWindowOpen {
+				continue
+			}
+		}
+
 		// don't consider VMIs with migrations inflight as migratable for our dataset
 		// while a migrating workload can still be counted towards
 		// the outDatedVMIs list, we don't want to add it to any
@@ -455,9 +471,58 @@ func (c *WorkloadUpdateController) execute(key string) error {
 	return c.sync(kv)
 }
 
+func (c *WorkloadUpdateController) updateStatus(kv *virtv1.KubeVirt, numOutdated int, workloadUpdateStatus *virtv1.KubeVirtWorkloadUpdateStatus) error {
+	patchSet := patch.New()
+
+	// update outdated workload count on kv
+	if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil {
+		patchSet.AddOption(patch.WithAdd("/status/outdatedVirtualMachineInstanceWorkloads", numOutdated))
+	} else if *kv.Status.OutdatedVirtualMachineInstanceWorkloads != numOutdated {
+		patchSet.AddOption(
+			patch.WithTest("/status/outdatedVirtualMachineInstanceWorkloads", kv.Status.OutdatedVirtualMachineInstanceWorkloads),
+			patch.WithReplace("/status/outdatedVirtualMachineInstanceWorkloads", numOutdated),
+		)
+	}
+
+	if kv.Status.WorkloadUpdateStatus == nil {
+		patchSet.AddOption(patch.WithAdd("/status/workloadUpdateStatus", workloadUpdateStatus))
+	} else if !equality.Semantic.DeepEqual(kv.Status.WorkloadUpdateStatus, workloadUpdateStatus) {
+		patchSet.AddOption(
+			patch.WithTest("/status/workloadUpdateStatus", kv.Status.WorkloadUpdateStatus),
+			patch.WithReplace("/status/workloadUpdateStatus", workloadUpdateStatus),
+		)
+	}
+
+	if patchSet.IsEmpty() {
+		return nil
+	}
+
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.KubeVirt(kv.Namespace).PatchStatus(context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to patch kubevirt obj status to update the workload update status: %v", err)
+	}
+
+	return nil
+}
+
 func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
+	now := currentTime()
+
+	maintenanceWindowOpen, nextMaintenanceWindow, err := maintenanceWindowState(kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows, now)
+	if err != nil {
+		return fmt.Errorf("invalid workload update maintenance window: %v", err)
+	}
 
-	data := c.getUpdateData(kv)
+	exclusion, err := newExclusionFilter(&kv.Spec.WorkloadUpdateStrategy)
+	if err != nil {
+		return fmt.Errorf("invalid workload update excluded selector: %v", err)
+	}
+
+	data := c.getUpdateData(kv, exclusion, maintenanceWindowOpen)
 
 	key, err := controller.KeyFunc(kv)
 	if err != nil {
@@ -466,28 +531,22 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	metrics.SetOutdatedVirtualMachineInstanceWorkloads(len(data.allOutdatedVMIs))
 
-	// update outdated workload count on kv
-	if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil || *kv.Status.OutdatedVirtualMachineInstanceWorkloads != len(data.allOutdatedVMIs) {
-		l := len(data.allOutdatedVMIs)
-		kvCopy := kv.DeepCopy()
-		kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads = &l
-		patchSet := patch.New()
-		if kv.Status.OutdatedVirtualMachineInstanceWorkloads == nil {
-			patchSet.AddOption(patch.WithAdd("/status/outdatedVirtualMachineInstanceWorkloads", kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads))
-		} else {
-			patchSet.AddOption(
-				patch.WithTest("/status/outdatedVirtualMachineInstanceWorkloads", kv.Status.OutdatedVirtualMachineInstanceWorkloads),
-				patch.WithReplace("/status/outdatedVirtualMachineInstanceWorkloads", kvCopy.Status.OutdatedVirtualMachineInstanceWorkloads),
-			)
-		}
-		patchBytes, err := patchSet.GeneratePayload()
-		if err != nil {
-			return err
-		}
-		_, err = c.clientset.KubeVirt(kv.Namespace).PatchStatus(context.Background(), kv.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
-		if err != nil {
-			return fmt.Errorf("unable to patch kubevirt obj status to update the outdatedVirtualMachineInstanceWorkloads valued: %v", err)
-		}
+	workloadUpdateStatus := &virtv1.KubeVirtWorkloadUpdateStatus{
+		PendingVirtualMachineInstances:  len(data.allOutdatedVMIs) - data.numExcludedVMIs,
+		ExcludedVirtualMachineInstances: data.numExcludedVMIs,
+		MaintenanceWindowOpen:           maintenanceWindowOpen,
+	}
+	if !nextMaintenanceWindow.IsZero() {
+		workloadUpdateStatus.NextMaintenanceWindow = pointer.P(metav1.NewTime(nextMaintenanceWindow))
+	}
+
+	if err := c.updateStatus(kv, len(data.allOutdatedVMIs), workloadUpdateStatus); err != nil {
+		return err
+	}
+
+	// outdated VMIs are picked up again once the next maintenance window opens
+	if !maintenanceWindowOpen && workloadUpdateStatus.PendingVirtualMachineInstances > 0 && !nextMaintenanceWindow.IsZero() {
+		c.queue.AddAfter(key, nextMaintenanceWindow.Sub(now))
 	}
 
 	// Rather than enqueing based on VMI activity, we keep periodically poping the loop
@@ -514,8 +573,6 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		batchDeletionInterval = kv.Spec.WorkloadUpdateStrategy.BatchEvictionInterval.Duration
 	}
 
-	now := time.Now()
-
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
 	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
 		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
@@ -570,7 +627,8 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 					GenerateName: "kubevirt-workload-update-",
 				},
 				Spec: virtv1.VirtualMachineInstanceMigrationSpec{
-					VMIName: vmi.Name,
+					VMIName:  vmi.Name,
+					Priority: pointer.P(virtv1.MigrationPrioritySystemMaintenance),
 				},
 			}, metav1.CreateOptions{})
 			if err != nil {
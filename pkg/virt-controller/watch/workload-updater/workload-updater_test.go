@@ -133,6 +133,7 @@ var _ = Describe("Workload Updater", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(migrations.Items).To(HaveLen(1))
 			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm"))
+			Expect(migrations.Items[0].Spec.Priority).To(Equal(pointer.P(v1.MigrationPrioritySystemMaintenance)))
 		})
 
 		It("should do nothing if deployment is updating", func() {
@@ -494,6 +495,151 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("with maintenance windows and exclusions", func() {
+		now := time.Date(2024, time.May, 1, 12, 30, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			currentTime = func() time.Time { return now }
+			DeferCleanup(func() { currentTime = time.Now })
+		})
+
+		newWorkloadUpdateKubeVirt := func(windows ...v1.WorkloadUpdateMaintenanceWindow) *v1.KubeVirt {
+			kv := newKubeVirt(0)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = windows
+			return kv
+		}
+
+		addOutdatedVMI := func(name string, opts ...libvmi.Option) {
+			vmi := newVirtualMachineInstance(name, true, "madeup")
+			for _, opt := range opts {
+				opt(vmi)
+			}
+			controller.vmiStore.Add(vmi)
+			controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+		}
+
+		expectWorkloadUpdateStatus := func(kv *v1.KubeVirt, status *v1.KubeVirtWorkloadUpdateStatus) {
+			updatedKV, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), kv.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedKV.Status.WorkloadUpdateStatus).ToNot(BeNil())
+			if next := updatedKV.Status.WorkloadUpdateStatus.NextMaintenanceWindow; next != nil {
+				next.Time = next.UTC()
+			}
+			Expect(updatedKV.Status.WorkloadUpdateStatus).To(Equal(status))
+		}
+
+		expectMigrations := func(count int) {
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(count))
+		}
+
+		It("should not update outdated VMIs outside of the maintenance windows", func() {
+			addOutdatedVMI("testvm")
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+
+			kv := newWorkloadUpdateKubeVirt(v1.WorkloadUpdateMaintenanceWindow{
+				Schedule: "0 22 * * *",
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+			})
+			addKubeVirt(kv)
+			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+			expectMigrations(0)
+			expectWorkloadUpdateStatus(kv, &v1.KubeVirtWorkloadUpdateStatus{
+				PendingVirtualMachineInstances: 1,
+				MaintenanceWindowOpen:          false,
+				NextMaintenanceWindow:          pointer.P(metav1.NewTime(time.Date(2024, time.May, 1, 22, 0, 0, 0, time.UTC))),
+			})
+		})
+
+		It("should update outdated VMIs during a maintenance window", func() {
+			addOutdatedVMI("testvm")
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+
+			kv := newWorkloadUpdateKubeVirt(
+				v1.WorkloadUpdateMaintenanceWindow{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+				v1.WorkloadUpdateMaintenanceWindow{Schedule: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			)
+			addKubeVirt(kv)
+			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrations(1)
+			expectWorkloadUpdateStatus(kv, &v1.KubeVirtWorkloadUpdateStatus{
+				PendingVirtualMachineInstances: 1,
+				MaintenanceWindowOpen:          true,
+				NextMaintenanceWindow:          pointer.P(metav1.NewTime(time.Date(2024, time.May, 1, 22, 0, 0, 0, time.UTC))),
+			})
+		})
+
+		It("should not update VMIs which opted out by namespace or label", func() {
+			addOutdatedVMI("testvm-excluded-by-label", libvmi.WithLabel("workload-update", "disabled"))
+			addOutdatedVMI("testvm-excluded-by-namespace", libvmi.WithNamespace("excluded"))
+			addOutdatedVMI("testvm")
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 3)
+
+			kv := newWorkloadUpdateKubeVirt()
+			kv.Spec.WorkloadUpdateStrategy.ExcludedNamespaces = []string{"excluded"}
+			kv.Spec.WorkloadUpdateStrategy.ExcludedSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"workload-update": "disabled"},
+			}
+			addKubeVirt(kv)
+			_, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Create(context.Background(), kv, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(1))
+			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm"))
+
+			updatedKV, err := fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault).Get(context.Background(), kv.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedKV.Status.OutdatedVirtualMachineInstanceWorkloads).To(Equal(pointer.P(3)))
+			Expect(updatedKV.Status.WorkloadUpdateStatus).To(Equal(&v1.KubeVirtWorkloadUpdateStatus{
+				PendingVirtualMachineInstances:  1,
+				ExcludedVirtualMachineInstances: 2,
+				MaintenanceWindowOpen:           true,
+			}))
+		})
+
+		DescribeTable("should evaluate maintenance windows", func(windows []v1.WorkloadUpdateMaintenanceWindow, expectedOpen bool, expectedNext time.Time) {
+			open, next, err := maintenanceWindowState(windows, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(Equal(expectedOpen))
+			Expect(next).To(Equal(expectedNext))
+		},
+			Entry("as always open without windows", nil, true, time.Time{}),
+			Entry("as open within the duration of a window",
+				[]v1.WorkloadUpdateMaintenanceWindow{{Schedule: "30 11 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}}},
+				true, time.Date(2024, time.May, 2, 11, 30, 0, 0, time.UTC)),
+			Entry("as open when the window starts now",
+				[]v1.WorkloadUpdateMaintenanceWindow{{Schedule: "30 12 * * *", Duration: metav1.Duration{Duration: time.Minute}}},
+				true, time.Date(2024, time.May, 2, 12, 30, 0, 0, time.UTC)),
+			Entry("as closed when the window ended",
+				[]v1.WorkloadUpdateMaintenanceWindow{{Schedule: "30 11 * * *", Duration: metav1.Duration{Duration: time.Hour}}},
+				false, time.Date(2024, time.May, 2, 11, 30, 0, 0, time.UTC)),
+			Entry("as closed and report the earliest next window",
+				[]v1.WorkloadUpdateMaintenanceWindow{
+					{Schedule: "0 2 * * sat", Duration: metav1.Duration{Duration: time.Hour}},
+					{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				},
+				false, time.Date(2024, time.May, 1, 22, 0, 0, 0, time.UTC)),
+		)
+
+		It("should fail on an invalid maintenance window schedule", func() {
+			_, _, err := maintenanceWindowState([]v1.WorkloadUpdateMaintenanceWindow{{Schedule: "not a schedule"}}, now)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			condition := v1.VirtualMachineInstanceCondition{
@@ -679,6 +825,10 @@ func newKubeVirt(expectedNumOutdated int) *v1.KubeVirt {
 		Status: v1.KubeVirtStatus{
 			Phase:                                   v1.KubeVirtPhaseDeployed,
 			OutdatedVirtualMachineInstanceWorkloads: &expectedNumOutdated,
+			WorkloadUpdateStatus: &v1.KubeVirtWorkloadUpdateStatus{
+				PendingVirtualMachineInstances: expectedNumOutdated,
+				MaintenanceWindowOpen:          true,
+			},
 		},
 	}
 }
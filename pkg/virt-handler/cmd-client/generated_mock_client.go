@@ -44,6 +44,20 @@ func (m *MockLauncherClient) EXPECT() *MockLauncherClientMockRecorder {
 	return m.recorder
 }
 
+// BackupVirtualMachine mocks base method.
+func (m *MockLauncherClient) BackupVirtualMachine(arg0 *v1.VirtualMachineInstance, arg1 *v1.VirtualMachineInstanceBackupOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupVirtualMachine", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackupVirtualMachine indicates an expected call of BackupVirtualMachine.
+func (mr *MockLauncherClientMockRecorder) BackupVirtualMachine(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).BackupVirtualMachine), arg0, arg1)
+}
+
 // CancelVirtualMachineMigration mocks base method.
 func (m *MockLauncherClient) CancelVirtualMachineMigration(vmi *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
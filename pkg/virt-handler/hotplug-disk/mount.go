@@ -319,7 +319,7 @@ func (m *volumeMounter) mountFromPod(vmi *v1.VirtualMachineInstance, sourceUID t
 			continue
 		}
 		mountDirectory := false
-		if volumeStatus.MemoryDumpVolume != nil {
+		if volumeStatus.MemoryDumpVolume != nil || volumeStatus.BackupTargetVolume != nil {
 			mountDirectory = true
 		}
 		if sourceUID == "" {
@@ -335,7 +335,7 @@ func (m *volumeMounter) mountFromPod(vmi *v1.VirtualMachineInstance, sourceUID t
 func (m *volumeMounter) isDirectoryMounted(vmiStatus *v1.VirtualMachineInstanceStatus, volumeName string) bool {
 	for _, status := range vmiStatus.VolumeStatus {
 		if status.Name == volumeName {
-			return status.MemoryDumpVolume != nil
+			return status.MemoryDumpVolume != nil || status.BackupTargetVolume != nil
 		}
 	}
 	return false
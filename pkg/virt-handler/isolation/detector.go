@@ -146,7 +146,12 @@ func AdjustQemuProcessMemoryLimits(podIsoDetector PodIsolationDetector, vmi *v1.
 	if !util.IsVFIOVMI(vmi) && !vmi.IsRealtimeEnabled() && !util.IsSEVVMI(vmi) {
 		return nil
 	}
+	return LockQemuProcessMemoryLimits(podIsoDetector, vmi, additionalOverheadRatio)
+}
 
+// LockQemuProcessMemoryLimits sets the QEMU process MEMLOCK rlimits, that runs inside virt-launcher pod of
+// the given VMI, so all of its memory can be locked.
+func LockQemuProcessMemoryLimits(podIsoDetector PodIsolationDetector, vmi *v1.VirtualMachineInstance, additionalOverheadRatio *string) error {
 	isolationResult, err := podIsoDetector.Detect(vmi)
 	if err != nil {
 		return err
@@ -330,3 +330,37 @@ func (lh *LifecycleHandler) SEVInjectLaunchSecretHandler(request *restful.Reques
 
 	response.WriteHeader(http.StatusAccepted)
 }
+
+func (lh *LifecycleHandler) BackupHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: backup parameters are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve backup parameters from request"))
+		return
+	}
+
+	opts := &v1.VirtualMachineInstanceBackupOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode backup parameters")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Starting backup %s", opts.BackupName)
+
+	if err := client.BackupVirtualMachine(vmi, opts); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to start backup")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
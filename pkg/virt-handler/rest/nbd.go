@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// the NBD server of a Pull mode backup listens in the backup directory of virt-launcher
+const (
+	backupsDirName   = "backups"
+	backupSocketName = "backup.sock"
+)
+
+func (t *ConsoleHandler) NBDHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	unixSocketPath, err := t.getBackupSocketPath(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for the backup NBD server")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), make(chan struct{}))
+}
+
+func (t *ConsoleHandler) getBackupSocketPath(vmi *v1.VirtualMachineInstance) (string, error) {
+	backupState := vmi.Status.BackupState
+	if backupState == nil || backupState.Mode != v1.BackupModePull || backupState.Completed {
+		return "", errors.New("VMI has no running Pull mode backup")
+	}
+	backupName := backupState.BackupName
+	if backupName == "" || backupName == "." || backupName == ".." || path.Base(backupName) != backupName {
+		return "", fmt.Errorf("invalid backup name %q", backupName)
+	}
+
+	result, err := t.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return "", err
+	}
+	socketPath := path.Join("/proc", strconv.Itoa(result.Pid()), "root", "var", "run", "kubevirt-private", backupsDirName, backupName, backupSocketName)
+	if _, err = os.Stat(socketPath); err != nil {
+		return "", err
+	}
+	return socketPath, nil
+}
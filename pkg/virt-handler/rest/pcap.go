@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/capture"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/netns"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+func (t *ConsoleHandler) PcapHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+
+	interfaceName := request.QueryParameter("interface")
+	devices, err := captureDeviceCandidates(vmi, interfaceName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to find the device to capture on")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	filter, err := capture.ParseFilter(request.QueryParameter("filter"))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to parse the capture filter")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	t.stream(vmi, request, response, func() (net.Conn, error) {
+		isolationResult, err := t.podIsolationDetector.Detect(vmi)
+		if err != nil {
+			return nil, err
+		}
+
+		var sock *capture.Socket
+		var device string
+		err = netns.New(isolationResult.Pid()).Do(func() error {
+			var openErr error
+			for _, device = range devices {
+				if sock, openErr = capture.Open(device, filter); openErr == nil {
+					return nil
+				}
+			}
+			return openErr
+		})
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Failed to start the capture on interface %s", interfaceName)
+			return nil, err
+		}
+		log.Log.Object(vmi).Infof("Capturing on device %s for interface %s", device, interfaceName)
+
+		local, remote := net.Pipe()
+		stop := make(chan struct{})
+		go func() {
+			// The client does not send data, reading only detects when it disconnects.
+			_, _ = io.Copy(io.Discard, remote)
+			close(stop)
+		}()
+		go func() {
+			defer remote.Close()
+			defer sock.Close()
+			writer, err := capture.NewWriter(remote, interfaceName, capture.DefaultSnapLen)
+			if err == nil {
+				err = sock.Stream(writer, stop)
+			}
+			if err != nil {
+				log.Log.Object(vmi).Reason(err).Infof("Capture on device %s stopped", device)
+			}
+		}()
+		return local, nil
+	}, make(chan struct{}))
+}
+
+// captureDeviceCandidates returns the pod devices which carry the traffic of
+// the named VMI interface, in order of preference: the tap device which is
+// directly connected to the guest, followed by the in-pod bridge.
+func captureDeviceCandidates(vmi *v1.VirtualMachineInstance, interfaceName string) ([]string, error) {
+	if interfaceName == "" {
+		return nil, errors.New("interface query parameter is required")
+	}
+	if vmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, interfaceName) == nil {
+		return nil, fmt.Errorf("interface %s not found", interfaceName)
+	}
+	network := vmispec.LookupNetworkByName(vmi.Spec.Networks, interfaceName)
+	if network == nil {
+		return nil, fmt.Errorf("network %s not found", interfaceName)
+	}
+
+	podInterfaceName := namescheme.HashedPodInterfaceName(*network, vmi.Status.Interfaces)
+	return []string{
+		link.GenerateTapDeviceName(podInterfaceName, *network),
+		link.GenerateBridgeName(podInterfaceName),
+	}, nil
+}
@@ -152,6 +152,14 @@ const (
 
 	// MemoryHotplugFailedReason is the reason set when the VM cannot hotplug memory
 	memoryHotplugFailedReason = "Memory Hotplug Failed"
+
+	// zeroCopyMigrationDisabledReason is the reason set when a migration cannot use zero-copy
+	zeroCopyMigrationDisabledReason = "ZeroCopyMigrationDisabled"
+
+	//InterfaceLinkSetUp is the reason set when the configured link state of a VMI interface changes to up
+	InterfaceLinkSetUp = "InterfaceLinkSetUp"
+	//InterfaceLinkSetDown is the reason set when the configured link state of a VMI interface changes to down
+	InterfaceLinkSetDown = "InterfaceLinkSetDown"
 )
 
 var getCgroupManager = func(vmi *v1.VirtualMachineInstance, host string) (cgroup.Manager, error) {
@@ -603,6 +611,22 @@ func (c *VirtualMachineController) setMigrationProgressStatus(vmi *v1.VirtualMac
 	vmi.Status.MigrationState.Completed = migrationMetadata.Completed
 	vmi.Status.MigrationState.Failed = migrationMetadata.Failed
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	if migrationMetadata.Progress != nil {
+		vmi.Status.MigrationState.Progress = migrationProgressFromMetadata(migrationMetadata.Progress)
+	}
+}
+
+func migrationProgressFromMetadata(progress *api.MigrationProgressMetadata) *v1.MigrationProgress {
+	return &v1.MigrationProgress{
+		UpdateTimestamp:               progress.UpdateTimestamp,
+		DataProcessedBytes:            int64(progress.DataProcessed),
+		DataRemainingBytes:            int64(progress.DataRemaining),
+		DataTotalBytes:                int64(progress.DataTotal),
+		MemoryDirtyRateBytesPerSecond: int64(progress.MemoryDirtyRate),
+		ThroughputBytesPerSecond:      int64(progress.Throughput),
+		Iteration:                     int64(progress.Iteration),
+		EstimatedCompletionSeconds:    progress.EstimatedCompletionSeconds,
+	}
 }
 
 func (c *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -729,7 +753,12 @@ func (c *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 
 		// adjust QEMU process memlock limits in order to enable old virt-launcher pod's to
 		// perform hotplug host-devices on post migration.
-		if err := isolation.AdjustQemuProcessMemoryLimits(c.podIsolationDetector, vmi, c.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio); err != nil {
+		// Zero-copy migrations lock the memory on the target as well, as they do on the source.
+		adjustQemuProcessMemoryLimits := isolation.AdjustQemuProcessMemoryLimits
+		if isZeroCopyMigration(vmi) {
+			adjustQemuProcessMemoryLimits = isolation.LockQemuProcessMemoryLimits
+		}
+		if err := adjustQemuProcessMemoryLimits(c.podIsolationDetector, vmi, c.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio); err != nil {
 			c.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "Failed to update target node qemu memory limits during live migration")
 		}
 
@@ -1248,6 +1277,42 @@ func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineIn
 
 }
 
+func (c *VirtualMachineController) updateBackupStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Backup == nil {
+		return
+	}
+
+	backupMetadata := domain.Spec.Metadata.KubeVirt.Backup
+	backupState := &v1.VirtualMachineInstanceBackupState{
+		BackupName:      backupMetadata.Name,
+		Mode:            backupMetadata.Mode,
+		CheckpointName:  backupMetadata.Name,
+		IncrementalFrom: backupMetadata.IncrementalFrom,
+		Endpoint:        backupMetadata.Endpoint,
+		StartTimestamp:  backupMetadata.StartTimestamp,
+		EndTimestamp:    backupMetadata.EndTimestamp,
+		Completed:       backupMetadata.Completed,
+		Failed:          backupMetadata.Failed,
+		FailureReason:   backupMetadata.FailureReason,
+	}
+	if backupMetadata.Failed {
+		// a failed backup leaves no usable checkpoint behind
+		backupState.CheckpointName = ""
+	}
+	if backupMetadata.Volumes != nil {
+		for _, volume := range backupMetadata.Volumes.Volume {
+			backupState.Volumes = append(backupState.Volumes, v1.VirtualMachineInstanceBackupVolume{
+				VolumeName:   volume.Name,
+				Incremental:  volume.Incremental,
+				TargetFile:   volume.TargetFile,
+				ExportName:   volume.ExportName,
+				ExportBitmap: volume.ExportBitmap,
+			})
+		}
+	}
+	vmi.Status.BackupState = backupState
+}
+
 func IsoGuestVolumePath(namespace, name string, volume *v1.Volume) string {
 	const basepath = "/var/run"
 	switch {
@@ -1364,12 +1429,35 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	c.updateGuestInfoFromDomain(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
+	c.updateBackupStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
-	err = c.netStat.UpdateStatus(vmi, domain)
-	return err
+	prevIfacesStatus := vmi.Status.Interfaces
+	if err = c.netStat.UpdateStatus(vmi, domain); err != nil {
+		return err
+	}
+	c.generateEventsForInterfaceLinkStateChange(vmi, prevIfacesStatus)
+	return nil
+}
+
+// generateEventsForInterfaceLinkStateChange reports changes of the link state configured on the domain interfaces.
+// The guest carrier state is not known, therefore the events do not reflect the guest side of the link.
+func (c *VirtualMachineController) generateEventsForInterfaceLinkStateChange(vmi *v1.VirtualMachineInstance, prevIfacesStatus []v1.VirtualMachineInstanceNetworkInterface) {
+	prevIfacesStatusByName := netvmispec.IndexInterfaceStatusByName(prevIfacesStatus, nil)
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		prevIfaceStatus, exists := prevIfacesStatusByName[ifaceStatus.Name]
+		if ifaceStatus.Name == "" || !exists || prevIfaceStatus.LinkState == "" || prevIfaceStatus.LinkState == ifaceStatus.LinkState {
+			continue
+		}
+		switch ifaceStatus.LinkState {
+		case string(v1.InterfaceStateLinkDown):
+			c.recorder.Event(vmi, k8sv1.EventTypeWarning, InterfaceLinkSetDown, fmt.Sprintf("Interface %s link was set down", ifaceStatus.Name))
+		case string(v1.InterfaceStateLinkUp):
+			c.recorder.Event(vmi, k8sv1.EventTypeNormal, InterfaceLinkSetUp, fmt.Sprintf("Interface %s link was set up", ifaceStatus.Name))
+		}
+	}
 }
 
 func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
@@ -2696,6 +2784,15 @@ func (c *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 		}
 
 		configureParallelMigrationThreads(options, origVMI)
+		configureMigrationStreamOptions(options, migrationConfiguration)
+		if options.ZeroCopy {
+			// Zero-copy pins the guest memory while it is sent, within the QEMU process memlock limits
+			if err := isolation.LockQemuProcessMemoryLimits(c.podIsolationDetector, origVMI, c.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio); err != nil {
+				log.Log.Object(origVMI).Reason(err).Warning("failed to set the QEMU process memlock limits, migrating without zero-copy")
+				c.recorder.Event(origVMI, k8sv1.EventTypeWarning, zeroCopyMigrationDisabledReason, err.Error())
+				options.ZeroCopy = false
+			}
+		}
 
 		marshalledOptions, err := json.Marshal(options)
 		if err != nil {
@@ -3804,6 +3901,31 @@ func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *
 	options.ParallelMigrationThreads = pointer.P(parallelMultifdMigrationThreads)
 }
 
+// configureMigrationStreamOptions configures the compression, zero page detection, parallel streams and zero-copy
+// migration options. Zero-copy is not supported along with post-copy, hence it is not used when post-copy is allowed.
+// Whether post-copy can use the parallel streams depends on the QEMU version, and is decided by virt-launcher.
+func configureMigrationStreamOptions(options *cmdclient.MigrationOptions, migrationConfiguration *v1.MigrationConfiguration) {
+	options.Compression = migrationConfiguration.Compression
+	options.ZeroPageDetection = migrationConfiguration.ZeroPageDetection
+	if migrationConfiguration.ParallelStreams == nil {
+		return
+	}
+	options.ParallelMigrationThreads = pointer.P(uint(*migrationConfiguration.ParallelStreams))
+	options.ZeroCopy = !options.AllowPostCopy && migrationConfiguration.ZeroCopy != nil && *migrationConfiguration.ZeroCopy
+}
+
+func isZeroCopyMigration(vmi *v1.VirtualMachineInstance) bool {
+	migrationConfiguration := vmi.Status.MigrationState.MigrationConfiguration
+	if migrationConfiguration == nil {
+		return false
+	}
+	options := &cmdclient.MigrationOptions{
+		AllowPostCopy: migrationConfiguration.AllowPostCopy != nil && *migrationConfiguration.AllowPostCopy,
+	}
+	configureMigrationStreamOptions(options, migrationConfiguration)
+	return options.ZeroCopy
+}
+
 func isReadOnlyDisk(disk *v1.Disk) bool {
 	isReadOnlyCDRom := disk.CDRom != nil && (disk.CDRom.ReadOnly == nil || *disk.CDRom.ReadOnly)
 
@@ -2989,6 +2989,31 @@ var _ = Describe("VirtualMachineInstance", func() {
 				InterfaceName: domain.Status.Interfaces[0].InterfaceName,
 			}))
 		})
+
+		DescribeTable("should generate an event when an interface configured link state changes", func(prevLinkState, linkState, expectedReason string) {
+			const ifaceName = "default"
+			prevIfacesStatus := []v1.VirtualMachineInstanceNetworkInterface{{Name: ifaceName, LinkState: prevLinkState}}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: ifaceName, LinkState: linkState}}
+
+			controller.generateEventsForInterfaceLinkStateChange(vmi, prevIfacesStatus)
+
+			testutils.ExpectEvent(recorder, expectedReason)
+		},
+			Entry("from up to down", "up", "down", InterfaceLinkSetDown),
+			Entry("from down to up", "down", "up", InterfaceLinkSetUp),
+		)
+
+		DescribeTable("should not generate an event", func(prevIfacesStatus []v1.VirtualMachineInstanceNetworkInterface) {
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", LinkState: "down"}}
+
+			controller.generateEventsForInterfaceLinkStateChange(vmi, prevIfacesStatus)
+
+			Expect(recorder.Events).To(BeEmpty())
+		},
+			Entry("when the link state did not change", []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", LinkState: "down"}}),
+			Entry("when the interface was not reported before", nil),
+			Entry("when the link state was not reported before", []v1.VirtualMachineInstanceNetworkInterface{{Name: "default"}}),
+		)
 	})
 
 	Context("VirtualMachineInstance controller gets informed about changes in a Domain", func() {
@@ -3416,6 +3441,68 @@ var _ = Describe("VirtualMachineInstance", func() {
 				controller.Execute()
 				testutils.ExpectEvent(recorder, VMIMigrating)
 			})
+
+			It("should configure the stream options of the migration configuration", func() {
+				vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU] = resource.MustParse("4")
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration().DeepCopy()
+				migrationConfiguration.ParallelStreams = pointer.P(uint32(4))
+				migrationConfiguration.Compression = &v1.MigrationCompression{
+					Method: v1.MigrationCompressionZstd,
+					Level:  pointer.P(int32(3)),
+				}
+				migrationConfiguration.ZeroPageDetection = pointer.P(v1.MigrationZeroPageDetectionMultifd)
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ParallelMigrationThreads).To(PointTo(Equal(uint(4))))
+					Expect(options.Compression).To(Equal(migrationConfiguration.Compression))
+					Expect(options.ZeroCopy).To(BeFalse())
+					Expect(options.ZeroPageDetection).To(PointTo(Equal(v1.MigrationZeroPageDetectionMultifd)))
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
+
+			It("should not configure zero-copy when post-copy is allowed", func() {
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration().DeepCopy()
+				migrationConfiguration.ParallelStreams = pointer.P(uint32(4))
+				migrationConfiguration.ZeroCopy = pointer.P(true)
+				migrationConfiguration.AllowPostCopy = pointer.P(true)
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.AllowPostCopy).To(BeTrue())
+					Expect(options.ZeroCopy).To(BeFalse())
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
+
+			It("should not configure zero-copy when the QEMU process memory cannot be locked", func() {
+				migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration().DeepCopy()
+				migrationConfiguration.ParallelStreams = pointer.P(uint32(4))
+				migrationConfiguration.ZeroCopy = pointer.P(true)
+				vmi.Status.MigrationState.MigrationConfiguration = migrationConfiguration
+
+				ctrl := gomock.NewController(GinkgoT())
+				isolationResult := isolation.NewMockIsolationResult(ctrl)
+				isolationResult.EXPECT().Pid().Return(1).AnyTimes()
+				isolationResult.EXPECT().GetQEMUProcess().Return(nil, fmt.Errorf("no QEMU process")).Times(1)
+				isolationDetector := isolation.NewMockPodIsolationDetector(ctrl)
+				isolationDetector.EXPECT().Detect(gomock.Any()).Return(isolationResult, nil).AnyTimes()
+				controller.podIsolationDetector = isolationDetector
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.ParallelMigrationThreads).To(PointTo(Equal(uint(4))))
+					Expect(options.ZeroCopy).To(BeFalse())
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, zeroCopyMigrationDisabledReason)
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
 		})
 	})
 
@@ -3483,6 +3570,39 @@ var _ = Describe("VirtualMachineInstance", func() {
 				d.Spec.Metadata.KubeVirt.Migration.AbortStatus)))
 		})
 
+		It("should report the migration progress", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			d.Spec.Metadata.KubeVirt.Migration.Progress = &api.MigrationProgressMetadata{
+				UpdateTimestamp:            pointer.P(metav1.NewTime(time.Now())),
+				DataProcessed:              100,
+				DataRemaining:              200,
+				DataTotal:                  300,
+				MemoryDirtyRate:            10,
+				Throughput:                 50,
+				Iteration:                  2,
+				EstimatedCompletionSeconds: pointer.P(int64(5)),
+			}
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+				}))))
+
+			controller.setMigrationProgressStatus(vmi, d)
+
+			Expect(vmi.Status.MigrationState.Progress).To(Equal(&v1.MigrationProgress{
+				UpdateTimestamp:               d.Spec.Metadata.KubeVirt.Migration.Progress.UpdateTimestamp,
+				DataProcessedBytes:            100,
+				DataRemainingBytes:            200,
+				DataTotalBytes:                300,
+				MemoryDirtyRateBytesPerSecond: 10,
+				ThroughputBytesPerSecond:      50,
+				Iteration:                     2,
+				EstimatedCompletionSeconds:    pointer.P(int64(5)),
+			}))
+		})
+
 		It("should send an event if the migration failed", func() {
 			d := newDomainMigrationKubevirtMetadata("1234", pointer.P(metav1.NewTime(time.Now())),
 				true, true, v1.MigrationPreCopy)
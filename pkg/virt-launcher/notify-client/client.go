@@ -425,6 +425,9 @@ func (n *Notifier) StartDomainNotifier(
 		var fsFreezeStatus *api.FSFreeze
 		var eventCaller eventCaller
 
+		interfaceStatsWatcher := newInterfaceStatsWatcher()
+		interfaceStatsTicker := time.NewTicker(interfaceStatsInterval)
+
 		for {
 			select {
 			case event := <-eventChan:
@@ -450,6 +453,9 @@ func (n *Notifier) StartDomainNotifier(
 			case <-reconnectChan:
 				n.SendDomainEvent(newWatchEventError(fmt.Errorf("Libvirt reconnect, domain %s", domainName)))
 
+			case now := <-interfaceStatsTicker.C:
+				n.sendInterfaceStatsEvents(domainConn, interfaceStatsWatcher, vmi, now)
+
 			case <-metadataCache.Listen():
 				// Metadata cache updates should be processed only *after* at least one
 				// libvirt event arrived (which creates the first domainCache).
@@ -574,6 +580,24 @@ func (n *Notifier) StartDomainNotifier(
 	return nil
 }
 
+// sendInterfaceStatsEvents sends a k8s event for every domain interface which started dropping packets or
+// having packet errors since the previous check.
+// The traffic counters themselves are exposed by the domain network metrics.
+func (n *Notifier) sendInterfaceStatsEvents(domainConn cli.Connection, watcher *interfaceStatsWatcher, vmi *v1.VirtualMachineInstance, now time.Time) {
+	domainStats, err := domainConn.GetDomainStats(libvirt.DOMAIN_STATS_INTERFACE, nil, libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING)
+	if err != nil {
+		log.Log.Reason(err).Warning("Could not get the domain interfaces statistics")
+		return
+	}
+	for _, domainStat := range domainStats {
+		for _, event := range watcher.update(now, domainStat.Net) {
+			if err := n.SendK8sEvent(vmi, event.severity, event.reason, event.message); err != nil {
+				log.Log.Reason(err).Error("Could not send k8s event")
+			}
+		}
+	}
+}
+
 func (n *Notifier) SendK8sEvent(vmi *v1.VirtualMachineInstance, severity string, reason string, message string) error {
 	vmiRef, err := reference.GetReference(scheme, vmi)
 	if err != nil {
@@ -0,0 +1,134 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"fmt"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	// interfaceStatsInterval is the interval in which the domain interfaces counters are checked
+	interfaceStatsInterval = time.Minute
+	// interfacePacketsThreshold is the number of dropped or erroneous packets per interval,
+	// from which an interface is reported
+	interfacePacketsThreshold = 10
+	// interfaceStatsEventInterval is the minimal interval between two events of the same reason on an interface
+	interfaceStatsEventInterval = 10 * time.Minute
+
+	interfacePacketsDroppedReason = "InterfacePacketsDropped"
+	interfacePacketErrorsReason   = "InterfacePacketErrors"
+)
+
+type interfaceCounters struct {
+	rxErrs uint64
+	txErrs uint64
+	rxDrop uint64
+	txDrop uint64
+}
+
+type interfaceState struct {
+	counters           interfaceCounters
+	dropping           bool
+	erroring           bool
+	droppingReportedAt time.Time
+	erroringReportedAt time.Time
+}
+
+type interfaceStatsEvent struct {
+	severity string
+	reason   string
+	message  string
+}
+
+// interfaceStatsWatcher tracks the error and drop counters of the domain interfaces,
+// reporting the interfaces which started dropping packets or having packet errors.
+type interfaceStatsWatcher struct {
+	stateByIfaceName map[string]interfaceState
+}
+
+func newInterfaceStatsWatcher() *interfaceStatsWatcher {
+	return &interfaceStatsWatcher{stateByIfaceName: map[string]interfaceState{}}
+}
+
+// update stores the given interfaces counters and returns events for every interface whose dropped packets or
+// packet errors since the previous update crossed the threshold.
+// An interface is reported again only after its counters increase falls below the threshold, and not before
+// interfaceStatsEventInterval passed since it was last reported for the same reason.
+// The event messages are constant per interface and reason, allowing the events to be aggregated.
+// Interfaces seen for the first time, or whose counters were reset, are not reported.
+func (w *interfaceStatsWatcher) update(now time.Time, netStats []stats.DomainStatsNet) []interfaceStatsEvent {
+	var events []interfaceStatsEvent
+	stateByIfaceName := map[string]interfaceState{}
+	for _, netStat := range netStats {
+		if !netStat.AliasSet || netStat.Alias == "" {
+			continue
+		}
+		prevState, exists := w.stateByIfaceName[netStat.Alias]
+		state := interfaceState{
+			counters: interfaceCounters{
+				rxErrs: netStat.RxErrs,
+				txErrs: netStat.TxErrs,
+				rxDrop: netStat.RxDrop,
+				txDrop: netStat.TxDrop,
+			},
+			droppingReportedAt: prevState.droppingReportedAt,
+			erroringReportedAt: prevState.erroringReportedAt,
+		}
+
+		if exists && !state.counters.isReset(prevState.counters) {
+			prevCounters := prevState.counters
+			state.dropping = state.counters.rxDrop-prevCounters.rxDrop+state.counters.txDrop-prevCounters.txDrop >= interfacePacketsThreshold
+			state.erroring = state.counters.rxErrs-prevCounters.rxErrs+state.counters.txErrs-prevCounters.txErrs >= interfacePacketsThreshold
+		}
+
+		if state.dropping && !prevState.dropping && shouldReportInterfaceStats(now, state.droppingReportedAt) {
+			state.droppingReportedAt = now
+			events = append(events, interfaceStatsEvent{
+				severity: k8sv1.EventTypeWarning,
+				reason:   interfacePacketsDroppedReason,
+				message:  fmt.Sprintf("Interface %s is dropping packets", netStat.Alias),
+			})
+		}
+		if state.erroring && !prevState.erroring && shouldReportInterfaceStats(now, state.erroringReportedAt) {
+			state.erroringReportedAt = now
+			events = append(events, interfaceStatsEvent{
+				severity: k8sv1.EventTypeWarning,
+				reason:   interfacePacketErrorsReason,
+				message:  fmt.Sprintf("Interface %s has packet errors", netStat.Alias),
+			})
+		}
+		stateByIfaceName[netStat.Alias] = state
+	}
+	w.stateByIfaceName = stateByIfaceName
+	return events
+}
+
+func shouldReportInterfaceStats(now, reportedAt time.Time) bool {
+	return reportedAt.IsZero() || now.Sub(reportedAt) >= interfaceStatsEventInterval
+}
+
+func (c interfaceCounters) isReset(prev interfaceCounters) bool {
+	return c.rxErrs < prev.rxErrs || c.txErrs < prev.txErrs || c.rxDrop < prev.rxDrop || c.txDrop < prev.txDrop
+}
@@ -0,0 +1,120 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package eventsclient
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Interface stats watcher", func() {
+	const ifaceName = "default"
+
+	now := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	newNetStat := func(alias string, rxErrs, txErrs, rxDrop, txDrop uint64) stats.DomainStatsNet {
+		return stats.DomainStatsNet{
+			Name:     "tap0",
+			NameSet:  true,
+			Alias:    alias,
+			AliasSet: alias != "",
+			RxErrs:   rxErrs,
+			TxErrs:   txErrs,
+			RxDrop:   rxDrop,
+			TxDrop:   txDrop,
+		}
+	}
+
+	It("should not report an interface seen for the first time", func() {
+		watcher := newInterfaceStatsWatcher()
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 100, 200, 300, 400)})).To(BeEmpty())
+	})
+
+	It("should not report an interface whose counters did not change", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 1, 2, 3, 4)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 1, 2, 3, 4)})).To(BeEmpty())
+	})
+
+	It("should not report an interface whose counters increase is below the threshold", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 1, 2, 3, 4)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 5, 6, 7, 8)})).To(BeEmpty())
+	})
+
+	It("should report an interface whose dropped packets and packet errors cross the threshold", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 1, 2, 3, 4)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 10, 3, 13, 5)})).To(ConsistOf(
+			interfaceStatsEvent{
+				severity: k8sv1.EventTypeWarning,
+				reason:   interfacePacketsDroppedReason,
+				message:  "Interface default is dropping packets",
+			},
+			interfaceStatsEvent{
+				severity: k8sv1.EventTypeWarning,
+				reason:   interfacePacketErrorsReason,
+				message:  "Interface default has packet errors",
+			},
+		))
+	})
+
+	It("should report an interface again only after it stopped crossing the threshold", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 0, 0)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 10, 0)})).To(HaveLen(1))
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 20, 0)})).To(BeEmpty())
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 20, 0)})).To(BeEmpty())
+		Expect(watcher.update(now.Add(interfaceStatsEventInterval), []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 30, 0)})).To(HaveLen(1))
+	})
+
+	It("should not report an interface again before the event interval passed", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 0, 0)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 10, 0)})).To(HaveLen(1))
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 10, 0)})).To(BeEmpty())
+
+		later := now.Add(interfaceStatsEventInterval - time.Second)
+		Expect(watcher.update(later, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 20, 0)})).To(BeEmpty())
+		Expect(watcher.update(later, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 20, 0)})).To(BeEmpty())
+
+		later = now.Add(interfaceStatsEventInterval)
+		Expect(watcher.update(later, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 30, 0)})).To(HaveLen(1))
+	})
+
+	It("should not report an interface whose counters were reset", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 100, 100, 100, 100)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 10, 0)})).To(BeEmpty())
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat(ifaceName, 0, 0, 20, 0)})).To(HaveLen(1))
+	})
+
+	It("should ignore interfaces without an alias", func() {
+		watcher := newInterfaceStatsWatcher()
+		watcher.update(now, []stats.DomainStatsNet{newNetStat("", 0, 0, 0, 0)})
+		Expect(watcher.update(now, []stats.DomainStatsNet{newNetStat("", 100, 100, 100, 100)})).To(BeEmpty())
+	})
+})
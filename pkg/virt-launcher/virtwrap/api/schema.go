@@ -390,6 +390,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Backup           *BackupMetadata           `xml:"backup,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -406,15 +407,54 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type BackupMetadata struct {
+	Name            string                 `xml:"name,omitempty"`
+	Mode            v1.BackupMode          `xml:"mode,omitempty"`
+	IncrementalFrom string                 `xml:"incrementalFrom,omitempty"`
+	Endpoint        string                 `xml:"endpoint,omitempty"`
+	StartTimestamp  *metav1.Time           `xml:"startTimestamp,omitempty"`
+	EndTimestamp    *metav1.Time           `xml:"endTimestamp,omitempty"`
+	Stopped         bool                   `xml:"stopped,omitempty"`
+	Completed       bool                   `xml:"completed,omitempty"`
+	Failed          bool                   `xml:"failed,omitempty"`
+	FailureReason   string                 `xml:"failureReason,omitempty"`
+	Volumes         *BackupVolumesMetadata `xml:"volumes,omitempty"`
+}
+
+// BackupVolumesMetadata is kept behind a pointer so BackupMetadata stays comparable
+type BackupVolumesMetadata struct {
+	Volume []BackupVolumeMetadata `xml:"volume,omitempty"`
+}
+
+type BackupVolumeMetadata struct {
+	Name         string `xml:"name,attr"`
+	Incremental  bool   `xml:"incremental,attr,omitempty"`
+	TargetFile   string `xml:"targetFile,attr,omitempty"`
+	ExportName   string `xml:"exportName,attr,omitempty"`
+	ExportBitmap string `xml:"exportBitmap,attr,omitempty"`
+}
+
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Completed      bool             `xml:"completed,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID            types.UID                  `xml:"uid,omitempty"`
+	StartTimestamp *metav1.Time               `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time               `xml:"endTimestamp,omitempty"`
+	Completed      bool                       `xml:"completed,omitempty"`
+	Failed         bool                       `xml:"failed,omitempty"`
+	FailureReason  string                     `xml:"failureReason,omitempty"`
+	AbortStatus    string                     `xml:"abortStatus,omitempty"`
+	Mode           v1.MigrationMode           `xml:"mode,omitempty"`
+	Progress       *MigrationProgressMetadata `xml:"progress,omitempty"`
+}
+
+type MigrationProgressMetadata struct {
+	UpdateTimestamp            *metav1.Time `xml:"updateTimestamp,omitempty"`
+	DataProcessed              uint64       `xml:"dataProcessed,omitempty"`
+	DataRemaining              uint64       `xml:"dataRemaining,omitempty"`
+	DataTotal                  uint64       `xml:"dataTotal,omitempty"`
+	MemoryDirtyRate            uint64       `xml:"memoryDirtyRate,omitempty"`
+	Throughput                 uint64       `xml:"throughput,omitempty"`
+	Iteration                  uint64       `xml:"iteration,omitempty"`
+	EstimatedCompletionSeconds *int64       `xml:"estimatedCompletionSeconds,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -866,7 +906,7 @@ type ACPI struct {
 }
 
 type InterfaceDriver struct {
-	Name   string `xml:"name,attr"`
+	Name   string `xml:"name,attr,omitempty"`
 	Queues *uint  `xml:"queues,attr,omitempty"`
 	IOMMU  string `xml:"iommu,attr,omitempty"`
 }
@@ -876,6 +916,14 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthLimit `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimit `xml:"outbound,omitempty"`
+}
+
+type BandWidthLimit struct {
+	Average string `xml:"average,attr"`
+	Peak    string `xml:"peak,attr,omitempty"`
+	Burst   string `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -895,6 +943,8 @@ type FilterRef struct {
 }
 
 type InterfaceSource struct {
+	Type    string   `xml:"type,attr,omitempty"`
+	Path    string   `xml:"path,attr,omitempty"`
 	Network string   `xml:"network,attr,omitempty"`
 	Device  string   `xml:"dev,attr,omitempty"`
 	Bridge  string   `xml:"bridge,attr,omitempty"`
@@ -1248,6 +1298,54 @@ type SecretSpec struct {
 	Usage       SecretUsage `xml:"usage,omitempty"`
 }
 
+// DomainBackup mirroring libvirt XML under https://libvirt.org/formatbackup.html
+type DomainBackup struct {
+	XMLName     xml.Name            `xml:"domainbackup"`
+	Mode        string              `xml:"mode,attr,omitempty"`
+	Incremental string              `xml:"incremental,omitempty"`
+	Server      *DomainBackupServer `xml:"server,omitempty"`
+	Disks       []DomainBackupDisk  `xml:"disks>disk"`
+}
+
+type DomainBackupServer struct {
+	Transport string `xml:"transport,attr,omitempty"`
+	Socket    string `xml:"socket,attr,omitempty"`
+}
+
+type DomainBackupDisk struct {
+	Name         string                  `xml:"name,attr"`
+	Backup       string                  `xml:"backup,attr,omitempty"`
+	BackupMode   string                  `xml:"backupmode,attr,omitempty"`
+	Incremental  string                  `xml:"incremental,attr,omitempty"`
+	Type         string                  `xml:"type,attr,omitempty"`
+	ExportName   string                  `xml:"exportname,attr,omitempty"`
+	ExportBitmap string                  `xml:"exportbitmap,attr,omitempty"`
+	Driver       *DomainBackupDiskDriver `xml:"driver,omitempty"`
+	Target       *DomainBackupDiskFile   `xml:"target,omitempty"`
+	Scratch      *DomainBackupDiskFile   `xml:"scratch,omitempty"`
+}
+
+type DomainBackupDiskDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+type DomainBackupDiskFile struct {
+	File string `xml:"file,attr"`
+}
+
+// DomainCheckpoint mirroring libvirt XML under https://libvirt.org/formatcheckpoint.html
+type DomainCheckpoint struct {
+	XMLName xml.Name               `xml:"domaincheckpoint"`
+	Name    string                 `xml:"name"`
+	Disks   []DomainCheckpointDisk `xml:"disks>disk"`
+}
+
+type DomainCheckpointDisk struct {
+	Name       string `xml:"name,attr"`
+	Checkpoint string `xml:"checkpoint,attr"`
+	Bitmap     string `xml:"bitmap,attr,omitempty"`
+}
+
 func NewMinimalDomainSpec(vmiName string) *DomainSpec {
 	precond.MustNotBeEmpty(vmiName)
 	domain := &DomainSpec{}
@@ -0,0 +1,440 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const (
+	failedDomainBackup = "Domain backup failed"
+
+	backupSocketName   = "backup.sock"
+	backupBitmapPrefix = "backup-"
+
+	backupJobPollInterval = time.Second
+)
+
+// backupBaseDir holds the pull mode scratch files and the NBD socket
+var backupBaseDir = "/var/run/kubevirt-private/backups"
+
+func backupDir(backupName string) string {
+	return filepath.Join(backupBaseDir, backupName)
+}
+
+// backupTargetDir is where the push mode backup is written, on the hotplugged BackupTarget volume
+func backupTargetDir(options *v1.VirtualMachineInstanceBackupOptions) string {
+	return filepath.Join(hotplugdisk.GetVolumeMountDir(options.TargetVolume), options.BackupName)
+}
+
+// isBackupCapableDisk returns true for disks which are backed by a PVC or a DataVolume
+func isBackupCapableDisk(vmi *v1.VirtualMachineInstance, diskName string) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != diskName {
+			continue
+		}
+		return volume.PersistentVolumeClaim != nil || volume.DataVolume != nil
+	}
+	return false
+}
+
+func diskSupportsCheckpoints(disk api.Disk) bool {
+	return disk.Driver != nil && disk.Driver.Type == "qcow2"
+}
+
+// generateBackupXML renders the libvirt domainbackup and domaincheckpoint definitions for the given domain disks.
+// Only qcow2 disks can carry a persistent dirty bitmap, all other disks are explicitly backed up in full,
+// even when the backup is incremental.
+func generateBackupXML(vmi *v1.VirtualMachineInstance, disks []api.Disk, options *v1.VirtualMachineInstanceBackupOptions) (*api.DomainBackup, *api.DomainCheckpoint, []api.BackupVolumeMetadata) {
+	dir := backupDir(options.BackupName)
+	targetDir := backupTargetDir(options)
+	checkpointName := options.BackupName
+	incremental := options.IncrementalFrom != nil && *options.IncrementalFrom != ""
+
+	backup := &api.DomainBackup{
+		Mode: "push",
+	}
+	if options.Mode == v1.BackupModePull {
+		backup.Mode = "pull"
+		backup.Server = &api.DomainBackupServer{
+			Transport: "unix",
+			Socket:    filepath.Join(dir, backupSocketName),
+		}
+	}
+	if incremental {
+		backup.Incremental = *options.IncrementalFrom
+	}
+
+	checkpoint := &api.DomainCheckpoint{
+		Name: checkpointName,
+	}
+
+	var volumes []api.BackupVolumeMetadata
+	for _, disk := range disks {
+		if disk.Alias == nil || disk.Target.Device == "" {
+			continue
+		}
+		diskName := disk.Alias.GetName()
+		if !isBackupCapableDisk(vmi, diskName) {
+			backup.Disks = append(backup.Disks, api.DomainBackupDisk{Name: disk.Target.Device, Backup: "no"})
+			checkpoint.Disks = append(checkpoint.Disks, api.DomainCheckpointDisk{Name: disk.Target.Device, Checkpoint: "no"})
+			continue
+		}
+
+		backupDisk := api.DomainBackupDisk{
+			Name:   disk.Target.Device,
+			Backup: "yes",
+			Type:   "file",
+		}
+		volume := api.BackupVolumeMetadata{
+			Name: diskName,
+		}
+		if diskSupportsCheckpoints(disk) {
+			checkpoint.Disks = append(checkpoint.Disks, api.DomainCheckpointDisk{Name: disk.Target.Device, Checkpoint: "bitmap", Bitmap: checkpointName})
+			if incremental {
+				backupDisk.BackupMode = "incremental"
+				backupDisk.Incremental = backup.Incremental
+				volume.Incremental = true
+			} else {
+				backupDisk.BackupMode = "full"
+			}
+		} else {
+			checkpoint.Disks = append(checkpoint.Disks, api.DomainCheckpointDisk{Name: disk.Target.Device, Checkpoint: "no"})
+			// without a bitmap the disk would inherit the incremental mode of the backup
+			backupDisk.BackupMode = "full"
+		}
+
+		if options.Mode == v1.BackupModePull {
+			backupDisk.ExportName = diskName
+			backupDisk.ExportBitmap = backupBitmapPrefix + diskName
+			backupDisk.Scratch = &api.DomainBackupDiskFile{File: filepath.Join(dir, diskName+".scratch")}
+			volume.ExportName = backupDisk.ExportName
+			volume.ExportBitmap = backupDisk.ExportBitmap
+		} else {
+			backupDisk.Driver = &api.DomainBackupDiskDriver{Type: "qcow2"}
+			backupDisk.Target = &api.DomainBackupDiskFile{File: filepath.Join(targetDir, diskName+".qcow2")}
+			volume.TargetFile = backupDisk.Target.File
+		}
+
+		backup.Disks = append(backup.Disks, backupDisk)
+		volumes = append(volumes, volume)
+	}
+
+	return backup, checkpoint, volumes
+}
+
+func (l *LibvirtDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error {
+	if l.migrationInProgress() {
+		return fmt.Errorf("failed to backup VMI, VMI is currently during migration")
+	}
+	if options == nil || options.BackupName == "" {
+		return fmt.Errorf("backup name is required")
+	}
+	if !options.Stop && options.Mode != v1.BackupModePull && options.TargetVolume == "" {
+		return fmt.Errorf("a target volume is required for a push mode backup")
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+	domName := api.VMINamespaceKeyFunc(vmi)
+
+	if options.Stop {
+		return l.stopBackup(vmi, domName, options.BackupName)
+	}
+
+	if l.shouldSkipBackup(options.BackupName) {
+		return nil
+	}
+	if previous, exists := l.metadataCache.Backup.Load(); exists && !previous.Completed {
+		return fmt.Errorf("backup %s is still in progress", previous.Name)
+	}
+
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	disks, err := getAllDomainDisks(dom)
+	if err != nil {
+		return err
+	}
+
+	backup, checkpoint, volumes := generateBackupXML(vmi, disks, options)
+	if len(volumes) == 0 {
+		return fmt.Errorf("no volumes eligible for backup")
+	}
+
+	backupXML, err := xml.Marshal(backup)
+	if err != nil {
+		return err
+	}
+	checkpointXML, err := xml.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	dir := backupDir(options.BackupName)
+	if backup.Mode == "push" {
+		dir = backupTargetDir(options)
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	endpoint := ""
+	if backup.Server != nil {
+		endpoint = fmt.Sprintf("nbd+unix:///?socket=%s", backup.Server.Socket)
+	}
+	l.initializeBackupMetadata(options, endpoint, volumes)
+
+	logger.Infof("Starting %s backup %s", backup.Mode, options.BackupName)
+	if err := dom.BackupBegin(string(backupXML), string(checkpointXML), 0); err != nil {
+		l.setBackupResult(true, fmt.Sprintf("%s: %v", failedDomainBackup, err))
+		backupMetadata, _ := l.metadataCache.Backup.Load()
+		cleanupBackup(vmi, dom, backupMetadata)
+		return err
+	}
+
+	// a pull mode backup stays in progress until its client stops it or the job ends
+	go l.monitorBackupJob(vmi, domName)
+	return nil
+}
+
+// stopBackup aborts the domain job of a running backup. The monitor of the job reports the result.
+func (l *LibvirtDomainManager) stopBackup(vmi *v1.VirtualMachineInstance, domName, backupName string) error {
+	backupMetadata, exists := l.metadataCache.Backup.Load()
+	if !exists || backupMetadata.Name != backupName || backupMetadata.Completed || backupMetadata.Stopped {
+		// nothing left to stop
+		return nil
+	}
+
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		backupMetadata.Stopped = true
+	})
+	log.Log.Object(vmi).Infof("Stopping backup %s", backupName)
+	return dom.AbortJob()
+}
+
+func (l *LibvirtDomainManager) monitorBackupJob(vmi *v1.VirtualMachineInstance, domName string) {
+	logger := log.Log.Object(vmi)
+	ticker := time.NewTicker(backupJobPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		failed, reason, done := l.backupJobResult(domName)
+		if !done {
+			continue
+		}
+		if failed {
+			logger.Error(reason)
+		} else {
+			logger.Infof("Completed backup successfully")
+		}
+		l.setBackupResult(failed, reason)
+		l.cleanupBackupJob(vmi, domName)
+		return
+	}
+}
+
+func (l *LibvirtDomainManager) cleanupBackupJob(vmi *v1.VirtualMachineInstance, domName string) {
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to look up the domain to clean up the backup")
+		return
+	}
+	defer dom.Free()
+
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	cleanupBackup(vmi, dom, backupMetadata)
+}
+
+// cleanupBackup applies the checkpoint retention policy once a backup ended: only the checkpoint of the last
+// successful backup is kept, as it is the only possible base of the next incremental backup. The checkpoint
+// of a failed backup is removed together with its partial push mode target files. The pull mode scratch files
+// are not needed anymore in either case.
+func cleanupBackup(vmi *v1.VirtualMachineInstance, dom cli.VirDomain, backupMetadata api.BackupMetadata) {
+	logger := log.Log.Object(vmi)
+
+	var err error
+	if backupMetadata.Failed {
+		err = deleteCheckpoint(dom, backupMetadata.Name)
+	} else {
+		err = deleteCheckpointsExcept(dom, backupMetadata.Name)
+	}
+	if err != nil {
+		logger.Reason(err).Errorf("Failed to apply the checkpoint retention after backup %s", backupMetadata.Name)
+	}
+
+	if backupMetadata.Failed && backupMetadata.Volumes != nil {
+		for _, volume := range backupMetadata.Volumes.Volume {
+			if volume.TargetFile == "" {
+				continue
+			}
+			if err := os.Remove(volume.TargetFile); err != nil && !errors.Is(err, os.ErrNotExist) {
+				logger.Reason(err).Errorf("Failed to remove the backup target file %s", volume.TargetFile)
+			}
+		}
+	}
+	if err := os.RemoveAll(backupDir(backupMetadata.Name)); err != nil {
+		logger.Reason(err).Errorf("Failed to remove the scratch files of backup %s", backupMetadata.Name)
+	}
+}
+
+func deleteCheckpoint(dom cli.VirDomain, name string) error {
+	checkpoint, err := dom.CheckpointLookupByName(name, 0)
+	if err != nil {
+		if libvirtError, ok := err.(libvirt.Error); ok && libvirtError.Code == libvirt.ERR_NO_DOMAIN_CHECKPOINT {
+			return nil
+		}
+		return err
+	}
+	defer checkpoint.Free()
+	return checkpoint.Delete(0)
+}
+
+func deleteCheckpointsExcept(dom cli.VirDomain, keep string) error {
+	checkpoints, err := dom.ListAllCheckpoints(0)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range checkpoints {
+		checkpoint := &checkpoints[i]
+		name, err := checkpoint.GetName()
+		if err == nil && name != keep {
+			err = checkpoint.Delete(0)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+		checkpoint.Free()
+	}
+	return errors.Join(errs...)
+}
+
+func (l *LibvirtDomainManager) backupJobResult(domName string) (failed bool, reason string, done bool) {
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return true, fmt.Sprintf("%s: %v", failedDomainBackup, err), true
+	}
+	defer dom.Free()
+
+	jobInfo, err := dom.GetJobInfo()
+	if err != nil {
+		return true, fmt.Sprintf("%s: %v", failedDomainBackup, err), true
+	}
+	if jobInfo.Type != libvirt.DOMAIN_JOB_NONE {
+		return false, "", false
+	}
+
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	return backupJobStatsResult(dom, backupMetadata)
+}
+
+func backupJobStatsResult(dom cli.VirDomain, backupMetadata api.BackupMetadata) (failed bool, reason string, done bool) {
+	stats, err := dom.GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED)
+	if err != nil {
+		return true, fmt.Sprintf("%s: %v", failedDomainBackup, err), true
+	}
+	// the job of a pull mode backup only ends once its client is done with the exports
+	pullFinished := backupMetadata.Mode == v1.BackupModePull && backupMetadata.Stopped
+	switch stats.Type {
+	case libvirt.DOMAIN_JOB_COMPLETED:
+		return false, "", true
+	case libvirt.DOMAIN_JOB_CANCELLED:
+		if pullFinished {
+			return false, "", true
+		}
+		if backupMetadata.Stopped {
+			return true, fmt.Sprintf("%s: backup was stopped", failedDomainBackup), true
+		}
+		return true, fmt.Sprintf("%s: job cancelled", failedDomainBackup), true
+	default:
+		reason = failedDomainBackup
+		if stats.ErrorMessageSet {
+			reason = fmt.Sprintf("%s: %s", failedDomainBackup, stats.ErrorMessage)
+		}
+		return true, reason, true
+	}
+}
+
+func (l *LibvirtDomainManager) shouldSkipBackup(backupName string) bool {
+	backupMetadata, _ := l.metadataCache.Backup.Load()
+	// backup still in progress or has just completed
+	return backupMetadata.Name == backupName
+}
+
+func (l *LibvirtDomainManager) initializeBackupMetadata(options *v1.VirtualMachineInstanceBackupOptions, endpoint string, volumes []api.BackupVolumeMetadata) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		now := metav1.Now()
+		*backupMetadata = api.BackupMetadata{
+			Name:           options.BackupName,
+			Mode:           options.Mode,
+			Endpoint:       endpoint,
+			StartTimestamp: &now,
+			Volumes:        &api.BackupVolumesMetadata{Volume: volumes},
+		}
+		if options.IncrementalFrom != nil {
+			backupMetadata.IncrementalFrom = *options.IncrementalFrom
+		}
+	})
+	log.Log.V(4).Infof("initialize backup metadata: %s", l.metadataCache.Backup.String())
+}
+
+func (l *LibvirtDomainManager) setBackupResult(failed bool, reason string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		if !initialized {
+			// nothing to report if backup metadata is empty
+			return
+		}
+
+		now := metav1.Now()
+		backupMetadata.Completed = true
+		backupMetadata.EndTimestamp = &now
+		backupMetadata.Failed = failed
+		backupMetadata.FailureReason = reason
+	})
+	log.Log.V(4).Infof("set backup results in metadata: %s", l.metadataCache.Backup.String())
+}
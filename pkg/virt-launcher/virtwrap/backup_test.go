@@ -0,0 +1,197 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Backup", func() {
+	const (
+		backupName   = "backup-1"
+		targetVolume = "backup-target"
+	)
+
+	newDisk := func(name, device, format string) api.Disk {
+		return api.Disk{
+			Alias:  api.NewUserDefinedAlias(name),
+			Target: api.DiskTarget{Device: device},
+			Driver: &api.DiskDriver{Name: "qemu", Type: format},
+		}
+	}
+
+	var (
+		vmi   *v1.VirtualMachineInstance
+		disks []api.Disk
+	)
+
+	BeforeEach(func() {
+		vmi = libvmi.New(
+			libvmi.WithPersistentVolumeClaim("rootdisk", "root-pvc"),
+			libvmi.WithDataVolume("datadisk", "data-dv"),
+			libvmi.WithContainerDisk("containerdisk", "image"),
+		)
+		disks = []api.Disk{
+			newDisk("rootdisk", "vda", "qcow2"),
+			newDisk("datadisk", "vdb", "raw"),
+			newDisk("containerdisk", "vdc", "qcow2"),
+		}
+	})
+
+	It("should skip disks which are not backed by a PVC or a DataVolume", func() {
+		backup, checkpoint, volumes := generateBackupXML(vmi, disks, &v1.VirtualMachineInstanceBackupOptions{BackupName: backupName, Mode: v1.BackupModePush, TargetVolume: targetVolume})
+
+		Expect(backup.Disks).To(ContainElement(api.DomainBackupDisk{Name: "vdc", Backup: "no"}))
+		Expect(checkpoint.Disks).To(ContainElement(api.DomainCheckpointDisk{Name: "vdc", Checkpoint: "no"}))
+		Expect(volumes).To(HaveLen(2))
+		Expect(volumes[0].Name).To(Equal("rootdisk"))
+		Expect(volumes[1].Name).To(Equal("datadisk"))
+	})
+
+	It("should only track dirty bitmaps of qcow2 disks", func() {
+		_, checkpoint, _ := generateBackupXML(vmi, disks, &v1.VirtualMachineInstanceBackupOptions{BackupName: backupName, Mode: v1.BackupModePush, TargetVolume: targetVolume})
+
+		Expect(checkpoint.Name).To(Equal(backupName))
+		Expect(checkpoint.Disks).To(ConsistOf(
+			api.DomainCheckpointDisk{Name: "vda", Checkpoint: "bitmap", Bitmap: backupName},
+			api.DomainCheckpointDisk{Name: "vdb", Checkpoint: "no"},
+			api.DomainCheckpointDisk{Name: "vdc", Checkpoint: "no"},
+		))
+	})
+
+	It("should write push mode backups to qcow2 targets on the target volume", func() {
+		backup, _, volumes := generateBackupXML(vmi, disks, &v1.VirtualMachineInstanceBackupOptions{BackupName: backupName, Mode: v1.BackupModePush, TargetVolume: targetVolume})
+
+		Expect(backup.Mode).To(Equal("push"))
+		Expect(backup.Server).To(BeNil())
+		Expect(backup.Incremental).To(BeEmpty())
+		Expect(backup.Disks[0].BackupMode).To(Equal("full"))
+		Expect(backup.Disks[0].Target.File).To(Equal(filepath.Join(hotplugdisk.GetVolumeMountDir(targetVolume), backupName, "rootdisk.qcow2")))
+		Expect(backup.Disks[0].Driver.Type).To(Equal("qcow2"))
+		Expect(volumes[0].TargetFile).To(Equal(backup.Disks[0].Target.File))
+		Expect(volumes[0].ExportName).To(BeEmpty())
+	})
+
+	It("should export pull mode backups over a unix socket", func() {
+		backup, _, volumes := generateBackupXML(vmi, disks, &v1.VirtualMachineInstanceBackupOptions{BackupName: backupName, Mode: v1.BackupModePull})
+
+		Expect(backup.Mode).To(Equal("pull"))
+		Expect(backup.Server).To(Equal(&api.DomainBackupServer{
+			Transport: "unix",
+			Socket:    filepath.Join(backupDir(backupName), backupSocketName),
+		}))
+		Expect(backup.Disks[0].ExportName).To(Equal("rootdisk"))
+		Expect(backup.Disks[0].ExportBitmap).To(Equal(backupBitmapPrefix + "rootdisk"))
+		Expect(backup.Disks[0].Scratch).ToNot(BeNil())
+		Expect(backup.Disks[0].Target).To(BeNil())
+		Expect(volumes[0].ExportName).To(Equal("rootdisk"))
+		Expect(volumes[0].TargetFile).To(BeEmpty())
+	})
+
+	It("should only back up the changed blocks of qcow2 disks for incremental backups", func() {
+		backup, _, volumes := generateBackupXML(vmi, disks, &v1.VirtualMachineInstanceBackupOptions{
+			BackupName:      backupName,
+			Mode:            v1.BackupModePush,
+			TargetVolume:    targetVolume,
+			IncrementalFrom: pointer.P("backup-0"),
+		})
+
+		Expect(backup.Incremental).To(Equal("backup-0"))
+		Expect(backup.Disks[0].BackupMode).To(Equal("incremental"))
+		Expect(backup.Disks[0].Incremental).To(Equal("backup-0"))
+		Expect(volumes[0].Incremental).To(BeTrue())
+		Expect(backup.Disks[1].BackupMode).To(Equal("full"))
+		Expect(volumes[1].Incremental).To(BeFalse())
+	})
+
+	DescribeTable("should report the result of the finished backup job", func(jobType libvirt.DomainJobType, backupMetadata api.BackupMetadata, expectedFailed bool) {
+		dom := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		dom.EXPECT().GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED).Return(&libvirt.DomainJobInfo{Type: jobType}, nil)
+
+		failed, _, done := backupJobStatsResult(dom, backupMetadata)
+		Expect(done).To(BeTrue())
+		Expect(failed).To(Equal(expectedFailed))
+	},
+		Entry("completed push backup", libvirt.DOMAIN_JOB_COMPLETED, api.BackupMetadata{Mode: v1.BackupModePush}, false),
+		Entry("cancelled push backup", libvirt.DOMAIN_JOB_CANCELLED, api.BackupMetadata{Mode: v1.BackupModePush}, true),
+		Entry("stopped push backup", libvirt.DOMAIN_JOB_CANCELLED, api.BackupMetadata{Mode: v1.BackupModePush, Stopped: true}, true),
+		Entry("cancelled pull backup", libvirt.DOMAIN_JOB_CANCELLED, api.BackupMetadata{Mode: v1.BackupModePull}, true),
+		Entry("pull backup stopped by its client", libvirt.DOMAIN_JOB_CANCELLED, api.BackupMetadata{Mode: v1.BackupModePull, Stopped: true}, false),
+		Entry("failed pull backup", libvirt.DOMAIN_JOB_FAILED, api.BackupMetadata{Mode: v1.BackupModePull, Stopped: true}, true),
+	)
+
+	Context("cleanup", func() {
+		var (
+			dom        *cli.MockVirDomain
+			targetFile string
+		)
+
+		BeforeEach(func() {
+			dom = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+
+			origBackupBaseDir := backupBaseDir
+			backupBaseDir = GinkgoT().TempDir()
+			DeferCleanup(func() { backupBaseDir = origBackupBaseDir })
+			Expect(os.MkdirAll(backupDir(backupName), 0750)).To(Succeed())
+
+			targetFile = filepath.Join(GinkgoT().TempDir(), "rootdisk.qcow2")
+			Expect(os.WriteFile(targetFile, []byte("partial"), 0640)).To(Succeed())
+		})
+
+		newBackupMetadata := func(failed bool) api.BackupMetadata {
+			return api.BackupMetadata{
+				Name:      backupName,
+				Completed: true,
+				Failed:    failed,
+				Volumes:   &api.BackupVolumesMetadata{Volume: []api.BackupVolumeMetadata{{Name: "rootdisk", TargetFile: targetFile}}},
+			}
+		}
+
+		It("should keep only the checkpoint of a succeeded backup", func() {
+			dom.EXPECT().ListAllCheckpoints(libvirt.DomainCheckpointListFlags(0)).Return([]libvirt.DomainCheckpoint{}, nil)
+
+			cleanupBackup(vmi, dom, newBackupMetadata(false))
+			Expect(targetFile).To(BeAnExistingFile())
+			Expect(backupDir(backupName)).ToNot(BeADirectory())
+		})
+
+		It("should remove the checkpoint and the target files of a failed backup", func() {
+			dom.EXPECT().CheckpointLookupByName(backupName, uint32(0)).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN_CHECKPOINT})
+
+			cleanupBackup(vmi, dom, newBackupMetadata(true))
+			Expect(targetFile).ToNot(BeAnExistingFile())
+			Expect(backupDir(backupName)).ToNot(BeADirectory())
+		})
+	})
+})
@@ -453,6 +453,20 @@ func (mr *MockVirDomainMockRecorder) AuthorizedSSHKeysSet(user, keys, flags any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizedSSHKeysSet", reflect.TypeOf((*MockVirDomain)(nil).AuthorizedSSHKeysSet), user, keys, flags)
 }
 
+// BackupBegin mocks base method.
+func (m *MockVirDomain) BackupBegin(backupXML, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupBegin", backupXML, checkpointXML, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackupBegin indicates an expected call of BackupBegin.
+func (mr *MockVirDomainMockRecorder) BackupBegin(backupXML, checkpointXML, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupBegin", reflect.TypeOf((*MockVirDomain)(nil).BackupBegin), backupXML, checkpointXML, flags)
+}
+
 // BlockResize mocks base method.
 func (m *MockVirDomain) BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error {
 	m.ctrl.T.Helper()
@@ -467,6 +481,21 @@ func (mr *MockVirDomainMockRecorder) BlockResize(disk, size, flags any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockResize", reflect.TypeOf((*MockVirDomain)(nil).BlockResize), disk, size, flags)
 }
 
+// CheckpointLookupByName mocks base method.
+func (m *MockVirDomain) CheckpointLookupByName(name string, flags uint32) (*libvirt.DomainCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLookupByName", name, flags)
+	ret0, _ := ret[0].(*libvirt.DomainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLookupByName indicates an expected call of CheckpointLookupByName.
+func (mr *MockVirDomainMockRecorder) CheckpointLookupByName(name, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLookupByName", reflect.TypeOf((*MockVirDomain)(nil).CheckpointLookupByName), name, flags)
+}
+
 // CoreDumpWithFormat mocks base method.
 func (m *MockVirDomain) CoreDumpWithFormat(to string, format libvirt.DomainCoreDumpFormat, flags libvirt.DomainCoreDumpFlags) error {
 	m.ctrl.T.Helper()
@@ -716,6 +745,21 @@ func (mr *MockVirDomainMockRecorder) GetXMLDesc(flags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetXMLDesc", reflect.TypeOf((*MockVirDomain)(nil).GetXMLDesc), flags)
 }
 
+// ListAllCheckpoints mocks base method.
+func (m *MockVirDomain) ListAllCheckpoints(flags libvirt.DomainCheckpointListFlags) ([]libvirt.DomainCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllCheckpoints", flags)
+	ret0, _ := ret[0].([]libvirt.DomainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllCheckpoints indicates an expected call of ListAllCheckpoints.
+func (mr *MockVirDomainMockRecorder) ListAllCheckpoints(flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllCheckpoints", reflect.TypeOf((*MockVirDomain)(nil).ListAllCheckpoints), flags)
+}
+
 // MemoryStats mocks base method.
 func (m *MockVirDomain) MemoryStats(nrStats, flags uint32) ([]libvirt.DomainMemoryStat, error) {
 	m.ctrl.T.Helper()
@@ -787,6 +831,21 @@ func (mr *MockVirDomainMockRecorder) PinVcpuFlags(vcpu, cpuMap, flags any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinVcpuFlags", reflect.TypeOf((*MockVirDomain)(nil).PinVcpuFlags), vcpu, cpuMap, flags)
 }
 
+// QemuMonitorCommand mocks base method.
+func (m *MockVirDomain) QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QemuMonitorCommand", command, flags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QemuMonitorCommand indicates an expected call of QemuMonitorCommand.
+func (mr *MockVirDomainMockRecorder) QemuMonitorCommand(command, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuMonitorCommand", reflect.TypeOf((*MockVirDomain)(nil).QemuMonitorCommand), command, flags)
+}
+
 // Reboot mocks base method.
 func (m *MockVirDomain) Reboot(flags libvirt.DomainRebootFlagValues) error {
 	m.ctrl.T.Helper()
@@ -760,6 +760,30 @@ func (l *Launcher) InjectLaunchSecret(_ context.Context, request *cmdv1.InjectLa
 	return response, nil
 }
 
+func (l *Launcher) BackupVirtualMachine(_ context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	var backupOptions v1.VirtualMachineInstanceBackupOptions
+	if err := json.Unmarshal(request.Options, &backupOptions); err != nil {
+		response.Success = false
+		response.Message = "No valid backup options present in command server request"
+		return response, nil
+	}
+
+	if err := l.domainManager.BackupVMI(vmi, &backupOptions); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to backup VMI")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Started backup %s", backupOptions.BackupName)
+	return response, nil
+}
+
 func (l *Launcher) SyncVirtualMachineMemory(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
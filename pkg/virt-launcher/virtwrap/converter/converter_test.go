@@ -1684,6 +1684,25 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
 			Expect(domain.Spec.Devices.Interfaces[0].LinkState.State).To(Equal("down"))
 		})
+		It("Should set domain interface bandwidth", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				*v1.DefaultBridgeNetworkInterface(),
+			}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: 1000, Peak: pointer.P(uint32(2000)), Burst: pointer.P(uint32(512))},
+				Outbound: &v1.BandwidthLimit{Average: 500},
+			}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+				Inbound:  &api.BandWidthLimit{Average: "1000", Peak: "2000", Burst: "512"},
+				Outbound: &api.BandWidthLimit{Average: "500"},
+			}))
+		})
 		It("Should set domain interface source correctly for multus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
@@ -1844,6 +1863,38 @@ var _ = Describe("Converter", func() {
 			Expect(domain).ToNot(BeNil())
 			Expect(domain.Spec.Devices.Interfaces).To(BeEmpty())
 		})
+		It("Should create network configuration for an interface using a binding plugin with vhost-user domain attachment", func() {
+			const name1 = "dpdk"
+			socketSource := api.InterfaceSource{Type: "unix", Path: "/var/run/vhostuser/dpdk.sock", Mode: "client"}
+			c.DomainAttachmentByInterfaceName[name1] = string(v1.VhostUser)
+			c.VhostUserSourceByInterfaceName = map[string]api.InterfaceSource{name1: socketSource}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			iface1 := v1.Interface{Name: name1, Binding: &v1.PluginBinding{Name: "vhostuser"}, MacAddress: "02:00:00:00:00:01"}
+			vmi.Spec.Networks = []v1.Network{*libvmi.MultusNetwork(name1, "dpdk-net")}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vhostuser"))
+			Expect(domain.Spec.Devices.Interfaces[0].Source).To(Equal(socketSource))
+			Expect(domain.Spec.Devices.Interfaces[0].MAC).To(Equal(&api.MAC{MAC: "02:00:00:00:00:01"}))
+			Expect(domain.Spec.MemoryBacking).ToNot(BeNil())
+			Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+			Expect(domain.Spec.MemoryBacking.Source).To(Equal(&api.MemoryBackingSource{Type: "memfd"}))
+		})
+		It("Should fail to create network configuration for a vhost-user interface without a socket", func() {
+			const name1 = "dpdk"
+			c.DomainAttachmentByInterfaceName[name1] = string(v1.VhostUser)
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			vmi.Spec.Networks = []v1.Network{*libvmi.MultusNetwork(name1, "dpdk-net")}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: name1, Binding: &v1.PluginBinding{Name: "vhostuser"}}}
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(
+				MatchError("failed to find the vhost-user socket of interface dpdk"))
+		})
 		It("creates SRIOV hostdev", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			domain := &api.Domain{}
@@ -25,6 +25,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/arch"
@@ -48,7 +49,8 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			return nil, fmt.Errorf("failed to find network %s", iface.Name)
 		}
 
-		if (iface.Binding != nil && c.DomainAttachmentByInterfaceName[iface.Name] != string(v1.Tap)) || iface.SRIOV != nil {
+		domainAttachment := c.DomainAttachmentByInterfaceName[iface.Name]
+		if (iface.Binding != nil && domainAttachment != string(v1.Tap) && domainAttachment != string(v1.VhostUser)) || iface.SRIOV != nil {
 			continue
 		}
 
@@ -77,16 +79,28 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
-		if c.DomainAttachmentByInterfaceName[iface.Name] == string(v1.Tap) {
+		switch domainAttachment {
+		case string(v1.Tap):
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
 			domainIface.Type = "ethernet"
-			if iface.BootOrder != nil {
-				domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
-			} else if arch.NewConverter(vmi.Spec.Architecture).IsROMTuningSupported() {
-				// s390x does not support setting ROM tuning, as it is for PCI Devices only
-				domainIface.Rom = &api.Rom{Enabled: "no"}
+			setBootOrderOrDisableROM(vmi, iface, &domainIface)
+		case string(v1.VhostUser):
+			// https://libvirt.org/formatdomain.html#vhost-user-interface
+			source, exists := c.VhostUserSourceByInterfaceName[iface.Name]
+			if !exists {
+				return nil, fmt.Errorf("failed to find the vhost-user socket of interface %s", iface.Name)
 			}
+			domainIface.Type = "vhostuser"
+			domainIface.Source = source
+			if domainIface.Driver != nil {
+				// The vhost driver is not relevant to a userspace datapath, only the queues are.
+				domainIface.Driver.Name = ""
+			}
+			if iface.MacAddress != "" {
+				domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
+			}
+			setBootOrderOrDisableROM(vmi, iface, &domainIface)
 		}
 
 		if c.UseLaunchSecurity {
@@ -104,12 +118,22 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 		if iface.State == v1.InterfaceStateLinkDown {
 			domainIface.LinkState = &api.LinkState{State: "down"}
 		}
+		domainIface.BandWidth = domainspec.BandwidthFromInterface(&nonAbsentIfaces[i])
 		domainInterfaces = append(domainInterfaces, domainIface)
 	}
 
 	return domainInterfaces, nil
 }
 
+func setBootOrderOrDisableROM(vmi *v1.VirtualMachineInstance, iface v1.Interface, domainIface *api.Interface) {
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	} else if arch.NewConverter(vmi.Spec.Architecture).IsROMTuningSupported() {
+		// s390x does not support setting ROM tuning, as it is for PCI Devices only
+		domainIface.Rom = &api.Rom{Enabled: "no"}
+	}
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -46,6 +46,20 @@ func (m *MockDomainManager) EXPECT() *MockDomainManagerMockRecorder {
 	return m.recorder
 }
 
+// BackupVMI mocks base method.
+func (m *MockDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupVMI", vmi, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackupVMI indicates an expected call of BackupVMI.
+func (mr *MockDomainManagerMockRecorder) BackupVMI(vmi, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupVMI", reflect.TypeOf((*MockDomainManager)(nil).BackupVMI), vmi, options)
+}
+
 // CancelVMIMigration mocks base method.
 func (m *MockDomainManager) CancelVMIMigration(arg0 *v1.VirtualMachineInstance) error {
 	m.ctrl.T.Helper()
@@ -24,6 +24,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -60,10 +61,19 @@ import (
 
 const liveMigrationFailed = "Live migration failed."
 
+// The first QEMU version which supports post-copy along with parallel connections
+const (
+	minParallelPostCopyQemuMajor = 10
+	minParallelPostCopyQemuMinor = 0
+)
+
 const (
 	monitorSleepPeriodMS = 400
 	monitorLogPeriodMS   = 4000
 	monitorLogInterval   = monitorLogPeriodMS / monitorSleepPeriodMS
+	// The migration progress is reported less frequently, as each report results in a VMI status update
+	monitorProgressPeriodMS = 10000
+	monitorProgressInterval = monitorProgressPeriodMS / monitorSleepPeriodMS
 )
 
 type migrationDisks struct {
@@ -94,7 +104,7 @@ type inflightMigrationAborted struct {
 	abortStatus v1.MigrationAbortStatus
 }
 
-func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdclient.MigrationOptions) libvirt.DomainMigrateFlags {
+func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdclient.MigrationOptions) (libvirt.DomainMigrateFlags, error) {
 	migrateFlags := libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST
 
 	if isBlockMigration {
@@ -112,12 +122,22 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if migratePaused {
 		migrateFlags |= libvirt.MIGRATE_PAUSED
 	}
-	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
+	shouldConfigureParallel, _ := shouldConfigureParallelMigration(options)
+	if shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	compression, err := migrationCompression(options)
+	if err != nil {
+		return 0, err
+	}
+	if compression != nil {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
+	if options.ZeroCopy && !options.AllowPostCopy && shouldConfigureParallel && compression == nil {
+		migrateFlags |= libvirt.MIGRATE_ZEROCOPY
+	}
 
-	return migrateFlags
-
+	return migrateFlags, nil
 }
 
 func hotUnplugHostDevices(virConn cli.Connection, dom cli.VirDomain) error {
@@ -675,6 +695,9 @@ func (m *migrationMonitor) startMonitor() {
 			if logInterval%monitorLogInterval == 0 {
 				logMigrationInfo(logger, string(vmi.Status.MigrationState.MigrationUID), stats)
 			}
+			if logInterval%monitorProgressInterval == 0 {
+				m.l.updateVMIMigrationProgress(newMigrationProgressMetadata(stats))
+			}
 		case libvirt.DOMAIN_JOB_NONE:
 			completedJobInfo = m.determineNonRunningMigrationStatus(dom)
 		case libvirt.DOMAIN_JOB_COMPLETED:
@@ -770,6 +793,14 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnections:    parallelMigrationThreads,
 	}
 
+	compression, err := migrationCompression(options)
+	if err != nil {
+		return nil, err
+	}
+	if err := setMigrationCompressionParams(params, compression); err != nil {
+		return nil, err
+	}
+
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
 		params.MigrateDisks = copyDisks
@@ -967,7 +998,14 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	if err != nil {
 		return fmt.Errorf("failed to retrive domain state")
 	}
-	migrateFlags := generateMigrationFlags(vmi.IsBlockMigration(), migratePaused, options)
+	options, err = withSupportedParallelMigration(l.virConn, options)
+	if err != nil {
+		return err
+	}
+	migrateFlags, err := generateMigrationFlags(vmi.IsBlockMigration(), migratePaused, options)
+	if err != nil {
+		return err
+	}
 
 	// anything that modifies the domain needs to be performed with the domainModifyLock held
 	// The domain params and unHotplug need to be performed in a critical section together.
@@ -1002,6 +1040,10 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 		dstURI = fmt.Sprintf("qemu+unix:///system?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, string(vmi.UID)))
 	}
 
+	if err := setZeroPageDetection(dom, options); err != nil {
+		return err
+	}
+
 	err = dom.MigrateToURI3(dstURI, params, migrateFlags)
 	if err != nil {
 		return fmt.Errorf("error encountered during MigrateToURI3 libvirt api call: %v", err)
@@ -1063,13 +1105,37 @@ func (l *LibvirtDomainManager) updateVMIMigrationMode(mode v1.MigrationMode) {
 	log.Log.V(4).Infof("Migration mode set in metadata: %s", l.metadataCache.Migration.String())
 }
 
+func (l *LibvirtDomainManager) updateVMIMigrationProgress(progress *api.MigrationProgressMetadata) {
+	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+		migrationMetadata.Progress = progress
+	})
+}
+
+// newMigrationProgressMetadata creates the migration progress out of the migration job info.
+// The completion is estimated based on the rate in which the remaining data shrinks, i.e. the throughput
+// minus the memory dirty rate. No estimation is given when the migration does not converge.
+func newMigrationProgressMetadata(info *libvirt.DomainJobInfo) *api.MigrationProgressMetadata {
+	now := metav1.Now()
+	progress := &api.MigrationProgressMetadata{
+		UpdateTimestamp: &now,
+		DataProcessed:   info.DataProcessed,
+		DataRemaining:   info.DataRemaining,
+		DataTotal:       info.DataTotal,
+		MemoryDirtyRate: info.MemDirtyRate * info.MemPageSize,
+		Throughput:      info.MemBps + info.DiskBps,
+		Iteration:       info.MemIteration,
+	}
+	if progress.Throughput > progress.MemoryDirtyRate {
+		estimatedCompletionSeconds := int64(progress.DataRemaining / (progress.Throughput - progress.MemoryDirtyRate))
+		progress.EstimatedCompletionSeconds = &estimatedCompletionSeconds
+	}
+	return progress
+}
+
 func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shouldConfigure bool, threadsCount int) {
 	if options == nil {
 		return
 	}
-	if options.AllowPostCopy {
-		return
-	}
 	if options.ParallelMigrationThreads == nil {
 		return
 	}
@@ -1078,3 +1144,101 @@ func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shou
 	threadsCount = int(*options.ParallelMigrationThreads)
 	return
 }
+
+// withSupportedParallelMigration returns the options the migration can use with the running QEMU.
+// Post-copy is supported along with parallel connections from QEMU 10.0, older versions migrate a VMI
+// which allows post-copy over a single connection.
+func withSupportedParallelMigration(virConn cli.Connection, options *cmdclient.MigrationOptions) (*cmdclient.MigrationOptions, error) {
+	if options == nil || !options.AllowPostCopy || options.ParallelMigrationThreads == nil {
+		return options, nil
+	}
+	qemuVersion, err := virConn.GetQemuVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the QEMU version: %v", err)
+	}
+	if supportsParallelPostCopy(qemuVersion) {
+		return options, nil
+	}
+
+	log.Log.Infof("%s does not support post-copy along with parallel connections, migrating over a single connection", qemuVersion)
+	singleConnectionOptions := *options
+	singleConnectionOptions.ParallelMigrationThreads = nil
+	return &singleConnectionOptions, nil
+}
+
+// supportsParallelPostCopy parses a version formatted as "QEMU <major>.<minor>.<release>"
+func supportsParallelPostCopy(qemuVersion string) bool {
+	version := strings.Split(strings.TrimPrefix(qemuVersion, "QEMU "), ".")
+	if len(version) < 2 {
+		return false
+	}
+	major, err := strconv.Atoi(version[0])
+	if err != nil {
+		return false
+	}
+	minor, err := strconv.Atoi(version[1])
+	if err != nil {
+		return false
+	}
+	return major > minParallelPostCopyQemuMajor || (major == minParallelPostCopyQemuMajor && minor >= minParallelPostCopyQemuMinor)
+}
+
+// migrationCompression returns the compression of the migration stream, if any.
+// The zlib and zstd methods compress the parallel connections, hence the migration fails when it does not use them.
+func migrationCompression(options *cmdclient.MigrationOptions) (*v1.MigrationCompression, error) {
+	if options == nil || options.Compression == nil {
+		return nil, nil
+	}
+	if options.Compression.Method != v1.MigrationCompressionXBZRLE {
+		if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); !shouldConfigureParallel {
+			return nil, fmt.Errorf("the %q migration compression requires parallel connections", options.Compression.Method)
+		}
+	}
+	return options.Compression, nil
+}
+
+// setZeroPageDetection configures how QEMU detects the zero pages of the guest memory.
+// libvirt does not expose this migration parameter, hence it is set through the QEMU monitor, which requires QEMU 9.0 or newer.
+func setZeroPageDetection(dom cli.VirDomain, options *cmdclient.MigrationOptions) error {
+	if options == nil || options.ZeroPageDetection == nil {
+		return nil
+	}
+	command := fmt.Sprintf(`{"execute":"migrate-set-parameters","arguments":{"zero-page-detection":%q}}`, *options.ZeroPageDetection)
+	if _, err := dom.QemuMonitorCommand(command, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT); err != nil {
+		return fmt.Errorf("failed to set the %q zero page detection: %v", *options.ZeroPageDetection, err)
+	}
+	return nil
+}
+
+func setMigrationCompressionParams(params *libvirt.DomainMigrateParameters, compression *v1.MigrationCompression) error {
+	if compression == nil {
+		return nil
+	}
+
+	params.Compression = string(compression.Method)
+	params.CompressionSet = true
+	switch compression.Method {
+	case v1.MigrationCompressionZlib:
+		if compression.Level != nil {
+			params.CompressionZlibLevel = int(*compression.Level)
+			params.CompressionZlibLevelSet = true
+		}
+	case v1.MigrationCompressionZstd:
+		if compression.Level != nil {
+			params.CompressionZstdLevel = int(*compression.Level)
+			params.CompressionZstdLevelSet = true
+		}
+	case v1.MigrationCompressionXBZRLE:
+		if compression.XBZRLECacheSize != nil {
+			cacheSize, ok := compression.XBZRLECacheSize.AsInt64()
+			if !ok || cacheSize <= 0 {
+				return fmt.Errorf("invalid xbzrle cache size %s", compression.XBZRLECacheSize.String())
+			}
+			params.CompressionXBZRLECache = uint64(cacheSize)
+			params.CompressionXBZRLECacheSet = true
+		}
+	default:
+		return fmt.Errorf("unsupported migration compression method %q", compression.Method)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 
+	"libvirt.org/go/libvirt"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
@@ -31,4 +33,37 @@ var _ = Describe("Live Migration for the source", func() {
 				})))
 		})
 	})
+
+	Context("newMigrationProgressMetadata", func() {
+		It("should report the migration progress with an estimated completion", func() {
+			progress := newMigrationProgressMetadata(&libvirt.DomainJobInfo{
+				DataProcessed: 1000,
+				DataRemaining: 2000,
+				DataTotal:     3000,
+				MemDirtyRate:  10,
+				MemPageSize:   4,
+				MemBps:        120,
+				DiskBps:       20,
+				MemIteration:  3,
+			})
+			Expect(progress.UpdateTimestamp).ToNot(BeNil())
+			Expect(progress.DataProcessed).To(BeEquivalentTo(1000))
+			Expect(progress.DataRemaining).To(BeEquivalentTo(2000))
+			Expect(progress.DataTotal).To(BeEquivalentTo(3000))
+			Expect(progress.MemoryDirtyRate).To(BeEquivalentTo(40))
+			Expect(progress.Throughput).To(BeEquivalentTo(140))
+			Expect(progress.Iteration).To(BeEquivalentTo(3))
+			Expect(progress.EstimatedCompletionSeconds).To(PointTo(BeEquivalentTo(20)))
+		})
+
+		It("should not estimate the completion when the migration does not converge", func() {
+			progress := newMigrationProgressMetadata(&libvirt.DomainJobInfo{
+				DataRemaining: 2000,
+				MemDirtyRate:  100,
+				MemPageSize:   4,
+				MemBps:        200,
+			})
+			Expect(progress.EstimatedCompletionSeconds).To(BeNil())
+		})
+	})
 })
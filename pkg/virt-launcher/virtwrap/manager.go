@@ -65,6 +65,8 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
+	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	osdisk "kubevirt.io/kubevirt/pkg/os/disk"
@@ -143,6 +145,7 @@ type DomainManager interface {
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	BackupVMI(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -695,6 +698,14 @@ func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineIn
 		if devicesMetadata != nil {
 			cloudInitDataStore.DevicesData = &devicesMetadata
 		}
+		// the MAC addresses not set in the VMI spec are only known once the domain is defined
+		if cloudInitDataStore.GenerateNetworkData && domPtr != nil {
+			macs, err := domainInterfaceMACs(*domPtr)
+			if err != nil {
+				return err
+			}
+			cloudInitDataStore.InterfaceMACs = macs
+		}
 		var err error
 		if size != 0 {
 			err = cloudinit.GenerateEmptyIso(vmi.Name, vmi.Namespace, cloudInitDataStore, size)
@@ -715,6 +726,21 @@ func (l *LibvirtDomainManager) generateSomeCloudInitISO(vmi *v1.VirtualMachineIn
 	return nil
 }
 
+// domainInterfaceMACs returns the MAC addresses of the domain interfaces by interface name
+func domainInterfaceMACs(dom cli.VirDomain) (map[string]string, error) {
+	domainSpec, err := getDomainSpec(dom)
+	if err != nil {
+		return nil, err
+	}
+	macs := map[string]string{}
+	for _, nic := range domainSpec.Devices.Interfaces {
+		if nic.Alias != nil && nic.MAC != nil {
+			macs[nic.Alias.GetName()] = nic.MAC.MAC
+		}
+	}
+	return macs, nil
+}
+
 func (l *LibvirtDomainManager) generateCloudInitISO(vmi *v1.VirtualMachineInstance, domPtr *cli.VirDomain) error {
 	return l.generateSomeCloudInitISO(vmi, domPtr, 0)
 }
@@ -789,10 +815,18 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 	if options != nil {
 		interfaceDomainAttachments = options.GetInterfaceDomainAttachment()
 	}
-	err = netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}, netsetup.WithDomainAttachments(interfaceDomainAttachments)).SetupPodNetworkPhase2(domain, nonAbsentNets)
+	netConfigurator := netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}, netsetup.WithDomainAttachments(interfaceDomainAttachments))
+	err = netConfigurator.SetupPodNetworkPhase2(domain, nonAbsentNets)
 	if err != nil {
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
+	// the generated network data configures DHCP only on the interfaces served by the DHCP server
+	if l.cloudInitDataStore != nil && l.cloudInitDataStore.GenerateNetworkData {
+		l.cloudInitDataStore.DHCPInterfaces, err = netConfigurator.DHCPv4Interfaces(domain, nonAbsentNets)
+		if err != nil {
+			return domain, fmt.Errorf("reading the DHCP interfaces failed: %v", err)
+		}
+	}
 
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, l.disksInfo)
@@ -1075,6 +1109,13 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 
 		c.DomainAttachmentByInterfaceName = options.GetInterfaceDomainAttachment()
+		if domainspec.HasVhostUserAttachment(c.DomainAttachmentByInterfaceName) {
+			vhostUserSources, err := readVhostUserSources(c.DomainAttachmentByInterfaceName)
+			if err != nil {
+				return nil, err
+			}
+			c.VhostUserSourceByInterfaceName = vhostUserSources
+		}
 	}
 	c.DisksInfo = l.disksInfo
 
@@ -1103,6 +1144,18 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 	return c, nil
 }
 
+func readVhostUserSources(domainAttachmentByInterfaceName map[string]string) (map[string]api.InterfaceSource, error) {
+	networkInfoBytes, err := os.ReadFile(filepath.Join(downwardapi.MountPath, downwardapi.NetworkInfoVolumePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read network-info: %v", err)
+	}
+	vhostUserSources, err := domainspec.VhostUserSourceByInterfaceName(domainAttachmentByInterfaceName, networkInfoBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vhost-user interfaces: %v", err)
+	}
+	return vhostUserSources, nil
+}
+
 func isFreePageReportingEnabled(clusterFreePageReportingDisabled bool, vmi *v1.VirtualMachineInstance) bool {
 	if clusterFreePageReportingDisabled ||
 		(vmi.Spec.Domain.Devices.AutoattachMemBalloon != nil && *vmi.Spec.Domain.Devices.AutoattachMemBalloon == false) ||
@@ -1289,7 +1342,7 @@ func (l *LibvirtDomainManager) syncNetwork(
 	if err := networkInterfaceManager.hotUnplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}); err != nil {
 		return err
 	}
-	if err := networkInterfaceManager.updateDomainLinkState(&api.Domain{Spec: *oldSpec}, domain); err != nil {
+	if err := networkInterfaceManager.updateDomainInterfaces(&api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
 
@@ -2132,7 +2132,8 @@ var _ = Describe("Manager", func() {
 				options.ParallelMigrationThreads = virtpointer.P(uint(parallelMigrationThreads))
 			}
 
-			flags := generateMigrationFlags(isBlockMigration, isVmiPaused, options)
+			flags, err := generateMigrationFlags(isBlockMigration, isVmiPaused, options)
+			Expect(err).ToNot(HaveOccurred())
 			expectedMigrateFlags := libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST
 
 			if isBlockMigration {
@@ -3191,16 +3192,164 @@ var _ = Describe("Manager helper functions", func() {
 			Entry("with nil options", nil),
 			Entry("with nil migration threads", &cmdclient.MigrationOptions{ParallelMigrationThreads: nil}),
 			Entry("with nil migration threads and post-copy allowed", &cmdclient.MigrationOptions{ParallelMigrationThreads: nil, AllowPostCopy: true}),
-			Entry("with non-nil migration threads and post-copy allowed", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(3)), AllowPostCopy: true}),
 		)
 
-		It("should configure parallel migration with non-nil migration threads and post-copy not allowed", func() {
+		DescribeTable("should configure parallel migration with non-nil migration threads", func(allowPostCopy bool) {
 			options := &cmdclient.MigrationOptions{
 				ParallelMigrationThreads: virtpointer.P(uint(3)),
-				AllowPostCopy:            false,
+				AllowPostCopy:            allowPostCopy,
 			}
-			shouldConfigure, _ := shouldConfigureParallelMigration(options)
+			shouldConfigure, threadsCount := shouldConfigureParallelMigration(options)
 			Expect(shouldConfigure).To(BeTrue())
+			Expect(threadsCount).To(Equal(3))
+		},
+			Entry("and post-copy not allowed", false),
+			Entry("and post-copy allowed", true),
+		)
+	})
+
+	Context("parallel migration along with post-copy", func() {
+		var ctrl *gomock.Controller
+		var mockConn *cli.MockConnection
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockConn = cli.NewMockConnection(ctrl)
+		})
+
+		DescribeTable("should keep the options", func(options *cmdclient.MigrationOptions) {
+			Expect(withSupportedParallelMigration(mockConn, options)).To(BeIdenticalTo(options))
+		},
+			Entry("without options", nil),
+			Entry("when post-copy is not allowed", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(4))}),
+			Entry("without parallel migration", &cmdclient.MigrationOptions{AllowPostCopy: true}),
+		)
+
+		It("should keep the parallel connections when QEMU supports them along with post-copy", func() {
+			options := &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(4)), AllowPostCopy: true}
+			mockConn.EXPECT().GetQemuVersion().Return("QEMU 10.0.0", nil)
+			Expect(withSupportedParallelMigration(mockConn, options)).To(BeIdenticalTo(options))
+		})
+
+		It("should migrate over a single connection when QEMU does not support parallel post-copy", func() {
+			options := &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(4)), AllowPostCopy: true}
+			mockConn.EXPECT().GetQemuVersion().Return("QEMU 9.2.0", nil)
+			adjusted, err := withSupportedParallelMigration(mockConn, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(adjusted.AllowPostCopy).To(BeTrue())
+			Expect(adjusted.ParallelMigrationThreads).To(BeNil())
+			Expect(*options.ParallelMigrationThreads).To(Equal(uint(4)))
+		})
+
+		It("should fail when the QEMU version is unknown", func() {
+			options := &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(4)), AllowPostCopy: true}
+			mockConn.EXPECT().GetQemuVersion().Return("", fmt.Errorf("no connection"))
+			_, err := withSupportedParallelMigration(mockConn, options)
+			Expect(err).To(HaveOccurred())
+		})
+
+		DescribeTable("should parse the QEMU version", func(qemuVersion string, expected bool) {
+			Expect(supportsParallelPostCopy(qemuVersion)).To(Equal(expected))
+		},
+			Entry("9.2.0", "QEMU 9.2.0", false),
+			Entry("10.0.0", "QEMU 10.0.0", true),
+			Entry("10.1.2", "QEMU 10.1.2", true),
+			Entry("11.0.0", "QEMU 11.0.0", true),
+			Entry("a malformed version", "QEMU ten", false),
+		)
+	})
+
+	Context("migration stream options", func() {
+		const baseMigrateFlags = libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST
+
+		DescribeTable("should generate the migration flags", func(options *cmdclient.MigrationOptions, expectedFlags libvirt.DomainMigrateFlags) {
+			flags, err := generateMigrationFlags(false, false, options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(flags).To(Equal(baseMigrateFlags | expectedFlags))
+		},
+			Entry("with xbzrle compression",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE}},
+				libvirt.MIGRATE_COMPRESSED),
+			Entry("with zstd compression and parallel migration",
+				&cmdclient.MigrationOptions{
+					ParallelMigrationThreads: virtpointer.P(uint(4)),
+					Compression:              &v1.MigrationCompression{Method: v1.MigrationCompressionZstd},
+				},
+				libvirt.MIGRATE_PARALLEL|libvirt.MIGRATE_COMPRESSED),
+			Entry("with zero-copy and parallel migration",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(4)), ZeroCopy: true},
+				libvirt.MIGRATE_PARALLEL|libvirt.MIGRATE_ZEROCOPY),
+			Entry("without zero-copy when parallel migration is not configured",
+				&cmdclient.MigrationOptions{ZeroCopy: true},
+				libvirt.DomainMigrateFlags(0)),
+			Entry("with post-copy over parallel connections without zero-copy",
+				&cmdclient.MigrationOptions{
+					ParallelMigrationThreads: virtpointer.P(uint(4)),
+					AllowPostCopy:            true,
+					Compression:              &v1.MigrationCompression{Method: v1.MigrationCompressionZstd},
+					ZeroCopy:                 true,
+				},
+				libvirt.MIGRATE_POSTCOPY|libvirt.MIGRATE_PARALLEL|libvirt.MIGRATE_COMPRESSED),
+		)
+
+		DescribeTable("should fail when a compression method requires parallel migration", func(method v1.MigrationCompressionMethod) {
+			options := &cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Method: method}, AllowPostCopy: true}
+			_, err := generateMigrationFlags(false, false, options)
+			Expect(err).To(MatchError(ContainSubstring("requires parallel connections")))
+		},
+			Entry("zlib", v1.MigrationCompressionZlib),
+			Entry("zstd", v1.MigrationCompressionZstd),
+		)
+
+		Context("zero page detection", func() {
+			var ctrl *gomock.Controller
+			var mockDomain *cli.MockVirDomain
+
+			BeforeEach(func() {
+				ctrl = gomock.NewController(GinkgoT())
+				mockDomain = cli.NewMockVirDomain(ctrl)
+			})
+
+			It("should not be set by default", func() {
+				Expect(setZeroPageDetection(mockDomain, &cmdclient.MigrationOptions{})).To(Succeed())
+			})
+
+			It("should be set through the QEMU monitor", func() {
+				mockDomain.EXPECT().QemuMonitorCommand(
+					`{"execute":"migrate-set-parameters","arguments":{"zero-page-detection":"legacy"}}`,
+					libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT,
+				).Return(`{"return":{}}`, nil)
+				options := &cmdclient.MigrationOptions{ZeroPageDetection: virtpointer.P(v1.MigrationZeroPageDetectionLegacy)}
+				Expect(setZeroPageDetection(mockDomain, options)).To(Succeed())
+			})
+
+			It("should fail when QEMU rejects it", func() {
+				mockDomain.EXPECT().QemuMonitorCommand(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("unsupported parameter"))
+				options := &cmdclient.MigrationOptions{ZeroPageDetection: virtpointer.P(v1.MigrationZeroPageDetectionMultifd)}
+				Expect(setZeroPageDetection(mockDomain, options)).ToNot(Succeed())
+			})
+		})
+
+		DescribeTable("should set the compression parameters", func(compression *v1.MigrationCompression, expectedParams *libvirt.DomainMigrateParameters) {
+			params := &libvirt.DomainMigrateParameters{}
+			Expect(setMigrationCompressionParams(params, compression)).To(Succeed())
+			Expect(params).To(Equal(expectedParams))
+		},
+			Entry("without compression", nil, &libvirt.DomainMigrateParameters{}),
+			Entry("with zlib level",
+				&v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: virtpointer.P(int32(6))},
+				&libvirt.DomainMigrateParameters{Compression: "zlib", CompressionSet: true, CompressionZlibLevel: 6, CompressionZlibLevelSet: true}),
+			Entry("with zstd level",
+				&v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: virtpointer.P(int32(3))},
+				&libvirt.DomainMigrateParameters{Compression: "zstd", CompressionSet: true, CompressionZstdLevel: 3, CompressionZstdLevelSet: true}),
+			Entry("with xbzrle cache size",
+				&v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, XBZRLECacheSize: virtpointer.P(resource.MustParse("64Mi"))},
+				&libvirt.DomainMigrateParameters{Compression: "xbzrle", CompressionSet: true, CompressionXBZRLECache: 64 * 1024 * 1024, CompressionXBZRLECacheSet: true}),
+		)
+
+		It("should fail to set an unsupported compression method", func() {
+			params := &libvirt.DomainMigrateParameters{}
+			Expect(setMigrationCompressionParams(params, &v1.MigrationCompression{Method: "lz4"})).ToNot(Succeed())
 		})
 	})
 
@@ -22,6 +22,7 @@ package virtwrap
 import (
 	"encoding/xml"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
@@ -97,7 +98,9 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 	return nil
 }
 
-func (vim *virtIOInterfaceManager) updateDomainLinkState(currentDomain, desiredDomain *api.Domain) error {
+// updateDomainInterfaces applies the live-updatable settings of the desired
+// interfaces, link state and bandwidth, to the interfaces of the running domain.
+func (vim *virtIOInterfaceManager) updateDomainInterfaces(currentDomain, desiredDomain *api.Domain) error {
 
 	currentDomainIfacesByAlias := indexedDomainInterfaces(currentDomain)
 	for _, desiredIface := range desiredDomain.Spec.Devices.Interfaces {
@@ -106,8 +109,9 @@ func (vim *virtIOInterfaceManager) updateDomainLinkState(currentDomain, desiredD
 			continue
 		}
 
-		if !isLinkStateEqual(curIface, desiredIface) {
+		if !isLinkStateEqual(curIface, desiredIface) || !isBandwidthEqual(curIface, desiredIface) {
 			curIface.LinkState = desiredIface.LinkState
+			curIface.BandWidth = desiredIface.BandWidth
 			if err := vim.updateIfaceInDomain(&curIface); err != nil {
 				return err
 			}
@@ -118,14 +122,14 @@ func (vim *virtIOInterfaceManager) updateDomainLinkState(currentDomain, desiredD
 }
 
 func (vim *virtIOInterfaceManager) updateIfaceInDomain(domIfaceToUpdate *api.Interface) error {
-	log.Log.Infof("preparing to update link state to interface %q", domIfaceToUpdate.Alias.GetName())
+	log.Log.Infof("preparing to update interface %q", domIfaceToUpdate.Alias.GetName())
 	ifaceXML, err := xml.Marshal(domIfaceToUpdate)
 	if err != nil {
 		return err
 	}
 
 	if err = vim.dom.UpdateDeviceFlags(strings.ToLower(string(ifaceXML)), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
-		log.Log.Reason(err).Errorf("libvirt failed to set link state to interface %s , %v", domIfaceToUpdate.Alias.GetName(), err)
+		log.Log.Reason(err).Errorf("libvirt failed to update interface %s , %v", domIfaceToUpdate.Alias.GetName(), err)
 		return err
 	}
 	return nil
@@ -280,3 +284,7 @@ func isLinkStateEqual(iface1, iface2 api.Interface) bool {
 
 	return iface1.LinkState.State == iface2.LinkState.State
 }
+
+func isBandwidthEqual(iface1, iface2 api.Interface) bool {
+	return reflect.DeepEqual(iface1.BandWidth, iface2.BandWidth)
+}
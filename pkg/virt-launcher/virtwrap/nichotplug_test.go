@@ -355,7 +355,7 @@ var _ = Describe("interface link state update", func() {
 			networkInterfaceManager := newVirtIOInterfaceManager(
 				expectMockFunc(gomock.NewController(GinkgoT())),
 				&fakeVMConfigurator{})
-			Expect(networkInterfaceManager.updateDomainLinkState(domainFrom, domainTo)).To(Succeed())
+			Expect(networkInterfaceManager.updateDomainInterfaces(domainFrom, domainTo)).To(Succeed())
 		},
 
 		Entry("none to none",
@@ -381,6 +381,42 @@ var _ = Describe("interface link state update", func() {
 	)
 })
 
+var _ = Describe("interface bandwidth update", func() {
+	const interfaceWithBandwidthXML = `<interface type=""><source></source><bandwidth><inbound average="1000" peak="2000"></inbound></bandwidth><alias name="ua-default"></alias></interface>`
+	const interfaceWithoutBandwidthXML = `<interface type=""><source></source><alias name="ua-default"></alias></interface>`
+
+	bandwidth := &api.BandWidth{Inbound: &api.BandWidthLimit{Average: "1000", Peak: "2000"}}
+
+	DescribeTable("updates the domain interface", func(domainFrom, domainTo *api.Domain, expectedXML string) {
+		mockClient := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		mockClient.EXPECT().UpdateDeviceFlags(expectedXML, gomock.Any()).Times(1).Return(nil)
+
+		networkInterfaceManager := newVirtIOInterfaceManager(mockClient, &fakeVMConfigurator{})
+		Expect(networkInterfaceManager.updateDomainInterfaces(domainFrom, domainTo)).To(Succeed())
+	},
+		Entry("none to limited",
+			dummyDomain(defaultNet),
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, bandwidth)),
+			interfaceWithBandwidthXML,
+		),
+		Entry("limited to none",
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, bandwidth)),
+			dummyDomain(defaultNet),
+			interfaceWithoutBandwidthXML,
+		),
+	)
+
+	It("does not update the domain interface when the bandwidth is unchanged", func() {
+		networkInterfaceManager := newVirtIOInterfaceManager(
+			expectUpdateDeviceNotCalled(gomock.NewController(GinkgoT())),
+			&fakeVMConfigurator{})
+		Expect(networkInterfaceManager.updateDomainInterfaces(
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, bandwidth)),
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, bandwidth.DeepCopy())),
+		)).To(Succeed())
+	})
+})
+
 type libvirtClientResult struct {
 	expectedError           error
 	expectedAttachedDevices int
@@ -496,3 +532,10 @@ func newDeviceInterface(ifaceName, state string) api.Interface {
 		LinkState: &api.LinkState{State: state},
 	}
 }
+
+func newDeviceInterfaceWithBandwidth(ifaceName string, bandwidth *api.BandWidth) api.Interface {
+	return api.Interface{
+		Alias:     api.NewUserDefinedAlias(ifaceName),
+		BandWidth: bandwidth,
+	}
+}
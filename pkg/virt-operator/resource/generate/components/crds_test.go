@@ -43,6 +43,10 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd),
+		Entry("for VirtualMachineBackup", NewVirtualMachineBackupCrd),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd),
+		Entry("for VirtualMachineGroupSnapshot", NewVirtualMachineGroupSnapshotCrd),
+		Entry("for VirtualMachineGroupRestore", NewVirtualMachineGroupRestoreCrd),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
@@ -50,6 +54,7 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for IPPool", NewIPPoolCrd),
 	)
 
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
@@ -136,6 +141,10 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd, "SourceKind", "SourceName", "Phase", "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd, "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd, "TargetKind", "TargetName", "Complete", "RestoreTime"),
+		Entry("for VirtualMachineBackup", NewVirtualMachineBackupCrd, "SourceKind", "SourceName", "Type", "Phase", "CreationTime", "Error"),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd, "Schedule", "Disabled", "LastRunTime", "NextRunTime", "Error"),
+		Entry("for VirtualMachineGroupSnapshot", NewVirtualMachineGroupSnapshotCrd, "Phase", "ReadyToUse", "CreationTime", "Error"),
+		Entry("for VirtualMachineGroupRestore", NewVirtualMachineGroupRestoreCrd, "GroupSnapshotName", "Complete", "RestoreTime"),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd, "SourceKind", "SourceName", "Phase"),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
@@ -143,6 +152,7 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineClusterPreference", NewVirtualMachineClusterPreferenceCrd),
 		Entry("for VirtualMachineClone", NewVirtualMachineCloneCrd, "Phase", "SourceVirtualMachine", "TargetVirtualMachine"),
 		Entry("for MigrationPolicy", NewMigrationPolicyCrd),
+		Entry("for IPPool", NewIPPoolCrd, "CIDR", "Gateway", "Age"),
 	)
 
 	DescribeTable("Additional printer columns map to expected value", func(crdFunc func() (*extv1.CustomResourceDefinition, error), obj any, expected ...string) {
@@ -302,6 +312,66 @@ var _ = Describe("CRDs", func() {
 			},
 			"VirtualMachine", "test-vm", "false", timestamp,
 		),
+		Entry("for VirtualMachineBackup", NewVirtualMachineBackupCrd,
+			snapshotv1beta1.VirtualMachineBackup{
+				Spec: snapshotv1beta1.VirtualMachineBackupSpec{
+					Source: k8sv1.TypedLocalObjectReference{
+						Kind: "VirtualMachine",
+						Name: "test-vm",
+					},
+				},
+				Status: &snapshotv1beta1.VirtualMachineBackupStatus{
+					Type:         snapshotv1beta1.IncrementalBackup,
+					Phase:        snapshotv1beta1.Succeeded,
+					CreationTime: pointer.P(createTime()),
+					Error: &snapshotv1beta1.Error{
+						Message: pointer.P("test-error"),
+					},
+				},
+			},
+			"VirtualMachine", "test-vm", "Incremental", "Succeeded", timestamp, "test-error",
+		),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd,
+			snapshotv1beta1.VirtualMachineSnapshotSchedule{
+				Spec: snapshotv1beta1.VirtualMachineSnapshotScheduleSpec{
+					Schedule: "0 2 * * *",
+					Disabled: pointer.P(true),
+				},
+				Status: &snapshotv1beta1.VirtualMachineSnapshotScheduleStatus{
+					LastRunTime: pointer.P(createTime()),
+					NextRunTime: pointer.P(createTime()),
+					Error: &snapshotv1beta1.Error{
+						Message: pointer.P("test-error"),
+					},
+				},
+			},
+			"0 2 * * *", "true", timestamp, timestamp, "test-error",
+		),
+		Entry("for VirtualMachineGroupSnapshot", NewVirtualMachineGroupSnapshotCrd,
+			snapshotv1beta1.VirtualMachineGroupSnapshot{
+				Status: &snapshotv1beta1.VirtualMachineGroupSnapshotStatus{
+					Phase:        snapshotv1beta1.Succeeded,
+					ReadyToUse:   pointer.P(true),
+					CreationTime: pointer.P(createTime()),
+					Error: &snapshotv1beta1.Error{
+						Message: pointer.P("test-error"),
+					},
+				},
+			},
+			"Succeeded", "true", timestamp, "test-error",
+		),
+		Entry("for VirtualMachineGroupRestore", NewVirtualMachineGroupRestoreCrd,
+			snapshotv1beta1.VirtualMachineGroupRestore{
+				Spec: snapshotv1beta1.VirtualMachineGroupRestoreSpec{
+					VirtualMachineGroupSnapshotName: "test-group-snapshot",
+				},
+				Status: &snapshotv1beta1.VirtualMachineGroupRestoreStatus{
+					Complete:    pointer.P(true),
+					RestoreTime: pointer.P(createTime()),
+				},
+			},
+			"test-group-snapshot", "true", timestamp,
+		),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd,
 			exportv1beta1.VirtualMachineExport{
 				Spec: exportv1beta1.VirtualMachineExportSpec{
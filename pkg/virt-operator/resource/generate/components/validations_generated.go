@@ -622,6 +622,79 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"ippool": `openAPIV3Schema:
+  description: |-
+    IPPool defines a subnet from which IP addresses are allocated to VM interfaces
+    connected to a bridge network without an IPAM plugin.
+    Each VM interface keeps its address until the VM is deleted.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        cidr:
+          description: CIDR is the IPv4 subnet of the pool, e.g. 192.168.10.0/24.
+          type: string
+        exclude:
+          description: Exclude lists addresses or subnets of the pool which must not
+            be allocated.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        gateway:
+          description: |-
+            Gateway is the default gateway advertised to the guests.
+            It is never allocated.
+          type: string
+      required:
+      - cidr
+      type: object
+    status:
+      properties:
+        allocations:
+          description: Allocations lists the addresses allocated from the pool.
+          items:
+            description: IPPoolAllocation is an address allocated to an interface
+              of a VM.
+            properties:
+              address:
+                description: Address is the allocated IP address.
+                type: string
+              interface:
+                description: Interface is the name of the VM interface owning the
+                  address.
+                type: string
+              virtualMachine:
+                description: VirtualMachine is the name of the VM owning the address.
+                type: string
+            required:
+            - address
+            - interface
+            - virtualMachine
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"kubevirt": `openAPIV3Schema:
   description: KubeVirt represents the object deploying all KubeVirt resources
@@ -1215,6 +1288,32 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: Compression configures the compression of the live
+                    migration stream. Defaults to no compression
+                  properties:
+                    level:
+                      description: Level is the compression level of the zlib (0-9)
+                        and zstd (0-20) methods
+                      format: int32
+                      type: integer
+                    method:
+                      description: |-
+                        Method is the compression method.
+                        The zlib and zstd methods compress the parallel connections and require ParallelStreams to be set.
+                        The xbzrle method only sends the changes of memory pages which are transferred again in later iterations.
+                      type: string
+                    xbzrleCacheSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: XBZRLECacheSize is the size of the page cache used
+                        by the xbzrle method
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - method
+                  type: object
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -1249,6 +1348,15 @@ var CRDsValidation map[string]string = map[string]string{
                     allowed per node. Defaults to 2
                   format: int32
                   type: integer
+                parallelStreams:
+                  description: |-
+                    ParallelStreams is the number of parallel connections used to transfer the guest memory.
+                    Parallel connections are used along with post-copy when QEMU supports it (QEMU 10.0 or newer),
+                    otherwise a migration which allows post-copy uses a single connection, and fails when it is
+                    configured with a compression method which requires parallel connections.
+                    By default, KubeVirt uses parallel connections only for pre-copy migrations of VMIs without a CPU limit.
+                  format: int32
+                  type: integer
                 progressTimeout:
                   description: |-
                     ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
@@ -1261,6 +1369,20 @@ var CRDsValidation map[string]string = map[string]string{
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
                     indicates the migration will be unsafe to the guest. Defaults to false
                   type: boolean
+                zeroCopy:
+                  description: |-
+                    ZeroCopy enables sending the guest memory over the parallel connections without copying it first.
+                    It requires ParallelStreams and is incompatible with compression. The guest memory is locked on both nodes,
+                    and zero-copy is not used when post-copy is allowed or when the memory cannot be locked.
+                    Defaults to false
+                  type: boolean
+                zeroPageDetection:
+                  description: |-
+                    ZeroPageDetection configures how the guest memory pages which only hold zeros are detected, so that they are
+                    not transferred. The legacy method detects them in the main migration thread, the multifd method in the
+                    threads of the parallel connections, and falls back to the legacy method without parallel connections.
+                    Defaults to the QEMU default, which is multifd with QEMU 9.0 or newer.
+                  type: string
               type: object
             minCPUModel:
               type: string
@@ -1303,7 +1425,7 @@ var CRDsValidation map[string]string = map[string]string{
                       domainAttachmentType:
                         description: |-
                           DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-                          Supported values: "tap", "managedTap" (since v1.4).
+                          Supported values: "tap", "managedTap" (since v1.4), "vhostUser".
                           The standard domain attachment can be used instead or in addition to the sidecarImage.
                           version: 1alphav1
                         type: string
@@ -2746,6 +2868,86 @@ var CRDsValidation map[string]string = map[string]string{
 
                 Defaults to 10
               type: integer
+            excludedNamespaces:
+              description: |-
+                ExcludedNamespaces lists namespaces whose VMIs are never disrupted by
+                automated workload updates
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            excludedSelector:
+              description: |-
+                ExcludedSelector opts VMIs matching the label selector out of
+                automated workload updates
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: |-
+                      A label selector requirement is a selector that contains values, a key, and an operator that
+                      relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies
+                          to.
+                        type: string
+                      operator:
+                        description: |-
+                          operator represents a key's relationship to a set of values.
+                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                        type: string
+                      values:
+                        description: |-
+                          values is an array of string values. If the operator is In or NotIn,
+                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                          the values array must be empty. This array is replaced during a strategic
+                          merge patch.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                  type: object
+              type: object
+              x-kubernetes-map-type: atomic
+            maintenanceWindows:
+              description: |-
+                MaintenanceWindows restricts automated workload updates to recurring time windows.
+                Outdated VMIs are only migrated or evicted while at least one window is open.
+
+                An empty list allows workload updates at any time
+              items:
+                description: |-
+                  WorkloadUpdateMaintenanceWindow defines a recurring time window in which
+                  automated workload updates are allowed
+                properties:
+                  duration:
+                    description: Duration defines how long the window stays open
+                    type: string
+                  schedule:
+                    description: Schedule is a cron expression, evaluated in UTC,
+                      defining when the window opens
+                    type: string
+                required:
+                - duration
+                - schedule
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             workloadUpdateMethods:
               description: |-
                 WorkloadUpdateMethods defines the methods that can be used to disrupt workloads
@@ -3851,6 +4053,32 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         targetKubeVirtVersion:
           type: string
+        workloadUpdateStatus:
+          description: KubeVirtWorkloadUpdateStatus reports the progress of automated
+            workload updates
+          properties:
+            excludedVirtualMachineInstances:
+              description: ExcludedVirtualMachineInstances is the number of outdated
+                VMIs opted out of automated updates
+              type: integer
+            maintenanceWindowOpen:
+              description: MaintenanceWindowOpen indicates whether workload updates
+                are currently allowed
+              type: boolean
+            nextMaintenanceWindow:
+              description: NextMaintenanceWindow is the time the next maintenance
+                window opens
+              format: date-time
+              type: string
+            pendingVirtualMachineInstances:
+              description: PendingVirtualMachineInstances is the number of outdated
+                VMIs waiting to be updated
+              type: integer
+          required:
+          - excludedVirtualMachineInstances
+          - maintenanceWindowOpen
+          - pendingVirtualMachineInstances
+          type: object
       type: object
   required:
   - spec
@@ -3894,6 +4122,35 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: MigrationCompression configures the compression of the live
+            migration stream
+          properties:
+            level:
+              description: Level is the compression level of the zlib (0-9) and zstd
+                (0-20) methods
+              format: int32
+              type: integer
+            method:
+              description: |-
+                Method is the compression method.
+                The zlib and zstd methods compress the parallel connections and require ParallelStreams to be set.
+                The xbzrle method only sends the changes of memory pages which are transferred again in later iterations.
+              type: string
+            xbzrleCacheSize:
+              anyOf:
+              - type: integer
+              - type: string
+              description: XBZRLECacheSize is the size of the page cache used by the
+                xbzrle method
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+          required:
+          - method
+          type: object
+        parallelStreams:
+          format: int32
+          type: integer
         selectors:
           properties:
             namespaceSelector:
@@ -3905,6 +4162,10 @@ var CRDsValidation map[string]string = map[string]string{
                 type: string
               type: object
           type: object
+        zeroCopy:
+          type: boolean
+        zeroPageDetection:
+          type: string
       required:
       - selectors
       type: object
@@ -6263,6 +6524,61 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic passing through the interface.
+                                  It can be updated on a running VMI.
+                                  Not supported for SR-IOV interfaces.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of data which
+                                          can be sent at the peak rate, in kibibytes.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than Average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of data which
+                                          can be sent at the peak rate, in kibibytes.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than Average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6329,89 +6645,322 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
-                              macAddress:
-                                description: 'Interface MAC address. For example:
-                                  de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
-                                type: string
-                              macvtap:
-                                description: |-
-                                  DeprecatedMacvtap is an alias to the deprecated Macvtap interface,
-                                  please refer to Kubevirt user guide for alternatives.
-                                  Deprecated: Removed in v1.3
-                                type: object
-                              masquerade:
-                                description: InterfaceMasquerade connects to a given
-                                  network using netfilter rules to nat the traffic.
-                                type: object
-                              model:
-                                description: |-
-                                  Interface model.
-                                  One of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.
-                                  Defaults to virtio.
-                                type: string
-                              name:
-                                description: |-
-                                  Logical name of the interface as well as a reference to the associated networks.
-                                  Must match the Name of a Network.
-                                type: string
-                              passt:
-                                description: |-
-                                  DeprecatedPasst is an alias to the deprecated Passt interface,
-                                  please refer to Kubevirt user guide for alternatives.
-                                  Deprecated: Removed in v1.3
-                                type: object
-                              pciAddress:
-                                description: 'If specified, the virtual network interface
-                                  will be placed on the guests pci address with the
-                                  specified PCI address. For example: 0000:81:01.10'
-                                type: string
-                              ports:
-                                description: List of ports to be forwarded to the
-                                  virtual machine.
-                                items:
-                                  description: |-
-                                    Port represents a port to expose from the virtual machine.
-                                    Default protocol TCP.
-                                    The port field is mandatory
-                                  properties:
-                                    name:
-                                      description: |-
-                                        If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
-                                        named port in a pod must have a unique name. Name for the port that can be
-                                        referred to by services.
-                                      type: string
-                                    port:
-                                      description: |-
-                                        Number of port to expose for the virtual machine.
-                                        This must be a valid port number, 0 < x < 65536.
-                                      format: int32
-                                      type: integer
-                                    protocol:
-                                      description: |-
-                                        Protocol for port. Must be UDP or TCP.
-                                        Defaults to "TCP".
-                                      type: string
-                                  required:
-                                  - port
-                                  type: object
-                                type: array
-                              slirp:
-                                description: |-
-                                  DeprecatedSlirp is an alias to the deprecated Slirp interface
-                                  Deprecated: Removed in v1.3
-                                type: object
-                              sriov:
-                                description: InterfaceSRIOV connects to a given network
-                                  by passing-through an SR-IOV PCI device via vfio.
-                                type: object
-                              state:
+                              firewall:
                                 description: |-
-                                  State represents the requested operational state of the interface.
-                                  The supported values are:
-                                  'absent', expressing a request to remove the interface.
-                                  'down', expressing a request to set the link down.
-                                  'up', expressing a request to set the link up.
-                                  Empty value functions as 'up'.
+                                  Firewall filters the traffic passing through the interface.
+                                  Only supported for bridge binding.
+                                properties:
+                                  antiSpoofing:
+                                    description: |-
+                                      AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+                                      than the one assigned to the interface. When the pod interface of the network has an
+                                      IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+                                      address is dropped too. IPv6 source addresses are not checked.
+                                      Defaults to true.
+                                    type: boolean
+                                  egress:
+                                    description: |-
+                                      Egress filters the traffic sent by the guest.
+                                      If not specified, all outbound traffic is allowed.
+                                    properties:
+                                      allow:
+                                        description: Allow lists the allowed traffic.
+                                          An empty list drops all traffic.
+                                        items:
+                                          properties:
+                                            networks:
+                                              description: |-
+                                                Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                and the destination for egress. If neither networks nor a peer selector are
+                                                specified, all peers are allowed.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            peerSelector:
+                                              description: |-
+                                                PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                in addition to the peer networks. The addresses reported in the status of the
+                                                selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                Until the selected peers are known, the rule allows no peers besides the networks.
+                                              properties:
+                                                matchExpressions:
+                                                  description: matchExpressions is
+                                                    a list of label selector requirements.
+                                                    The requirements are ANDed.
+                                                  items:
+                                                    description: |-
+                                                      A label selector requirement is a selector that contains values, a key, and an operator that
+                                                      relates the key and values.
+                                                    properties:
+                                                      key:
+                                                        description: key is the label
+                                                          key that the selector applies
+                                                          to.
+                                                        type: string
+                                                      operator:
+                                                        description: |-
+                                                          operator represents a key's relationship to a set of values.
+                                                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                        type: string
+                                                      values:
+                                                        description: |-
+                                                          values is an array of string values. If the operator is In or NotIn,
+                                                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                          the values array must be empty. This array is replaced during a strategic
+                                                          merge patch.
+                                                        items:
+                                                          type: string
+                                                        type: array
+                                                        x-kubernetes-list-type: atomic
+                                                    required:
+                                                    - key
+                                                    - operator
+                                                    type: object
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                matchLabels:
+                                                  additionalProperties:
+                                                    type: string
+                                                  description: |-
+                                                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                  type: object
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            ports:
+                                              description: |-
+                                                Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                If not specified, all ports are allowed.
+                                              items:
+                                                properties:
+                                                  endPort:
+                                                    description: EndPort is the last
+                                                      port of the allowed range.
+                                                    format: int32
+                                                    type: integer
+                                                  port:
+                                                    description: Port is the allowed
+                                                      port, or the first port of the
+                                                      allowed range if EndPort is
+                                                      set.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - port
+                                                type: object
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            protocol:
+                                              description: |-
+                                                Protocol of the allowed traffic.
+                                                One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                              type: string
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                  ingress:
+                                    description: |-
+                                      Ingress filters the traffic received by the guest.
+                                      If not specified, all inbound traffic is allowed.
+                                    properties:
+                                      allow:
+                                        description: Allow lists the allowed traffic.
+                                          An empty list drops all traffic.
+                                        items:
+                                          properties:
+                                            networks:
+                                              description: |-
+                                                Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                and the destination for egress. If neither networks nor a peer selector are
+                                                specified, all peers are allowed.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            peerSelector:
+                                              description: |-
+                                                PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                in addition to the peer networks. The addresses reported in the status of the
+                                                selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                Until the selected peers are known, the rule allows no peers besides the networks.
+                                              properties:
+                                                matchExpressions:
+                                                  description: matchExpressions is
+                                                    a list of label selector requirements.
+                                                    The requirements are ANDed.
+                                                  items:
+                                                    description: |-
+                                                      A label selector requirement is a selector that contains values, a key, and an operator that
+                                                      relates the key and values.
+                                                    properties:
+                                                      key:
+                                                        description: key is the label
+                                                          key that the selector applies
+                                                          to.
+                                                        type: string
+                                                      operator:
+                                                        description: |-
+                                                          operator represents a key's relationship to a set of values.
+                                                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                        type: string
+                                                      values:
+                                                        description: |-
+                                                          values is an array of string values. If the operator is In or NotIn,
+                                                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                          the values array must be empty. This array is replaced during a strategic
+                                                          merge patch.
+                                                        items:
+                                                          type: string
+                                                        type: array
+                                                        x-kubernetes-list-type: atomic
+                                                    required:
+                                                    - key
+                                                    - operator
+                                                    type: object
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                matchLabels:
+                                                  additionalProperties:
+                                                    type: string
+                                                  description: |-
+                                                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                  type: object
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            ports:
+                                              description: |-
+                                                Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                If not specified, all ports are allowed.
+                                              items:
+                                                properties:
+                                                  endPort:
+                                                    description: EndPort is the last
+                                                      port of the allowed range.
+                                                    format: int32
+                                                    type: integer
+                                                  port:
+                                                    description: Port is the allowed
+                                                      port, or the first port of the
+                                                      allowed range if EndPort is
+                                                      set.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - port
+                                                type: object
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            protocol:
+                                              description: |-
+                                                Protocol of the allowed traffic.
+                                                One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                              type: string
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                type: object
+                              macAddress:
+                                description: 'Interface MAC address. For example:
+                                  de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                                type: string
+                              macvtap:
+                                description: |-
+                                  DeprecatedMacvtap is an alias to the deprecated Macvtap interface,
+                                  please refer to Kubevirt user guide for alternatives.
+                                  Deprecated: Removed in v1.3
+                                type: object
+                              masquerade:
+                                description: InterfaceMasquerade connects to a given
+                                  network using netfilter rules to nat the traffic.
+                                type: object
+                              model:
+                                description: |-
+                                  Interface model.
+                                  One of: e1000, e1000e, igb, ne2k_pci, pcnet, rtl8139, virtio.
+                                  Defaults to virtio.
+                                type: string
+                              name:
+                                description: |-
+                                  Logical name of the interface as well as a reference to the associated networks.
+                                  Must match the Name of a Network.
+                                type: string
+                              passt:
+                                description: |-
+                                  DeprecatedPasst is an alias to the deprecated Passt interface,
+                                  please refer to Kubevirt user guide for alternatives.
+                                  Deprecated: Removed in v1.3
+                                type: object
+                              pciAddress:
+                                description: 'If specified, the virtual network interface
+                                  will be placed on the guests pci address with the
+                                  specified PCI address. For example: 0000:81:01.10'
+                                type: string
+                              ports:
+                                description: List of ports to be forwarded to the
+                                  virtual machine.
+                                items:
+                                  description: |-
+                                    Port represents a port to expose from the virtual machine.
+                                    Default protocol TCP.
+                                    The port field is mandatory
+                                  properties:
+                                    endPort:
+                                      description: |-
+                                        EndPort is the last port of the range to expose, starting at Port.
+                                        Supported only by the masquerade binding.
+                                      format: int32
+                                      type: integer
+                                    exclude:
+                                      description: |-
+                                        Exclude marks the port, or the range, as not exposed.
+                                        When all the ports of an interface are excluded, all the other ports are exposed.
+                                        Supported only by the masquerade binding.
+                                      type: boolean
+                                    name:
+                                      description: |-
+                                        If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
+                                        named port in a pod must have a unique name. Name for the port that can be
+                                        referred to by services.
+                                      type: string
+                                    port:
+                                      description: |-
+                                        Number of port to expose for the virtual machine.
+                                        This must be a valid port number, 0 < x < 65536.
+                                        If EndPort is specified, this is the first port of the range.
+                                      format: int32
+                                      type: integer
+                                    protocol:
+                                      description: |-
+                                        Protocol for port. Must be UDP or TCP.
+                                        Defaults to "TCP".
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
+                                type: array
+                              slirp:
+                                description: |-
+                                  DeprecatedSlirp is an alias to the deprecated Slirp interface
+                                  Deprecated: Removed in v1.3
+                                type: object
+                              sriov:
+                                description: InterfaceSRIOV connects to a given network
+                                  by passing-through an SR-IOV PCI device via vfio.
+                                type: object
+                              state:
+                                description: |-
+                                  State represents the requested operational state of the interface.
+                                  The supported values are:
+                                  'absent', expressing a request to remove the interface.
+                                  'down', expressing a request to set the link down.
+                                  'up', expressing a request to set the link up.
+                                  Empty value functions as 'up'.
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -7129,6 +7678,12 @@ var CRDsValidation map[string]string = map[string]string{
                               Select the default network and add it to the
                               multus-cni.io/default-network annotation.
                             type: boolean
+                          ipPool:
+                            description: |-
+                              IPPool is the name of an IP pool in the VMI namespace to allocate the
+                              interface address from. The address is served to the guest over DHCP.
+                              Only supported for bridge binding, on networks without an IPAM plugin.
+                            type: string
                           networkName:
                             description: |-
                               References to a NetworkAttachmentDefinition CRD object. Format:
@@ -7560,12 +8115,39 @@ var CRDsValidation map[string]string = map[string]string{
                   items:
                     description: Volume represents a named volume in a vmi.
                     properties:
+                      backupTarget:
+                        description: BackupTarget is attached to the virt launcher
+                          and receives the push mode backups of the vmi disks
+                        properties:
+                          claimName:
+                            description: |-
+                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          readOnly:
+                            description: |-
+                              readOnly Will force the ReadOnly setting in VolumeMounts.
+                              Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
                       cloudInitConfigDrive:
                         description: |-
                           CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
                           The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                         properties:
+                          generateNetworkData:
+                            description: |-
+                              GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the
+                              VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                              Cannot be combined with another networkdata source.
+                            type: boolean
                           networkData:
                             description: NetworkData contains config drive inline
                               cloud-init networkdata.
@@ -7619,6 +8201,12 @@ var CRDsValidation map[string]string = map[string]string{
                           The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                         properties:
+                          generateNetworkData:
+                            description: |-
+                              GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the
+                              VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                              Cannot be combined with another networkdata source.
+                            type: boolean
                           networkData:
                             description: NetworkData contains NoCloud inline cloud-init
                               networkdata.
@@ -8620,8 +9208,9 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineclone": `openAPIV3Schema:
-  description: VirtualMachineClone is a CRD that clones one VM into another.
+	"virtualmachinebackup": `openAPIV3Schema:
+  description: VirtualMachineBackup defines the operation of backing up the disks
+    of a running VM
   properties:
     apiVersion:
       description: |-
@@ -8641,49 +9230,32 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
+      description: VirtualMachineBackupSpec is the spec for a VirtualMachineBackup
+        resource
       properties:
-        annotationFilters:
-          description: |-
-            Example use: "!some/key*".
-            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        labelFilters:
-          description: |-
-            Example use: "!some/key*".
-            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        newMacAddresses:
-          additionalProperties:
-            type: string
+        failureDeadline:
           description: |-
-            NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
-            value is the new mac address. If this field is not specified, a new MAC address will
-            be generated automatically, as for any interface that is not included in this map.
-          type: object
-        newSMBiosSerial:
+            This time represents the number of seconds we permit the vm backup
+            to take. In case we pass this deadline we mark this backup
+            as failed.
+            Defaults to DefaultFailureDeadline - 5min
+          type: string
+        incrementalFrom:
           description: |-
-            NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
-            be generated automatically.
+            IncrementalFrom is the name of a succeeded VirtualMachineBackup of the same VM.
+            When set, only the blocks changed since that backup are copied.
+            Only the checkpoint of the latest succeeded backup is kept, it is therefore the only valid base.
           type: string
-        patches:
+        mode:
           description: |-
-            Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
-            Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
+            Mode defines how the backup data is delivered, Push writes a qcow2 file per disk
+            and Pull exposes the disks through an NBD server.
+            Defaults to Push
+          type: string
         source:
           description: |-
-            Source is the object that would be cloned. Currently supported source types are:
-            VirtualMachine of kubevirt.io API group,
-            VirtualMachineSnapshot of snapshot.kubevirt.io API group
+            TypedLocalObjectReference contains enough information to let you locate the
+            typed referenced object inside the same namespace.
           properties:
             apiGroup:
               description: |-
@@ -8702,14 +9274,217 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
-        target:
+        stop:
           description: |-
-            Target is the outcome of the cloning process.
-            Currently supported source types are:
-            - VirtualMachine of kubevirt.io API group
-            - Empty (nil).
-            If the target is not provided, the target type would default to VirtualMachine and a random
-            name would be generated for the target. The target's name can be viewed by
+            Stop ends the backup. It is set by the client of a Pull mode backup once it
+            is done reading the exports, and aborts a running Push mode backup.
+          type: boolean
+        targetClaimName:
+          description: |-
+            TargetClaimName is the PersistentVolumeClaim the Push mode backup is written to.
+            The claim is hotplugged into the running VM for the duration of the backup.
+            Required for Push mode.
+          type: string
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineBackupStatus is the status for a VirtualMachineBackup
+        resource
+      properties:
+        baseCheckpointName:
+          description: BaseCheckpointName is the checkpoint an incremental backup
+            is relative to
+          type: string
+        checkpointName:
+          description: CheckpointName is the name of the checkpoint created together
+            with the backup
+          type: string
+        completionTime:
+          format: date-time
+          nullable: true
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        endpoint:
+          description: |-
+            Endpoint is the unix socket, inside the virt-launcher pod, of the NBD server exposing a Pull backup.
+            The server is reachable through the nbd subresource of the VirtualMachineInstance, e.g. with virtctl nbd.
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
+        sourceUID:
+          description: |-
+            UID is a type that holds unique ID values, including UUIDs.  Because we
+            don't ONLY use UUIDs, this is an alias to string.  Being a type captures
+            intent and helps make sure that UIDs and names do not get conflated.
+          type: string
+        type:
+          description: BackupType is the type of a VirtualMachineBackup
+          type: string
+        volumes:
+          items:
+            description: VirtualMachineInstanceBackupVolume describes the backup of
+              a single volume
+            properties:
+              exportBitmap:
+                description: ExportBitmap is the dirty bitmap published alongside
+                  ExportName for incremental Pull backups
+                type: string
+              exportName:
+                description: ExportName is the NBD export of the volume in Pull mode
+                type: string
+              incremental:
+                description: |-
+                  Incremental indicates that only the blocks changed since the base checkpoint are
+                  part of the backup. Volumes which cannot track changed blocks are always fully copied.
+                type: boolean
+              targetFile:
+                description: TargetFile is the qcow2 file the volume is written to
+                  in Push mode
+                type: string
+              volumeName:
+                description: VolumeName is the name of the backed up volume
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone is a CRD that clones one VM into another.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        annotationFilters:
+          description: |-
+            Example use: "!some/key*".
+            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        labelFilters:
+          description: |-
+            Example use: "!some/key*".
+            For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: |-
+            NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the
+            value is the new mac address. If this field is not specified, a new MAC address will
+            be generated automatically, as for any interface that is not included in this map.
+          type: object
+        newSMBiosSerial:
+          description: |-
+            NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
+            be generated automatically.
+          type: string
+        patches:
+          description: |-
+            Patches holds JSON patches to apply to target. Patches should fit the target's Kind.
+            Example: '{"op": "add", "path": "/spec/template/metadata/labels/example", "value": "new-label"}'
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        source:
+          description: |-
+            Source is the object that would be cloned. Currently supported source types are:
+            VirtualMachine of kubevirt.io API group,
+            VirtualMachineSnapshot of snapshot.kubevirt.io API group
+          properties:
+            apiGroup:
+              description: |-
+                APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core API group.
+                For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+          x-kubernetes-map-type: atomic
+        target:
+          description: |-
+            Target is the outcome of the cloning process.
+            Currently supported source types are:
+            - VirtualMachine of kubevirt.io API group
+            - Empty (nil).
+            If the target is not provided, the target type would default to VirtualMachine and a random
+            name would be generated for the target. The target's name can be viewed by
             inspecting status "TargetName" field below.
           properties:
             apiGroup:
@@ -8729,6 +9504,12 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+        targetNamespace:
+          description: |-
+            TargetNamespace is the namespace the target is created in. It defaults to the namespace of the clone.
+            Cloning into another namespace requires the same permissions in the source namespace as a CDI
+            cross-namespace clone.
+          type: string
         template:
           description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
           properties:
@@ -9969,12 +10750,274 @@ var CRDsValidation map[string]string = map[string]string{
             Formula is CreationTimestamp + TTL
           format: date-time
           type: string
-        virtualMachineName:
-          description: |-
-            VirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine or
-            a VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a
-            VirtualMachineSnapshot
+        virtualMachineName:
+          description: |-
+            VirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine or
+            a VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a
+            VirtualMachineSnapshot
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinegrouprestore": `openAPIV3Schema:
+  description: VirtualMachineGroupRestore restores all the members of a VirtualMachineGroupSnapshot
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineGroupRestoreSpec is the spec for a VirtualMachineGroupRestore
+        resource
+      properties:
+        targetReadinessPolicy:
+          description: TargetReadinessPolicy is passed on to the VirtualMachineRestores
+            of the members
+          type: string
+        virtualMachineGroupSnapshotName:
+          description: |-
+            VirtualMachineGroupSnapshotName is the group snapshot to restore,
+            every member VM is restored from its snapshot in the group
+          type: string
+      required:
+      - virtualMachineGroupSnapshotName
+      type: object
+    status:
+      description: VirtualMachineGroupRestoreStatus is the status for a VirtualMachineGroupRestore
+        resource
+      properties:
+        complete:
+          description: Complete is true once all the members are restored
+          type: boolean
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        members:
+          description: Members lists the VirtualMachines of the group and their restores
+          items:
+            description: VirtualMachineGroupRestoreMember is a VirtualMachine restored
+              by a group restore
+            properties:
+              complete:
+                type: boolean
+              virtualMachineName:
+                type: string
+              virtualMachineRestoreName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineRestoreName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        restoreTime:
+          format: date-time
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinegroupsnapshot": `openAPIV3Schema:
+  description: |-
+    VirtualMachineGroupSnapshot takes crash consistent snapshots of a group of VMs,
+    the guest filesystems of all the selected VMs are frozen while their volumes are snapshotted
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineGroupSnapshotSpec is the spec for a VirtualMachineGroupSnapshot
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is passed on to the VirtualMachineSnapshots
+            of the members
+          type: string
+        failureDeadline:
+          description: |-
+            FailureDeadline is the time the group snapshot is permitted to take,
+            the frozen members are thawed once it is exceeded.
+            Defaults to DefaultFailureDeadline - 5min
+          type: string
+        selector:
+          description: |-
+            Selector selects the VirtualMachines, in the namespace of the group snapshot, to snapshot.
+            The members are determined once, when the group snapshot starts
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - selector
+      type: object
+    status:
+      description: VirtualMachineGroupSnapshotStatus is the status for a VirtualMachineGroupSnapshot
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        creationTime:
+          description: CreationTime is the time the volumes of all the members were
+            snapshotted
+          format: date-time
+          nullable: true
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        members:
+          description: Members lists the VirtualMachines of the group and their snapshots
+          items:
+            description: VirtualMachineGroupSnapshotMember is a VirtualMachine of
+              a group snapshot
+            properties:
+              phase:
+                description: VirtualMachineSnapshotPhase is the current phase of the
+                  VirtualMachineSnapshot
+                type: string
+              readyToUse:
+                type: boolean
+              virtualMachineName:
+                type: string
+              virtualMachineSnapshotName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
           type: string
+        readyToUse:
+          description: ReadyToUse is true once the snapshots of all the members are
+            ready to use
+          type: boolean
       type: object
   required:
   - spec
@@ -11581,6 +12624,58 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic passing through the interface.
+                          It can be updated on a running VMI.
+                          Not supported for SR-IOV interfaces.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of data which can
+                                  be sent at the peak rate, in kibibytes.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than Average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of data which can
+                                  be sent at the peak rate, in kibibytes.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than Average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -11647,6 +12742,222 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      firewall:
+                        description: |-
+                          Firewall filters the traffic passing through the interface.
+                          Only supported for bridge binding.
+                        properties:
+                          antiSpoofing:
+                            description: |-
+                              AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+                              than the one assigned to the interface. When the pod interface of the network has an
+                              IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+                              address is dropped too. IPv6 source addresses are not checked.
+                              Defaults to true.
+                            type: boolean
+                          egress:
+                            description: |-
+                              Egress filters the traffic sent by the guest.
+                              If not specified, all outbound traffic is allowed.
+                            properties:
+                              allow:
+                                description: Allow lists the allowed traffic. An empty
+                                  list drops all traffic.
+                                items:
+                                  properties:
+                                    networks:
+                                      description: |-
+                                        Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                        and the destination for egress. If neither networks nor a peer selector are
+                                        specified, all peers are allowed.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    peerSelector:
+                                      description: |-
+                                        PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                        in addition to the peer networks. The addresses reported in the status of the
+                                        selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                        Until the selected peers are known, the rule allows no peers besides the networks.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    ports:
+                                      description: |-
+                                        Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                        If not specified, all ports are allowed.
+                                      items:
+                                        properties:
+                                          endPort:
+                                            description: EndPort is the last port
+                                              of the allowed range.
+                                            format: int32
+                                            type: integer
+                                          port:
+                                            description: Port is the allowed port,
+                                              or the first port of the allowed range
+                                              if EndPort is set.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - port
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    protocol:
+                                      description: |-
+                                        Protocol of the allowed traffic.
+                                        One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                      type: string
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                          ingress:
+                            description: |-
+                              Ingress filters the traffic received by the guest.
+                              If not specified, all inbound traffic is allowed.
+                            properties:
+                              allow:
+                                description: Allow lists the allowed traffic. An empty
+                                  list drops all traffic.
+                                items:
+                                  properties:
+                                    networks:
+                                      description: |-
+                                        Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                        and the destination for egress. If neither networks nor a peer selector are
+                                        specified, all peers are allowed.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    peerSelector:
+                                      description: |-
+                                        PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                        in addition to the peer networks. The addresses reported in the status of the
+                                        selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                        Until the selected peers are known, the rule allows no peers besides the networks.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    ports:
+                                      description: |-
+                                        Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                        If not specified, all ports are allowed.
+                                      items:
+                                        properties:
+                                          endPort:
+                                            description: EndPort is the last port
+                                              of the allowed range.
+                                            format: int32
+                                            type: integer
+                                          port:
+                                            description: Port is the allowed port,
+                                              or the first port of the allowed range
+                                              if EndPort is set.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - port
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    protocol:
+                                      description: |-
+                                        Protocol of the allowed traffic.
+                                        One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                      type: string
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -11692,6 +13003,18 @@ var CRDsValidation map[string]string = map[string]string{
                             Default protocol TCP.
                             The port field is mandatory
                           properties:
+                            endPort:
+                              description: |-
+                                EndPort is the last port of the range to expose, starting at Port.
+                                Supported only by the masquerade binding.
+                              format: int32
+                              type: integer
+                            exclude:
+                              description: |-
+                                Exclude marks the port, or the range, as not exposed.
+                                When all the ports of an interface are excluded, all the other ports are exposed.
+                                Supported only by the masquerade binding.
+                              type: boolean
                             name:
                               description: |-
                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -11702,6 +13025,7 @@ var CRDsValidation map[string]string = map[string]string{
                               description: |-
                                 Number of port to expose for the virtual machine.
                                 This must be a valid port number, 0 < x < 65536.
+                                If EndPort is specified, this is the first port of the range.
                               format: int32
                               type: integer
                             protocol:
@@ -12436,6 +13760,12 @@ var CRDsValidation map[string]string = map[string]string{
                       Select the default network and add it to the
                       multus-cni.io/default-network annotation.
                     type: boolean
+                  ipPool:
+                    description: |-
+                      IPPool is the name of an IP pool in the VMI namespace to allocate the
+                      interface address from. The address is served to the guest over DHCP.
+                      Only supported for bridge binding, on networks without an IPAM plugin.
+                    type: string
                   networkName:
                     description: |-
                       References to a NetworkAttachmentDefinition CRD object. Format:
@@ -12866,12 +14196,39 @@ var CRDsValidation map[string]string = map[string]string{
           items:
             description: Volume represents a named volume in a vmi.
             properties:
+              backupTarget:
+                description: BackupTarget is attached to the virt launcher and receives
+                  the push mode backups of the vmi disks
+                properties:
+                  claimName:
+                    description: |-
+                      claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                      More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                    type: string
+                  hotpluggable:
+                    description: Hotpluggable indicates whether the volume can be
+                      hotplugged and hotunplugged.
+                    type: boolean
+                  readOnly:
+                    description: |-
+                      readOnly Will force the ReadOnly setting in VolumeMounts.
+                      Default false.
+                    type: boolean
+                required:
+                - claimName
+                type: object
               cloudInitConfigDrive:
                 description: |-
                   CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
                   The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                   More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                 properties:
+                  generateNetworkData:
+                    description: |-
+                      GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the
+                      VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                      Cannot be combined with another networkdata source.
+                    type: boolean
                   networkData:
                     description: NetworkData contains config drive inline cloud-init
                       networkdata.
@@ -12925,6 +14282,12 @@ var CRDsValidation map[string]string = map[string]string{
                   The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                   More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                 properties:
+                  generateNetworkData:
+                    description: |-
+                      GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the
+                      VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                      Cannot be combined with another networkdata source.
+                    type: boolean
                   networkData:
                     description: NetworkData contains NoCloud inline cloud-init networkdata.
                     type: string
@@ -13327,6 +14690,81 @@ var CRDsValidation map[string]string = map[string]string{
             ActivePods is a mapping of pod UID to node name.
             It is possible for multiple pods to be running for a single VMI during migration.
           type: object
+        backupState:
+          description: BackupState represents the state of the last backup of the
+            VMI disks
+          properties:
+            backupName:
+              description: BackupName is the name of the backup
+              type: string
+            checkpointName:
+              description: |-
+                CheckpointName is the name of the checkpoint created together with the backup.
+                It can be used as the base of a future incremental backup.
+              type: string
+            completed:
+              description: Indicates the backup completed
+              type: boolean
+            endTimestamp:
+              description: The time the backup ended
+              format: date-time
+              type: string
+            endpoint:
+              description: |-
+                Endpoint is the unix socket of the NBD server exposing the backup in Pull mode.
+                The server is reachable through the nbd subresource.
+              type: string
+            failed:
+              description: Indicates that the backup failed
+              type: boolean
+            failureReason:
+              description: FailureReason is a detailed message about the failure of
+                the backup
+              type: string
+            incrementalFrom:
+              description: IncrementalFrom is the checkpoint the backup is relative
+                to, empty for full backups
+              type: string
+            mode:
+              description: Mode is the mode the backup was taken with
+              type: string
+            startTimestamp:
+              description: The time the backup started
+              format: date-time
+              type: string
+            volumes:
+              description: Volumes lists where the data of every backed up volume
+                can be found
+              items:
+                description: VirtualMachineInstanceBackupVolume describes the backup
+                  of a single volume
+                properties:
+                  exportBitmap:
+                    description: ExportBitmap is the dirty bitmap published alongside
+                      ExportName for incremental Pull backups
+                    type: string
+                  exportName:
+                    description: ExportName is the NBD export of the volume in Pull
+                      mode
+                    type: string
+                  incremental:
+                    description: |-
+                      Incremental indicates that only the blocks changed since the base checkpoint are
+                      part of the backup. Volumes which cannot track changed blocks are always fully copied.
+                    type: boolean
+                  targetFile:
+                    description: TargetFile is the qcow2 file the volume is written
+                      to in Push mode
+                    type: string
+                  volumeName:
+                    description: VolumeName is the name of the backed up volume
+                    type: string
+                required:
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         conditions:
           description: Conditions are specific points in VirtualMachineInstance's
             pod runtime.
@@ -13383,6 +14821,55 @@ var CRDsValidation map[string]string = map[string]string{
             EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want
             to evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.
           type: string
+        firewallPeers:
+          description: FirewallPeers lists the addresses of the peers selected by
+            labels in the firewall rules of the VMI interfaces
+          items:
+            description: VirtualMachineInstanceFirewallPeers holds the peers selected
+              by labels in the firewall rules of a VMI interface
+            properties:
+              egress:
+                description: Egress lists the peers of the egress allow rules, in
+                  the order of the rules
+                items:
+                  description: FirewallRulePeers holds the addresses of the peers
+                    selected by a firewall allow rule
+                  properties:
+                    addresses:
+                      description: Addresses of the selected peers, empty for a rule
+                        without a peer selector
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              ingress:
+                description: Ingress lists the peers of the ingress allow rules, in
+                  the order of the rules
+                items:
+                  description: FirewallRulePeers holds the addresses of the peers
+                    selected by a firewall allow rule
+                  properties:
+                    addresses:
+                      description: Addresses of the selected peers, empty for a rule
+                        without a peer selector
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              name:
+                description: Name of the interface
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         fsFreezeStatus:
           description: |-
             FSFreezeStatus indicates whether a freeze operation was requested for the guest filesystem.
@@ -13459,6 +14946,35 @@ var CRDsValidation map[string]string = map[string]string{
                 type: integer
             type: object
           type: array
+        ipAllocations:
+          description: IPAllocations lists the addresses allocated to the VMI interfaces
+            from IP pools
+          items:
+            description: VirtualMachineInstanceIPAllocation is an address allocated
+              to a VMI interface from an IP pool
+            properties:
+              address:
+                description: Address is the allocated IP address with its prefix length,
+                  e.g. 192.168.10.5/24
+                type: string
+              gateway:
+                description: Gateway is the default gateway of the IP pool
+                type: string
+              ipPool:
+                description: IPPool is the name of the IP pool the address is allocated
+                  from
+                type: string
+              name:
+                description: Name of the interface, corresponds to name of the network
+                  assigned to the interface
+                type: string
+            required:
+            - address
+            - ipPool
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         kernelBootStatus:
           description: KernelBootStatus contains info about the kernelBootContainer
           properties:
@@ -13701,6 +15217,32 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: Compression configures the compression of the live
+                    migration stream. Defaults to no compression
+                  properties:
+                    level:
+                      description: Level is the compression level of the zlib (0-9)
+                        and zstd (0-20) methods
+                      format: int32
+                      type: integer
+                    method:
+                      description: |-
+                        Method is the compression method.
+                        The zlib and zstd methods compress the parallel connections and require ParallelStreams to be set.
+                        The xbzrle method only sends the changes of memory pages which are transferred again in later iterations.
+                      type: string
+                    xbzrleCacheSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: XBZRLECacheSize is the size of the page cache used
+                        by the xbzrle method
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - method
+                  type: object
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -13735,6 +15277,15 @@ var CRDsValidation map[string]string = map[string]string{
                     allowed per node. Defaults to 2
                   format: int32
                   type: integer
+                parallelStreams:
+                  description: |-
+                    ParallelStreams is the number of parallel connections used to transfer the guest memory.
+                    Parallel connections are used along with post-copy when QEMU supports it (QEMU 10.0 or newer),
+                    otherwise a migration which allows post-copy uses a single connection, and fails when it is
+                    configured with a compression method which requires parallel connections.
+                    By default, KubeVirt uses parallel connections only for pre-copy migrations of VMIs without a CPU limit.
+                  format: int32
+                  type: integer
                 progressTimeout:
                   description: |-
                     ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
@@ -13747,6 +15298,20 @@ var CRDsValidation map[string]string = map[string]string{
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
                     indicates the migration will be unsafe to the guest. Defaults to false
                   type: boolean
+                zeroCopy:
+                  description: |-
+                    ZeroCopy enables sending the guest memory over the parallel connections without copying it first.
+                    It requires ParallelStreams and is incompatible with compression. The guest memory is locked on both nodes,
+                    and zero-copy is not used when post-copy is allowed or when the memory cannot be locked.
+                    Defaults to false
+                  type: boolean
+                zeroPageDetection:
+                  description: |-
+                    ZeroPageDetection configures how the guest memory pages which only hold zeros are detected, so that they are
+                    not transferred. The legacy method detects them in the main migration thread, the multifd method in the
+                    threads of the parallel connections, and falls back to the legacy method without parallel connections.
+                    Defaults to the QEMU default, which is multifd with QEMU 9.0 or newer.
+                  type: string
               type: object
             migrationNetworkType:
               description: The type of migration network, either 'pod' or 'migration'
@@ -13763,6 +15328,49 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            progress:
+              description: Progress reports the progress of the migration, as observed
+                by the migration source
+              properties:
+                dataProcessedBytes:
+                  description: The amount of data transferred to the migration target,
+                    in bytes
+                  format: int64
+                  type: integer
+                dataRemainingBytes:
+                  description: The amount of data remaining to be transferred to the
+                    migration target, in bytes
+                  format: int64
+                  type: integer
+                dataTotalBytes:
+                  description: The total amount of data to be transferred to the migration
+                    target, in bytes
+                  format: int64
+                  type: integer
+                estimatedCompletionSeconds:
+                  description: |-
+                    The estimated number of seconds until the migration completes.
+                    Not set when the migration does not converge, i.e. the guest dirties its memory faster than it is transferred.
+                  format: int64
+                  type: integer
+                iteration:
+                  description: The number of memory copy iterations performed so far
+                  format: int64
+                  type: integer
+                memoryDirtyRateBytesPerSecond:
+                  description: The rate in which the guest dirties its memory, in
+                    bytes per second
+                  format: int64
+                  type: integer
+                throughputBytesPerSecond:
+                  description: The data transfer throughput, in bytes per second
+                  format: int64
+                  type: integer
+                updateTimestamp:
+                  description: The time the progress was last updated
+                  format: date-time
+                  type: string
+              type: object
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -13988,6 +15596,15 @@ var CRDsValidation map[string]string = map[string]string{
             description: VolumeStatus represents information about the status of volumes
               attached to the VirtualMachineInstance.
             properties:
+              backupTargetVolume:
+                description: If the volume is a backup target volume, this will contain
+                  the backup target info.
+                properties:
+                  claimName:
+                    description: ClaimName is the name of the pvc the push mode backups
+                      are written to
+                    type: string
+                type: object
               containerDiskVolume:
                 description: ContainerDiskVolume shows info about the containerdisk,
                   if the volume is a containerdisk
@@ -14149,6 +15766,14 @@ var CRDsValidation map[string]string = map[string]string{
             are going to be preserved to ensure that addedNodeSelector
             can only restrict but not bypass constraints already set on the VM object.
           type: object
+        priority:
+          description: |-
+            Priority of the migration. Pending migrations are started in order of priority and creation time,
+            and a migration may preempt pending migrations of a lower priority whose target pod is not ready yet.
+            Preempted migrations are moved back to the Pending phase. The system-critical and system-maintenance priorities
+            are reserved to KubeVirt and to users allowed to "use" them as "migrationpriorities" of the kubevirt.io group.
+            Defaults to system-critical for evacuations, system-maintenance for workload updates and user-triggered otherwise.
+          type: string
         receive:
           description: If receieve is specified, this VirtualMachineInstanceMigration
             will be considered the target
@@ -14267,6 +15892,32 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy or cancelled depending on other settings. Defaults to 150
                   format: int64
                   type: integer
+                compression:
+                  description: Compression configures the compression of the live
+                    migration stream. Defaults to no compression
+                  properties:
+                    level:
+                      description: Level is the compression level of the zlib (0-9)
+                        and zstd (0-20) methods
+                      format: int32
+                      type: integer
+                    method:
+                      description: |-
+                        Method is the compression method.
+                        The zlib and zstd methods compress the parallel connections and require ParallelStreams to be set.
+                        The xbzrle method only sends the changes of memory pages which are transferred again in later iterations.
+                      type: string
+                    xbzrleCacheSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: XBZRLECacheSize is the size of the page cache used
+                        by the xbzrle method
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - method
+                  type: object
                 disableTLS:
                   description: |-
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
@@ -14301,6 +15952,15 @@ var CRDsValidation map[string]string = map[string]string{
                     allowed per node. Defaults to 2
                   format: int32
                   type: integer
+                parallelStreams:
+                  description: |-
+                    ParallelStreams is the number of parallel connections used to transfer the guest memory.
+                    Parallel connections are used along with post-copy when QEMU supports it (QEMU 10.0 or newer),
+                    otherwise a migration which allows post-copy uses a single connection, and fails when it is
+                    configured with a compression method which requires parallel connections.
+                    By default, KubeVirt uses parallel connections only for pre-copy migrations of VMIs without a CPU limit.
+                  format: int32
+                  type: integer
                 progressTimeout:
                   description: |-
                     ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
@@ -14313,6 +15973,20 @@ var CRDsValidation map[string]string = map[string]string{
                     UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
                     indicates the migration will be unsafe to the guest. Defaults to false
                   type: boolean
+                zeroCopy:
+                  description: |-
+                    ZeroCopy enables sending the guest memory over the parallel connections without copying it first.
+                    It requires ParallelStreams and is incompatible with compression. The guest memory is locked on both nodes,
+                    and zero-copy is not used when post-copy is allowed or when the memory cannot be locked.
+                    Defaults to false
+                  type: boolean
+                zeroPageDetection:
+                  description: |-
+                    ZeroPageDetection configures how the guest memory pages which only hold zeros are detected, so that they are
+                    not transferred. The legacy method detects them in the main migration thread, the multifd method in the
+                    threads of the parallel connections, and falls back to the legacy method without parallel connections.
+                    Defaults to the QEMU default, which is multifd with QEMU 9.0 or newer.
+                  type: string
               type: object
             migrationNetworkType:
               description: The type of migration network, either 'pod' or 'migration'
@@ -14329,6 +16003,49 @@ var CRDsValidation map[string]string = map[string]string{
               description: Lets us know if the vmi is currently running pre or post
                 copy migration
               type: string
+            progress:
+              description: Progress reports the progress of the migration, as observed
+                by the migration source
+              properties:
+                dataProcessedBytes:
+                  description: The amount of data transferred to the migration target,
+                    in bytes
+                  format: int64
+                  type: integer
+                dataRemainingBytes:
+                  description: The amount of data remaining to be transferred to the
+                    migration target, in bytes
+                  format: int64
+                  type: integer
+                dataTotalBytes:
+                  description: The total amount of data to be transferred to the migration
+                    target, in bytes
+                  format: int64
+                  type: integer
+                estimatedCompletionSeconds:
+                  description: |-
+                    The estimated number of seconds until the migration completes.
+                    Not set when the migration does not converge, i.e. the guest dirties its memory faster than it is transferred.
+                  format: int64
+                  type: integer
+                iteration:
+                  description: The number of memory copy iterations performed so far
+                  format: int64
+                  type: integer
+                memoryDirtyRateBytesPerSecond:
+                  description: The rate in which the guest dirties its memory, in
+                    bytes per second
+                  format: int64
+                  type: integer
+                throughputBytesPerSecond:
+                  description: The data transfer throughput, in bytes per second
+                  format: int64
+                  type: integer
+                updateTimestamp:
+                  description: The time the progress was last updated
+                  format: date-time
+                  type: string
+              type: object
             sourceNode:
               description: The source node that the VMI originated on
               type: string
@@ -15043,6 +16760,58 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic passing through the interface.
+                          It can be updated on a running VMI.
+                          Not supported for SR-IOV interfaces.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of data which can
+                                  be sent at the peak rate, in kibibytes.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than Average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate of the
+                                  shaped traffic, in kibibytes per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of data which can
+                                  be sent at the peak rate, in kibibytes.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: |-
+                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                  Must not be lower than Average.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -15109,6 +16878,222 @@ var CRDsValidation map[string]string = map[string]string{
                               DHCP server
                             type: string
                         type: object
+                      firewall:
+                        description: |-
+                          Firewall filters the traffic passing through the interface.
+                          Only supported for bridge binding.
+                        properties:
+                          antiSpoofing:
+                            description: |-
+                              AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+                              than the one assigned to the interface. When the pod interface of the network has an
+                              IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+                              address is dropped too. IPv6 source addresses are not checked.
+                              Defaults to true.
+                            type: boolean
+                          egress:
+                            description: |-
+                              Egress filters the traffic sent by the guest.
+                              If not specified, all outbound traffic is allowed.
+                            properties:
+                              allow:
+                                description: Allow lists the allowed traffic. An empty
+                                  list drops all traffic.
+                                items:
+                                  properties:
+                                    networks:
+                                      description: |-
+                                        Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                        and the destination for egress. If neither networks nor a peer selector are
+                                        specified, all peers are allowed.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    peerSelector:
+                                      description: |-
+                                        PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                        in addition to the peer networks. The addresses reported in the status of the
+                                        selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                        Until the selected peers are known, the rule allows no peers besides the networks.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    ports:
+                                      description: |-
+                                        Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                        If not specified, all ports are allowed.
+                                      items:
+                                        properties:
+                                          endPort:
+                                            description: EndPort is the last port
+                                              of the allowed range.
+                                            format: int32
+                                            type: integer
+                                          port:
+                                            description: Port is the allowed port,
+                                              or the first port of the allowed range
+                                              if EndPort is set.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - port
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    protocol:
+                                      description: |-
+                                        Protocol of the allowed traffic.
+                                        One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                      type: string
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                          ingress:
+                            description: |-
+                              Ingress filters the traffic received by the guest.
+                              If not specified, all inbound traffic is allowed.
+                            properties:
+                              allow:
+                                description: Allow lists the allowed traffic. An empty
+                                  list drops all traffic.
+                                items:
+                                  properties:
+                                    networks:
+                                      description: |-
+                                        Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                        and the destination for egress. If neither networks nor a peer selector are
+                                        specified, all peers are allowed.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    peerSelector:
+                                      description: |-
+                                        PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                        in addition to the peer networks. The addresses reported in the status of the
+                                        selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                        Until the selected peers are known, the rule allows no peers besides the networks.
+                                      properties:
+                                        matchExpressions:
+                                          description: matchExpressions is a list
+                                            of label selector requirements. The requirements
+                                            are ANDed.
+                                          items:
+                                            description: |-
+                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                              relates the key and values.
+                                            properties:
+                                              key:
+                                                description: key is the label key
+                                                  that the selector applies to.
+                                                type: string
+                                              operator:
+                                                description: |-
+                                                  operator represents a key's relationship to a set of values.
+                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                type: string
+                                              values:
+                                                description: |-
+                                                  values is an array of string values. If the operator is In or NotIn,
+                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                  the values array must be empty. This array is replaced during a strategic
+                                                  merge patch.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            required:
+                                            - key
+                                            - operator
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
+                                        matchLabels:
+                                          additionalProperties:
+                                            type: string
+                                          description: |-
+                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                          type: object
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    ports:
+                                      description: |-
+                                        Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                        If not specified, all ports are allowed.
+                                      items:
+                                        properties:
+                                          endPort:
+                                            description: EndPort is the last port
+                                              of the allowed range.
+                                            format: int32
+                                            type: integer
+                                          port:
+                                            description: Port is the allowed port,
+                                              or the first port of the allowed range
+                                              if EndPort is set.
+                                            format: int32
+                                            type: integer
+                                        required:
+                                        - port
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    protocol:
+                                      description: |-
+                                        Protocol of the allowed traffic.
+                                        One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                      type: string
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                            type: object
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -15154,6 +17139,18 @@ var CRDsValidation map[string]string = map[string]string{
                             Default protocol TCP.
                             The port field is mandatory
                           properties:
+                            endPort:
+                              description: |-
+                                EndPort is the last port of the range to expose, starting at Port.
+                                Supported only by the masquerade binding.
+                              format: int32
+                              type: integer
+                            exclude:
+                              description: |-
+                                Exclude marks the port, or the range, as not exposed.
+                                When all the ports of an interface are excluded, all the other ports are exposed.
+                                Supported only by the masquerade binding.
+                              type: boolean
                             name:
                               description: |-
                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -15164,6 +17161,7 @@ var CRDsValidation map[string]string = map[string]string{
                               description: |-
                                 Number of port to expose for the virtual machine.
                                 This must be a valid port number, 0 < x < 65536.
+                                If EndPort is specified, this is the first port of the range.
                               format: int32
                               type: integer
                             protocol:
@@ -17479,6 +19477,61 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic passing through the interface.
+                                  It can be updated on a running VMI.
+                                  Not supported for SR-IOV interfaces.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of data which
+                                          can be sent at the peak rate, in kibibytes.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than Average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          of the shaped traffic, in kibibytes per
+                                          second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of data which
+                                          can be sent at the peak rate, in kibibytes.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: |-
+                                          Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                          Must not be lower than Average.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -17545,6 +19598,226 @@ var CRDsValidation map[string]string = map[string]string{
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              firewall:
+                                description: |-
+                                  Firewall filters the traffic passing through the interface.
+                                  Only supported for bridge binding.
+                                properties:
+                                  antiSpoofing:
+                                    description: |-
+                                      AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+                                      than the one assigned to the interface. When the pod interface of the network has an
+                                      IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+                                      address is dropped too. IPv6 source addresses are not checked.
+                                      Defaults to true.
+                                    type: boolean
+                                  egress:
+                                    description: |-
+                                      Egress filters the traffic sent by the guest.
+                                      If not specified, all outbound traffic is allowed.
+                                    properties:
+                                      allow:
+                                        description: Allow lists the allowed traffic.
+                                          An empty list drops all traffic.
+                                        items:
+                                          properties:
+                                            networks:
+                                              description: |-
+                                                Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                and the destination for egress. If neither networks nor a peer selector are
+                                                specified, all peers are allowed.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            peerSelector:
+                                              description: |-
+                                                PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                in addition to the peer networks. The addresses reported in the status of the
+                                                selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                Until the selected peers are known, the rule allows no peers besides the networks.
+                                              properties:
+                                                matchExpressions:
+                                                  description: matchExpressions is
+                                                    a list of label selector requirements.
+                                                    The requirements are ANDed.
+                                                  items:
+                                                    description: |-
+                                                      A label selector requirement is a selector that contains values, a key, and an operator that
+                                                      relates the key and values.
+                                                    properties:
+                                                      key:
+                                                        description: key is the label
+                                                          key that the selector applies
+                                                          to.
+                                                        type: string
+                                                      operator:
+                                                        description: |-
+                                                          operator represents a key's relationship to a set of values.
+                                                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                        type: string
+                                                      values:
+                                                        description: |-
+                                                          values is an array of string values. If the operator is In or NotIn,
+                                                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                          the values array must be empty. This array is replaced during a strategic
+                                                          merge patch.
+                                                        items:
+                                                          type: string
+                                                        type: array
+                                                        x-kubernetes-list-type: atomic
+                                                    required:
+                                                    - key
+                                                    - operator
+                                                    type: object
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                matchLabels:
+                                                  additionalProperties:
+                                                    type: string
+                                                  description: |-
+                                                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                  type: object
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            ports:
+                                              description: |-
+                                                Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                If not specified, all ports are allowed.
+                                              items:
+                                                properties:
+                                                  endPort:
+                                                    description: EndPort is the last
+                                                      port of the allowed range.
+                                                    format: int32
+                                                    type: integer
+                                                  port:
+                                                    description: Port is the allowed
+                                                      port, or the first port of the
+                                                      allowed range if EndPort is
+                                                      set.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - port
+                                                type: object
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            protocol:
+                                              description: |-
+                                                Protocol of the allowed traffic.
+                                                One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                              type: string
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                  ingress:
+                                    description: |-
+                                      Ingress filters the traffic received by the guest.
+                                      If not specified, all inbound traffic is allowed.
+                                    properties:
+                                      allow:
+                                        description: Allow lists the allowed traffic.
+                                          An empty list drops all traffic.
+                                        items:
+                                          properties:
+                                            networks:
+                                              description: |-
+                                                Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                and the destination for egress. If neither networks nor a peer selector are
+                                                specified, all peers are allowed.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            peerSelector:
+                                              description: |-
+                                                PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                in addition to the peer networks. The addresses reported in the status of the
+                                                selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                Until the selected peers are known, the rule allows no peers besides the networks.
+                                              properties:
+                                                matchExpressions:
+                                                  description: matchExpressions is
+                                                    a list of label selector requirements.
+                                                    The requirements are ANDed.
+                                                  items:
+                                                    description: |-
+                                                      A label selector requirement is a selector that contains values, a key, and an operator that
+                                                      relates the key and values.
+                                                    properties:
+                                                      key:
+                                                        description: key is the label
+                                                          key that the selector applies
+                                                          to.
+                                                        type: string
+                                                      operator:
+                                                        description: |-
+                                                          operator represents a key's relationship to a set of values.
+                                                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                        type: string
+                                                      values:
+                                                        description: |-
+                                                          values is an array of string values. If the operator is In or NotIn,
+                                                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                          the values array must be empty. This array is replaced during a strategic
+                                                          merge patch.
+                                                        items:
+                                                          type: string
+                                                        type: array
+                                                        x-kubernetes-list-type: atomic
+                                                    required:
+                                                    - key
+                                                    - operator
+                                                    type: object
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                                matchLabels:
+                                                  additionalProperties:
+                                                    type: string
+                                                  description: |-
+                                                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                  type: object
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            ports:
+                                              description: |-
+                                                Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                If not specified, all ports are allowed.
+                                              items:
+                                                properties:
+                                                  endPort:
+                                                    description: EndPort is the last
+                                                      port of the allowed range.
+                                                    format: int32
+                                                    type: integer
+                                                  port:
+                                                    description: Port is the allowed
+                                                      port, or the first port of the
+                                                      allowed range if EndPort is
+                                                      set.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - port
+                                                type: object
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                            protocol:
+                                              description: |-
+                                                Protocol of the allowed traffic.
+                                                One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                              type: string
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                    type: object
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -17590,6 +19863,18 @@ var CRDsValidation map[string]string = map[string]string{
                                     Default protocol TCP.
                                     The port field is mandatory
                                   properties:
+                                    endPort:
+                                      description: |-
+                                        EndPort is the last port of the range to expose, starting at Port.
+                                        Supported only by the masquerade binding.
+                                      format: int32
+                                      type: integer
+                                    exclude:
+                                      description: |-
+                                        Exclude marks the port, or the range, as not exposed.
+                                        When all the ports of an interface are excluded, all the other ports are exposed.
+                                        Supported only by the masquerade binding.
+                                      type: boolean
                                     name:
                                       description: |-
                                         If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -17600,6 +19885,7 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: |-
                                         Number of port to expose for the virtual machine.
                                         This must be a valid port number, 0 < x < 65536.
+                                        If EndPort is specified, this is the first port of the range.
                                       format: int32
                                       type: integer
                                     protocol:
@@ -18345,6 +20631,12 @@ var CRDsValidation map[string]string = map[string]string{
                               Select the default network and add it to the
                               multus-cni.io/default-network annotation.
                             type: boolean
+                          ipPool:
+                            description: |-
+                              IPPool is the name of an IP pool in the VMI namespace to allocate the
+                              interface address from. The address is served to the guest over DHCP.
+                              Only supported for bridge binding, on networks without an IPAM plugin.
+                            type: string
                           networkName:
                             description: |-
                               References to a NetworkAttachmentDefinition CRD object. Format:
@@ -18776,12 +21068,39 @@ var CRDsValidation map[string]string = map[string]string{
                   items:
                     description: Volume represents a named volume in a vmi.
                     properties:
+                      backupTarget:
+                        description: BackupTarget is attached to the virt launcher
+                          and receives the push mode backups of the vmi disks
+                        properties:
+                          claimName:
+                            description: |-
+                              claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                              More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                            type: string
+                          hotpluggable:
+                            description: Hotpluggable indicates whether the volume
+                              can be hotplugged and hotunplugged.
+                            type: boolean
+                          readOnly:
+                            description: |-
+                              readOnly Will force the ReadOnly setting in VolumeMounts.
+                              Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
                       cloudInitConfigDrive:
                         description: |-
                           CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
                           The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                         properties:
+                          generateNetworkData:
+                            description: |-
+                              GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the
+                              VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                              Cannot be combined with another networkdata source.
+                            type: boolean
                           networkData:
                             description: NetworkData contains config drive inline
                               cloud-init networkdata.
@@ -18835,6 +21154,12 @@ var CRDsValidation map[string]string = map[string]string{
                           The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                           More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                         properties:
+                          generateNetworkData:
+                            description: |-
+                              GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the
+                              VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                              Cannot be combined with another networkdata source.
+                            type: boolean
                           networkData:
                             description: NetworkData contains NoCloud inline cloud-init
                               networkdata.
@@ -19570,6 +21895,25 @@ var CRDsValidation map[string]string = map[string]string{
             zero and not specified. Defaults to 1.
           format: int32
           type: integer
+        scaleInStrategy:
+          description: |-
+            The strategy used to remove VMs when the pool scales in.
+            Defaults to removing random VMs together with their volumes.
+          properties:
+            selectionPolicy:
+              description: |-
+                Policy used to select the VMs to remove, either Random, PreferStopped,
+                PreferNotReady or HighestOrdinal. Ties are broken randomly.
+                Defaults to Random.
+              type: string
+            volumePolicy:
+              description: |-
+                Policy applied to the volumes created from the DataVolumeTemplates of
+                removed VMs, either Delete or Retain. Defaults to Delete. Retained
+                volumes are adopted again by VMs created later with the same name and
+                have to be deleted manually when the pool no longer needs them.
+              type: string
+          type: object
         selector:
           description: |-
             Label selector for pods. Existing Poolss whose pods are
@@ -19617,6 +21961,49 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
           type: object
           x-kubernetes-map-type: atomic
+        updateStrategy:
+          description: |-
+            The strategy used to update the VMs of the pool when the VM template changes.
+            Defaults to a proactive update which restarts all outdated VMs at once.
+          properties:
+            proactiveMethod:
+              description: |-
+                Method used to update outdated VMs with a Proactive update strategy,
+                either Restart or Recreate. Defaults to Restart.
+              type: string
+            rollingUpdate:
+              description: |-
+                Rolling update configuration parameters. If not set, all outdated VMs
+                are updated at once.
+              properties:
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of VMs that can be created over the desired number of
+                    VMs while outdated VMs are recreated. Only used by the Recreate method.
+                    Value can be an absolute number (ex: 5) or a percentage of desired VMs (ex: 10%).
+                    Absolute number is calculated from percentage by rounding up.
+                    Defaults to 0.
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of VMs that can be unavailable during the update.
+                    Value can be an absolute number (ex: 5) or a percentage of desired VMs (ex: 10%).
+                    Absolute number is calculated from percentage by rounding down.
+                    Defaults to 25%.
+                  x-kubernetes-int-or-string: true
+              type: object
+            type:
+              description: |-
+                Type of the update strategy, either Proactive or Opportunistic.
+                Defaults to Proactive.
+              type: string
+          type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
           properties:
@@ -21990,6 +24377,63 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          Bandwidth limits the traffic passing through the interface.
+                                          It can be updated on a running VMI.
+                                          Not supported for SR-IOV interfaces.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  bit rate of the shaped traffic,
+                                                  in kibibytes per second.
+                                                format: int32
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  data which can be sent at the peak
+                                                  rate, in kibibytes.
+                                                format: int32
+                                                type: integer
+                                              peak:
+                                                description: |-
+                                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                  Must not be lower than Average.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  bit rate of the shaped traffic,
+                                                  in kibibytes per second.
+                                                format: int32
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  data which can be sent at the peak
+                                                  rate, in kibibytes.
+                                                format: int32
+                                                type: integer
+                                              peak:
+                                                description: |-
+                                                  Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                  Must not be lower than Average.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -22059,6 +24503,236 @@ var CRDsValidation map[string]string = map[string]string{
                                               66 to interface's DHCP server
                                             type: string
                                         type: object
+                                      firewall:
+                                        description: |-
+                                          Firewall filters the traffic passing through the interface.
+                                          Only supported for bridge binding.
+                                        properties:
+                                          antiSpoofing:
+                                            description: |-
+                                              AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+                                              than the one assigned to the interface. When the pod interface of the network has an
+                                              IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+                                              address is dropped too. IPv6 source addresses are not checked.
+                                              Defaults to true.
+                                            type: boolean
+                                          egress:
+                                            description: |-
+                                              Egress filters the traffic sent by the guest.
+                                              If not specified, all outbound traffic is allowed.
+                                            properties:
+                                              allow:
+                                                description: Allow lists the allowed
+                                                  traffic. An empty list drops all
+                                                  traffic.
+                                                items:
+                                                  properties:
+                                                    networks:
+                                                      description: |-
+                                                        Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                        and the destination for egress. If neither networks nor a peer selector are
+                                                        specified, all peers are allowed.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    peerSelector:
+                                                      description: |-
+                                                        PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                        in addition to the peer networks. The addresses reported in the status of the
+                                                        selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                        Until the selected peers are known, the rule allows no peers besides the networks.
+                                                      properties:
+                                                        matchExpressions:
+                                                          description: matchExpressions
+                                                            is a list of label selector
+                                                            requirements. The requirements
+                                                            are ANDed.
+                                                          items:
+                                                            description: |-
+                                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                                              relates the key and values.
+                                                            properties:
+                                                              key:
+                                                                description: key is
+                                                                  the label key that
+                                                                  the selector applies
+                                                                  to.
+                                                                type: string
+                                                              operator:
+                                                                description: |-
+                                                                  operator represents a key's relationship to a set of values.
+                                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                                type: string
+                                                              values:
+                                                                description: |-
+                                                                  values is an array of string values. If the operator is In or NotIn,
+                                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                                  the values array must be empty. This array is replaced during a strategic
+                                                                  merge patch.
+                                                                items:
+                                                                  type: string
+                                                                type: array
+                                                                x-kubernetes-list-type: atomic
+                                                            required:
+                                                            - key
+                                                            - operator
+                                                            type: object
+                                                          type: array
+                                                          x-kubernetes-list-type: atomic
+                                                        matchLabels:
+                                                          additionalProperties:
+                                                            type: string
+                                                          description: |-
+                                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                          type: object
+                                                      type: object
+                                                      x-kubernetes-map-type: atomic
+                                                    ports:
+                                                      description: |-
+                                                        Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                        If not specified, all ports are allowed.
+                                                      items:
+                                                        properties:
+                                                          endPort:
+                                                            description: EndPort is
+                                                              the last port of the
+                                                              allowed range.
+                                                            format: int32
+                                                            type: integer
+                                                          port:
+                                                            description: Port is the
+                                                              allowed port, or the
+                                                              first port of the allowed
+                                                              range if EndPort is
+                                                              set.
+                                                            format: int32
+                                                            type: integer
+                                                        required:
+                                                        - port
+                                                        type: object
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol of the allowed traffic.
+                                                        One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                                      type: string
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            type: object
+                                          ingress:
+                                            description: |-
+                                              Ingress filters the traffic received by the guest.
+                                              If not specified, all inbound traffic is allowed.
+                                            properties:
+                                              allow:
+                                                description: Allow lists the allowed
+                                                  traffic. An empty list drops all
+                                                  traffic.
+                                                items:
+                                                  properties:
+                                                    networks:
+                                                      description: |-
+                                                        Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                        and the destination for egress. If neither networks nor a peer selector are
+                                                        specified, all peers are allowed.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    peerSelector:
+                                                      description: |-
+                                                        PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                        in addition to the peer networks. The addresses reported in the status of the
+                                                        selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                        Until the selected peers are known, the rule allows no peers besides the networks.
+                                                      properties:
+                                                        matchExpressions:
+                                                          description: matchExpressions
+                                                            is a list of label selector
+                                                            requirements. The requirements
+                                                            are ANDed.
+                                                          items:
+                                                            description: |-
+                                                              A label selector requirement is a selector that contains values, a key, and an operator that
+                                                              relates the key and values.
+                                                            properties:
+                                                              key:
+                                                                description: key is
+                                                                  the label key that
+                                                                  the selector applies
+                                                                  to.
+                                                                type: string
+                                                              operator:
+                                                                description: |-
+                                                                  operator represents a key's relationship to a set of values.
+                                                                  Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                                type: string
+                                                              values:
+                                                                description: |-
+                                                                  values is an array of string values. If the operator is In or NotIn,
+                                                                  the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                                  the values array must be empty. This array is replaced during a strategic
+                                                                  merge patch.
+                                                                items:
+                                                                  type: string
+                                                                type: array
+                                                                x-kubernetes-list-type: atomic
+                                                            required:
+                                                            - key
+                                                            - operator
+                                                            type: object
+                                                          type: array
+                                                          x-kubernetes-list-type: atomic
+                                                        matchLabels:
+                                                          additionalProperties:
+                                                            type: string
+                                                          description: |-
+                                                            matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                            map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                            operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                          type: object
+                                                      type: object
+                                                      x-kubernetes-map-type: atomic
+                                                    ports:
+                                                      description: |-
+                                                        Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                        If not specified, all ports are allowed.
+                                                      items:
+                                                        properties:
+                                                          endPort:
+                                                            description: EndPort is
+                                                              the last port of the
+                                                              allowed range.
+                                                            format: int32
+                                                            type: integer
+                                                          port:
+                                                            description: Port is the
+                                                              allowed port, or the
+                                                              first port of the allowed
+                                                              range if EndPort is
+                                                              set.
+                                                            format: int32
+                                                            type: integer
+                                                        required:
+                                                        - port
+                                                        type: object
+                                                      type: array
+                                                      x-kubernetes-list-type: atomic
+                                                    protocol:
+                                                      description: |-
+                                                        Protocol of the allowed traffic.
+                                                        One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                                      type: string
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                            type: object
+                                        type: object
                                       macAddress:
                                         description: 'Interface MAC address. For example:
                                           de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -22106,6 +24780,18 @@ var CRDsValidation map[string]string = map[string]string{
                                             Default protocol TCP.
                                             The port field is mandatory
                                           properties:
+                                            endPort:
+                                              description: |-
+                                                EndPort is the last port of the range to expose, starting at Port.
+                                                Supported only by the masquerade binding.
+                                              format: int32
+                                              type: integer
+                                            exclude:
+                                              description: |-
+                                                Exclude marks the port, or the range, as not exposed.
+                                                When all the ports of an interface are excluded, all the other ports are exposed.
+                                                Supported only by the masquerade binding.
+                                              type: boolean
                                             name:
                                               description: |-
                                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -22116,6 +24802,7 @@ var CRDsValidation map[string]string = map[string]string{
                                               description: |-
                                                 Number of port to expose for the virtual machine.
                                                 This must be a valid port number, 0 < x < 65536.
+                                                If EndPort is specified, this is the first port of the range.
                                               format: int32
                                               type: integer
                                             protocol:
@@ -22873,6 +25560,12 @@ var CRDsValidation map[string]string = map[string]string{
                                       Select the default network and add it to the
                                       multus-cni.io/default-network annotation.
                                     type: boolean
+                                  ipPool:
+                                    description: |-
+                                      IPPool is the name of an IP pool in the VMI namespace to allocate the
+                                      interface address from. The address is served to the guest over DHCP.
+                                      Only supported for bridge binding, on networks without an IPAM plugin.
+                                    type: string
                                   networkName:
                                     description: |-
                                       References to a NetworkAttachmentDefinition CRD object. Format:
@@ -23306,12 +25999,40 @@ var CRDsValidation map[string]string = map[string]string{
                           items:
                             description: Volume represents a named volume in a vmi.
                             properties:
+                              backupTarget:
+                                description: BackupTarget is attached to the virt
+                                  launcher and receives the push mode backups of the
+                                  vmi disks
+                                properties:
+                                  claimName:
+                                    description: |-
+                                      claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                                      More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                                    type: string
+                                  hotpluggable:
+                                    description: Hotpluggable indicates whether the
+                                      volume can be hotplugged and hotunplugged.
+                                    type: boolean
+                                  readOnly:
+                                    description: |-
+                                      readOnly Will force the ReadOnly setting in VolumeMounts.
+                                      Default false.
+                                    type: boolean
+                                required:
+                                - claimName
+                                type: object
                               cloudInitConfigDrive:
                                 description: |-
                                   CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
                                   The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                   More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                                 properties:
+                                  generateNetworkData:
+                                    description: |-
+                                      GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the
+                                      VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                                      Cannot be combined with another networkdata source.
+                                    type: boolean
                                   networkData:
                                     description: NetworkData contains config drive
                                       inline cloud-init networkdata.
@@ -23366,6 +26087,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                   More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                                 properties:
+                                  generateNetworkData:
+                                    description: |-
+                                      GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the
+                                      VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                                      Cannot be combined with another networkdata source.
+                                    type: boolean
                                   networkData:
                                     description: NetworkData contains NoCloud inline
                                       cloud-init networkdata.
@@ -23809,6 +26536,11 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        currentRevision:
+          description: |-
+            Name of the ControllerRevision the VMs of the pool are at before the
+            current update. It equals UpdateRevision once all VMs are updated.
+          type: string
         labelSelector:
           description: Canonical form of the label selector for HPA which consumes
             it through the scale subresource.
@@ -23819,6 +26551,15 @@ var CRDsValidation map[string]string = map[string]string{
         replicas:
           format: int32
           type: integer
+        updateRevision:
+          description: Name of the ControllerRevision the VMs of the pool are updated
+            to.
+          type: string
+        updatedReplicas:
+          description: Number of VMs whose template matches the current VM template
+            of the pool.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -24556,6 +27297,20 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         virtualMachineSnapshotName:
           type: string
+        volumeRestoreMode:
+          description: |-
+            VolumeRestoreMode defines how the volumes listed in Volumes are restored,
+            defaults to NewPVC
+          type: string
+        volumes:
+          description: |-
+            Volumes restricts the restore to the named volumes of the snapshot,
+            the rest of the target spec is left untouched. The target has to be
+            the source VM of the snapshot.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
       required:
       - target
       - virtualMachineSnapshotName
@@ -27204,6 +29959,63 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              Bandwidth limits the traffic passing through the interface.
+                                              It can be updated on a running VMI.
+                                              Not supported for SR-IOV interfaces.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      bit rate of the shaped traffic,
+                                                      in kibibytes per second.
+                                                    format: int32
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of data which can be sent at
+                                                      the peak rate, in kibibytes.
+                                                    format: int32
+                                                    type: integer
+                                                  peak:
+                                                    description: |-
+                                                      Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                      Must not be lower than Average.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      bit rate of the shaped traffic,
+                                                      in kibibytes per second.
+                                                    format: int32
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of data which can be sent at
+                                                      the peak rate, in kibibytes.
+                                                    format: int32
+                                                    type: integer
+                                                  peak:
+                                                    description: |-
+                                                      Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+                                                      Must not be lower than Average.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -27273,6 +30085,240 @@ var CRDsValidation map[string]string = map[string]string{
                                                   option 66 to interface's DHCP server
                                                 type: string
                                             type: object
+                                          firewall:
+                                            description: |-
+                                              Firewall filters the traffic passing through the interface.
+                                              Only supported for bridge binding.
+                                            properties:
+                                              antiSpoofing:
+                                                description: |-
+                                                  AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+                                                  than the one assigned to the interface. When the pod interface of the network has an
+                                                  IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+                                                  address is dropped too. IPv6 source addresses are not checked.
+                                                  Defaults to true.
+                                                type: boolean
+                                              egress:
+                                                description: |-
+                                                  Egress filters the traffic sent by the guest.
+                                                  If not specified, all outbound traffic is allowed.
+                                                properties:
+                                                  allow:
+                                                    description: Allow lists the allowed
+                                                      traffic. An empty list drops
+                                                      all traffic.
+                                                    items:
+                                                      properties:
+                                                        networks:
+                                                          description: |-
+                                                            Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                            and the destination for egress. If neither networks nor a peer selector are
+                                                            specified, all peers are allowed.
+                                                          items:
+                                                            type: string
+                                                          type: array
+                                                          x-kubernetes-list-type: atomic
+                                                        peerSelector:
+                                                          description: |-
+                                                            PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                            in addition to the peer networks. The addresses reported in the status of the
+                                                            selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                            Until the selected peers are known, the rule allows no peers besides the networks.
+                                                          properties:
+                                                            matchExpressions:
+                                                              description: matchExpressions
+                                                                is a list of label
+                                                                selector requirements.
+                                                                The requirements are
+                                                                ANDed.
+                                                              items:
+                                                                description: |-
+                                                                  A label selector requirement is a selector that contains values, a key, and an operator that
+                                                                  relates the key and values.
+                                                                properties:
+                                                                  key:
+                                                                    description: key
+                                                                      is the label
+                                                                      key that the
+                                                                      selector applies
+                                                                      to.
+                                                                    type: string
+                                                                  operator:
+                                                                    description: |-
+                                                                      operator represents a key's relationship to a set of values.
+                                                                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                                    type: string
+                                                                  values:
+                                                                    description: |-
+                                                                      values is an array of string values. If the operator is In or NotIn,
+                                                                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                                      the values array must be empty. This array is replaced during a strategic
+                                                                      merge patch.
+                                                                    items:
+                                                                      type: string
+                                                                    type: array
+                                                                    x-kubernetes-list-type: atomic
+                                                                required:
+                                                                - key
+                                                                - operator
+                                                                type: object
+                                                              type: array
+                                                              x-kubernetes-list-type: atomic
+                                                            matchLabels:
+                                                              additionalProperties:
+                                                                type: string
+                                                              description: |-
+                                                                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                                operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                              type: object
+                                                          type: object
+                                                          x-kubernetes-map-type: atomic
+                                                        ports:
+                                                          description: |-
+                                                            Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                            If not specified, all ports are allowed.
+                                                          items:
+                                                            properties:
+                                                              endPort:
+                                                                description: EndPort
+                                                                  is the last port
+                                                                  of the allowed range.
+                                                                format: int32
+                                                                type: integer
+                                                              port:
+                                                                description: Port
+                                                                  is the allowed port,
+                                                                  or the first port
+                                                                  of the allowed range
+                                                                  if EndPort is set.
+                                                                format: int32
+                                                                type: integer
+                                                            required:
+                                                            - port
+                                                            type: object
+                                                          type: array
+                                                          x-kubernetes-list-type: atomic
+                                                        protocol:
+                                                          description: |-
+                                                            Protocol of the allowed traffic.
+                                                            One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                                          type: string
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                type: object
+                                              ingress:
+                                                description: |-
+                                                  Ingress filters the traffic received by the guest.
+                                                  If not specified, all inbound traffic is allowed.
+                                                properties:
+                                                  allow:
+                                                    description: Allow lists the allowed
+                                                      traffic. An empty list drops
+                                                      all traffic.
+                                                    items:
+                                                      properties:
+                                                        networks:
+                                                          description: |-
+                                                            Networks lists the allowed peer networks in CIDR notation, the source for ingress
+                                                            and the destination for egress. If neither networks nor a peer selector are
+                                                            specified, all peers are allowed.
+                                                          items:
+                                                            type: string
+                                                          type: array
+                                                          x-kubernetes-list-type: atomic
+                                                        peerSelector:
+                                                          description: |-
+                                                            PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+                                                            in addition to the peer networks. The addresses reported in the status of the
+                                                            selected VMIs are allowed, and they are updated as the VMIs come and go.
+                                                            Until the selected peers are known, the rule allows no peers besides the networks.
+                                                          properties:
+                                                            matchExpressions:
+                                                              description: matchExpressions
+                                                                is a list of label
+                                                                selector requirements.
+                                                                The requirements are
+                                                                ANDed.
+                                                              items:
+                                                                description: |-
+                                                                  A label selector requirement is a selector that contains values, a key, and an operator that
+                                                                  relates the key and values.
+                                                                properties:
+                                                                  key:
+                                                                    description: key
+                                                                      is the label
+                                                                      key that the
+                                                                      selector applies
+                                                                      to.
+                                                                    type: string
+                                                                  operator:
+                                                                    description: |-
+                                                                      operator represents a key's relationship to a set of values.
+                                                                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                                    type: string
+                                                                  values:
+                                                                    description: |-
+                                                                      values is an array of string values. If the operator is In or NotIn,
+                                                                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                                      the values array must be empty. This array is replaced during a strategic
+                                                                      merge patch.
+                                                                    items:
+                                                                      type: string
+                                                                    type: array
+                                                                    x-kubernetes-list-type: atomic
+                                                                required:
+                                                                - key
+                                                                - operator
+                                                                type: object
+                                                              type: array
+                                                              x-kubernetes-list-type: atomic
+                                                            matchLabels:
+                                                              additionalProperties:
+                                                                type: string
+                                                              description: |-
+                                                                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                                                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                                                operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                              type: object
+                                                          type: object
+                                                          x-kubernetes-map-type: atomic
+                                                        ports:
+                                                          description: |-
+                                                            Ports lists the allowed destination ports. Only supported for TCP and UDP.
+                                                            If not specified, all ports are allowed.
+                                                          items:
+                                                            properties:
+                                                              endPort:
+                                                                description: EndPort
+                                                                  is the last port
+                                                                  of the allowed range.
+                                                                format: int32
+                                                                type: integer
+                                                              port:
+                                                                description: Port
+                                                                  is the allowed port,
+                                                                  or the first port
+                                                                  of the allowed range
+                                                                  if EndPort is set.
+                                                                format: int32
+                                                                type: integer
+                                                            required:
+                                                            - port
+                                                            type: object
+                                                          type: array
+                                                          x-kubernetes-list-type: atomic
+                                                        protocol:
+                                                          description: |-
+                                                            Protocol of the allowed traffic.
+                                                            One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+                                                          type: string
+                                                      type: object
+                                                    type: array
+                                                    x-kubernetes-list-type: atomic
+                                                type: object
+                                            type: object
                                           macAddress:
                                             description: 'Interface MAC address. For
                                               example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -27320,6 +30366,18 @@ var CRDsValidation map[string]string = map[string]string{
                                                 Default protocol TCP.
                                                 The port field is mandatory
                                               properties:
+                                                endPort:
+                                                  description: |-
+                                                    EndPort is the last port of the range to expose, starting at Port.
+                                                    Supported only by the masquerade binding.
+                                                  format: int32
+                                                  type: integer
+                                                exclude:
+                                                  description: |-
+                                                    Exclude marks the port, or the range, as not exposed.
+                                                    When all the ports of an interface are excluded, all the other ports are exposed.
+                                                    Supported only by the masquerade binding.
+                                                  type: boolean
                                                 name:
                                                   description: |-
                                                     If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -27330,6 +30388,7 @@ var CRDsValidation map[string]string = map[string]string{
                                                   description: |-
                                                     Number of port to expose for the virtual machine.
                                                     This must be a valid port number, 0 < x < 65536.
+                                                    If EndPort is specified, this is the first port of the range.
                                                   format: int32
                                                   type: integer
                                                 protocol:
@@ -28090,6 +31149,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           Select the default network and add it to the
                                           multus-cni.io/default-network annotation.
                                         type: boolean
+                                      ipPool:
+                                        description: |-
+                                          IPPool is the name of an IP pool in the VMI namespace to allocate the
+                                          interface address from. The address is served to the guest over DHCP.
+                                          Only supported for bridge binding, on networks without an IPAM plugin.
+                                        type: string
                                       networkName:
                                         description: |-
                                           References to a NetworkAttachmentDefinition CRD object. Format:
@@ -28526,12 +31591,40 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: Volume represents a named volume in a
                                   vmi.
                                 properties:
+                                  backupTarget:
+                                    description: BackupTarget is attached to the virt
+                                      launcher and receives the push mode backups
+                                      of the vmi disks
+                                    properties:
+                                      claimName:
+                                        description: |-
+                                          claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+                                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
+                                        type: string
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the volume can be hotplugged and hotunplugged.
+                                        type: boolean
+                                      readOnly:
+                                        description: |-
+                                          readOnly Will force the ReadOnly setting in VolumeMounts.
+                                          Default false.
+                                        type: boolean
+                                    required:
+                                    - claimName
+                                    type: object
                                   cloudInitConfigDrive:
                                     description: |-
                                       CloudInitConfigDrive represents a cloud-init Config Drive user-data source.
                                       The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                       More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html
                                     properties:
+                                      generateNetworkData:
+                                        description: |-
+                                          GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the
+                                          VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                                          Cannot be combined with another networkdata source.
+                                        type: boolean
                                       networkData:
                                         description: NetworkData contains config drive
                                           inline cloud-init networkdata.
@@ -28589,6 +31682,12 @@ var CRDsValidation map[string]string = map[string]string{
                                       The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest.
                                       More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html
                                     properties:
+                                      generateNetworkData:
+                                        description: |-
+                                          GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the
+                                          VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+                                          Cannot be combined with another networkdata source.
+                                        type: boolean
                                       networkData:
                                         description: NetworkData contains NoCloud
                                           inline cloud-init networkdata.
@@ -29896,5 +32995,184 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule periodically takes VirtualMachineSnapshots
+    of the selected VMs
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        disabled:
+          description: Disabled suspends the schedule, snapshots that were already
+            taken are still pruned
+          type: boolean
+        failureDeadline:
+          description: FailureDeadline is passed on to the VirtualMachineSnapshots
+            taken by the schedule
+          type: string
+        quiesce:
+          description: |-
+            Quiesce defines whether the guest filesystems of running VMs have to be frozen.
+            Defaults to Preferred
+          type: string
+        retention:
+          description: Retention defines which of the snapshots taken by the schedule
+            are kept
+          properties:
+            maxAge:
+              description: MaxAge is the maximum age of the snapshots kept
+              type: string
+            maxCount:
+              description: MaxCount is the maximum number of succeeded snapshots kept
+                per VirtualMachine
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: Schedule is a cron expression in the standard five field format,
+            evaluated in UTC
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines, in the namespace of the
+            schedule, to snapshot
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        failures:
+          description: Failures lists the VirtualMachines that could not be snapshotted
+            during the last run
+          items:
+            description: SnapshotScheduleFailure describes why a VirtualMachine could
+              not be snapshotted
+            properties:
+              message:
+                type: string
+              snapshotName:
+                type: string
+              virtualMachineName:
+                type: string
+            required:
+            - virtualMachineName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        lastRunTime:
+          description: LastRunTime is the time the schedule last created snapshots
+          format: date-time
+          nullable: true
+          type: string
+        nextRunTime:
+          description: NextRunTime is the time the schedule will create snapshots
+            next
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
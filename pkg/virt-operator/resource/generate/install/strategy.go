@@ -462,7 +462,9 @@ func GenerateCurrentInstallStrategy(config *operatorutil.KubeVirtDeploymentConfi
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineExportCrd,
-		components.NewVirtualMachineCloneCrd,
+		components.NewVirtualMachineCloneCrd, components.NewVirtualMachineBackupCrd,
+		components.NewVirtualMachineSnapshotScheduleCrd, components.NewVirtualMachineGroupSnapshotCrd,
+		components.NewVirtualMachineGroupRestoreCrd, components.NewIPPoolCrd,
 	}
 	for _, f := range functions {
 		crd, err := f()
@@ -323,6 +323,16 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshotcontents/finalizers",
 					"virtualmachinerestores",
 					"virtualmachinerestores/status",
+					"virtualmachinebackups",
+					"virtualmachinebackups/status",
+					"virtualmachinebackups/finalizers",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotschedules/status",
+					"virtualmachinegroupsnapshots",
+					"virtualmachinegroupsnapshots/status",
+					"virtualmachinegroupsnapshots/finalizers",
+					"virtualmachinegrouprestores",
+					"virtualmachinegrouprestores/status",
 				},
 				Verbs: []string{
 					"get", "list", "watch", "create", "update", "delete", "patch",
@@ -362,6 +372,18 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get",
 				},
 			},
+			{
+				APIGroups: []string{
+					"ipam.kubevirt.io",
+				},
+				Resources: []string{
+					"ippools",
+					"ippools/status",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"kubevirt.io",
@@ -400,6 +422,7 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/sev/setupsession",
 					"virtualmachineinstances/sev/injectlaunchsecret",
+					"virtualmachineinstances/backup",
 				},
 				Verbs: []string{
 					"update",
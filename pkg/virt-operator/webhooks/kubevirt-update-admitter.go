@@ -44,6 +44,8 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/cron"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
@@ -111,6 +113,15 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if migrationConf := newKV.Spec.Configuration.MigrationConfiguration; migrationConf != nil {
+		migrationsField := field.NewPath("spec", "configuration", "migrations")
+		results = append(results, migrationutils.ValidateStreamOptions(migrationsField,
+			migrationConf.Compression, migrationConf.ParallelStreams, migrationConf.ZeroCopy)...)
+		results = append(results, migrationutils.ValidateZeroPageDetection(migrationsField, migrationConf.ZeroPageDetection)...)
+	}
+
+	results = append(results, validateWorkloadUpdateStrategy(field.NewPath("spec", "workloadUpdateStrategy"), &newKV.Spec.WorkloadUpdateStrategy)...)
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -432,6 +443,40 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateWorkloadUpdateStrategy(field *field.Path, strategy *v1.KubeVirtWorkloadUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for i, window := range strategy.MaintenanceWindows {
+		windowField := field.Child("maintenanceWindows").Index(i)
+		if _, err := cron.Parse(window.Schedule); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid schedule: %v", err),
+				Field:   windowField.Child("schedule").String(),
+			})
+		}
+		if window.Duration.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "duration must be greater than 0",
+				Field:   windowField.Child("duration").String(),
+			})
+		}
+	}
+
+	if strategy.ExcludedSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(strategy.ExcludedSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid label selector: %v", err),
+				Field:   field.Child("excludedSelector").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
@@ -24,6 +24,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -164,6 +165,101 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with migration stream options", func() {
+		DescribeTable("should validate", func(migrationConfiguration *v1.MigrationConfiguration, expectedFields []string) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+			kvBytes, err := json.Marshal(v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{MigrationConfiguration: migrationConfiguration},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			response := admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: kvBytes},
+					OldObject: runtime.RawExtension{Raw: kvBytes},
+					Operation: admissionv1.Update,
+				},
+			})
+			Expect(response.Allowed).To(Equal(len(expectedFields) == 0))
+			if len(expectedFields) > 0 {
+				Expect(response.Result.Details.Causes).To(HaveLen(len(expectedFields)))
+				for _, cause := range response.Result.Details.Causes {
+					Expect(cause.Field).To(BeElementOf(expectedFields))
+				}
+			}
+		},
+			Entry("accepting zstd compression with parallel streams", &v1.MigrationConfiguration{
+				ParallelStreams: pointer.P(uint32(4)),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionZstd},
+			}, nil),
+			Entry("accepting zero-copy with parallel streams", &v1.MigrationConfiguration{
+				ParallelStreams: pointer.P(uint32(4)),
+				ZeroCopy:        pointer.P(true),
+			}, nil),
+			Entry("rejecting zlib compression without parallel streams", &v1.MigrationConfiguration{
+				Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib},
+			}, []string{"spec.configuration.migrations.compression.method"}),
+			Entry("rejecting zero-copy along with compression", &v1.MigrationConfiguration{
+				ParallelStreams: pointer.P(uint32(4)),
+				ZeroCopy:        pointer.P(true),
+				Compression:     &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE},
+			}, []string{"spec.configuration.migrations.zeroCopy"}),
+			Entry("accepting the legacy zero page detection", &v1.MigrationConfiguration{
+				ZeroPageDetection: pointer.P(v1.MigrationZeroPageDetectionLegacy),
+			}, nil),
+			Entry("rejecting an unsupported zero page detection", &v1.MigrationConfiguration{
+				ZeroPageDetection: pointer.P(v1.MigrationZeroPageDetection("sparse")),
+			}, []string{"spec.configuration.migrations.zeroPageDetection"}),
+		)
+	})
+
+	Context("with workload update strategy", func() {
+		strategyField := field.NewPath("spec", "workloadUpdateStrategy")
+		windowField := strategyField.Child("maintenanceWindows").Index(0)
+
+		DescribeTable("should validate", func(strategy *v1.KubeVirtWorkloadUpdateStrategy, expectedFields []string) {
+			causes := validateWorkloadUpdateStrategy(strategyField, strategy)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for _, cause := range causes {
+				Expect(cause.Field).To(BeElementOf(expectedFields))
+			}
+		},
+			Entry("accepting an empty strategy", &v1.KubeVirtWorkloadUpdateStrategy{}, nil),
+			Entry("accepting maintenance windows and exclusions", &v1.KubeVirtWorkloadUpdateStrategy{
+				MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+					{Schedule: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+					{Schedule: "@weekly", Duration: metav1.Duration{Duration: 24 * time.Hour}},
+				},
+				ExcludedNamespaces: []string{"critical"},
+				ExcludedSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"workload-update": "disabled"},
+				},
+			}, nil),
+			Entry("rejecting an invalid schedule", &v1.KubeVirtWorkloadUpdateStrategy{
+				MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+					{Schedule: "0 25 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+				},
+			}, []string{windowField.Child("schedule").String()}),
+			Entry("rejecting a window without duration", &v1.KubeVirtWorkloadUpdateStrategy{
+				MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+					{Schedule: "@daily"},
+				},
+			}, []string{windowField.Child("duration").String()}),
+			Entry("rejecting an invalid excluded selector", &v1.KubeVirtWorkloadUpdateStrategy{
+				ExcludedSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "workload-update", Operator: "Unknown"},
+					},
+				},
+			}, []string{strategyField.Child("excludedSelector").String()}),
+		)
+	})
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
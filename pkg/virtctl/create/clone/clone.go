@@ -41,6 +41,7 @@ const (
 	NameFlag                     = "name"
 	SourceNameFlag               = "source-name"
 	TargetNameFlag               = "target-name"
+	TargetNamespaceFlag          = "target-namespace"
 	SourceTypeFlag               = "source-type"
 	TargetTypeFlag               = "target-type"
 	LabelFilterFlag              = "label-filter"
@@ -59,6 +60,7 @@ type createClone struct {
 	name                      string
 	sourceName                string
 	targetName                string
+	targetNamespace           string
 	sourceType                string
 	targetType                string
 	labelFilters              []string
@@ -91,6 +93,7 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&c.name, NameFlag, emptyValue, "Specify the name of the clone. If not specified, name would be randomized.")
 	cmd.Flags().StringVar(&c.sourceName, SourceNameFlag, emptyValue, "Specify the clone's source name.")
 	cmd.Flags().StringVar(&c.targetName, TargetNameFlag, emptyValue, "Specify the clone's target name.")
+	cmd.Flags().StringVar(&c.targetNamespace, TargetNamespaceFlag, emptyValue, "Specify the clone's target namespace. If not specified, the target is created in the namespace of the clone.")
 	cmd.Flags().StringVar(&c.sourceType, SourceTypeFlag, emptyValue, "Specify the clone's source type. Default type is VM. Supported types: "+supportedSourceTypes)
 	cmd.Flags().StringVar(&c.targetType, TargetTypeFlag, emptyValue, "Specify the clone's target type. Default type is VM. Supported types: "+supportedTargetTypes)
 	cmd.Flags().StringArrayVar(&c.labelFilters, LabelFilterFlag, nil, "Specify clone's label filters. "+supportsMultipleFlags)
@@ -151,6 +154,9 @@ func (c *createClone) usage() string {
   # Create a manifest for a clone with a source type snapshot to a target type VM:
   {{ProgramName}} create clone --source-name mySnapshot --source-type snapshot --target-name targetVM
 
+  # Create a manifest for a clone of a VM into another namespace:
+  {{ProgramName}} create clone --source-name sourceVM --target-name targetVM --target-namespace targetNamespace
+
   # Create a manifest for a clone with label filters:
   {{ProgramName}} create clone --source-name sourceVM --label-filter '*' --label-filter '!some/key'
 
@@ -200,6 +206,10 @@ func (c *createClone) newClone() (*clone.VirtualMachineClone, error) {
 		},
 	}
 
+	if c.targetNamespace != "" {
+		vmClone.Spec.TargetNamespace = pointer.P(c.targetNamespace)
+	}
+
 	if c.newSmbiosSerial != "" {
 		vmClone.Spec.NewSMBiosSerial = pointer.P(c.newSmbiosSerial)
 	}
@@ -107,6 +107,21 @@ var _ = Describe("create clone", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("target namespace", func() {
+			flags := getSourceNameFlags()
+			flags = addFlag(flags, virtctlclone.TargetNamespaceFlag, "target-namespace")
+
+			cloneObj, err := newCommand(flags...)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloneObj.Spec.TargetNamespace).To(HaveValue(Equal("target-namespace")))
+		})
+
+		It("target namespace defaults to the namespace of the clone", func() {
+			cloneObj, err := newCommand(getSourceNameFlags()...)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cloneObj.Spec.TargetNamespace).To(BeNil())
+		})
+
 		It("unknown source type", func() {
 			flags := getSourceNameFlags()
 			flags = addFlag(flags, virtctlclone.SourceTypeFlag, "unknown type")
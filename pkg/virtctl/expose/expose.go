@@ -297,6 +297,10 @@ func podNetworkPorts(vmiSpec *v1.VirtualMachineInstanceSpec) []k8sv1.ServicePort
 			if device.Name == podNetworkName {
 				ports := []k8sv1.ServicePort{}
 				for i, port := range device.Ports {
+					// Services cannot expose port ranges or excluded ports.
+					if port.EndPort != nil || port.Exclude {
+						continue
+					}
 					ports = append(ports, k8sv1.ServicePort{Name: fmt.Sprintf("port-%d", i+1), Protocol: k8sv1.Protocol(port.Protocol), Port: port.Port})
 				}
 				return ports
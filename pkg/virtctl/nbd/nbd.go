@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nbd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	addressFlag = "address"
+	portFlag    = "port"
+
+	// defaultPort is the IANA assigned NBD port
+	defaultPort = 10809
+)
+
+type nbd struct {
+	address string
+	port    int
+}
+
+func NewCommand() *cobra.Command {
+	c := nbd{}
+	cmd := &cobra.Command{
+		Use:     "nbd (VMI)",
+		Short:   "Serve the NBD exports of the running Pull mode backup of a virtual machine instance locally.",
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVar(&c.address, addressFlag, "127.0.0.1", "The local address to listen on.")
+	cmd.Flags().IntVar(&c.port, portFlag, defaultPort, "The local port to listen on.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Serve the exports of the Pull mode backup of VirtualMachineInstance 'myvmi' and copy the disk 'rootdisk':
+  {{ProgramName}} nbd myvmi &
+  qemu-img convert -O qcow2 nbd://127.0.0.1:10809/rootdisk rootdisk.qcow2`
+}
+
+func (c *nbd) run(cmd *cobra.Command, args []string) error {
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	name := args[0]
+	vmis := client.VirtualMachineInstance(namespace)
+
+	vmi, err := vmis.Get(cmd.Context(), name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("can't access VMI %s: %v", name, err)
+	}
+	backupState := vmi.Status.BackupState
+	if backupState == nil || backupState.Mode != v1.BackupModePull || backupState.Completed {
+		return fmt.Errorf("VMI %s has no running Pull mode backup", name)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(c.address, strconv.Itoa(c.port)))
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s:%d: %v", c.address, c.port, err)
+	}
+	defer listener.Close()
+
+	cmd.Printf("Serving the exports of backup %s on nbd://%s\n", backupState.BackupName, listener.Addr())
+	for _, volume := range backupState.Volumes {
+		if volume.Incremental {
+			cmd.Printf("  %s: export %s, dirty bitmap %s\n", volume.VolumeName, volume.ExportName, volume.ExportBitmap)
+		} else {
+			cmd.Printf("  %s: export %s\n", volume.VolumeName, volume.ExportName)
+		}
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		if _, ok := <-interrupt; ok {
+			listener.Close()
+		}
+	}()
+
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return fmt.Errorf("error accepting connection: %v", err)
+		}
+		stream, err := vmis.NBD(name)
+		if err != nil {
+			local.Close()
+			return fmt.Errorf("can't access the backup of VMI %s: %v", name, err)
+		}
+		go forward(local, stream)
+	}
+}
+
+// forward copies the data between a local NBD client and the NBD server of the backup until either side is done
+func forward(local net.Conn, stream kvcorev1.StreamInterface) {
+	remote := stream.AsConn()
+	errs := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(remote, local)
+		errs <- err
+	}()
+	go func() {
+		_, err := io.Copy(local, remote)
+		errs <- err
+	}()
+
+	if err := <-errs; err != nil {
+		log.Log.Reason(err).Info("NBD connection closed")
+	}
+	local.Close()
+	remote.Close()
+	<-errs
+}
@@ -0,0 +1,11 @@
+package nbd_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestNBD(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,103 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nbd_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("NBD command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	expectVMI := func(backupState *v1.VirtualMachineInstanceBackupState) {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: vmiName, Namespace: metav1.NamespaceDefault},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: v1.Running, BackupState: backupState},
+		}
+		vmiInterface.EXPECT().Get(gomock.Any(), vmiName, metav1.GetOptions{}).Return(vmi, nil)
+	}
+
+	freePort := func() string {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+	}
+
+	It("should fail without the VMI name", func() {
+		err := testing.NewRepeatableVirtctlCommand("nbd")()
+		Expect(err).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	DescribeTable("should fail when the VMI has no running Pull mode backup", func(backupState *v1.VirtualMachineInstanceBackupState) {
+		expectVMI(backupState)
+
+		err := testing.NewRepeatableVirtctlCommand("nbd", vmiName)()
+		Expect(err).To(MatchError("VMI testvmi has no running Pull mode backup"))
+	},
+		Entry("without a backup", nil),
+		Entry("with a Push mode backup", &v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePush}),
+		Entry("with a completed backup", &v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePull, Completed: true}),
+	)
+
+	It("should connect every local NBD client to the backup", func() {
+		expectVMI(&v1.VirtualMachineInstanceBackupState{BackupName: "backup", Mode: v1.BackupModePull})
+		vmiInterface.EXPECT().NBD(vmiName).Return(nil, errors.New("backup is gone"))
+		port := freePort()
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- testing.NewRepeatableVirtctlCommand("nbd", vmiName, "--port", port)()
+		}()
+
+		Eventually(func() error {
+			conn, err := (&net.Dialer{}).DialContext(context.Background(), "tcp", net.JoinHostPort("127.0.0.1", port))
+			if err == nil {
+				conn.Close()
+			}
+			return err
+		}, 5*time.Second, 50*time.Millisecond).Should(Succeed())
+		Eventually(errChan, 5*time.Second).Should(Receive(MatchError("can't access the backup of VMI testvmi: backup is gone")))
+	})
+})
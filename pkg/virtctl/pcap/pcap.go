@@ -0,0 +1,121 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	interfaceFlag = "interface"
+	filterFlag    = "filter"
+	outputFlag    = "output"
+)
+
+type pcap struct {
+	interfaceName string
+	filter        string
+	output        string
+}
+
+func NewCommand() *cobra.Command {
+	c := pcap{}
+	cmd := &cobra.Command{
+		Use:     "pcap (VMI)",
+		Short:   "Capture the live traffic of a virtual machine instance interface in pcapng format.",
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVar(&c.interfaceName, interfaceFlag, "", "The name of the virtual machine instance interface to capture on.")
+	cmd.Flags().StringVar(&c.filter, filterFlag, "", "An optional BPF filter, as printed by 'tcpdump -ddd <expression>'.")
+	cmd.Flags().StringVarP(&c.output, outputFlag, "o", "-", "The file to write the capture to, '-' writes to stdout.")
+	if err := cmd.MarkFlagRequired(interfaceFlag); err != nil {
+		panic(err)
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Capture the traffic of interface 'default' on VirtualMachineInstance 'myvmi' and show it in Wireshark:
+  {{ProgramName}} pcap myvmi --interface default | wireshark -k -i -
+
+  # Capture only the TCP traffic of interface 'blue' into a file:
+  {{ProgramName}} pcap myvmi --interface blue --filter "$(tcpdump -ddd tcp)" --output capture.pcapng`
+}
+
+func (c *pcap) run(cmd *cobra.Command, args []string) error {
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if c.output != "-" {
+		file, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("failed to create the output file: %v", err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	stream, err := client.VirtualMachineInstance(namespace).Pcap(args[0], &v1.PcapOptions{
+		InterfaceName: c.interfaceName,
+		Filter:        c.filter,
+	})
+	if err != nil {
+		return fmt.Errorf("can't access VMI %s: %v", args[0], err)
+	}
+	conn := stream.AsConn()
+	defer conn.Close()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	interrupted := make(chan struct{})
+	go func() {
+		if _, ok := <-interrupt; ok {
+			close(interrupted)
+			conn.Close()
+		}
+	}()
+
+	if _, err := io.Copy(out, conn); err != nil {
+		select {
+		case <-interrupted:
+			return nil
+		default:
+			return fmt.Errorf("error encountered while capturing: %v", err)
+		}
+	}
+	return nil
+}
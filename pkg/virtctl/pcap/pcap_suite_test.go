@@ -0,0 +1,11 @@
+package pcap_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestPcap(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
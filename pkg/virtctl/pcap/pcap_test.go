@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package pcap_test
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+type fakeStream struct {
+	conn net.Conn
+}
+
+func (s *fakeStream) Stream(_ kvcorev1.StreamOptions) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeStream) AsConn() net.Conn {
+	return s.conn
+}
+
+var _ = Describe("Pcap command", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	It("should fail without the VMI name", func() {
+		err := testing.NewRepeatableVirtctlCommand("pcap", "--interface", "default")()
+		Expect(err).To(MatchError("accepts 1 arg(s), received 0"))
+	})
+
+	It("should fail without the interface name", func() {
+		err := testing.NewRepeatableVirtctlCommand("pcap", vmiName)()
+		Expect(err).To(MatchError(`required flag(s) "interface" not set`))
+	})
+
+	It("should fail when the capture can not be started", func() {
+		vmiInterface.EXPECT().Pcap(vmiName, &v1.PcapOptions{InterfaceName: "default"}).Return(nil, errors.New("interface default not found"))
+
+		err := testing.NewRepeatableVirtctlCommand("pcap", vmiName, "--interface", "default")()
+		Expect(err).To(MatchError("can't access VMI testvmi: interface default not found"))
+	})
+
+	It("should write the capture to the output file", func() {
+		local, remote := net.Pipe()
+		vmiInterface.EXPECT().Pcap(vmiName, &v1.PcapOptions{InterfaceName: "default", Filter: "1\n6 0 0 262144\n"}).
+			Return(&fakeStream{conn: local}, nil)
+		go func() {
+			defer GinkgoRecover()
+			_, err := remote.Write([]byte("captured"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(remote.Close()).To(Succeed())
+		}()
+
+		output := filepath.Join(GinkgoT().TempDir(), "capture.pcapng")
+		err := testing.NewRepeatableVirtctlCommand("pcap", vmiName,
+			"--interface", "default", "--filter", "1\n6 0 0 262144\n", "--output", output)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(output)).To(Equal([]byte("captured")))
+	})
+})
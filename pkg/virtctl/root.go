@@ -26,7 +26,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
+	"kubevirt.io/kubevirt/pkg/virtctl/nbd"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
+	"kubevirt.io/kubevirt/pkg/virtctl/pcap"
 	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
 	"kubevirt.io/kubevirt/pkg/virtctl/reset"
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
@@ -108,6 +110,8 @@ func NewVirtctlCommandFn() *cobra.Command {
 		scp.NewCommand(),
 		ssh.NewCommand(),
 		portforward.NewCommand(),
+		pcap.NewCommand(),
+		nbd.NewCommand(),
 		vm.NewStartCommand(),
 		vm.NewStopCommand(),
 		vm.NewRestartCommand(),
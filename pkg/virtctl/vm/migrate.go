@@ -21,21 +21,37 @@ package vm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_MIGRATE = "migrate"
+const (
+	COMMAND_MIGRATE = "migrate"
+
+	migrationWatchInterval       = time.Second
+	defaultMigrationWatchTimeout = time.Hour
+)
 
 type migrateCommand struct {
 	command           string
 	addedNodeSelector map[string]string
+	watch             bool
+	watchTimeout      time.Duration
 }
 
 func NewMigrateCommand() *cobra.Command {
@@ -49,6 +65,8 @@ func NewMigrateCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringToStringVar(&c.addedNodeSelector, "addedNodeSelector", nil, "--addedNodeSelector=key=value1,key2=value2: configure an additional node selector for the one-off migration attempt. AddedNodeSelector can only restrict constraints already set on the VM. By default the scheduler is responsible for finding the best Node, which is the recommended way of migrating VMs.")
+	cmd.Flags().BoolVar(&c.watch, "watch", false, "--watch: follow the progress of the migration until it is completed or failed.")
+	cmd.Flags().DurationVar(&c.watchTimeout, "watch-timeout", defaultMigrationWatchTimeout, "--watch-timeout=30m: the maximum time to follow the progress of the migration, when --watch is set.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
@@ -69,6 +87,14 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 		AddedNodeSelector: c.addedNodeSelector,
 	}
 
+	var prevMigrations sets.Set[string]
+	if c.watch && !dryRun {
+		prevMigrations, err = listMigrationNames(cmd.Context(), virtClient, namespace, vmiName)
+		if err != nil {
+			return fmt.Errorf("Error listing migrations of VirtualMachine %s: %v", vmiName, err)
+		}
+	}
+
 	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, options)
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
@@ -76,5 +102,172 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, c.command)
 
+	if dryRun {
+		return checkMigration(cmd.Context(), virtClient, namespace, vmiName)
+	}
+
+	if c.watch {
+		return watchMigration(cmd.Context(), virtClient, namespace, vmiName, prevMigrations, c.watchTimeout)
+	}
+
+	return nil
+}
+
+// checkMigration prints the per-node migration compatibility of the VMI and fails if it cannot be migrated anywhere
+func checkMigration(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string) error {
+	check, err := virtClient.VirtualMachineInstance(namespace).MigrateCheck(ctx, vmiName)
+	if err != nil {
+		return fmt.Errorf("Error checking migration of VirtualMachineInstance %s: %v", vmiName, err)
+	}
+
+	fmt.Print(formatMigrationCheck(&check))
+	if !check.Migratable {
+		return fmt.Errorf("VirtualMachineInstance %s cannot be migrated", vmiName)
+	}
+	return nil
+}
+
+func formatMigrationCheck(check *v1.VirtualMachineInstanceMigrationCheck) string {
+	var sb strings.Builder
+	for _, reason := range check.Reasons {
+		fmt.Fprintf(&sb, "Migration blocked: %s\n", reason)
+	}
+	for _, node := range check.Nodes {
+		if node.Compatible {
+			fmt.Fprintf(&sb, "Node %s: compatible\n", node.Name)
+		} else {
+			fmt.Fprintf(&sb, "Node %s: incompatible: %s\n", node.Name, strings.Join(node.Reasons, "; "))
+		}
+	}
+	return sb.String()
+}
+
+// watchMigration prints the progress of the migration requested for the VM until the migration is final.
+// The requested migration is the newest one which did not exist before the request. In case there is no such
+// migration, or it is removed while being watched, it is considered finished.
+func watchMigration(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string,
+	prevMigrations sets.Set[string], timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	migration, err := findRequestedMigration(ctx, virtClient, namespace, vmiName, prevMigrations)
+	if err != nil {
+		return fmt.Errorf("Error watching migration of VirtualMachine %s: %v", vmiName, err)
+	}
+	if migration == nil {
+		fmt.Printf("No migration of VirtualMachine %s was found, assuming it is finished\n", vmiName)
+		return nil
+	}
+	fmt.Println(formatMigrationProgress(migration))
+
+	migrationName := migration.Name
+	err = wait.PollUntilContextCancel(ctx, migrationWatchInterval, false, func(ctx context.Context) (bool, error) {
+		if migration.IsFinal() {
+			return true, nil
+		}
+		current, err := virtClient.VirtualMachineInstanceMigration(namespace).Get(ctx, migrationName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			migration = nil
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		migration = current
+		fmt.Println(formatMigrationProgress(migration))
+		return migration.IsFinal(), nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("Timed out after %s watching migration %s", timeout, migrationName)
+	}
+	if err != nil {
+		return fmt.Errorf("Error watching migration of VirtualMachine %s: %v", vmiName, err)
+	}
+
+	if migration == nil {
+		fmt.Printf("Migration %s was removed, assuming it is finished\n", migrationName)
+		return nil
+	}
+	if migration.Status.Phase == v1.MigrationFailed {
+		if migration.Status.MigrationState != nil && migration.Status.MigrationState.FailureReason != "" {
+			return fmt.Errorf("Migration %s failed: %s", migration.Name, migration.Status.MigrationState.FailureReason)
+		}
+		return fmt.Errorf("Migration %s failed", migration.Name)
+	}
 	return nil
 }
+
+func listMigrations(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string) ([]v1.VirtualMachineInstanceMigration, error) {
+	labelselector := fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName)
+	migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelselector})
+	if err != nil {
+		return nil, err
+	}
+	return migrations.Items, nil
+}
+
+func listMigrationNames(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string) (sets.Set[string], error) {
+	migrations, err := listMigrations(ctx, virtClient, namespace, vmiName)
+	if err != nil {
+		return nil, err
+	}
+	names := sets.New[string]()
+	for i := range migrations {
+		names.Insert(migrations[i].Name)
+	}
+	return names, nil
+}
+
+func findRequestedMigration(ctx context.Context, virtClient kubecli.KubevirtClient, namespace, vmiName string,
+	prevMigrations sets.Set[string]) (*v1.VirtualMachineInstanceMigration, error) {
+	migrations, err := listMigrations(ctx, virtClient, namespace, vmiName)
+	if err != nil {
+		return nil, err
+	}
+
+	var requested *v1.VirtualMachineInstanceMigration
+	for i := range migrations {
+		if prevMigrations.Has(migrations[i].Name) {
+			continue
+		}
+		if requested == nil || requested.CreationTimestamp.Before(&migrations[i].CreationTimestamp) {
+			requested = &migrations[i]
+		}
+	}
+	return requested, nil
+}
+
+func formatMigrationProgress(migration *v1.VirtualMachineInstanceMigration) string {
+	line := fmt.Sprintf("Migration %s: %s", migration.Name, migration.Status.Phase)
+	if migration.IsFinal() || migration.Status.MigrationState == nil || migration.Status.MigrationState.Progress == nil {
+		return line
+	}
+
+	progress := migration.Status.MigrationState.Progress
+	line += fmt.Sprintf(", iteration %d, processed %s, remaining %s of %s, dirty rate %s/s, throughput %s/s",
+		progress.Iteration,
+		formatBytes(progress.DataProcessedBytes),
+		formatBytes(progress.DataRemainingBytes),
+		formatBytes(progress.DataTotalBytes),
+		formatBytes(progress.MemoryDirtyRateBytesPerSecond),
+		formatBytes(progress.ThroughputBytesPerSecond),
+	)
+	if progress.EstimatedCompletionSeconds != nil {
+		return line + fmt.Sprintf(", ETA %s", time.Duration(*progress.EstimatedCompletionSeconds)*time.Second)
+	}
+	return line + ", ETA unknown (not converging)"
+}
+
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
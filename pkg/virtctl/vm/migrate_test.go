@@ -21,21 +21,25 @@ package vm_test
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 )
 
 var _ = Describe("Migrate command", func() {
 	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
 	var ctrl *gomock.Controller
 	const vmName = "testvm"
 
@@ -44,6 +48,7 @@ var _ = Describe("Migrate command", func() {
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
 		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
 		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 	})
 
 	It("should fail with missing input parameters", func() {
@@ -58,6 +63,13 @@ var _ = Describe("Migrate command", func() {
 
 		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
 		vmInterface.EXPECT().Migrate(context.Background(), vm.Name, expectedMigrateOptions).Return(nil).Times(1)
+		if len(expectedMigrateOptions.DryRun) > 0 {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().MigrateCheck(gomock.Any(), vm.Name).Return(v1.VirtualMachineInstanceMigrationCheck{
+				Migratable: true,
+				Nodes:      []v1.NodeMigrationCheck{{Name: "node01", Compatible: true}},
+			}, nil).Times(1)
+		}
 
 		args := []string{"migrate", vmName}
 		args = append(args, extraArgs...)
@@ -104,4 +116,135 @@ var _ = Describe("Migrate command", func() {
 			"--addedNodeSelector", "key1,key2"),
 	)
 
+	Context("with dry-run", func() {
+		BeforeEach(func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Migrate(gomock.Any(), vmName, &v1.MigrateOptions{
+				DryRun: []string{k8smetav1.DryRunAll},
+			}).Return(nil).Times(1)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		})
+
+		It("should fail when no node is compatible", func() {
+			vmiInterface.EXPECT().MigrateCheck(gomock.Any(), vmName).Return(v1.VirtualMachineInstanceMigrationCheck{
+				Migratable: false,
+				Nodes: []v1.NodeMigrationCheck{
+					{Name: "node01", Compatible: false, Reasons: []string{"node is cordoned"}},
+				},
+			}, nil).Times(1)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--dry-run")()
+			Expect(err).To(MatchError(fmt.Sprintf("VirtualMachineInstance %s cannot be migrated", vmName)))
+		})
+
+		It("should fail when the check fails", func() {
+			vmiInterface.EXPECT().MigrateCheck(gomock.Any(), vmName).Return(v1.VirtualMachineInstanceMigrationCheck{}, fmt.Errorf("error")).Times(1)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--dry-run")()
+			Expect(err).To(MatchError(fmt.Sprintf("Error checking migration of VirtualMachineInstance %s: error", vmName)))
+		})
+	})
+
+	Context("with watch", func() {
+		var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+		var migration *v1.VirtualMachineInstanceMigration
+
+		expectMigrationsList := func(before, after []v1.VirtualMachineInstanceMigration) {
+			listOptions := k8smetav1.ListOptions{
+				LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmName),
+			}
+			gomock.InOrder(
+				migrationInterface.EXPECT().List(gomock.Any(), listOptions).Return(
+					&v1.VirtualMachineInstanceMigrationList{Items: before}, nil,
+				).Times(1),
+				migrationInterface.EXPECT().List(gomock.Any(), listOptions).Return(
+					&v1.VirtualMachineInstanceMigrationList{Items: after}, nil,
+				).Times(1),
+			)
+		}
+
+		BeforeEach(func() {
+			migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+			migration = kubecli.NewMinimalMigration(fmt.Sprintf("%s-migration", vmName))
+			migration.Status.Phase = v1.MigrationRunning
+			migration.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				Progress: &v1.MigrationProgress{
+					DataProcessedBytes:            1024,
+					DataRemainingBytes:            2048,
+					DataTotalBytes:                3072,
+					MemoryDirtyRateBytesPerSecond: 10,
+					ThroughputBytesPerSecond:      100,
+					Iteration:                     1,
+					EstimatedCompletionSeconds:    pointer.P(int64(22)),
+				},
+			}
+
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Migrate(gomock.Any(), vmName, &v1.MigrateOptions{}).Return(nil).Times(1)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+		})
+
+		It("should follow the migration until it succeeds", func() {
+			expectMigrationsList(nil, []v1.VirtualMachineInstanceMigration{*migration})
+			succeeded := migration.DeepCopy()
+			succeeded.Status.Phase = v1.MigrationSucceeded
+			migrationInterface.EXPECT().Get(gomock.Any(), migration.Name, k8smetav1.GetOptions{}).Return(succeeded, nil).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch")()).To(Succeed())
+		})
+
+		It("should fail when the migration fails", func() {
+			expectMigrationsList(nil, []v1.VirtualMachineInstanceMigration{*migration})
+			failed := migration.DeepCopy()
+			failed.Status.Phase = v1.MigrationFailed
+			failed.Status.MigrationState.FailureReason = "some failure"
+			migrationInterface.EXPECT().Get(gomock.Any(), migration.Name, k8smetav1.GetOptions{}).Return(failed, nil).Times(1)
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch")()
+			Expect(err).To(MatchError(fmt.Sprintf("Migration %s failed: some failure", migration.Name)))
+		})
+
+		It("should finish when the migration is already final", func() {
+			migration.Status.Phase = v1.MigrationSucceeded
+			expectMigrationsList(nil, []v1.VirtualMachineInstanceMigration{*migration})
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch")()).To(Succeed())
+		})
+
+		It("should ignore migrations which existed before the request", func() {
+			prevMigration := kubecli.NewMinimalMigration(fmt.Sprintf("%s-prev-migration", vmName))
+			prevMigration.Status.Phase = v1.MigrationFailed
+			expectMigrationsList(
+				[]v1.VirtualMachineInstanceMigration{*prevMigration},
+				[]v1.VirtualMachineInstanceMigration{*prevMigration, *migration},
+			)
+			succeeded := migration.DeepCopy()
+			succeeded.Status.Phase = v1.MigrationSucceeded
+			migrationInterface.EXPECT().Get(gomock.Any(), migration.Name, k8smetav1.GetOptions{}).Return(succeeded, nil).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch")()).To(Succeed())
+		})
+
+		It("should finish when the migration is not found", func() {
+			expectMigrationsList(nil, nil)
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch")()).To(Succeed())
+		})
+
+		It("should finish when the migration is removed", func() {
+			expectMigrationsList(nil, []v1.VirtualMachineInstanceMigration{*migration})
+			migrationInterface.EXPECT().Get(gomock.Any(), migration.Name, k8smetav1.GetOptions{}).Return(
+				nil, k8serrors.NewNotFound(v1.Resource("virtualmachineinstancemigrations"), migration.Name),
+			).Times(1)
+
+			Expect(testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch")()).To(Succeed())
+		})
+
+		It("should fail when the watch times out", func() {
+			expectMigrationsList(nil, []v1.VirtualMachineInstanceMigration{*migration})
+
+			err := testing.NewRepeatableVirtctlCommand("migrate", vmName, "--watch", "--watch-timeout=10ms")()
+			Expect(err).To(MatchError(fmt.Sprintf("Timed out after 10ms watching migration %s", migration.Name)))
+		})
+	})
 })
@@ -0,0 +1,362 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+// importResources are the resources needed to recreate the exported VirtualMachine in another cluster
+type importResources struct {
+	configMaps  []*k8sv1.ConfigMap
+	secrets     []*k8sv1.Secret
+	dataVolumes []*cdiv1.DataVolume
+	vm          *virtv1.VirtualMachine
+}
+
+// ImportVirtualMachineExport recreates the VirtualMachine of a VirtualMachineExport in the target cluster.
+// The volumes of the VirtualMachine are imported by CDI from the export, so the VirtualMachineExport
+// is always retained.
+func ImportVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) error {
+	targetClient, namespace, err := getImportTarget(client, vmeInfo)
+	if err != nil {
+		return err
+	}
+
+	if vmeInfo.ShouldCreate {
+		if err := CreateVirtualMachineExport(client, vmeInfo); err != nil && !errExportAlreadyExists(err) {
+			return err
+		}
+	}
+
+	// Wait for the vmexport object to be ready
+	if err := WaitForVirtualMachineExportFn(client, vmeInfo, processingWaitInterval, vmeInfo.ReadinessTimeout); err != nil {
+		return err
+	}
+
+	vmexport, err := getVirtualMachineExport(client, vmeInfo)
+	if err != nil {
+		return err
+	}
+	if vmexport == nil {
+		return fmt.Errorf("unable to get '%s/%s' VirtualMachineExport", vmeInfo.Namespace, vmeInfo.Name)
+	}
+
+	var resources *importResources
+	for attempt := 0; attempt <= vmeInfo.DownloadRetries && resources == nil; attempt++ {
+		if attempt > 0 {
+			printToOutput("Retrying...\n")
+			time.Sleep(2 * time.Second)
+		}
+		if resources, err = getImportResources(client, vmexport, vmeInfo); err != nil {
+			return err
+		}
+	}
+	if resources == nil {
+		return fmt.Errorf("retry count reached, exiting unsuccesfully")
+	}
+
+	resources.setNamespace(namespace)
+	if err := createImportResources(targetClient, namespace, resources); err != nil {
+		return err
+	}
+
+	printToOutput("VirtualMachine '%s/%s' imported, its volumes are populated from the '%s/%s' VirtualMachineExport\n", namespace, resources.vm.Name, vmexport.Namespace, vmexport.Name)
+	return nil
+}
+
+// getImportTarget returns the client and namespace of the cluster the VirtualMachine is imported into
+func getImportTarget(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) (kubecli.KubevirtClient, string, error) {
+	if vmeInfo.TargetKubeconfig == "" && vmeInfo.TargetContext == "" {
+		if vmeInfo.TargetNamespace != "" {
+			return client, vmeInfo.TargetNamespace, nil
+		}
+		return client, vmeInfo.Namespace, nil
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = vmeInfo.TargetKubeconfig
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
+		CurrentContext: vmeInfo.TargetContext,
+	})
+	targetClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return nil, "", err
+	}
+	if vmeInfo.TargetNamespace != "" {
+		return targetClient, vmeInfo.TargetNamespace, nil
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, "", err
+	}
+	return targetClient, namespace, nil
+}
+
+// getImportResources retrieves the manifests of the VirtualMachine, its DataVolumes and the CDI header secret from the
+// VirtualMachineExport. It returns nil if the export server returns a bad status.
+func getImportResources(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*importResources, error) {
+	manifestMap, err := GetManifestUrlsFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := &importResources{}
+	for _, manifestType := range []exportv1.ExportManifestType{exportv1.AllManifests, exportv1.AuthHeader} {
+		manifestUrl, ok := manifestMap[manifestType]
+		if !ok {
+			return nil, fmt.Errorf("unable to get the %s manifest URL from '%s/%s' VirtualMachineExport", manifestType, vmexport.Namespace, vmexport.Name)
+		}
+		data, err := getManifest(client, vmexport, vmeInfo, manifestUrl)
+		if err != nil || data == nil {
+			return nil, err
+		}
+		if err := resources.decode(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if resources.vm == nil {
+		return nil, fmt.Errorf("unable to find the VirtualMachine manifest in '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+	return resources, nil
+}
+
+func getManifest(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, manifestUrl string) ([]byte, error) {
+	headers := map[string]string{
+		ACCEPT: APPLICATION_JSON,
+	}
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, manifestUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check server response
+	if resp.StatusCode != http.StatusOK {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return nil, nil
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// decode adds the resources of a manifest list returned by the export server
+func (r *importResources) decode(data []byte) error {
+	list := &k8sv1.List{}
+	if err := json.Unmarshal(data, list); err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		typeMeta := &metav1.TypeMeta{}
+		if err := json.Unmarshal(item.Raw, typeMeta); err != nil {
+			return err
+		}
+		switch typeMeta.Kind {
+		case "ConfigMap":
+			cm := &k8sv1.ConfigMap{}
+			if err := json.Unmarshal(item.Raw, cm); err != nil {
+				return err
+			}
+			r.configMaps = append(r.configMaps, cm)
+		case "Secret":
+			secret := &k8sv1.Secret{}
+			if err := json.Unmarshal(item.Raw, secret); err != nil {
+				return err
+			}
+			r.secrets = append(r.secrets, secret)
+		case "DataVolume":
+			dv := &cdiv1.DataVolume{}
+			if err := json.Unmarshal(item.Raw, dv); err != nil {
+				return err
+			}
+			r.dataVolumes = append(r.dataVolumes, dv)
+		case virtv1.VirtualMachineGroupVersionKind.Kind:
+			vm := &virtv1.VirtualMachine{}
+			if err := json.Unmarshal(item.Raw, vm); err != nil {
+				return err
+			}
+			r.vm = vm
+		default:
+			return fmt.Errorf("unexpected %s in the exported manifests", typeMeta.Kind)
+		}
+	}
+	return nil
+}
+
+// setNamespace moves the resources into the target namespace and rewrites the VirtualMachine to use the imported
+// DataVolumes, so it doesn't start before the volumes are populated
+func (r *importResources) setNamespace(namespace string) {
+	for _, cm := range r.configMaps {
+		cm.ObjectMeta = importObjectMeta(cm.ObjectMeta, namespace)
+	}
+	for _, secret := range r.secrets {
+		secret.ObjectMeta = importObjectMeta(secret.ObjectMeta, namespace)
+	}
+	dataVolumes := map[string]bool{}
+	for _, dv := range r.dataVolumes {
+		dv.ObjectMeta = importObjectMeta(dv.ObjectMeta, namespace)
+		dataVolumes[dv.Name] = true
+	}
+
+	r.vm.ObjectMeta = importObjectMeta(r.vm.ObjectMeta, namespace)
+	r.vm.Status = virtv1.VirtualMachineStatus{}
+	for i := range r.vm.Spec.DataVolumeTemplates {
+		r.vm.Spec.DataVolumeTemplates[i].Namespace = ""
+	}
+	if r.vm.Spec.Template == nil {
+		return
+	}
+	for i, volume := range r.vm.Spec.Template.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil || !dataVolumes[volume.PersistentVolumeClaim.ClaimName] {
+			continue
+		}
+		r.vm.Spec.Template.Spec.Volumes[i].VolumeSource = virtv1.VolumeSource{
+			DataVolume: &virtv1.DataVolumeSource{
+				Name:         volume.PersistentVolumeClaim.ClaimName,
+				Hotpluggable: volume.PersistentVolumeClaim.Hotpluggable,
+			},
+		}
+	}
+}
+
+func importObjectMeta(meta metav1.ObjectMeta, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
+
+// createImportResources creates the resources in the target cluster, the VirtualMachine is created last.
+// Resources left behind by a previous import of the same VirtualMachineExport are reused, so an interrupted
+// import can be run again.
+func createImportResources(client kubecli.KubevirtClient, namespace string, resources *importResources) error {
+	ctx := context.Background()
+	for _, cm := range resources.configMaps {
+		err := createImportResource("ConfigMap", namespace, cm.Name, func() error {
+			_, err := client.CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{})
+			return err
+		}, func() (bool, error) {
+			existing, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, cm.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return equality.Semantic.DeepEqual(existing.Data, cm.Data) &&
+				equality.Semantic.DeepEqual(existing.BinaryData, cm.BinaryData), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, secret := range resources.secrets {
+		err := createImportResource("Secret", namespace, secret.Name, func() error {
+			_, err := client.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+			return err
+		}, func() (bool, error) {
+			existing, err := client.CoreV1().Secrets(namespace).Get(ctx, secret.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return equality.Semantic.DeepEqual(secretData(existing), secretData(secret)), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, dv := range resources.dataVolumes {
+		err := createImportResource("DataVolume", namespace, dv.Name, func() error {
+			_, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(ctx, dv, metav1.CreateOptions{})
+			return err
+		}, func() (bool, error) {
+			existing, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Get(ctx, dv.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			return equality.Semantic.DeepEqual(existing.Spec.Source, dv.Spec.Source) &&
+				equality.Semantic.DeepEqual(existing.Spec.SourceRef, dv.Spec.SourceRef), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	vm := resources.vm
+	return createImportResource(virtv1.VirtualMachineGroupVersionKind.Kind, namespace, vm.Name, func() error {
+		_, err := client.VirtualMachine(namespace).Create(ctx, vm, metav1.CreateOptions{})
+		return err
+	}, func() (bool, error) {
+		existing, err := client.VirtualMachine(namespace).Get(ctx, vm.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		// The VirtualMachine spec is defaulted on creation, only the volumes are compared
+		return existing.Spec.Template != nil && vm.Spec.Template != nil &&
+			equality.Semantic.DeepEqual(existing.Spec.Template.Spec.Volumes, vm.Spec.Template.Spec.Volumes), nil
+	})
+}
+
+// createImportResource creates a resource, tolerating an existing resource with the same content
+func createImportResource(kind, namespace, name string, create func() error, matchesExisting func() (bool, error)) error {
+	err := create()
+	if err == nil {
+		printToOutput("%s '%s/%s' created\n", kind, namespace, name)
+		return nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	matches, err := matchesExisting()
+	if err != nil {
+		return err
+	}
+	if !matches {
+		return fmt.Errorf("%s '%s/%s' already exists and differs from the exported one", kind, namespace, name)
+	}
+	printToOutput("%s '%s/%s' already exists\n", kind, namespace, name)
+	return nil
+}
+
+// secretData returns the data of a secret, including the data that is only set in StringData before creation
+func secretData(secret *k8sv1.Secret) map[string][]byte {
+	data := map[string][]byte{}
+	for key, value := range secret.Data {
+		data[key] = value
+	}
+	for key, value := range secret.StringData {
+		data[key] = []byte(value)
+	}
+	return data
+}
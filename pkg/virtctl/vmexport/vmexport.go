@@ -24,6 +24,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
@@ -62,6 +63,7 @@ const (
 	CREATE   = "create"
 	DELETE   = "delete"
 	DOWNLOAD = "download"
+	IMPORT   = "import"
 
 	// Available vmexport flags
 	OUTPUT_FLAG            = "--output"
@@ -84,14 +86,18 @@ const (
 	LABELS_FLAG            = "--labels"
 	ANNOTATIONS_FLAG       = "--annotations"
 	READINESS_TIMEOUT_FLAG = "--readiness-timeout"
+	TARGET_KUBECONFIG_FLAG = "--target-kubeconfig"
+	TARGET_CONTEXT_FLAG    = "--target-context"
+	TARGET_NAMESPACE_FLAG  = "--target-namespace"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
 	OUTPUT_FORMAT_YAML = "yaml"
 
 	// Possible output format for volumes
-	GZIP_FORMAT = "gzip"
-	RAW_FORMAT  = "raw"
+	GZIP_FORMAT  = "gzip"
+	RAW_FORMAT   = "raw"
+	QCOW2_FORMAT = "qcow2"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -106,6 +112,10 @@ const (
 	exportTokenHeader = "x-kubevirt-export-token"
 	// secretTokenKey is the entry used to store the token in the virtualMachineExport secret
 	secretTokenKey = "token"
+	// extentsQueryParam is the query parameter used to list the data extents of a raw volume
+	extentsQueryParam = "extents"
+	// extentCoalesceGap is the largest hole between two data extents downloaded with a single range request
+	extentCoalesceGap = 1024 * 1024
 
 	// ErrRequiredFlag serves as error message when a mandatory flag is missing
 	ErrRequiredFlag = "need to specify the '%s' flag when using '%s'"
@@ -147,6 +157,9 @@ var (
 	resourceLabels       []string
 	resourceAnnotations  []string
 	readinessTimeout     string
+	targetKubeconfig     string
+	targetContext        string
+	targetNamespace      string
 )
 
 type VMExportInfo struct {
@@ -157,6 +170,8 @@ type VMExportInfo struct {
 	IncludeSecret    bool
 	ExportManifest   bool
 	Decompress       bool
+	Qcow2            bool
+	Sparse           bool
 	PortForward      bool
 	LocalPort        string
 	OutputFile       string
@@ -172,6 +187,9 @@ type VMExportInfo struct {
 	ReadinessTimeout time.Duration
 	Labels           map[string]string
 	Annotations      map[string]string
+	TargetKubeconfig string
+	TargetContext    string
+	TargetNamespace  string
 }
 
 type command struct {
@@ -218,11 +236,17 @@ func usage() string {
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
 
+	# Download a volume as sparse qcow2 image, only the allocated data of the volume is transferred
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --format=qcow2 --output=disk.qcow2
+
 	# Create a VirtualMachineExport and get the VirtualMachine manifest in Yaml format
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
 	# Get the VirtualMachine manifest in Yaml format from an existing VirtualMachineExport including CDI header secret
-	{{ProgramName}} vmexport download existing-export --include-secret --manifest`
+	{{ProgramName}} vmexport download existing-export --include-secret --manifest
+
+	# Create a VirtualMachineExport and import the VirtualMachine with its volumes into another cluster
+	{{ProgramName}} vmexport import vm1-export --vm=vm1 --target-kubeconfig=target.kubeconfig --target-namespace=ns1`
 	return usage
 }
 
@@ -245,7 +269,7 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's three options: gzip (default), raw and qcow2.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be retained after the download finishes.")
 	cmd.Flags().BoolVar(&deleteVme, "delete-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be deleted after the download finishes.")
@@ -261,6 +285,9 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.Flags().StringSliceVar(&resourceLabels, "labels", nil, "Specify custom labels to VM export object and its associated pod")
 	cmd.Flags().StringSliceVar(&resourceAnnotations, "annotations", nil, "Specify custom annotations to VM export object and its associated pod")
 	cmd.Flags().StringVar(&readinessTimeout, "readiness-timeout", "", "Specify maximum wait for VM export object to be ready")
+	cmd.Flags().StringVar(&targetKubeconfig, "target-kubeconfig", "", "When used with the 'import' option, specifies the kubeconfig of the cluster to import the VirtualMachine into, defaults to the cluster of the VirtualMachineExport")
+	cmd.Flags().StringVar(&targetContext, "target-context", "", "When used with the 'import' option, specifies the kubeconfig context of the cluster to import the VirtualMachine into")
+	cmd.Flags().StringVar(&targetNamespace, "target-namespace", "", "When used with the 'import' option, specifies the namespace to import the VirtualMachine into, defaults to the namespace of the target context")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -285,7 +312,7 @@ func (c *command) run(cmd *cobra.Command, args []string) error {
 	}
 	vmeInfo.Namespace = namespace
 
-	// Finally, run the vmexport function (create|delete|download)
+	// Finally, run the vmexport function (create|delete|download|import)
 	if err := exportFunction(virtClient, &vmeInfo); err != nil {
 		return err
 	}
@@ -294,7 +321,7 @@ func (c *command) run(cmd *cobra.Command, args []string) error {
 }
 
 // parseExportArguments parses and validates vmexport arguments and flags. These arguments should always be:
-//  1. The vmexport function (create|delete|download)
+//  1. The vmexport function (create|delete|download|import)
 //  2. The VirtualMachineExport name
 func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) error {
 	funcName := strings.ToLower(args[0])
@@ -316,6 +343,11 @@ func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) err
 		if err := handleDownloadFlags(); err != nil {
 			return err
 		}
+	case IMPORT:
+		exportFunction = ImportVirtualMachineExport
+		if err := handleImportFlags(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid function '%s'", funcName)
 	}
@@ -350,10 +382,13 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 			return fmt.Fprintf(os.Stderr, format, a...)
 		}
 	}
-	// If raw format is specified, we'll attempt to download and decompress a gzipped volume
+	// If raw format is specified, we'll attempt to download only the data of the raw volume into the
+	// output file and otherwise download and decompress a gzipped volume
 	if format == RAW_FORMAT {
 		vmeInfo.Decompress = true
+		vmeInfo.Sparse = vmeInfo.OutputFile != ""
 	}
+	vmeInfo.Qcow2 = format == QCOW2_FORMAT
 	vmeInfo.DownloadRetries = downloadRetries
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
@@ -392,6 +427,9 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 
 	vmeInfo.Labels = convertSliceToMap(resourceLabels)
 	vmeInfo.Annotations = convertSliceToMap(resourceAnnotations)
+	vmeInfo.TargetKubeconfig = targetKubeconfig
+	vmeInfo.TargetContext = targetContext
+	vmeInfo.TargetNamespace = targetNamespace
 
 	return nil
 }
@@ -583,6 +621,17 @@ func getVirtualMachineManifest(client kubecli.KubevirtClient, vmexport *exportv1
 
 // downloadVolume handles the process of downloading the requested volume from a VirtualMachineExport
 func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	if vmeInfo.Sparse {
+		supported, err := downloadSparseVolume(client, vmexport, vmeInfo)
+		if err != nil {
+			return false, err
+		}
+		if supported {
+			printToOutput("Download finished succesfully\n")
+			return true, nil
+		}
+	}
+
 	// Extract the URL from the vmexport
 	downloadUrl, err := GetUrlFromVirtualMachineExport(vmexport, vmeInfo)
 	if err != nil {
@@ -629,14 +678,9 @@ func replaceUrlWithServiceUrl(manifestUrl string, vmeInfo *VMExportInfo) (string
 	return manUrl.String(), nil
 }
 
-// GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
-func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
-	var (
-		downloadUrl string
-		err         error
-		format      exportv1.VirtualMachineExportVolumeFormat
-		links       *exportv1.VirtualMachineExportLink
-	)
+// getExportVolume returns the requested volume from the VirtualMachineExport status
+func getExportVolume(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*exportv1.VirtualMachineExportVolume, error) {
+	var links *exportv1.VirtualMachineExportLink
 
 	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
 		links = vmexport.Status.Links.External
@@ -644,33 +688,61 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 		links = vmexport.Status.Links.Internal
 	}
 	if links == nil || len(links.Volumes) <= 0 {
-		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
 	volumeNumber := len(links.Volumes)
 	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
 	}
-	for _, exportVolume := range links.Volumes {
+	for i, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
-			for _, format = range exportVolume.Formats {
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+			return &links.Volumes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
+func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
+	var (
+		downloadUrl string
+		format      exportv1.VirtualMachineExportVolumeFormat
+	)
+
+	exportVolume, err := getExportVolume(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
+	}
+	if exportVolume != nil {
+		for _, format = range exportVolume.Formats {
+			// qcow2 images are only downloaded when requested explicitly
+			if vmeInfo.Qcow2 {
+				if format.Format == exportv1.KubeVirtQcow2 {
 					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
 					if err != nil {
 						return "", err
 					}
-				}
-				// By default, we always attempt to find and get the compressed file URL,
-				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
 					break
 				}
+				continue
+			}
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+				downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+				if err != nil {
+					return "", err
+				}
+			}
+			// By default, we always attempt to find and get the compressed file URL,
+			// so we only break the loop when one is found.
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+				break
 			}
 		}
 	}
 
 	// No need to decompress file if format is not gzip
-	if format.Format == exportv1.KubeVirtRaw {
+	if format.Format == exportv1.KubeVirtRaw || format.Format == exportv1.KubeVirtQcow2 {
 		vmeInfo.Decompress = false
 	}
 
@@ -681,6 +753,139 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	return downloadUrl, nil
 }
 
+// getRawUrlFromVirtualMachineExport returns the URL of the requested volume in raw format, if there is one
+func getRawUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
+	exportVolume, err := getExportVolume(vmexport, vmeInfo)
+	if err != nil || exportVolume == nil {
+		return "", err
+	}
+	for _, format := range exportVolume.Formats {
+		if format.Format == exportv1.KubeVirtRaw {
+			return replaceUrlWithServiceUrl(format.Url, vmeInfo)
+		}
+	}
+	return "", nil
+}
+
+// diskExtents are the ranges of a raw volume containing data as listed by the export server
+type diskExtents struct {
+	Size    int64        `json:"size"`
+	Extents []diskExtent `json:"extents"`
+}
+
+type diskExtent struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+}
+
+// coalesceExtents merges extents separated by holes smaller than maxGap, so a fragmented
+// volume is downloaded with few range requests at the cost of writing the small holes
+func coalesceExtents(extents []diskExtent, maxGap int64) []diskExtent {
+	var coalesced []diskExtent
+	for _, extent := range extents {
+		if n := len(coalesced); n > 0 {
+			last := &coalesced[n-1]
+			if extent.Offset-(last.Offset+last.Length) <= maxGap {
+				last.Length = max(last.Length, extent.Offset+extent.Length-last.Offset)
+				continue
+			}
+		}
+		coalesced = append(coalesced, extent)
+	}
+	return coalesced
+}
+
+// getDiskExtents requests the data extents of a raw volume, it returns nil if the export server doesn't list them
+func getDiskExtents(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, rawUrl string) (*diskExtents, error) {
+	extentsUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	query := extentsUrl.Query()
+	query.Set(extentsQueryParam, "")
+	extentsUrl.RawQuery = query.Encode()
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, extentsUrl.String(), vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Older export servers ignore the query and return the volume itself
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != APPLICATION_JSON {
+		return nil, nil
+	}
+	extents := &diskExtents{}
+	if err := json.NewDecoder(resp.Body).Decode(extents); err != nil {
+		return nil, nil
+	}
+	return extents, nil
+}
+
+// downloadSparseVolume downloads only the data extents of a raw volume into the output file, the holes
+// of the volume remain unallocated. It returns false if the volume can't be downloaded this way, including
+// when its extents can't be requested, so it is downloaded fully instead.
+func downloadSparseVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	output, ok := vmeInfo.OutputWriter.(*os.File)
+	if !ok {
+		return false, nil
+	}
+	rawUrl, err := getRawUrlFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil || rawUrl == "" {
+		return false, nil
+	}
+	extents, err := getDiskExtents(client, vmexport, vmeInfo, rawUrl)
+	if err != nil {
+		printToOutput("Warning: unable to get the data extents of the volume, downloading it fully: %v\n", err)
+		return false, nil
+	}
+	if extents == nil {
+		return false, nil
+	}
+
+	ranges := coalesceExtents(extents.Extents, extentCoalesceGap)
+	var total int64
+	for _, extent := range ranges {
+		total += extent.Length
+	}
+	printToOutput("Downloading %d of %d bytes containing data\n", total, extents.Size)
+
+	barTemplate := fmt.Sprintf(`{{ "Downloading file:" }} {{counters . }} {{ cycle . %s }} {{speed . }}`, progressBarCycle)
+	bar := pb.ProgressBarTemplate(barTemplate).Start64(total)
+	defer bar.Finish()
+
+	for _, extent := range ranges {
+		if err := downloadExtent(client, vmexport, vmeInfo, rawUrl, output, extent.Offset, extent.Length, bar); err != nil {
+			return true, err
+		}
+	}
+	// The trailing hole of the volume is not part of any extent
+	return true, output.Truncate(extents.Size)
+}
+
+func downloadExtent(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, rawUrl string, output *os.File, offset, length int64, bar *pb.ProgressBar) error {
+	headers := map[string]string{
+		"Range": fmt.Sprintf("bytes=%d-%d", offset, offset+length-1),
+	}
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, rawUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPartialContent {
+		return fmt.Errorf("unable to download range %s of the volume: %s", headers["Range"], resp.Status)
+	}
+	n, err := io.Copy(io.NewOffsetWriter(output, offset), bar.NewProxyReader(io.LimitReader(resp.Body, length)))
+	if err != nil {
+		return err
+	}
+	if n != length {
+		return fmt.Errorf("unable to download range %s of the volume: got %d bytes", headers["Range"], n)
+	}
+	return nil
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -939,7 +1144,7 @@ func handleCreateFlags() error {
 		return fmt.Errorf(ErrIncompatibleFlag, RETRY_FLAG, CREATE)
 	}
 
-	return nil
+	return handleTargetFlags(CREATE)
 }
 
 // handleDeleteFlags ensures that only compatible flag combinations are used with 'delete'
@@ -988,7 +1193,7 @@ func handleDeleteFlags() error {
 		return fmt.Errorf(ErrIncompatibleFlag, ANNOTATIONS_FLAG, DELETE)
 	}
 
-	return nil
+	return handleTargetFlags(DELETE)
 }
 
 // handleDownloadFlags ensures that only compatible flag combinations are used with 'download'
@@ -1005,8 +1210,8 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT && format != QCOW2_FORMAT {
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/qcow2")
 	}
 
 	if downloadRetries < 0 {
@@ -1031,6 +1236,66 @@ func handleDownloadFlags() error {
 		return fmt.Errorf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", OUTPUT_FLAG, OUTPUT_FLAG)
 	}
 
+	return handleTargetFlags(DOWNLOAD)
+}
+
+// handleImportFlags ensures that only compatible flag combinations are used with 'import'
+func handleImportFlags() error {
+	// We assume that the vmexport should be created if a source has been specified
+	if hasSource := vm != "" || snapshot != ""; hasSource {
+		shouldCreate = true
+	}
+
+	if pvc != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, IMPORT)
+	}
+	if outputFile != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_FLAG, IMPORT)
+	}
+	if volumeName != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, IMPORT)
+	}
+	if format != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, FORMAT_FLAG, IMPORT)
+	}
+	if exportManifest {
+		return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, IMPORT)
+	}
+	if manifestOutputFormat != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_FORMAT_FLAG, IMPORT)
+	}
+	if includeSecret {
+		return fmt.Errorf(ErrIncompatibleFlag, INCLUDE_SECRET_FLAG, IMPORT)
+	}
+	// The imported volumes are populated from the export after the command finishes,
+	// so neither the port-forward nor the vmexport object can go away
+	if portForward {
+		return fmt.Errorf(ErrIncompatibleFlag, PORT_FORWARD_FLAG, IMPORT)
+	}
+	if localPort != "0" {
+		return fmt.Errorf(ErrIncompatibleFlag, LOCAL_PORT_FLAG, IMPORT)
+	}
+	if deleteVme {
+		return fmt.Errorf(ErrIncompatibleFlag, DELETE_FLAG, IMPORT)
+	}
+	if downloadRetries < 0 {
+		return fmt.Errorf(ErrInvalidValue, RETRY_FLAG, "positive integers")
+	}
+
+	return nil
+}
+
+// handleTargetFlags ensures that the import target is only specified with 'import'
+func handleTargetFlags(function string) error {
+	if targetKubeconfig != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, TARGET_KUBECONFIG_FLAG, function)
+	}
+	if targetContext != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, TARGET_CONTEXT_FLAG, function)
+	}
+	if targetNamespace != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, TARGET_NAMESPACE_FLAG, function)
+	}
 	return nil
 }
 
@@ -1,8 +1,10 @@
 package vmexport_test
 
 import (
+	"bytes"
 	"context"
 	cryptorand "crypto/rand"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -27,8 +29,10 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
@@ -364,7 +368,7 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.VM_FLAG, "test"), setFlag(vmexport.VOLUME_FLAG, "volume")),
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw/qcow2"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -560,6 +564,98 @@ var _ = Describe("vmexport", func() {
 				)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			It("a VirtualMachineExport with raw format only downloading the data extents", func() {
+				const size = 8 * 1024 * 1024
+				disk := make([]byte, size)
+				copy(disk[4096:], bytes.Repeat([]byte{1}, 512))
+				copy(disk[200000:], bytes.Repeat([]byte{2}, 1000))
+				copy(disk[5*1024*1024:], bytes.Repeat([]byte{3}, 1000))
+				var ranges []string
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Query().Has("extents") {
+						w.Header().Set("Content-Type", vmexport.APPLICATION_JSON)
+						_, err := w.Write([]byte(`{"size":8388608,"extents":[{"offset":4096,"length":512},{"offset":200000,"length":1000},{"offset":5242880,"length":1000}]}`))
+						Expect(err).ToNot(HaveOccurred())
+						return
+					}
+					rangeHeader := r.Header.Get("Range")
+					ranges = append(ranges, rangeHeader)
+					var start, end int64
+					_, err := fmt.Sscanf(rangeHeader, "bytes=%d-%d", &start, &end)
+					Expect(err).ToNot(HaveOccurred())
+					w.WriteHeader(http.StatusPartialContent)
+					_, err = w.Write(disk[start : end+1])
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				updateVMEStatusOnCreate(exportv1.KubeVirtRaw)
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.PVC_FLAG, pvcName),
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				// extents separated by small holes are downloaded together
+				Expect(ranges).To(Equal([]string{"bytes=4096-200999", "bytes=5242880-5243879"}))
+
+				outputData, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bytes.Equal(outputData, disk)).To(BeTrue())
+			})
+
+			It("a VirtualMachineExport with raw format fully when its data extents can't be requested", func() {
+				data := []byte("raw image")
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Expect(r.URL.Query().Has("extents")).To(BeFalse())
+					_, err := w.Write(data)
+					Expect(err).ToNot(HaveOccurred())
+				})
+				vmexport.HandleHTTPGetRequestFn = func(client kubecli.KubevirtClient, vme *exportv1.VirtualMachineExport, downloadUrl string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+					if strings.Contains(downloadUrl, "extents") {
+						return nil, errors.New("connection reset")
+					}
+					return vmexport.HandleHTTPGetRequest(client, vme, downloadUrl, insecure, exportURL, headers)
+				}
+
+				updateVMEStatusOnCreate(exportv1.KubeVirtRaw)
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_FORMAT),
+					setFlag(vmexport.PVC_FLAG, pvcName),
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				outputData, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputData).To(Equal(data))
+			})
+
+			It("a VirtualMachineExport with qcow2 format", func() {
+				data := []byte("qcow2 image")
+				server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, err := w.Write(data)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				updateVMEStatusOnCreate(exportv1.KubeVirtQcow2)
+				err := runDownloadCmd(
+					setFlag(vmexport.FORMAT_FLAG, vmexport.QCOW2_FORMAT),
+					setFlag(vmexport.PVC_FLAG, pvcName),
+					setFlag(vmexport.VOLUME_FLAG, volumeName),
+					setFlag(vmexport.OUTPUT_FLAG, outputPath),
+					vmexport.INSECURE_FLAG,
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				outputData, err := os.ReadFile(outputPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputData).To(Equal(data))
+			})
 		})
 
 		It("Succesfully download a VirtualMachineExport with just 'raw' links", func() {
@@ -787,6 +883,246 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("Import", func() {
+		const (
+			manifestUrl     = "/test/all"
+			secretUrl       = "/test/secret"
+			targetNamespace = "target"
+		)
+
+		var cdiClient *cdifake.Clientset
+
+		toList := func(objects ...interface{}) []byte {
+			list := &k8sv1.List{}
+			for _, obj := range objects {
+				raw, err := json.Marshal(obj)
+				Expect(err).ToNot(HaveOccurred())
+				list.Items = append(list.Items, runtime.RawExtension{Raw: raw})
+			}
+			data, err := json.Marshal(list)
+			Expect(err).ToNot(HaveOccurred())
+			return data
+		}
+
+		exportedVM := func() *v1.VirtualMachine {
+			return &v1.VirtualMachine{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+					APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-vm",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: v1.VirtualMachineSpec{
+					DataVolumeTemplates: []v1.DataVolumeTemplateSpec{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "template-dv",
+							Namespace: metav1.NamespaceDefault,
+						},
+					}},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{
+							Volumes: []v1.Volume{
+								{
+									Name: "rootdisk",
+									VolumeSource: v1.VolumeSource{
+										DataVolume: &v1.DataVolumeSource{Name: "template-dv"},
+									},
+								},
+								{
+									Name: "datadisk",
+									VolumeSource: v1.VolumeSource{
+										PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+											PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		exportedDV := &cdiv1.DataVolume{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DataVolume",
+				APIVersion: "cdi.kubevirt.io/v1beta1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pvcName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				Source: &cdiv1.DataVolumeSource{
+					HTTP: &cdiv1.DataVolumeSourceHTTP{
+						URL:                "https://export/volumes/test-pvc/disk.img.gz",
+						CertConfigMap:      "export-ca-cm-test-vme",
+						SecretExtraHeaders: []string{"header-secret-test-vme"},
+					},
+				},
+			},
+		}
+
+		exportedManifests := func(vm *v1.VirtualMachine) []byte {
+			objects := []interface{}{
+				&k8sv1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+					ObjectMeta: metav1.ObjectMeta{Name: "export-ca-cm-test-vme"},
+				},
+				exportedDV,
+			}
+			if vm != nil {
+				objects = append(objects, vm)
+			}
+			return toList(objects...)
+		}
+
+		exportedSecret := toList(&k8sv1.Secret{
+			TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "header-secret-test-vme"},
+		})
+
+		serveManifests := func(manifests []byte) {
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header).To(HaveKeyWithValue("Accept", ConsistOf(vmexport.APPLICATION_JSON)))
+				switch r.URL.String() {
+				case manifestUrl:
+					_, err := w.Write(manifests)
+					Expect(err).ToNot(HaveOccurred())
+				case secretUrl:
+					_, err := w.Write(exportedSecret)
+					Expect(err).ToNot(HaveOccurred())
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			vme.Status = vmeStatusReady(nil)
+			vme.Status.Links.External.Manifests = []exportv1.VirtualMachineExportManifest{
+				{
+					Type: exportv1.AllManifests,
+					Url:  server.URL + manifestUrl,
+				},
+				{
+					Type: exportv1.AuthHeader,
+					Url:  server.URL + secretUrl,
+				},
+			}
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			cdiClient = cdifake.NewSimpleClientset()
+			kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(targetNamespace).Return(virtClient.KubevirtV1().VirtualMachines(targetNamespace)).AnyTimes()
+		})
+
+		verifyImported := func() {
+			_, err := kubeClient.CoreV1().ConfigMaps(targetNamespace).Get(context.Background(), "export-ca-cm-test-vme", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = kubeClient.CoreV1().Secrets(targetNamespace).Get(context.Background(), "header-secret-test-vme", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			dv, err := cdiClient.CdiV1beta1().DataVolumes(targetNamespace).Get(context.Background(), pvcName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Spec.Source).To(Equal(exportedDV.Spec.Source))
+
+			vm, err := virtClient.KubevirtV1().VirtualMachines(targetNamespace).Get(context.Background(), "test-vm", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.DataVolumeTemplates[0].Namespace).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("template-dv"))
+			// the VM uses the imported DataVolume instead of the PVC
+			Expect(vm.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim).To(BeNil())
+			Expect(vm.Spec.Template.Spec.Volumes[1].DataVolume).To(Equal(&v1.DataVolumeSource{Name: pvcName}))
+		}
+
+		It("should import the VirtualMachine into the target namespace", func() {
+			serveManifests(exportedManifests(exportedVM()))
+
+			err := runImportCmd(setFlag(vmexport.TARGET_NAMESPACE_FLAG, targetNamespace))
+			Expect(err).ToNot(HaveOccurred())
+			verifyImported()
+
+			// the VirtualMachineExport is needed to populate the volumes
+			_, err = virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Get(context.Background(), vme.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should import the VirtualMachine into the namespace of the target kubeconfig", func() {
+			serveManifests(exportedManifests(exportedVM()))
+
+			kubeconfig := filepath.Join(GinkgoT().TempDir(), "kubeconfig")
+			Expect(os.WriteFile(kubeconfig, []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: target
+  cluster:
+    server: https://target.invalid
+contexts:
+- name: target
+  context:
+    cluster: target
+    namespace: %s
+current-context: target
+`, targetNamespace)), 0600)).To(Succeed())
+
+			err := runImportCmd(setFlag(vmexport.TARGET_KUBECONFIG_FLAG, kubeconfig))
+			Expect(err).ToNot(HaveOccurred())
+			verifyImported()
+		})
+
+		It("should reuse the resources of a previous import", func() {
+			serveManifests(exportedManifests(exportedVM()))
+
+			Expect(runImportCmd(setFlag(vmexport.TARGET_NAMESPACE_FLAG, targetNamespace))).To(Succeed())
+			Expect(runImportCmd(setFlag(vmexport.TARGET_NAMESPACE_FLAG, targetNamespace))).To(Succeed())
+			verifyImported()
+		})
+
+		It("should fail if a resource with different content exists in the target namespace", func() {
+			serveManifests(exportedManifests(exportedVM()))
+			_, err := kubeClient.CoreV1().ConfigMaps(targetNamespace).Create(context.Background(), &k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "export-ca-cm-test-vme"},
+				Data:       map[string]string{"ca.pem": "other"},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runImportCmd(setFlag(vmexport.TARGET_NAMESPACE_FLAG, targetNamespace))
+			Expect(err).To(MatchError(fmt.Sprintf("ConfigMap '%s/export-ca-cm-test-vme' already exists and differs from the exported one", targetNamespace)))
+		})
+
+		It("should fail if the exported manifests do not contain a VirtualMachine", func() {
+			serveManifests(exportedManifests(nil))
+
+			err := runImportCmd(setFlag(vmexport.TARGET_NAMESPACE_FLAG, targetNamespace))
+			Expect(err).To(MatchError(ContainSubstring("unable to find the VirtualMachine manifest")))
+		})
+
+		DescribeTable("should fail with incompatible flags", func(expected string, args ...string) {
+			err := runImportCmd(args...)
+			Expect(err).To(MatchError(expected))
+		},
+			Entry("pvc source", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PVC_FLAG, vmexport.IMPORT), setFlag(vmexport.PVC_FLAG, pvcName)),
+			Entry("output", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.OUTPUT_FLAG, vmexport.IMPORT), setFlag(vmexport.OUTPUT_FLAG, "disk.img")),
+			Entry("port-forward", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PORT_FORWARD_FLAG, vmexport.IMPORT), vmexport.PORT_FORWARD_FLAG),
+			Entry("delete-vme", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.DELETE_FLAG, vmexport.IMPORT), vmexport.DELETE_FLAG),
+		)
+
+		DescribeTable("should fail when the target is used without import", func(expected string, run func(...string) error, args ...string) {
+			err := run(args...)
+			Expect(err).To(MatchError(expected))
+		},
+			Entry("create", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.TARGET_NAMESPACE_FLAG, vmexport.CREATE), runCreateCmd, setFlag(vmexport.VM_FLAG, "test"), setFlag(vmexport.TARGET_NAMESPACE_FLAG, targetNamespace)),
+			Entry("delete", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.TARGET_KUBECONFIG_FLAG, vmexport.DELETE), runDeleteCmd, setFlag(vmexport.TARGET_KUBECONFIG_FLAG, "kubeconfig")),
+			Entry("download", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.TARGET_CONTEXT_FLAG, vmexport.DOWNLOAD), runDownloadCmd, setFlag(vmexport.OUTPUT_FLAG, "disk.img"), setFlag(vmexport.TARGET_CONTEXT_FLAG, "target")),
+		)
+	})
+
 	Context("Port-forward", func() {
 		const (
 			localPort    = uint16(5432)
@@ -952,6 +1288,60 @@ var _ = Describe("vmexport", func() {
 			Expect(url).Should(Equal("raw"))
 		})
 
+		It("Should get qcow2 URL only when requested", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{
+						Format: exportv1.KubeVirtRaw,
+						Url:    "raw",
+					},
+					{
+						Format: exportv1.KubeVirtQcow2,
+						Url:    "qcow2",
+					},
+					{
+						Format: exportv1.KubeVirtGz,
+						Url:    "compressed",
+					},
+				}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:       vme.Name,
+				VolumeName: volumeName,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("compressed"))
+
+			vmeInfo.Qcow2 = true
+			vmeInfo.Decompress = true
+			url, err = vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal("qcow2"))
+			Expect(vmeInfo.Decompress).To(BeFalse())
+		})
+
+		It("Should not get any URL when qcow2 is requested but not available", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtGz,
+					Url:    "compressed",
+				}}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:       vme.Name,
+				VolumeName: volumeName,
+				Qcow2:      true,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).To(MatchError(ContainSubstring("unable to get a valid URL")))
+			Expect(url).To(Equal(""))
+		})
+
 		It("Should not get any URL when there's no valid options", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
 				Name: volumeName,
@@ -995,3 +1385,8 @@ func runDownloadCmd(args ...string) error {
 	_args := append([]string{"vmexport", vmexport.DOWNLOAD, vmeName}, args...)
 	return testing.NewRepeatableVirtctlCommand(_args...)()
 }
+
+func runImportCmd(args ...string) error {
+	_args := append([]string{"vmexport", vmexport.IMPORT, vmeName}, args...)
+	return testing.NewRepeatableVirtctlCommand(_args...)()
+}
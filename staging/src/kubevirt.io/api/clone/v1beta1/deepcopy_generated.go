@@ -120,6 +120,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(v1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetNamespace != nil {
+		in, out := &in.TargetNamespace, &out.TargetNamespace
+		*out = new(string)
+		**out = **in
+	}
 	if in.AnnotationFilters != nil {
 		in, out := &in.AnnotationFilters, &out.AnnotationFilters
 		*out = make([]string, len(*in))
@@ -66,6 +66,12 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace the target is created in. It defaults to the namespace of the clone.
+	// Cloning into another namespace requires the same permissions in the source namespace as a CDI
+	// cross-namespace clone.
+	// +optional
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
@@ -24,6 +24,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -151,6 +152,11 @@ type CloudInitNoCloudSource struct {
 	// NetworkData contains NoCloud inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the
+	// VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+	// Cannot be combined with another networkdata source.
+	// + optional
+	GenerateNetworkData *bool `json:"generateNetworkData,omitempty"`
 }
 
 // Represents a cloud-init config drive user data source.
@@ -174,6 +180,11 @@ type CloudInitConfigDriveSource struct {
 	// NetworkData contains config drive inline cloud-init networkdata.
 	// + optional
 	NetworkData string `json:"networkData,omitempty"`
+	// GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the
+	// VMI interfaces, their MAC addresses, the network status and the DNS configuration.
+	// Cannot be combined with another networkdata source.
+	// + optional
+	GenerateNetworkData *bool `json:"generateNetworkData,omitempty"`
 }
 
 type DomainSpec struct {
@@ -850,6 +861,8 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// BackupTarget is attached to the virt launcher and receives the push mode backups of the vmi disks
+	BackupTarget *BackupTargetVolumeSource `json:"backupTarget,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -892,6 +905,13 @@ type MemoryDumpVolumeSource struct {
 	PersistentVolumeClaimVolumeSource `json:",inline"`
 }
 
+type BackupTargetVolumeSource struct {
+	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
+	// Directly attached to the virt launcher
+	// +optional
+	PersistentVolumeClaimVolumeSource `json:",inline"`
+}
+
 type EphemeralVolumeSource struct {
 	// PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.
 	// Directly attached to the vmi via qemu.
@@ -1305,6 +1325,113 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Bandwidth limits the traffic passing through the interface.
+	// It can be updated on a running VMI.
+	// Not supported for SR-IOV interfaces.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+	// Firewall filters the traffic passing through the interface.
+	// Only supported for bridge binding.
+	// +optional
+	Firewall *InterfaceFirewall `json:"firewall,omitempty"`
+}
+
+// InterfaceFirewall filters the L3/L4 traffic of an interface, as seen from the guest.
+// It is enforced inside the virt-launcher pod network namespace, so it also applies to
+// secondary networks which are not covered by Kubernetes NetworkPolicies.
+// The rules are part of the VM spec, their peers are selected by CIDR or by the labels of
+// the VMIs in the same namespace.
+// Filtering the ingress or egress traffic requires the nf_conntrack_bridge kernel module
+// on the node.
+type InterfaceFirewall struct {
+	// Ingress filters the traffic received by the guest.
+	// If not specified, all inbound traffic is allowed.
+	// +optional
+	Ingress *FirewallRules `json:"ingress,omitempty"`
+	// Egress filters the traffic sent by the guest.
+	// If not specified, all outbound traffic is allowed.
+	// +optional
+	Egress *FirewallRules `json:"egress,omitempty"`
+	// AntiSpoofing drops the traffic sent by the guest with a source MAC address other
+	// than the one assigned to the interface. When the pod interface of the network has an
+	// IPv4 address, which the guest receives by DHCP, traffic with another source IPv4
+	// address is dropped too. IPv6 source addresses are not checked.
+	// Defaults to true.
+	// +optional
+	AntiSpoofing *bool `json:"antiSpoofing,omitempty"`
+}
+
+// FirewallRules allows traffic in one direction. Traffic not allowed by any rule is dropped,
+// except replies to allowed traffic and address resolution (ARP, IPv6 neighbor discovery).
+type FirewallRules struct {
+	// Allow lists the allowed traffic. An empty list drops all traffic.
+	// +optional
+	// +listType=atomic
+	Allow []FirewallRule `json:"allow,omitempty"`
+}
+
+type FirewallRule struct {
+	// Protocol of the allowed traffic.
+	// One of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.
+	// +optional
+	Protocol FirewallProtocol `json:"protocol,omitempty"`
+	// Ports lists the allowed destination ports. Only supported for TCP and UDP.
+	// If not specified, all ports are allowed.
+	// +optional
+	// +listType=atomic
+	Ports []FirewallPort `json:"ports,omitempty"`
+	// Networks lists the allowed peer networks in CIDR notation, the source for ingress
+	// and the destination for egress. If neither networks nor a peer selector are
+	// specified, all peers are allowed.
+	// +optional
+	// +listType=atomic
+	Networks []string `json:"networks,omitempty"`
+	// PeerSelector allows the VMIs in the same namespace matching the selector as peers,
+	// in addition to the peer networks. The addresses reported in the status of the
+	// selected VMIs are allowed, and they are updated as the VMIs come and go.
+	// Until the selected peers are known, the rule allows no peers besides the networks.
+	// +optional
+	PeerSelector *metav1.LabelSelector `json:"peerSelector,omitempty"`
+}
+
+type FirewallProtocol string
+
+const (
+	FirewallProtocolTCP    FirewallProtocol = "TCP"
+	FirewallProtocolUDP    FirewallProtocol = "UDP"
+	FirewallProtocolICMP   FirewallProtocol = "ICMP"
+	FirewallProtocolICMPv6 FirewallProtocol = "ICMPv6"
+)
+
+type FirewallPort struct {
+	// Port is the allowed port, or the first port of the allowed range if EndPort is set.
+	Port int32 `json:"port"`
+	// EndPort is the last port of the allowed range.
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// InterfaceBandwidth limits the traffic of an interface per direction, as seen from the guest.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimit `json:"outbound,omitempty"`
+}
+
+// BandwidthLimit shapes the traffic of an interface in one direction.
+type BandwidthLimit struct {
+	// Average is the average bit rate of the shaped traffic, in kibibytes per second.
+	Average uint32 `json:"average"`
+	// Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.
+	// Must not be lower than Average.
+	// +optional
+	Peak *uint32 `json:"peak,omitempty"`
+	// Burst is the amount of data which can be sent at the peak rate, in kibibytes.
+	// +optional
+	Burst *uint32 `json:"burst,omitempty"`
 }
 
 type InterfaceState string
@@ -1425,7 +1552,17 @@ type Port struct {
 	Protocol string `json:"protocol,omitempty"`
 	// Number of port to expose for the virtual machine.
 	// This must be a valid port number, 0 < x < 65536.
+	// If EndPort is specified, this is the first port of the range.
 	Port int32 `json:"port"`
+	// EndPort is the last port of the range to expose, starting at Port.
+	// Supported only by the masquerade binding.
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+	// Exclude marks the port, or the range, as not exposed.
+	// When all the ports of an interface are excluded, all the other ports are exposed.
+	// Supported only by the masquerade binding.
+	// +optional
+	Exclude bool `json:"exclude,omitempty"`
 }
 
 type AccessCredentialSecretSource struct {
@@ -1598,6 +1735,12 @@ type MultusNetwork struct {
 	// Select the default network and add it to the
 	// multus-cni.io/default-network annotation.
 	Default bool `json:"default,omitempty"`
+
+	// IPPool is the name of an IP pool in the VMI namespace to allocate the
+	// interface address from. The address is served to the guest over DHCP.
+	// Only supported for bridge binding, on networks without an IPAM plugin.
+	// +optional
+	IPPool string `json:"ipPool,omitempty"`
 }
 
 // CPUTopology allows specifying the amount of cores, sockets
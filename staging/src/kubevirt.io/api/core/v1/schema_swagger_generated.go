@@ -67,6 +67,7 @@ func (CloudInitNoCloudSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains NoCloud inline cloud-init networkdata.\n+ optional",
+		"generateNetworkData":  "GenerateNetworkData generates the NoCloud networkdata (network-config version 2) from the\nVMI interfaces, their MAC addresses, the network status and the DNS configuration.\nCannot be combined with another networkdata source.\n+ optional",
 	}
 }
 
@@ -79,6 +80,7 @@ func (CloudInitConfigDriveSource) SwaggerDoc() map[string]string {
 		"networkDataSecretRef": "NetworkDataSecretRef references a k8s secret that contains config drive networkdata.\n+ optional",
 		"networkDataBase64":    "NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.\n+ optional",
 		"networkData":          "NetworkData contains config drive inline cloud-init networkdata.\n+ optional",
+		"generateNetworkData":  "GenerateNetworkData generates the config drive networkdata (OpenStack network_data.json) from the\nVMI interfaces, their MAC addresses, the network status and the DNS configuration.\nCannot be combined with another networkdata source.\n+ optional",
 	}
 }
 
@@ -451,6 +453,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"backupTarget":          "BackupTarget is attached to the virt launcher and receives the push mode backups of the vmi disks",
 	}
 }
 
@@ -480,6 +483,10 @@ func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (BackupTargetVolumeSource) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"persistentVolumeClaim": "PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace.\nDirectly attached to the vmi via qemu.\nMore info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims\n+optional",
@@ -688,6 +695,57 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic passing through the interface.\nIt can be updated on a running VMI.\nNot supported for SR-IOV interfaces.\n+optional",
+		"firewall":    "Firewall filters the traffic passing through the interface.\nOnly supported for bridge binding.\n+optional",
+	}
+}
+
+func (InterfaceFirewall) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InterfaceFirewall filters the L3/L4 traffic of an interface, as seen from the guest.\nIt is enforced inside the virt-launcher pod network namespace, so it also applies to\nsecondary networks which are not covered by Kubernetes NetworkPolicies.\nThe rules are part of the VM spec, their peers are selected by CIDR or by the labels of\nthe VMIs in the same namespace.\nFiltering the ingress or egress traffic requires the nf_conntrack_bridge kernel module\non the node.",
+		"ingress":      "Ingress filters the traffic received by the guest.\nIf not specified, all inbound traffic is allowed.\n+optional",
+		"egress":       "Egress filters the traffic sent by the guest.\nIf not specified, all outbound traffic is allowed.\n+optional",
+		"antiSpoofing": "AntiSpoofing drops the traffic sent by the guest with a source MAC address other\nthan the one assigned to the interface. When the pod interface of the network has an\nIPv4 address, which the guest receives by DHCP, traffic with another source IPv4\naddress is dropped too. IPv6 source addresses are not checked.\nDefaults to true.\n+optional",
+	}
+}
+
+func (FirewallRules) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "FirewallRules allows traffic in one direction. Traffic not allowed by any rule is dropped,\nexcept replies to allowed traffic and address resolution (ARP, IPv6 neighbor discovery).",
+		"allow": "Allow lists the allowed traffic. An empty list drops all traffic.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FirewallRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"protocol":     "Protocol of the allowed traffic.\nOne of: TCP, UDP, ICMP, ICMPv6. If not specified, all protocols are allowed.\n+optional",
+		"ports":        "Ports lists the allowed destination ports. Only supported for TCP and UDP.\nIf not specified, all ports are allowed.\n+optional\n+listType=atomic",
+		"networks":     "Networks lists the allowed peer networks in CIDR notation, the source for ingress\nand the destination for egress. If neither networks nor a peer selector are\nspecified, all peers are allowed.\n+optional\n+listType=atomic",
+		"peerSelector": "PeerSelector allows the VMIs in the same namespace matching the selector as peers,\nin addition to the peer networks. The addresses reported in the status of the\nselected VMIs are allowed, and they are updated as the VMIs come and go.\nUntil the selected peers are known, the rule allows no peers besides the networks.\n+optional",
+	}
+}
+
+func (FirewallPort) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"port":    "Port is the allowed port, or the first port of the allowed range if EndPort is set.",
+		"endPort": "EndPort is the last port of the allowed range.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth limits the traffic of an interface per direction, as seen from the guest.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimit shapes the traffic of an interface in one direction.",
+		"average": "Average is the average bit rate of the shaped traffic, in kibibytes per second.",
+		"peak":    "Peak is the maximum rate at which the traffic can be sent, in kibibytes per second.\nMust not be lower than Average.\n+optional",
+		"burst":   "Burst is the amount of data which can be sent at the peak rate, in kibibytes.\n+optional",
 	}
 }
 
@@ -766,7 +824,9 @@ func (Port) SwaggerDoc() map[string]string {
 		"":         "Port represents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory",
 		"name":     "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each\nnamed port in a pod must have a unique name. Name for the port that can be\nreferred to by services.\n+optional",
 		"protocol": "Protocol for port. Must be UDP or TCP.\nDefaults to \"TCP\".\n+optional",
-		"port":     "Number of port to expose for the virtual machine.\nThis must be a valid port number, 0 < x < 65536.",
+		"port":     "Number of port to expose for the virtual machine.\nThis must be a valid port number, 0 < x < 65536.\nIf EndPort is specified, this is the first port of the range.",
+		"endPort":  "EndPort is the last port of the range to expose, starting at Port.\nSupported only by the masquerade binding.\n+optional",
+		"exclude":  "Exclude marks the port, or the range, as not exposed.\nWhen all the ports of an interface are excluded, all the other ports are exposed.\nSupported only by the masquerade binding.\n+optional",
 	}
 }
 
@@ -887,6 +947,7 @@ func (MultusNetwork) SwaggerDoc() map[string]string {
 		"":            "Represents the multus cni network.",
 		"networkName": "References to a NetworkAttachmentDefinition CRD object. Format:\n<networkName>, <namespace>/<networkName>. If namespace is not\nspecified, VMI namespace is assumed.",
 		"default":     "Select the default network and add it to the\nmultus-cni.io/default-network annotation.",
+		"ipPool":      "IPPool is the name of an IP pool in the VMI namespace to allocate the\ninterface address from. The address is served to the guest over DHCP.\nOnly supported for bridge binding, on networks without an IPAM plugin.\n+optional",
 	}
 }
 
@@ -300,6 +300,55 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+
+	// BackupState represents the state of the last backup of the VMI disks
+	// +optional
+	BackupState *VirtualMachineInstanceBackupState `json:"backupState,omitempty"`
+
+	// IPAllocations lists the addresses allocated to the VMI interfaces from IP pools
+	// +listType=atomic
+	// +optional
+	IPAllocations []VirtualMachineInstanceIPAllocation `json:"ipAllocations,omitempty"`
+
+	// FirewallPeers lists the addresses of the peers selected by labels in the firewall rules of the VMI interfaces
+	// +listType=atomic
+	// +optional
+	FirewallPeers []VirtualMachineInstanceFirewallPeers `json:"firewallPeers,omitempty"`
+}
+
+// VirtualMachineInstanceIPAllocation is an address allocated to a VMI interface from an IP pool
+type VirtualMachineInstanceIPAllocation struct {
+	// Name of the interface, corresponds to name of the network assigned to the interface
+	Name string `json:"name"`
+	// IPPool is the name of the IP pool the address is allocated from
+	IPPool string `json:"ipPool"`
+	// Address is the allocated IP address with its prefix length, e.g. 192.168.10.5/24
+	Address string `json:"address"`
+	// Gateway is the default gateway of the IP pool
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// VirtualMachineInstanceFirewallPeers holds the peers selected by labels in the firewall rules of a VMI interface
+type VirtualMachineInstanceFirewallPeers struct {
+	// Name of the interface
+	Name string `json:"name"`
+	// Ingress lists the peers of the ingress allow rules, in the order of the rules
+	// +listType=atomic
+	// +optional
+	Ingress []FirewallRulePeers `json:"ingress,omitempty"`
+	// Egress lists the peers of the egress allow rules, in the order of the rules
+	// +listType=atomic
+	// +optional
+	Egress []FirewallRulePeers `json:"egress,omitempty"`
+}
+
+// FirewallRulePeers holds the addresses of the peers selected by a firewall allow rule
+type FirewallRulePeers struct {
+	// Addresses of the selected peers, empty for a rule without a peer selector
+	// +listType=atomic
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
 }
 
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
@@ -368,6 +417,8 @@ type VolumeStatus struct {
 	Size int64 `json:"size,omitempty"`
 	// If the volume is memorydump volume, this will contain the memorydump info.
 	MemoryDumpVolume *DomainMemoryDumpInfo `json:"memoryDumpVolume,omitempty"`
+	// If the volume is a backup target volume, this will contain the backup target info.
+	BackupTargetVolume *BackupTargetInfo `json:"backupTargetVolume,omitempty"`
 	// ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk
 	ContainerDiskVolume *ContainerDiskInfo `json:"containerDiskVolume,omitempty"`
 }
@@ -392,6 +443,12 @@ type KernelBootStatus struct {
 	InitrdInfo *InitrdInfo `json:"initrdInfo,omitempty"`
 }
 
+// BackupTargetInfo represents the backup target volume information
+type BackupTargetInfo struct {
+	// ClaimName is the name of the pvc the push mode backups are written to
+	ClaimName string `json:"claimName,omitempty"`
+}
+
 // DomainMemoryDumpInfo represents the memory dump information
 type DomainMemoryDumpInfo struct {
 	// StartTimestamp is the time when the memory dump started
@@ -636,6 +693,9 @@ const (
 	// VirtualMachineInstanceMigrationAbortRequested indicates that live migration abort has been requested
 	VirtualMachineInstanceMigrationAbortRequested          VirtualMachineInstanceMigrationConditionType = "migrationAbortRequested"
 	VirtualMachineInstanceMigrationRejectedByResourceQuota VirtualMachineInstanceMigrationConditionType = "migrationRejectedByResourceQuota"
+	// VirtualMachineInstanceMigrationPreempted indicates that the target pod of the migration was deleted in favor of a
+	// migration with a higher priority, and that the migration waits again for a free migration slot
+	VirtualMachineInstanceMigrationPreempted VirtualMachineInstanceMigrationConditionType = "migrationPreempted"
 )
 
 type VirtualMachineInstanceCondition struct {
@@ -856,6 +916,29 @@ type VirtualMachineInstanceMigrationState struct {
 	TargetState *VirtualMachineInstanceMigrationTargetState `json:"targetState,omitempty"`
 	// The type of migration network, either 'pod' or 'migration'
 	MigrationNetworkType MigrationNetworkType `json:"migrationNetworkType,omitempty"`
+	// Progress reports the progress of the migration, as observed by the migration source
+	Progress *MigrationProgress `json:"progress,omitempty"`
+}
+
+// MigrationProgress reports the progress of a live migration
+type MigrationProgress struct {
+	// The time the progress was last updated
+	UpdateTimestamp *metav1.Time `json:"updateTimestamp,omitempty"`
+	// The amount of data transferred to the migration target, in bytes
+	DataProcessedBytes int64 `json:"dataProcessedBytes,omitempty"`
+	// The amount of data remaining to be transferred to the migration target, in bytes
+	DataRemainingBytes int64 `json:"dataRemainingBytes,omitempty"`
+	// The total amount of data to be transferred to the migration target, in bytes
+	DataTotalBytes int64 `json:"dataTotalBytes,omitempty"`
+	// The rate in which the guest dirties its memory, in bytes per second
+	MemoryDirtyRateBytesPerSecond int64 `json:"memoryDirtyRateBytesPerSecond,omitempty"`
+	// The data transfer throughput, in bytes per second
+	ThroughputBytesPerSecond int64 `json:"throughputBytesPerSecond,omitempty"`
+	// The number of memory copy iterations performed so far
+	Iteration int64 `json:"iteration,omitempty"`
+	// The estimated number of seconds until the migration completes.
+	// Not set when the migration does not converge, i.e. the guest dirties its memory faster than it is transferred.
+	EstimatedCompletionSeconds *int64 `json:"estimatedCompletionSeconds,omitempty"`
 }
 
 type MigrationAbortStatus string
@@ -1138,6 +1221,11 @@ const (
 	// originated from.
 	VirtualMachinePoolRevisionName string = "kubevirt.io/vm-pool-revision-name"
 
+	// VirtualMachinePoolRetainedFromAnnotation is set on DataVolumes and PVCs a
+	// VirtualMachinePool retained when removing a VM during scale-in. It stores the
+	// UID of the removed VM.
+	VirtualMachinePoolRetainedFromAnnotation string = "kubevirt.io/vm-pool-retained-from"
+
 	// VirtualMachineNameLabel is the name of the Virtual Machine
 	VirtualMachineNameLabel string = "vm.kubevirt.io/name"
 
@@ -1469,8 +1557,28 @@ type VirtualMachineInstanceMigrationSpec struct {
 	SendTo *VirtualMachineInstanceMigrationSource `json:"sendTo,omitempty"`
 	// If receieve is specified, this VirtualMachineInstanceMigration will be considered the target
 	Receive *VirtualMachineInstanceMigrationTarget `json:"receive,omitempty"`
+
+	// Priority of the migration. Pending migrations are started in order of priority and creation time,
+	// and a migration may preempt pending migrations of a lower priority whose target pod is not ready yet.
+	// Preempted migrations are moved back to the Pending phase. The system-critical and system-maintenance priorities
+	// are reserved to KubeVirt and to users allowed to "use" them as "migrationpriorities" of the kubevirt.io group.
+	// Defaults to system-critical for evacuations, system-maintenance for workload updates and user-triggered otherwise.
+	// +optional
+	Priority *MigrationPriority `json:"priority,omitempty"`
 }
 
+// MigrationPriority defines the order in which pending migrations are started
+type MigrationPriority string
+
+const (
+	// MigrationPrioritySystemCritical is used by migrations which keep the cluster operational, like node drain evacuations
+	MigrationPrioritySystemCritical MigrationPriority = "system-critical"
+	// MigrationPriorityUserTriggered is used by migrations which were requested by a user
+	MigrationPriorityUserTriggered MigrationPriority = "user-triggered"
+	// MigrationPrioritySystemMaintenance is used by background migrations, like workload updates
+	MigrationPrioritySystemMaintenance MigrationPriority = "system-maintenance"
+)
+
 type VirtualMachineInstanceMigrationSource struct {
 	// A unique identifier to identify this migration.
 	MigrationID string `json:"migrationID"`
@@ -2105,6 +2213,50 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restricts automated workload updates to recurring time windows.
+	// Outdated VMIs are only migrated or evicted while at least one window is open.
+	//
+	// An empty list allows workload updates at any time
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []WorkloadUpdateMaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// ExcludedNamespaces lists namespaces whose VMIs are never disrupted by
+	// automated workload updates
+	//
+	// +listType=set
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// ExcludedSelector opts VMIs matching the label selector out of
+	// automated workload updates
+	//
+	// +optional
+	ExcludedSelector *metav1.LabelSelector `json:"excludedSelector,omitempty"`
+}
+
+// WorkloadUpdateMaintenanceWindow defines a recurring time window in which
+// automated workload updates are allowed
+type WorkloadUpdateMaintenanceWindow struct {
+	// Schedule is a cron expression, evaluated in UTC, defining when the window opens
+	Schedule string `json:"schedule"`
+	// Duration defines how long the window stays open
+	Duration metav1.Duration `json:"duration"`
+}
+
+// KubeVirtWorkloadUpdateStatus reports the progress of automated workload updates
+type KubeVirtWorkloadUpdateStatus struct {
+	// PendingVirtualMachineInstances is the number of outdated VMIs waiting to be updated
+	PendingVirtualMachineInstances int `json:"pendingVirtualMachineInstances"`
+	// ExcludedVirtualMachineInstances is the number of outdated VMIs opted out of automated updates
+	ExcludedVirtualMachineInstances int `json:"excludedVirtualMachineInstances"`
+	// MaintenanceWindowOpen indicates whether workload updates are currently allowed
+	MaintenanceWindowOpen bool `json:"maintenanceWindowOpen"`
+	// NextMaintenanceWindow is the time the next maintenance window opens
+	// +optional
+	NextMaintenanceWindow *metav1.Time `json:"nextMaintenanceWindow,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -2253,7 +2405,8 @@ type KubeVirtStatus struct {
 	ObservedGeneration                      *int64              `json:"observedGeneration,omitempty"`
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
-	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	Generations          []GenerationStatus            `json:"generations,omitempty" optional:"true"`
+	WorkloadUpdateStatus *KubeVirtWorkloadUpdateStatus `json:"workloadUpdateStatus,omitempty" optional:"true"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2421,6 +2574,36 @@ type MigrateOptions struct {
 	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
 }
 
+// VirtualMachineInstanceMigrationCheck reports whether a VirtualMachineInstance can be live migrated, and to which nodes.
+// It is evaluated without creating a migration target pod.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceMigrationCheck struct {
+	metav1.TypeMeta `json:",inline"`
+	// Migratable is true when the VirtualMachineInstance can be migrated to at least one of the nodes
+	Migratable bool `json:"migratable"`
+	// Reasons why the VirtualMachineInstance cannot be migrated, regardless of the target node
+	// +optional
+	// +listType=atomic
+	Reasons []string `json:"reasons,omitempty"`
+	// Nodes holds the verdict of each candidate target node
+	// +optional
+	// +listType=atomic
+	Nodes []NodeMigrationCheck `json:"nodes,omitempty"`
+}
+
+// NodeMigrationCheck holds the verdict of a candidate migration target node
+type NodeMigrationCheck struct {
+	// Name of the node
+	Name string `json:"name"`
+	// Compatible is true when the node can host the migrated VirtualMachineInstance
+	Compatible bool `json:"compatible"`
+	// Reasons why the node cannot host the migrated VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	Reasons []string `json:"reasons,omitempty"`
+}
+
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2504,6 +2687,96 @@ type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// BackupMode defines how the data of a VirtualMachineInstance backup is delivered
+type BackupMode string
+
+const (
+	// BackupModePush makes the hypervisor write the backup of every disk into a qcow2 file
+	BackupModePush BackupMode = "Push"
+	// BackupModePull makes the hypervisor expose every disk, and the dirty bitmap of incremental
+	// backups, through an NBD server so that a third party can read them
+	BackupModePull BackupMode = "Pull"
+)
+
+// VirtualMachineInstanceBackupOptions is provided when starting a backup of a running VirtualMachineInstance
+type VirtualMachineInstanceBackupOptions struct {
+	// BackupName uniquely identifies the backup on the VirtualMachineInstance.
+	// It is also the name of the checkpoint created together with the backup.
+	BackupName string `json:"backupName"`
+	// Mode defines how the backup data is delivered. Defaults to Push.
+	// +optional
+	Mode BackupMode `json:"mode,omitempty"`
+	// IncrementalFrom is the name of a previous checkpoint. When set, only the blocks
+	// changed since that checkpoint are backed up. When empty, a full backup is taken.
+	// +optional
+	IncrementalFrom *string `json:"incrementalFrom,omitempty"`
+	// TargetVolume is the name of the BackupTarget volume the disks are written to in Push mode.
+	// +optional
+	TargetVolume string `json:"targetVolume,omitempty"`
+	// Stop ends the backup named BackupName. A Pull mode backup completes once its client
+	// is done reading the exports, a Push mode backup is aborted.
+	// +optional
+	Stop bool `json:"stop,omitempty"`
+}
+
+// VirtualMachineInstanceBackupState represents the state of the last backup of a VirtualMachineInstance
+type VirtualMachineInstanceBackupState struct {
+	// BackupName is the name of the backup
+	BackupName string `json:"backupName,omitempty"`
+	// Mode is the mode the backup was taken with
+	// +optional
+	Mode BackupMode `json:"mode,omitempty"`
+	// CheckpointName is the name of the checkpoint created together with the backup.
+	// It can be used as the base of a future incremental backup.
+	// +optional
+	CheckpointName string `json:"checkpointName,omitempty"`
+	// IncrementalFrom is the checkpoint the backup is relative to, empty for full backups
+	// +optional
+	IncrementalFrom string `json:"incrementalFrom,omitempty"`
+	// Endpoint is the unix socket of the NBD server exposing the backup in Pull mode.
+	// The server is reachable through the nbd subresource.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// The time the backup started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// The time the backup ended
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Indicates the backup completed
+	// +optional
+	Completed bool `json:"completed,omitempty"`
+	// Indicates that the backup failed
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+	// FailureReason is a detailed message about the failure of the backup
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+	// Volumes lists where the data of every backed up volume can be found
+	// +optional
+	// +listType=atomic
+	Volumes []VirtualMachineInstanceBackupVolume `json:"volumes,omitempty"`
+}
+
+// VirtualMachineInstanceBackupVolume describes the backup of a single volume
+type VirtualMachineInstanceBackupVolume struct {
+	// VolumeName is the name of the backed up volume
+	VolumeName string `json:"volumeName"`
+	// Incremental indicates that only the blocks changed since the base checkpoint are
+	// part of the backup. Volumes which cannot track changed blocks are always fully copied.
+	// +optional
+	Incremental bool `json:"incremental,omitempty"`
+	// TargetFile is the qcow2 file the volume is written to in Push mode
+	// +optional
+	TargetFile string `json:"targetFile,omitempty"`
+	// ExportName is the NBD export of the volume in Pull mode
+	// +optional
+	ExportName string `json:"exportName,omitempty"`
+	// ExportBitmap is the dirty bitmap published alongside ExportName for incremental Pull backups
+	// +optional
+	ExportBitmap string `json:"exportBitmap,omitempty"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2573,6 +2846,16 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// PcapOptions are the options of a packet capture on a VMI interface
+type PcapOptions struct {
+	// InterfaceName is the name of the VMI interface to capture the packets of
+	InterfaceName string `json:"interfaceName"`
+	// Filter is a classic BPF program in the format printed by `tcpdump -ddd`,
+	// with the instructions separated by commas. All the packets are captured when omitted.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -2890,7 +3173,56 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
-}
+	// Compression configures the compression of the live migration stream. Defaults to no compression
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// ParallelStreams is the number of parallel connections used to transfer the guest memory.
+	// Parallel connections are used along with post-copy when QEMU supports it (QEMU 10.0 or newer),
+	// otherwise a migration which allows post-copy uses a single connection, and fails when it is
+	// configured with a compression method which requires parallel connections.
+	// By default, KubeVirt uses parallel connections only for pre-copy migrations of VMIs without a CPU limit.
+	ParallelStreams *uint32 `json:"parallelStreams,omitempty"`
+	// ZeroCopy enables sending the guest memory over the parallel connections without copying it first.
+	// It requires ParallelStreams and is incompatible with compression. The guest memory is locked on both nodes,
+	// and zero-copy is not used when post-copy is allowed or when the memory cannot be locked.
+	// Defaults to false
+	ZeroCopy *bool `json:"zeroCopy,omitempty"`
+	// ZeroPageDetection configures how the guest memory pages which only hold zeros are detected, so that they are
+	// not transferred. The legacy method detects them in the main migration thread, the multifd method in the
+	// threads of the parallel connections, and falls back to the legacy method without parallel connections.
+	// Defaults to the QEMU default, which is multifd with QEMU 9.0 or newer.
+	// +optional
+	ZeroPageDetection *MigrationZeroPageDetection `json:"zeroPageDetection,omitempty"`
+}
+
+// MigrationCompression configures the compression of the live migration stream
+type MigrationCompression struct {
+	// Method is the compression method.
+	// The zlib and zstd methods compress the parallel connections and require ParallelStreams to be set.
+	// The xbzrle method only sends the changes of memory pages which are transferred again in later iterations.
+	Method MigrationCompressionMethod `json:"method"`
+	// Level is the compression level of the zlib (0-9) and zstd (0-20) methods
+	// +optional
+	Level *int32 `json:"level,omitempty"`
+	// XBZRLECacheSize is the size of the page cache used by the xbzrle method
+	// +optional
+	XBZRLECacheSize *resource.Quantity `json:"xbzrleCacheSize,omitempty"`
+}
+
+type MigrationCompressionMethod string
+
+const (
+	MigrationCompressionXBZRLE MigrationCompressionMethod = "xbzrle"
+	MigrationCompressionZlib   MigrationCompressionMethod = "zlib"
+	MigrationCompressionZstd   MigrationCompressionMethod = "zstd"
+)
+
+type MigrationZeroPageDetection string
+
+const (
+	MigrationZeroPageDetectionNone    MigrationZeroPageDetection = "none"
+	MigrationZeroPageDetectionLegacy  MigrationZeroPageDetection = "legacy"
+	MigrationZeroPageDetectionMultifd MigrationZeroPageDetection = "multifd"
+)
 
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
@@ -3061,7 +3393,7 @@ type InterfaceBindingPlugin struct {
 	// version: 1alphav1
 	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
 	// DomainAttachmentType is a standard domain network attachment method kubevirt supports.
-	// Supported values: "tap", "managedTap" (since v1.4).
+	// Supported values: "tap", "managedTap" (since v1.4), "vhostUser".
 	// The standard domain attachment can be used instead or in addition to the sidecarImage.
 	// version: 1alphav1
 	DomainAttachmentType DomainAttachmentType `json:"domainAttachmentType,omitempty"`
@@ -3104,6 +3436,10 @@ const (
 	// ManagedTap domain attachment type is binding an ethernet connection into guests using a tap device.
 	// The tap device is created (unless already present) on the network pod interface with a Linux bridge.
 	ManagedTap DomainAttachmentType = "managedTap"
+	// VhostUser domain attachment type is binding a userspace datapath into guests using a vhost-user socket.
+	// The socket is reported by the network provider through the "vhost-user" device-info of the network status,
+	// and the guest memory is shared with the datapath.
+	VhostUser DomainAttachmentType = "vhostUser"
 )
 
 type NetworkBindingDownwardAPIType string
@@ -83,6 +83,35 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"backupState":                   "BackupState represents the state of the last backup of the VMI disks\n+optional",
+		"ipAllocations":                 "IPAllocations lists the addresses allocated to the VMI interfaces from IP pools\n+listType=atomic\n+optional",
+		"firewallPeers":                 "FirewallPeers lists the addresses of the peers selected by labels in the firewall rules of the VMI interfaces\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceIPAllocation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineInstanceIPAllocation is an address allocated to a VMI interface from an IP pool",
+		"name":    "Name of the interface, corresponds to name of the network assigned to the interface",
+		"ipPool":  "IPPool is the name of the IP pool the address is allocated from",
+		"address": "Address is the allocated IP address with its prefix length, e.g. 192.168.10.5/24",
+		"gateway": "Gateway is the default gateway of the IP pool\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceFirewallPeers) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineInstanceFirewallPeers holds the peers selected by labels in the firewall rules of a VMI interface",
+		"name":    "Name of the interface",
+		"ingress": "Ingress lists the peers of the ingress allow rules, in the order of the rules\n+listType=atomic\n+optional",
+		"egress":  "Egress lists the peers of the egress allow rules, in the order of the rules\n+listType=atomic\n+optional",
+	}
+}
+
+func (FirewallRulePeers) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FirewallRulePeers holds the addresses of the peers selected by a firewall allow rule",
+		"addresses": "Addresses of the selected peers, empty for a rule without a peer selector\n+listType=atomic\n+optional",
 	}
 }
 
@@ -120,6 +149,7 @@ func (VolumeStatus) SwaggerDoc() map[string]string {
 		"hotplugVolume":             "If the volume is hotplug, this will contain the hotplug status.",
 		"size":                      "Represents the size of the volume",
 		"memoryDumpVolume":          "If the volume is memorydump volume, this will contain the memorydump info.",
+		"backupTargetVolume":        "If the volume is a backup target volume, this will contain the backup target info.",
 		"containerDiskVolume":       "ContainerDiskVolume shows info about the containerdisk, if the volume is a containerdisk",
 	}
 }
@@ -146,6 +176,13 @@ func (KernelBootStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (BackupTargetInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "BackupTargetInfo represents the backup target volume information",
+		"claimName": "ClaimName is the name of the pvc the push mode backups are written to",
+	}
+}
+
 func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "DomainMemoryDumpInfo represents the memory dump information",
@@ -273,6 +310,21 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"sourceState":                    "SourceState contains migration state managed by the source virt handler",
 		"targetState":                    "TargetState contains migration state managed by the target virt handler",
 		"migrationNetworkType":           "The type of migration network, either 'pod' or 'migration'",
+		"progress":                       "Progress reports the progress of the migration, as observed by the migration source",
+	}
+}
+
+func (MigrationProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                              "MigrationProgress reports the progress of a live migration",
+		"updateTimestamp":               "The time the progress was last updated",
+		"dataProcessedBytes":            "The amount of data transferred to the migration target, in bytes",
+		"dataRemainingBytes":            "The amount of data remaining to be transferred to the migration target, in bytes",
+		"dataTotalBytes":                "The total amount of data to be transferred to the migration target, in bytes",
+		"memoryDirtyRateBytesPerSecond": "The rate in which the guest dirties its memory, in bytes per second",
+		"throughputBytesPerSecond":      "The data transfer throughput, in bytes per second",
+		"iteration":                     "The number of memory copy iterations performed so far",
+		"estimatedCompletionSeconds":    "The estimated number of seconds until the migration completes.\nNot set when the migration does not converge, i.e. the guest dirties its memory faster than it is transferred.",
 	}
 }
 
@@ -356,6 +408,7 @@ func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to\ncomplement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects\nare going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
 		"sendTo":            "If sendTo is specified, this VirtualMachineInstanceMigration will be considered the source",
 		"receive":           "If receieve is specified, this VirtualMachineInstanceMigration will be considered the target",
+		"priority":          "Priority of the migration. Pending migrations are started in order of priority and creation time,\nand a migration may preempt pending migrations of a lower priority whose target pod is not ready yet.\nPreempted migrations are moved back to the Pending phase. The system-critical and system-maintenance priorities\nare reserved to KubeVirt and to users allowed to \"use\" them as \"migrationpriorities\" of the kubevirt.io group.\nDefaults to system-critical for evacuations, system-maintenance for workload updates and user-triggered otherwise.\n+optional",
 	}
 }
 
@@ -584,6 +637,27 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maintenanceWindows":    "MaintenanceWindows restricts automated workload updates to recurring time windows.\nOutdated VMIs are only migrated or evicted while at least one window is open.\n\nAn empty list allows workload updates at any time\n\n+listType=atomic\n+optional",
+		"excludedNamespaces":    "ExcludedNamespaces lists namespaces whose VMIs are never disrupted by\nautomated workload updates\n\n+listType=set\n+optional",
+		"excludedSelector":      "ExcludedSelector opts VMIs matching the label selector out of\nautomated workload updates\n\n+optional",
+	}
+}
+
+func (WorkloadUpdateMaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "WorkloadUpdateMaintenanceWindow defines a recurring time window in which\nautomated workload updates are allowed",
+		"schedule": "Schedule is a cron expression, evaluated in UTC, defining when the window opens",
+		"duration": "Duration defines how long the window stays open",
+	}
+}
+
+func (KubeVirtWorkloadUpdateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                "KubeVirtWorkloadUpdateStatus reports the progress of automated workload updates",
+		"pendingVirtualMachineInstances":  "PendingVirtualMachineInstances is the number of outdated VMIs waiting to be updated",
+		"excludedVirtualMachineInstances": "ExcludedVirtualMachineInstances is the number of outdated VMIs opted out of automated updates",
+		"maintenanceWindowOpen":           "MaintenanceWindowOpen indicates whether workload updates are currently allowed",
+		"nextMaintenanceWindow":           "NextMaintenanceWindow is the time the next maintenance window opens\n+optional",
 	}
 }
 
@@ -700,6 +774,24 @@ func (MigrateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceMigrationCheck) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceMigrationCheck reports whether a VirtualMachineInstance can be live migrated, and to which nodes.\nIt is evaluated without creating a migration target pod.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"migratable": "Migratable is true when the VirtualMachineInstance can be migrated to at least one of the nodes",
+		"reasons":    "Reasons why the VirtualMachineInstance cannot be migrated, regardless of the target node\n+optional\n+listType=atomic",
+		"nodes":      "Nodes holds the verdict of each candidate target node\n+optional\n+listType=atomic",
+	}
+}
+
+func (NodeMigrationCheck) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "NodeMigrationCheck holds the verdict of a candidate migration target node",
+		"name":       "Name of the node",
+		"compatible": "Compatible is true when the node can host the migrated VirtualMachineInstance",
+		"reasons":    "Reasons why the node cannot host the migrated VirtualMachineInstance\n+optional\n+listType=atomic",
+	}
+}
+
 func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -762,6 +854,45 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceBackupOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceBackupOptions is provided when starting a backup of a running VirtualMachineInstance",
+		"backupName":      "BackupName uniquely identifies the backup on the VirtualMachineInstance.\nIt is also the name of the checkpoint created together with the backup.",
+		"mode":            "Mode defines how the backup data is delivered. Defaults to Push.\n+optional",
+		"incrementalFrom": "IncrementalFrom is the name of a previous checkpoint. When set, only the blocks\nchanged since that checkpoint are backed up. When empty, a full backup is taken.\n+optional",
+		"targetVolume":    "TargetVolume is the name of the BackupTarget volume the disks are written to in Push mode.\n+optional",
+		"stop":            "Stop ends the backup named BackupName. A Pull mode backup completes once its client\nis done reading the exports, a Push mode backup is aborted.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceBackupState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceBackupState represents the state of the last backup of a VirtualMachineInstance",
+		"backupName":      "BackupName is the name of the backup",
+		"mode":            "Mode is the mode the backup was taken with\n+optional",
+		"checkpointName":  "CheckpointName is the name of the checkpoint created together with the backup.\nIt can be used as the base of a future incremental backup.\n+optional",
+		"incrementalFrom": "IncrementalFrom is the checkpoint the backup is relative to, empty for full backups\n+optional",
+		"endpoint":        "Endpoint is the unix socket of the NBD server exposing the backup in Pull mode.\nThe server is reachable through the nbd subresource.\n+optional",
+		"startTimestamp":  "The time the backup started\n+optional",
+		"endTimestamp":    "The time the backup ended\n+optional",
+		"completed":       "Indicates the backup completed\n+optional",
+		"failed":          "Indicates that the backup failed\n+optional",
+		"failureReason":   "FailureReason is a detailed message about the failure of the backup\n+optional",
+		"volumes":         "Volumes lists where the data of every backed up volume can be found\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceBackupVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineInstanceBackupVolume describes the backup of a single volume",
+		"volumeName":   "VolumeName is the name of the backed up volume",
+		"incremental":  "Incremental indicates that only the blocks changed since the base checkpoint are\npart of the backup. Volumes which cannot track changed blocks are always fully copied.\n+optional",
+		"targetFile":   "TargetFile is the qcow2 file the volume is written to in Push mode\n+optional",
+		"exportName":   "ExportName is the NBD export of the volume in Pull mode\n+optional",
+		"exportBitmap": "ExportBitmap is the dirty bitmap published alongside ExportName for incremental Pull backups\n+optional",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
@@ -793,6 +924,14 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (PcapOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "PcapOptions are the options of a packet capture on a VMI interface",
+		"interfaceName": "InterfaceName is the name of the VMI interface to capture the packets of",
+		"filter":        "Filter is a classic BPF program in the format printed by `tcpdump -ddd`,\nwith the instructions separated by commas. All the packets are captured when omitted.\n+optional",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -935,6 +1074,19 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"compression":                       "Compression configures the compression of the live migration stream. Defaults to no compression",
+		"parallelStreams":                   "ParallelStreams is the number of parallel connections used to transfer the guest memory.\nParallel connections are used along with post-copy when QEMU supports it (QEMU 10.0 or newer),\notherwise a migration which allows post-copy uses a single connection, and fails when it is\nconfigured with a compression method which requires parallel connections.\nBy default, KubeVirt uses parallel connections only for pre-copy migrations of VMIs without a CPU limit.",
+		"zeroCopy":                          "ZeroCopy enables sending the guest memory over the parallel connections without copying it first.\nIt requires ParallelStreams and is incompatible with compression. The guest memory is locked on both nodes,\nand zero-copy is not used when post-copy is allowed or when the memory cannot be locked.\nDefaults to false",
+		"zeroPageDetection":                 "ZeroPageDetection configures how the guest memory pages which only hold zeros are detected, so that they are\nnot transferred. The legacy method detects them in the main migration thread, the multifd method in the\nthreads of the parallel connections, and falls back to the legacy method without parallel connections.\nDefaults to the QEMU default, which is multifd with QEMU 9.0 or newer.\n+optional",
+	}
+}
+
+func (MigrationCompression) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "MigrationCompression configures the compression of the live migration stream",
+		"method":          "Method is the compression method.\nThe zlib and zstd methods compress the parallel connections and require ParallelStreams to be set.\nThe xbzrle method only sends the changes of memory pages which are transferred again in later iterations.",
+		"level":           "Level is the compression level of the zlib (0-9) and zstd (0-20) methods\n+optional",
+		"xbzrleCacheSize": "XBZRLECacheSize is the size of the page cache used by the xbzrle method\n+optional",
 	}
 }
 
@@ -1038,7 +1190,7 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sidecarImage":                "SidecarImage references a container image that runs in the virt-launcher pod.\nThe sidecar handles (libvirt) domain configuration and optional services.\nversion: 1alphav1",
 		"networkAttachmentDefinition": "NetworkAttachmentDefinition references to a NetworkAttachmentDefinition CR object.\nFormat: <name>, <namespace>/<name>.\nIf namespace is not specified, VMI namespace is assumed.\nversion: 1alphav1",
-		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4).\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
+		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\" (since v1.4), \"vhostUser\".\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1",
 		"migration":                   "Migration means the VM using the plugin can be safely migrated\nversion: 1alphav1",
 		"downwardAPI":                 "DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.\nSupported values: \"device-info\"\nversion: v1alphav1\n+optional",
 		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nversion: v1alphav1\n+optional",
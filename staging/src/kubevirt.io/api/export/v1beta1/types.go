@@ -157,6 +157,8 @@ const (
 	AllManifests ExportManifestType = "all"
 	// AuthHeader returns a CDI compatible secret containing the token as an Auth header
 	AuthHeader ExportManifestType = "auth-header-secret"
+	// OVA returns the VM as an OVA appliance, an OVF descriptor and the disks of the VM in qcow2 format
+	OVA ExportManifestType = "ova"
 )
 
 // VirtualMachineExportVolume contains the name and available formats for the exported volume
@@ -180,6 +182,8 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// KubeVirtQcow2 is the volume as sparse qcow2 image, only the allocated data of the volume is transferred
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
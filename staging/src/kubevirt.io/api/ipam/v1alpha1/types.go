@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPPool defines a subnet from which IP addresses are allocated to VM interfaces
+// connected to a bridge network without an IPAM plugin.
+// Each VM interface keeps its address until the VM is deleted.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type IPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              IPPoolSpec `json:"spec" valid:"required"`
+	// +optional
+	Status IPPoolStatus `json:"status,omitempty"`
+}
+
+type IPPoolSpec struct {
+	// CIDR is the IPv4 subnet of the pool, e.g. 192.168.10.0/24.
+	CIDR string `json:"cidr"`
+	// Gateway is the default gateway advertised to the guests.
+	// It is never allocated.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+	// Exclude lists addresses or subnets of the pool which must not be allocated.
+	// +optional
+	// +listType=atomic
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+type IPPoolStatus struct {
+	// Allocations lists the addresses allocated from the pool.
+	// +optional
+	// +listType=atomic
+	Allocations []IPPoolAllocation `json:"allocations,omitempty"`
+}
+
+// IPPoolAllocation is an address allocated to an interface of a VM.
+type IPPoolAllocation struct {
+	// VirtualMachine is the name of the VM owning the address.
+	VirtualMachine string `json:"virtualMachine"`
+	// Interface is the name of the VM interface owning the address.
+	Interface string `json:"interface"`
+	// Address is the allocated IP address.
+	Address string `json:"address"`
+}
+
+// IPPoolList is a list of IPPool
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type IPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []IPPool `json:"items"`
+}
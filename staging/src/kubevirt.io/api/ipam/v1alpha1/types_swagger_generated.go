@@ -0,0 +1,40 @@
+// Code generated by swagger-doc. DO NOT EDIT.
+
+package v1alpha1
+
+func (IPPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "IPPool defines a subnet from which IP addresses are allocated to VM interfaces\nconnected to a bridge network without an IPAM plugin.\nEach VM interface keeps its address until the VM is deleted.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (IPPoolSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"cidr":    "CIDR is the IPv4 subnet of the pool, e.g. 192.168.10.0/24.",
+		"gateway": "Gateway is the default gateway advertised to the guests.\nIt is never allocated.\n+optional",
+		"exclude": "Exclude lists addresses or subnets of the pool which must not be allocated.\n+optional\n+listType=atomic",
+	}
+}
+
+func (IPPoolStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"allocations": "Allocations lists the addresses allocated from the pool.\n+optional\n+listType=atomic",
+	}
+}
+
+func (IPPoolAllocation) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "IPPoolAllocation is an address allocated to an interface of a VM.",
+		"virtualMachine": "VirtualMachine is the name of the VM owning the address.",
+		"interface":      "Interface is the name of the VM interface owning the address.",
+		"address":        "Address is the allocated IP address.",
+	}
+}
+
+func (IPPoolList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "IPPoolList is a list of IPPool\n\n+k8s:openapi-gen=true\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
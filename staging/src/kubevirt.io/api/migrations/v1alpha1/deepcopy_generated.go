@@ -25,6 +25,7 @@ package v1alpha1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -143,6 +144,26 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParallelStreams != nil {
+		in, out := &in.ParallelStreams, &out.ParallelStreams
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ZeroCopy != nil {
+		in, out := &in.ZeroCopy, &out.ZeroCopy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ZeroPageDetection != nil {
+		in, out := &in.ZeroPageDetection, &out.ZeroPageDetection
+		*out = new(v1.MigrationZeroPageDetection)
+		**out = **in
+	}
 	return
 }
 
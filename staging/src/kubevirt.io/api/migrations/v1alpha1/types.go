@@ -53,6 +53,14 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	//+optional
+	ParallelStreams *uint32 `json:"parallelStreams,omitempty"`
+	//+optional
+	ZeroCopy *bool `json:"zeroCopy,omitempty"`
+	//+optional
+	ZeroPageDetection *k6tv1.MigrationZeroPageDetection `json:"zeroPageDetection,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -109,6 +117,25 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		// value of AllowPostCopy, if not explicitly set
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
+	if policySpec.Compression != nil {
+		changed = true
+		clusterMigrationConfigurations.Compression = policySpec.Compression.DeepCopy()
+	}
+	if policySpec.ParallelStreams != nil {
+		changed = true
+		parallelStreams := *policySpec.ParallelStreams
+		clusterMigrationConfigurations.ParallelStreams = &parallelStreams
+	}
+	if policySpec.ZeroCopy != nil {
+		changed = true
+		zeroCopy := *policySpec.ZeroCopy
+		clusterMigrationConfigurations.ZeroCopy = &zeroCopy
+	}
+	if policySpec.ZeroPageDetection != nil {
+		changed = true
+		zeroPageDetection := *policySpec.ZeroPageDetection
+		clusterMigrationConfigurations.ZeroPageDetection = &zeroPageDetection
+	}
 
 	return changed, nil
 }
@@ -16,6 +16,10 @@ func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 		"completionTimeoutPerGiB": "+optional",
 		"allowPostCopy":           "+optional",
 		"allowWorkloadDisruption": "+optional",
+		"compression":             "+optional",
+		"parallelStreams":         "+optional",
+		"zeroCopy":                "+optional",
+		"zeroPageDetection":       "+optional",
 	}
 }
 
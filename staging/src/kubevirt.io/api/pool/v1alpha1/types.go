@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
@@ -89,6 +90,16 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Number of VMs whose template matches the current VM template of the pool.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// Name of the ControllerRevision the VMs of the pool are at before the
+	// current update. It equals UpdateRevision once all VMs are updated.
+	CurrentRevision string `json:"currentRevision,omitempty" optional:"true"`
+
+	// Name of the ControllerRevision the VMs of the pool are updated to.
+	UpdateRevision string `json:"updateRevision,omitempty" optional:"true"`
 }
 
 // +k8s:openapi-gen=true
@@ -112,6 +123,127 @@ type VirtualMachinePoolSpec struct {
 	// Options for the name generation in a pool.
 	// +optional
 	NameGeneration *VirtualMachinePoolNameGeneration `json:"nameGeneration,omitempty"`
+
+	// The strategy used to update the VMs of the pool when the VM template changes.
+	// Defaults to a proactive update which restarts all outdated VMs at once.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// The strategy used to remove VMs when the pool scales in.
+	// Defaults to removing random VMs together with their volumes.
+	// +optional
+	ScaleInStrategy *VirtualMachinePoolScaleInStrategy `json:"scaleInStrategy,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInSelectionPolicy string
+
+const (
+	// VirtualMachinePoolScaleInRandom removes random VMs.
+	VirtualMachinePoolScaleInRandom VirtualMachinePoolScaleInSelectionPolicy = "Random"
+
+	// VirtualMachinePoolScaleInPreferStopped removes VMs without a VMI first.
+	VirtualMachinePoolScaleInPreferStopped VirtualMachinePoolScaleInSelectionPolicy = "PreferStopped"
+
+	// VirtualMachinePoolScaleInPreferNotReady removes VMs which are not ready first.
+	VirtualMachinePoolScaleInPreferNotReady VirtualMachinePoolScaleInSelectionPolicy = "PreferNotReady"
+
+	// VirtualMachinePoolScaleInHighestOrdinal removes the VMs with the highest
+	// index first.
+	VirtualMachinePoolScaleInHighestOrdinal VirtualMachinePoolScaleInSelectionPolicy = "HighestOrdinal"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInVolumePolicy string
+
+const (
+	// VirtualMachinePoolScaleInDeleteVolumes deletes the volumes of removed VMs.
+	VirtualMachinePoolScaleInDeleteVolumes VirtualMachinePoolScaleInVolumePolicy = "Delete"
+
+	// VirtualMachinePoolScaleInRetainVolumes detaches the DataVolumes and PVCs
+	// of removed VMs from them and keeps them. A VM created later with the
+	// same name adopts them again. Retained volumes stay reserved for the
+	// pool, there is no policy that hands them over to other VMs, and they
+	// have to be deleted manually when the pool no longer needs them.
+	VirtualMachinePoolScaleInRetainVolumes VirtualMachinePoolScaleInVolumePolicy = "Retain"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInStrategy struct {
+	// Policy used to select the VMs to remove, either Random, PreferStopped,
+	// PreferNotReady or HighestOrdinal. Ties are broken randomly.
+	// Defaults to Random.
+	// +optional
+	SelectionPolicy VirtualMachinePoolScaleInSelectionPolicy `json:"selectionPolicy,omitempty"`
+
+	// Policy applied to the volumes created from the DataVolumeTemplates of
+	// removed VMs, either Delete or Retain. Defaults to Delete. Retained
+	// volumes are adopted again by VMs created later with the same name and
+	// have to be deleted manually when the pool no longer needs them.
+	// +optional
+	VolumePolicy VirtualMachinePoolScaleInVolumePolicy `json:"volumePolicy,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategyType string
+
+const (
+	// VirtualMachinePoolProactiveUpdateStrategyType updates the VMs of the pool
+	// and applies the changes to their running VMIs right away.
+	VirtualMachinePoolProactiveUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "Proactive"
+
+	// VirtualMachinePoolOpportunisticUpdateStrategyType only updates the VMs of
+	// the pool. Running VMIs pick up the changes the next time they are started.
+	VirtualMachinePoolOpportunisticUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "Opportunistic"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolProactiveUpdateMethod string
+
+const (
+	// VirtualMachinePoolProactiveUpdateRestart updates an outdated VM in place
+	// and restarts its VMI.
+	VirtualMachinePoolProactiveUpdateRestart VirtualMachinePoolProactiveUpdateMethod = "Restart"
+
+	// VirtualMachinePoolProactiveUpdateRecreate deletes an outdated VM and
+	// creates it again from the VM template, including its DataVolumes.
+	VirtualMachinePoolProactiveUpdateRecreate VirtualMachinePoolProactiveUpdateMethod = "Recreate"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type of the update strategy, either Proactive or Opportunistic.
+	// Defaults to Proactive.
+	// +optional
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// Method used to update outdated VMs with a Proactive update strategy,
+	// either Restart or Recreate. Defaults to Restart.
+	// +optional
+	ProactiveMethod VirtualMachinePoolProactiveUpdateMethod `json:"proactiveMethod,omitempty"`
+
+	// Rolling update configuration parameters. If not set, all outdated VMs
+	// are updated at once.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// The maximum number of VMs that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired VMs (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// Defaults to 25%.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of VMs that can be created over the desired number of
+	// VMs while outdated VMs are recreated. Only used by the Recreate method.
+	// Value can be an absolute number (ex: 5) or a percentage of desired VMs (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 }
 
 // +k8s:openapi-gen=true
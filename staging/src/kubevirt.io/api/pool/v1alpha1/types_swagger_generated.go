@@ -26,9 +26,12 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                "+k8s:openapi-gen=true",
+		"conditions":      "+listType=atomic",
+		"labelSelector":   "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updatedReplicas": "Number of VMs whose template matches the current VM template of the pool.",
+		"currentRevision": "Name of the ControllerRevision the VMs of the pool are at before the\ncurrent update. It equals UpdateRevision once all VMs are updated.",
+		"updateRevision":  "Name of the ControllerRevision the VMs of the pool are updated to.",
 	}
 }
 
@@ -40,6 +43,33 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"virtualMachineTemplate": "Template describes the VM that will be created.",
 		"paused":                 "Indicates that the pool is paused.\n+optional",
 		"nameGeneration":         "Options for the name generation in a pool.\n+optional",
+		"updateStrategy":         "The strategy used to update the VMs of the pool when the VM template changes.\nDefaults to a proactive update which restarts all outdated VMs at once.\n+optional",
+		"scaleInStrategy":        "The strategy used to remove VMs when the pool scales in.\nDefaults to removing random VMs together with their volumes.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolScaleInStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"selectionPolicy": "Policy used to select the VMs to remove, either Random, PreferStopped,\nPreferNotReady or HighestOrdinal. Ties are broken randomly.\nDefaults to Random.\n+optional",
+		"volumePolicy":    "Policy applied to the volumes created from the DataVolumeTemplates of\nremoved VMs, either Delete or Retain. Defaults to Delete. Retained\nvolumes are adopted again by VMs created later with the same name and\nhave to be deleted manually when the pool no longer needs them.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"type":            "Type of the update strategy, either Proactive or Opportunistic.\nDefaults to Proactive.\n+optional",
+		"proactiveMethod": "Method used to update outdated VMs with a Proactive update strategy,\neither Restart or Recreate. Defaults to Restart.\n+optional",
+		"rollingUpdate":   "Rolling update configuration parameters. If not set, all outdated VMs\nare updated at once.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"maxUnavailable": "The maximum number of VMs that can be unavailable during the update.\nValue can be an absolute number (ex: 5) or a percentage of desired VMs (ex: 10%).\nAbsolute number is calculated from percentage by rounding down.\nDefaults to 25%.\n+optional",
+		"maxSurge":       "The maximum number of VMs that can be created over the desired number of\nVMs while outdated VMs are recreated. Only used by the Recreate method.\nValue can be an absolute number (ex: 5) or a percentage of desired VMs (ex: 10%).\nAbsolute number is calculated from percentage by rounding up.\nDefaults to 0.\n+optional",
 	}
 }
 
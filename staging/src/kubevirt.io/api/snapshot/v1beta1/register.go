@@ -56,6 +56,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineSnapshotContentList{},
 		&VirtualMachineRestore{},
 		&VirtualMachineRestoreList{},
+		&VirtualMachineBackup{},
+		&VirtualMachineBackupList{},
+		&VirtualMachineSnapshotSchedule{},
+		&VirtualMachineSnapshotScheduleList{},
+		&VirtualMachineGroupSnapshot{},
+		&VirtualMachineGroupSnapshotList{},
+		&VirtualMachineGroupRestore{},
+		&VirtualMachineGroupRestoreList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -335,6 +335,20 @@ const (
 	VirtualMachineRestoreWaitEventually TargetReadinessPolicy = "WaitEventually"
 )
 
+// VolumeRestoreMode defines how the volumes of a volume restore
+// are made available
+type VolumeRestoreMode string
+
+const (
+	// VolumeRestoreModeNewPVC restores the volumes into new PVCs and
+	// leaves the target untouched
+	VolumeRestoreModeNewPVC VolumeRestoreMode = "NewPVC"
+
+	// VolumeRestoreModeInPlace replaces the volumes of the stopped target
+	// with the restored PVCs
+	VolumeRestoreModeInPlace VolumeRestoreMode = "InPlace"
+)
+
 // VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreSpec struct {
 	// initially only VirtualMachine type supported
@@ -353,6 +367,18 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// Volumes restricts the restore to the named volumes of the snapshot,
+	// the rest of the target spec is left untouched. The target has to be
+	// the source VM of the snapshot.
+	// +optional
+	// +listType=set
+	Volumes []string `json:"volumes,omitempty"`
+
+	// VolumeRestoreMode defines how the volumes listed in Volumes are restored,
+	// defaults to NewPVC
+	// +optional
+	VolumeRestoreMode *VolumeRestoreMode `json:"volumeRestoreMode,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
@@ -396,3 +422,375 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineBackup defines the operation of backing up the disks of a running VM
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
+type VirtualMachineBackupSpec struct {
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// Mode defines how the backup data is delivered, Push writes a qcow2 file per disk
+	// and Pull exposes the disks through an NBD server.
+	// Defaults to Push
+	// +optional
+	Mode *v1.BackupMode `json:"mode,omitempty"`
+
+	// IncrementalFrom is the name of a succeeded VirtualMachineBackup of the same VM.
+	// When set, only the blocks changed since that backup are copied.
+	// Only the checkpoint of the latest succeeded backup is kept, it is therefore the only valid base.
+	// +optional
+	IncrementalFrom *string `json:"incrementalFrom,omitempty"`
+
+	// TargetClaimName is the PersistentVolumeClaim the Push mode backup is written to.
+	// The claim is hotplugged into the running VM for the duration of the backup.
+	// Required for Push mode.
+	// +optional
+	TargetClaimName *string `json:"targetClaimName,omitempty"`
+
+	// Stop ends the backup. It is set by the client of a Pull mode backup once it
+	// is done reading the exports, and aborts a running Push mode backup.
+	// +optional
+	Stop bool `json:"stop,omitempty"`
+
+	// This time represents the number of seconds we permit the vm backup
+	// to take. In case we pass this deadline we mark this backup
+	// as failed.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// BackupType is the type of a VirtualMachineBackup
+type BackupType string
+
+const (
+	// FullBackup copies all the data of the disks
+	FullBackup BackupType = "Full"
+	// IncrementalBackup copies only the blocks changed since a previous backup
+	IncrementalBackup BackupType = "Incremental"
+)
+
+// VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
+type VirtualMachineBackupStatus struct {
+	// +optional
+	SourceUID *types.UID `json:"sourceUID,omitempty"`
+
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// +optional
+	Type BackupType `json:"type,omitempty"`
+
+	// CheckpointName is the name of the checkpoint created together with the backup
+	// +optional
+	CheckpointName string `json:"checkpointName,omitempty"`
+
+	// BaseCheckpointName is the checkpoint an incremental backup is relative to
+	// +optional
+	BaseCheckpointName string `json:"baseCheckpointName,omitempty"`
+
+	// Endpoint is the unix socket, inside the virt-launcher pod, of the NBD server exposing a Pull backup.
+	// The server is reachable through the nbd subresource of the VirtualMachineInstance, e.g. with virtctl nbd.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Volumes []v1.VirtualMachineInstanceBackupVolume `json:"volumes,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineBackupList is a list of VirtualMachineBackup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackup `json:"items"`
+}
+
+// VirtualMachineSnapshotSchedule periodically takes VirtualMachineSnapshots of the selected VMs
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleLabel is set on the VirtualMachineSnapshots taken by a
+// VirtualMachineSnapshotSchedule, its value is the name of the schedule
+const VirtualMachineSnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// QuiescePolicy defines whether snapshots of running VMs have to be taken with frozen guest filesystems
+type QuiescePolicy string
+
+const (
+	// QuiescePreferred takes the snapshot even when the guest filesystems cannot be frozen
+	QuiescePreferred QuiescePolicy = "Preferred"
+
+	// QuiesceRequired only keeps snapshots of running VMs for which the guest filesystems were frozen
+	QuiesceRequired QuiescePolicy = "Required"
+)
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule is a cron expression in the standard five field format, evaluated in UTC
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines, in the namespace of the schedule, to snapshot
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Retention defines which of the snapshots taken by the schedule are kept
+	// +optional
+	Retention *SnapshotRetentionPolicy `json:"retention,omitempty"`
+
+	// Quiesce defines whether the guest filesystems of running VMs have to be frozen.
+	// Defaults to Preferred
+	// +optional
+	Quiesce *QuiescePolicy `json:"quiesce,omitempty"`
+
+	// Disabled suspends the schedule, snapshots that were already taken are still pruned
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// FailureDeadline is passed on to the VirtualMachineSnapshots taken by the schedule
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// SnapshotRetentionPolicy defines how many snapshots of every VM are kept,
+// succeeded snapshots exceeding any of the limits are deleted
+type SnapshotRetentionPolicy struct {
+	// MaxCount is the maximum number of succeeded snapshots kept per VirtualMachine
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// MaxAge is the maximum age of the snapshots kept
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// LastRunTime is the time the schedule last created snapshots
+	// +optional
+	// +nullable
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// NextRunTime is the time the schedule will create snapshots next
+	// +optional
+	// +nullable
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// Failures lists the VirtualMachines that could not be snapshotted during the last run
+	// +optional
+	// +listType=atomic
+	Failures []SnapshotScheduleFailure `json:"failures,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// SnapshotScheduleFailure describes why a VirtualMachine could not be snapshotted
+type SnapshotScheduleFailure struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// +optional
+	SnapshotName string `json:"snapshotName,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// VirtualMachineGroupSnapshot takes crash consistent snapshots of a group of VMs,
+// the guest filesystems of all the selected VMs are frozen while their volumes are snapshotted
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineGroupSnapshotSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineGroupSnapshotStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotLabel is set on the VirtualMachineSnapshots taken by a
+// VirtualMachineGroupSnapshot, its value is the name of the group snapshot
+const VirtualMachineGroupSnapshotLabel = "snapshot.kubevirt.io/group-snapshot"
+
+// VirtualMachineGroupSnapshotSpec is the spec for a VirtualMachineGroupSnapshot resource
+type VirtualMachineGroupSnapshotSpec struct {
+	// Selector selects the VirtualMachines, in the namespace of the group snapshot, to snapshot.
+	// The members are determined once, when the group snapshot starts
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is passed on to the VirtualMachineSnapshots of the members
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// FailureDeadline is the time the group snapshot is permitted to take,
+	// the frozen members are thawed once it is exceeded.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotStatus is the status for a VirtualMachineGroupSnapshot resource
+type VirtualMachineGroupSnapshotStatus struct {
+	// Members lists the VirtualMachines of the group and their snapshots
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineGroupSnapshotMember `json:"members,omitempty"`
+
+	// CreationTime is the time the volumes of all the members were snapshotted
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// ReadyToUse is true once the snapshots of all the members are ready to use
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotMember is a VirtualMachine of a group snapshot
+type VirtualMachineGroupSnapshotMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotList is a list of VirtualMachineGroupSnapshot resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineGroupSnapshot `json:"items"`
+}
+
+// VirtualMachineGroupRestore restores all the members of a VirtualMachineGroupSnapshot
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineGroupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineGroupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineGroupRestoreLabel is set on the VirtualMachineRestores created by a
+// VirtualMachineGroupRestore, its value is the name of the group restore
+const VirtualMachineGroupRestoreLabel = "snapshot.kubevirt.io/group-restore"
+
+// VirtualMachineGroupRestoreSpec is the spec for a VirtualMachineGroupRestore resource
+type VirtualMachineGroupRestoreSpec struct {
+	// VirtualMachineGroupSnapshotName is the group snapshot to restore,
+	// every member VM is restored from its snapshot in the group
+	VirtualMachineGroupSnapshotName string `json:"virtualMachineGroupSnapshotName"`
+
+	// TargetReadinessPolicy is passed on to the VirtualMachineRestores of the members
+	// +optional
+	TargetReadinessPolicy *TargetReadinessPolicy `json:"targetReadinessPolicy,omitempty"`
+}
+
+// VirtualMachineGroupRestoreStatus is the status for a VirtualMachineGroupRestore resource
+type VirtualMachineGroupRestoreStatus struct {
+	// Members lists the VirtualMachines of the group and their restores
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineGroupRestoreMember `json:"members,omitempty"`
+
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// Complete is true once all the members are restored
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineGroupRestoreMember is a VirtualMachine restored by a group restore
+type VirtualMachineGroupRestoreMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineRestoreName string `json:"virtualMachineRestoreName"`
+
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+}
+
+// VirtualMachineGroupRestoreList is a list of VirtualMachineGroupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineGroupRestore `json:"items"`
+}
@@ -143,6 +143,8 @@ func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 		"target":                "initially only VirtualMachine type supported",
 		"targetReadinessPolicy": "+optional",
 		"patches":               "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"volumes":               "Volumes restricts the restore to the named volumes of the snapshot,\nthe rest of the target spec is left untouched. The target has to be\nthe source VM of the snapshot.\n+optional\n+listType=set",
+		"volumeRestoreMode":     "VolumeRestoreMode defines how the volumes listed in Volumes are restored,\ndefaults to NewPVC\n+optional",
 	}
 }
 
@@ -169,3 +171,176 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineBackup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackup defines the operation of backing up the disks of a running VM\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource",
+		"mode":            "Mode defines how the backup data is delivered, Push writes a qcow2 file per disk\nand Pull exposes the disks through an NBD server.\nDefaults to Push\n+optional",
+		"incrementalFrom": "IncrementalFrom is the name of a succeeded VirtualMachineBackup of the same VM.\nWhen set, only the blocks changed since that backup are copied.\nOnly the checkpoint of the latest succeeded backup is kept, it is therefore the only valid base.\n+optional",
+		"targetClaimName": "TargetClaimName is the PersistentVolumeClaim the Push mode backup is written to.\nThe claim is hotplugged into the running VM for the duration of the backup.\nRequired for Push mode.\n+optional",
+		"stop":            "Stop ends the backup. It is set by the client of a Pull mode backup once it\nis done reading the exports, and aborts a running Push mode backup.\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the vm backup\nto take. In case we pass this deadline we mark this backup\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineBackupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
+		"sourceUID":          "+optional",
+		"phase":              "+optional",
+		"type":               "+optional",
+		"checkpointName":     "CheckpointName is the name of the checkpoint created together with the backup\n+optional",
+		"baseCheckpointName": "BaseCheckpointName is the checkpoint an incremental backup is relative to\n+optional",
+		"endpoint":           "Endpoint is the unix socket, inside the virt-launcher pod, of the NBD server exposing a Pull backup.\nThe server is reachable through the nbd subresource of the VirtualMachineInstance, e.g. with virtctl nbd.\n+optional",
+		"creationTime":       "+optional\n+nullable",
+		"completionTime":     "+optional\n+nullable",
+		"volumes":            "+optional\n+listType=atomic",
+		"error":              "+optional",
+		"conditions":         "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineBackupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineBackupList is a list of VirtualMachineBackup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule periodically takes VirtualMachineSnapshots of the selected VMs\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is a cron expression in the standard five field format, evaluated in UTC",
+		"selector":        "Selector selects the VirtualMachines, in the namespace of the schedule, to snapshot",
+		"retention":       "Retention defines which of the snapshots taken by the schedule are kept\n+optional",
+		"quiesce":         "Quiesce defines whether the guest filesystems of running VMs have to be frozen.\nDefaults to Preferred\n+optional",
+		"disabled":        "Disabled suspends the schedule, snapshots that were already taken are still pruned\n+optional",
+		"failureDeadline": "FailureDeadline is passed on to the VirtualMachineSnapshots taken by the schedule\n+optional",
+	}
+}
+
+func (SnapshotRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "SnapshotRetentionPolicy defines how many snapshots of every VM are kept,\nsucceeded snapshots exceeding any of the limits are deleted",
+		"maxCount": "MaxCount is the maximum number of succeeded snapshots kept per VirtualMachine\n+optional",
+		"maxAge":   "MaxAge is the maximum age of the snapshots kept\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastRunTime": "LastRunTime is the time the schedule last created snapshots\n+optional\n+nullable",
+		"nextRunTime": "NextRunTime is the time the schedule will create snapshots next\n+optional\n+nullable",
+		"failures":    "Failures lists the VirtualMachines that could not be snapshotted during the last run\n+optional\n+listType=atomic",
+		"error":       "+optional",
+		"conditions":  "+optional\n+listType=atomic",
+	}
+}
+
+func (SnapshotScheduleFailure) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "SnapshotScheduleFailure describes why a VirtualMachine could not be snapshotted",
+		"snapshotName": "+optional",
+		"message":      "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineGroupSnapshot) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineGroupSnapshot takes crash consistent snapshots of a group of VMs,\nthe guest filesystems of all the selected VMs are frozen while their volumes are snapshotted\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineGroupSnapshotSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineGroupSnapshotSpec is the spec for a VirtualMachineGroupSnapshot resource",
+		"selector":        "Selector selects the VirtualMachines, in the namespace of the group snapshot, to snapshot.\nThe members are determined once, when the group snapshot starts",
+		"deletionPolicy":  "DeletionPolicy is passed on to the VirtualMachineSnapshots of the members\n+optional",
+		"failureDeadline": "FailureDeadline is the time the group snapshot is permitted to take,\nthe frozen members are thawed once it is exceeded.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineGroupSnapshotStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineGroupSnapshotStatus is the status for a VirtualMachineGroupSnapshot resource",
+		"members":      "Members lists the VirtualMachines of the group and their snapshots\n+optional\n+listType=atomic",
+		"creationTime": "CreationTime is the time the volumes of all the members were snapshotted\n+optional\n+nullable",
+		"phase":        "+optional",
+		"readyToUse":   "ReadyToUse is true once the snapshots of all the members are ready to use\n+optional",
+		"error":        "+optional",
+		"conditions":   "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineGroupSnapshotMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineGroupSnapshotMember is a VirtualMachine of a group snapshot",
+		"phase":      "+optional",
+		"readyToUse": "+optional",
+	}
+}
+
+func (VirtualMachineGroupSnapshotList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineGroupSnapshotList is a list of VirtualMachineGroupSnapshot resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineGroupRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineGroupRestore restores all the members of a VirtualMachineGroupSnapshot\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineGroupRestoreSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                "VirtualMachineGroupRestoreSpec is the spec for a VirtualMachineGroupRestore resource",
+		"virtualMachineGroupSnapshotName": "VirtualMachineGroupSnapshotName is the group snapshot to restore,\nevery member VM is restored from its snapshot in the group",
+		"targetReadinessPolicy":           "TargetReadinessPolicy is passed on to the VirtualMachineRestores of the members\n+optional",
+	}
+}
+
+func (VirtualMachineGroupRestoreStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineGroupRestoreStatus is the status for a VirtualMachineGroupRestore resource",
+		"members":     "Members lists the VirtualMachines of the group and their restores\n+optional\n+listType=atomic",
+		"restoreTime": "+optional",
+		"complete":    "Complete is true once all the members are restored\n+optional",
+		"conditions":  "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineGroupRestoreMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineGroupRestoreMember is a VirtualMachine restored by a group restore",
+		"complete": "+optional",
+	}
+}
+
+func (VirtualMachineGroupRestoreList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineGroupRestoreList is a list of VirtualMachineGroupRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -86,8 +86,9 @@ import (
 	v122 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	v1beta117 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	v1beta118 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
-	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
-	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
+	v1alpha110 "kubevirt.io/client-go/kubevirt/typed/ipam/v1alpha1"
+	v1alpha111 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
+	v1alpha112 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	v1beta119 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
 	networkattachmentdefinitionclient "kubevirt.io/client-go/networkattachmentdefinitionclient"
 	prometheusoperator "kubevirt.io/client-go/prometheusoperator"
@@ -720,6 +721,20 @@ func (mr *MockKubevirtClientMockRecorder) GuestfsVersion() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GuestfsVersion", reflect.TypeOf((*MockKubevirtClient)(nil).GuestfsVersion))
 }
 
+// IPPool mocks base method.
+func (m *MockKubevirtClient) IPPool(namespace string) v1alpha110.IPPoolInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IPPool", namespace)
+	ret0, _ := ret[0].(v1alpha110.IPPoolInterface)
+	return ret0
+}
+
+// IPPool indicates an expected call of IPPool.
+func (mr *MockKubevirtClientMockRecorder) IPPool(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IPPool", reflect.TypeOf((*MockKubevirtClient)(nil).IPPool), namespace)
+}
+
 // InternalV1alpha1 mocks base method.
 func (m *MockKubevirtClient) InternalV1alpha1() v1alpha10.InternalV1alpha1Interface {
 	m.ctrl.T.Helper()
@@ -763,10 +778,10 @@ func (mr *MockKubevirtClientMockRecorder) KubernetesSnapshotClient() *gomock.Cal
 }
 
 // MigrationPolicy mocks base method.
-func (m *MockKubevirtClient) MigrationPolicy() v1alpha110.MigrationPolicyInterface {
+func (m *MockKubevirtClient) MigrationPolicy() v1alpha111.MigrationPolicyInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MigrationPolicy")
-	ret0, _ := ret[0].(v1alpha110.MigrationPolicyInterface)
+	ret0, _ := ret[0].(v1alpha111.MigrationPolicyInterface)
 	return ret0
 }
 
@@ -777,10 +792,10 @@ func (mr *MockKubevirtClientMockRecorder) MigrationPolicy() *gomock.Call {
 }
 
 // MigrationPolicyClient mocks base method.
-func (m *MockKubevirtClient) MigrationPolicyClient() *v1alpha110.MigrationsV1alpha1Client {
+func (m *MockKubevirtClient) MigrationPolicyClient() *v1alpha111.MigrationsV1alpha1Client {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "MigrationPolicyClient")
-	ret0, _ := ret[0].(*v1alpha110.MigrationsV1alpha1Client)
+	ret0, _ := ret[0].(*v1alpha111.MigrationsV1alpha1Client)
 	return ret0
 }
 
@@ -1183,6 +1198,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachine(namespace any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachine", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachine), namespace)
 }
 
+// VirtualMachineBackup mocks base method.
+func (m *MockKubevirtClient) VirtualMachineBackup(namespace string) v1beta119.VirtualMachineBackupInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineBackup", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineBackupInterface)
+	return ret0
+}
+
+// VirtualMachineBackup indicates an expected call of VirtualMachineBackup.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackup(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackup), namespace)
+}
+
 // VirtualMachineClone mocks base method.
 func (m *MockKubevirtClient) VirtualMachineClone(namespace string) v1beta116.VirtualMachineCloneInterface {
 	m.ctrl.T.Helper()
@@ -1239,6 +1268,34 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineExport(namespace any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineExport", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineExport), namespace)
 }
 
+// VirtualMachineGroupRestore mocks base method.
+func (m *MockKubevirtClient) VirtualMachineGroupRestore(namespace string) v1beta119.VirtualMachineGroupRestoreInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineGroupRestore", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineGroupRestoreInterface)
+	return ret0
+}
+
+// VirtualMachineGroupRestore indicates an expected call of VirtualMachineGroupRestore.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineGroupRestore(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineGroupRestore", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineGroupRestore), namespace)
+}
+
+// VirtualMachineGroupSnapshot mocks base method.
+func (m *MockKubevirtClient) VirtualMachineGroupSnapshot(namespace string) v1beta119.VirtualMachineGroupSnapshotInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineGroupSnapshot", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineGroupSnapshotInterface)
+	return ret0
+}
+
+// VirtualMachineGroupSnapshot indicates an expected call of VirtualMachineGroupSnapshot.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineGroupSnapshot(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineGroupSnapshot", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineGroupSnapshot), namespace)
+}
+
 // VirtualMachineInstance mocks base method.
 func (m *MockKubevirtClient) VirtualMachineInstance(namespace string) VirtualMachineInstanceInterface {
 	m.ctrl.T.Helper()
@@ -1296,10 +1353,10 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineInstancetype(namespace a
 }
 
 // VirtualMachinePool mocks base method.
-func (m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha111.VirtualMachinePoolInterface {
+func (m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha112.VirtualMachinePoolInterface {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "VirtualMachinePool", namespace)
-	ret0, _ := ret[0].(v1alpha111.VirtualMachinePoolInterface)
+	ret0, _ := ret[0].(v1alpha112.VirtualMachinePoolInterface)
 	return ret0
 }
 
@@ -1365,6 +1422,20 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotContent(namespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotContent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotContent), namespace)
 }
 
+// VirtualMachineSnapshotSchedule mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1beta119.VirtualMachineSnapshotScheduleInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1beta119.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotSchedule indicates an expected call of VirtualMachineSnapshotSchedule.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotSchedule(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotSchedule", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotSchedule), namespace)
+}
+
 // MockVirtualMachineInstanceInterface is a mock of VirtualMachineInstanceInterface interface.
 type MockVirtualMachineInstanceInterface struct {
 	ctrl     *gomock.Controller
@@ -1403,6 +1474,20 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) AddVolume(ctx, name,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVolume", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).AddVolume), ctx, name, addVolumeOptions)
 }
 
+// Backup mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Backup(ctx context.Context, name string, backupOptions *v121.VirtualMachineInstanceBackupOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", ctx, name, backupOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Backup(ctx, name, backupOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Backup), ctx, name, backupOptions)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Create(ctx context.Context, virtualMachineInstance *v121.VirtualMachineInstance, opts v12.CreateOptions) (*v121.VirtualMachineInstance, error) {
 	m.ctrl.T.Helper()
@@ -1520,6 +1605,36 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) List(ctx, opts any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).List), ctx, opts)
 }
 
+// MigrateCheck mocks base method.
+func (m *MockVirtualMachineInstanceInterface) MigrateCheck(ctx context.Context, name string) (v121.VirtualMachineInstanceMigrationCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCheck", ctx, name)
+	ret0, _ := ret[0].(v121.VirtualMachineInstanceMigrationCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateCheck indicates an expected call of MigrateCheck.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) MigrateCheck(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCheck", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).MigrateCheck), ctx, name)
+}
+
+// NBD mocks base method.
+func (m *MockVirtualMachineInstanceInterface) NBD(name string) (v122.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NBD", name)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NBD indicates an expected call of NBD.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) NBD(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NBD", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).NBD), name)
+}
+
 // Patch mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v12.PatchOptions, subresources ...string) (*v121.VirtualMachineInstance, error) {
 	m.ctrl.T.Helper()
@@ -1554,6 +1669,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Pause(ctx, name, paus
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Pause), ctx, name, pauseOptions)
 }
 
+// Pcap mocks base method.
+func (m *MockVirtualMachineInstanceInterface) Pcap(name string, options *v121.PcapOptions) (v122.StreamInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pcap", name, options)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pcap indicates an expected call of Pcap.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Pcap(name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pcap", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Pcap), name, options)
+}
+
 // PortForward mocks base method.
 func (m *MockVirtualMachineInstanceInterface) PortForward(name string, port int, protocol string) (v122.StreamInterface, error) {
 	m.ctrl.T.Helper()
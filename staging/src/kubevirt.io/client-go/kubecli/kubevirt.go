@@ -46,6 +46,7 @@ import (
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	exportv1 "kubevirt.io/client-go/kubevirt/typed/export/v1beta1"
 	instancetypev1beta1 "kubevirt.io/client-go/kubevirt/typed/instancetype/v1beta1"
+	ipamv1 "kubevirt.io/client-go/kubevirt/typed/ipam/v1alpha1"
 	migrationsv1 "kubevirt.io/client-go/kubevirt/typed/migrations/v1alpha1"
 	poolv1 "kubevirt.io/client-go/kubevirt/typed/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/kubevirt/typed/snapshot/v1beta1"
@@ -65,12 +66,17 @@ type KubevirtClient interface {
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
+	VirtualMachineBackup(namespace string) snapshotv1.VirtualMachineBackupInterface
+	VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineGroupSnapshot(namespace string) snapshotv1.VirtualMachineGroupSnapshotInterface
+	VirtualMachineGroupRestore(namespace string) snapshotv1.VirtualMachineGroupRestoreInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	IPPool(namespace string) ipamv1.IPPoolInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface
@@ -183,6 +189,22 @@ func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.Virtu
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineBackup(namespace string) snapshotv1.VirtualMachineBackupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineBackups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineGroupSnapshot(namespace string) snapshotv1.VirtualMachineGroupSnapshotInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineGroupSnapshots(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineGroupRestore(namespace string) snapshotv1.VirtualMachineGroupRestoreInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineGroupRestores(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1beta1().VirtualMachineExports(namespace)
 }
@@ -219,6 +241,10 @@ func (k kubevirtClient) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1
 	return k.migrationsClient
 }
 
+func (k kubevirtClient) IPPool(namespace string) ipamv1.IPPoolInterface {
+	return k.generatedKubeVirtClient.IpamV1alpha1().IPPools(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineClone(namespace string) clone.VirtualMachineCloneInterface {
 	return k.generatedKubeVirtClient.CloneV1beta1().VirtualMachineClones(namespace)
 }
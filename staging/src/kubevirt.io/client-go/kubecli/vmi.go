@@ -179,3 +179,19 @@ func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInte
 	queryParams.Add("tls", strconv.FormatBool(useTLS))
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vsock", queryParams)
 }
+
+func (v *vmis) Pcap(name string, options *v1.PcapOptions) (kvcorev1.StreamInterface, error) {
+	if options == nil || options.InterfaceName == "" {
+		return nil, fmt.Errorf("interface name is required but not provided")
+	}
+	queryParams := url.Values{}
+	queryParams.Add("interface", options.InterfaceName)
+	if options.Filter != "" {
+		queryParams.Add("filter", options.Filter)
+	}
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "pcap", queryParams)
+}
+
+func (v *vmis) NBD(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "nbd", url.Values{})
+}
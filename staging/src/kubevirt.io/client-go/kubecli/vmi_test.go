@@ -449,6 +449,31 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should check migration compatibility via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		migrationCheck := v1.VirtualMachineInstanceMigrationCheck{
+			Migratable: true,
+			Nodes: []v1.NodeMigrationCheck{
+				{Name: "node01", Compatible: true},
+				{Name: "node02", Compatible: false, Reasons: []string{"node is cordoned"}},
+			},
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "migrate-check")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, migrationCheck),
+		))
+		fetchedCheck, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).MigrateCheck(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred(), "should fetch the migration check normally")
+		Expect(fetchedCheck).To(Equal(migrationCheck), "fetched migration check should be the same as passed in")
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch SEV platform info via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -34,6 +34,7 @@ import (
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	ipamv1alpha1 "kubevirt.io/api/ipam/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -52,6 +53,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	instancetypev1alpha1.AddToScheme,
 	instancetypev1alpha2.AddToScheme,
 	instancetypev1beta1.AddToScheme,
+	ipamv1alpha1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
@@ -132,6 +132,14 @@ func (c *FakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOption
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) Pcap(name string, options *v1.PcapOptions) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
+func (c *FakeVirtualMachineInstances) NBD(name string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "sev/fetchcertchain", name), &v1.SEVPlatformInfo{})
@@ -159,3 +167,20 @@ func (c *FakeVirtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context,
 
 	return err
 }
+
+func (c *FakeVirtualMachineInstances) Backup(ctx context.Context, name string, backupOptions *v1.VirtualMachineInstanceBackupOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "backup", name, backupOptions), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) MigrateCheck(ctx context.Context, name string) (v1.VirtualMachineInstanceMigrationCheck, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "migrate-check", name), &v1.VirtualMachineInstanceMigrationCheck{})
+
+	if obj == nil {
+		return v1.VirtualMachineInstanceMigrationCheck{}, err
+	}
+	return *obj.(*v1.VirtualMachineInstanceMigrationCheck), err
+}
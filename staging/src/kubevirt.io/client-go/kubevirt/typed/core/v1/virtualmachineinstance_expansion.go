@@ -55,10 +55,14 @@ type VirtualMachineInstanceExpansion interface {
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
+	Pcap(name string, options *v1.PcapOptions) (StreamInterface, error)
+	NBD(name string) (StreamInterface, error)
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(ctx context.Context, name string, sevSessionOptions *v1.SEVSessionOptions) error
 	SEVInjectLaunchSecret(ctx context.Context, name string, sevSecretOptions *v1.SEVSecretOptions) error
+	Backup(ctx context.Context, name string, backupOptions *v1.VirtualMachineInstanceBackupOptions) error
+	MigrateCheck(ctx context.Context, name string) (v1.VirtualMachineInstanceMigrationCheck, error)
 }
 
 func (c *virtualMachineInstances) SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error) {
@@ -315,6 +319,18 @@ func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (
 	return nil, fmt.Errorf("VSOCK is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) Pcap(name string, options *v1.PcapOptions) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("Pcap is not implemented yet in generated client")
+}
+
+func (c *virtualMachineInstances) NBD(name string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("NBD is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error) {
 	sevPlatformInfo := v1.SEVPlatformInfo{}
 	err := c.GetClient().Get().
@@ -375,3 +391,33 @@ func (c *virtualMachineInstances) SEVInjectLaunchSecret(ctx context.Context, nam
 		Do(context.Background()).
 		Error()
 }
+
+func (c *virtualMachineInstances) Backup(ctx context.Context, name string, backupOptions *v1.VirtualMachineInstanceBackupOptions) error {
+	body, err := json.Marshal(backupOptions)
+	if err != nil {
+		return fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("backup").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) MigrateCheck(ctx context.Context, name string) (v1.VirtualMachineInstanceMigrationCheck, error) {
+	migrationCheck := v1.VirtualMachineInstanceMigrationCheck{}
+	err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("migrate-check").
+		Do(ctx).
+		Into(&migrationCheck)
+
+	return migrationCheck, err
+}
@@ -0,0 +1,149 @@
+/*
+This file is part of the KubeVirt project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+Copyright The KubeVirt Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// FakeVirtualMachineGroupRestores implements VirtualMachineGroupRestoreInterface
+type FakeVirtualMachineGroupRestores struct {
+	Fake *FakeSnapshotV1beta1
+	ns   string
+}
+
+var virtualmachinegrouprestoresResource = v1beta1.SchemeGroupVersion.WithResource("virtualmachinegrouprestores")
+
+var virtualmachinegrouprestoresKind = v1beta1.SchemeGroupVersion.WithKind("VirtualMachineGroupRestore")
+
+// Get takes name of the virtualMachineGroupRestore, and returns the corresponding virtualMachineGroupRestore object, and an error if there is any.
+func (c *FakeVirtualMachineGroupRestores) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineGroupRestore, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupRestore{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinegrouprestoresResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupRestore), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineGroupRestores that match those selectors.
+func (c *FakeVirtualMachineGroupRestores) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineGroupRestoreList, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupRestoreList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinegrouprestoresResource, virtualmachinegrouprestoresKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineGroupRestoreList{ListMeta: obj.(*v1beta1.VirtualMachineGroupRestoreList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineGroupRestoreList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineGroupRestores.
+func (c *FakeVirtualMachineGroupRestores) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinegrouprestoresResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineGroupRestore and creates it.  Returns the server's representation of the virtualMachineGroupRestore, and an error, if there is any.
+func (c *FakeVirtualMachineGroupRestores) Create(ctx context.Context, virtualMachineGroupRestore *v1beta1.VirtualMachineGroupRestore, opts v1.CreateOptions) (result *v1beta1.VirtualMachineGroupRestore, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupRestore{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinegrouprestoresResource, c.ns, virtualMachineGroupRestore, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupRestore), err
+}
+
+// Update takes the representation of a virtualMachineGroupRestore and updates it. Returns the server's representation of the virtualMachineGroupRestore, and an error, if there is any.
+func (c *FakeVirtualMachineGroupRestores) Update(ctx context.Context, virtualMachineGroupRestore *v1beta1.VirtualMachineGroupRestore, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineGroupRestore, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupRestore{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinegrouprestoresResource, c.ns, virtualMachineGroupRestore, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupRestore), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineGroupRestores) UpdateStatus(ctx context.Context, virtualMachineGroupRestore *v1beta1.VirtualMachineGroupRestore, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineGroupRestore, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupRestore{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(virtualmachinegrouprestoresResource, "status", c.ns, virtualMachineGroupRestore, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupRestore), err
+}
+
+// Delete takes name of the virtualMachineGroupRestore and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineGroupRestores) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinegrouprestoresResource, c.ns, name, opts), &v1beta1.VirtualMachineGroupRestore{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineGroupRestores) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinegrouprestoresResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineGroupRestoreList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineGroupRestore.
+func (c *FakeVirtualMachineGroupRestores) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineGroupRestore, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupRestore{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinegrouprestoresResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupRestore), err
+}
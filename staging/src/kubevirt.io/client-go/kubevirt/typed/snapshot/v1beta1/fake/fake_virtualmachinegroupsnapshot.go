@@ -0,0 +1,149 @@
+/*
+This file is part of the KubeVirt project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+Copyright The KubeVirt Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// FakeVirtualMachineGroupSnapshots implements VirtualMachineGroupSnapshotInterface
+type FakeVirtualMachineGroupSnapshots struct {
+	Fake *FakeSnapshotV1beta1
+	ns   string
+}
+
+var virtualmachinegroupsnapshotsResource = v1beta1.SchemeGroupVersion.WithResource("virtualmachinegroupsnapshots")
+
+var virtualmachinegroupsnapshotsKind = v1beta1.SchemeGroupVersion.WithKind("VirtualMachineGroupSnapshot")
+
+// Get takes name of the virtualMachineGroupSnapshot, and returns the corresponding virtualMachineGroupSnapshot object, and an error if there is any.
+func (c *FakeVirtualMachineGroupSnapshots) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineGroupSnapshot, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupSnapshot{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupSnapshot), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineGroupSnapshots that match those selectors.
+func (c *FakeVirtualMachineGroupSnapshots) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineGroupSnapshotList, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupSnapshotList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinegroupsnapshotsResource, virtualmachinegroupsnapshotsKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineGroupSnapshotList{ListMeta: obj.(*v1beta1.VirtualMachineGroupSnapshotList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineGroupSnapshotList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineGroupSnapshots.
+func (c *FakeVirtualMachineGroupSnapshots) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineGroupSnapshot and creates it.  Returns the server's representation of the virtualMachineGroupSnapshot, and an error, if there is any.
+func (c *FakeVirtualMachineGroupSnapshots) Create(ctx context.Context, virtualMachineGroupSnapshot *v1beta1.VirtualMachineGroupSnapshot, opts v1.CreateOptions) (result *v1beta1.VirtualMachineGroupSnapshot, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupSnapshot{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, virtualMachineGroupSnapshot, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupSnapshot), err
+}
+
+// Update takes the representation of a virtualMachineGroupSnapshot and updates it. Returns the server's representation of the virtualMachineGroupSnapshot, and an error, if there is any.
+func (c *FakeVirtualMachineGroupSnapshots) Update(ctx context.Context, virtualMachineGroupSnapshot *v1beta1.VirtualMachineGroupSnapshot, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineGroupSnapshot, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupSnapshot{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, virtualMachineGroupSnapshot, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupSnapshot), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineGroupSnapshots) UpdateStatus(ctx context.Context, virtualMachineGroupSnapshot *v1beta1.VirtualMachineGroupSnapshot, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineGroupSnapshot, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupSnapshot{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(virtualmachinegroupsnapshotsResource, "status", c.ns, virtualMachineGroupSnapshot, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupSnapshot), err
+}
+
+// Delete takes name of the virtualMachineGroupSnapshot and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineGroupSnapshots) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, name, opts), &v1beta1.VirtualMachineGroupSnapshot{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineGroupSnapshots) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineGroupSnapshotList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineGroupSnapshot.
+func (c *FakeVirtualMachineGroupSnapshots) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineGroupSnapshot, err error) {
+	emptyResult := &v1beta1.VirtualMachineGroupSnapshot{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinegroupsnapshotsResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineGroupSnapshot), err
+}
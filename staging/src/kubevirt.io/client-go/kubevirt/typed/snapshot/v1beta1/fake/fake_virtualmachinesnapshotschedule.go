@@ -0,0 +1,149 @@
+/*
+This file is part of the KubeVirt project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+Copyright The KubeVirt Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+// FakeVirtualMachineSnapshotSchedules implements VirtualMachineSnapshotScheduleInterface
+type FakeVirtualMachineSnapshotSchedules struct {
+	Fake *FakeSnapshotV1beta1
+	ns   string
+}
+
+var virtualmachinesnapshotschedulesResource = v1beta1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+
+var virtualmachinesnapshotschedulesKind = v1beta1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotSchedule")
+
+// Get takes name of the virtualMachineSnapshotSchedule, and returns the corresponding virtualMachineSnapshotSchedule object, and an error if there is any.
+func (c *FakeVirtualMachineSnapshotSchedules) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.VirtualMachineSnapshotSchedule, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotSchedule{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, name, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotSchedule), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineSnapshotSchedules that match those selectors.
+func (c *FakeVirtualMachineSnapshotSchedules) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.VirtualMachineSnapshotScheduleList, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotScheduleList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewListActionWithOptions(virtualmachinesnapshotschedulesResource, virtualmachinesnapshotschedulesKind, c.ns, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VirtualMachineSnapshotScheduleList{ListMeta: obj.(*v1beta1.VirtualMachineSnapshotScheduleList).ListMeta}
+	for _, item := range obj.(*v1beta1.VirtualMachineSnapshotScheduleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineSnapshotSchedules.
+func (c *FakeVirtualMachineSnapshotSchedules) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineSnapshotSchedule and creates it.  Returns the server's representation of the virtualMachineSnapshotSchedule, and an error, if there is any.
+func (c *FakeVirtualMachineSnapshotSchedules) Create(ctx context.Context, virtualMachineSnapshotSchedule *v1beta1.VirtualMachineSnapshotSchedule, opts v1.CreateOptions) (result *v1beta1.VirtualMachineSnapshotSchedule, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotSchedule{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, virtualMachineSnapshotSchedule, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotSchedule), err
+}
+
+// Update takes the representation of a virtualMachineSnapshotSchedule and updates it. Returns the server's representation of the virtualMachineSnapshotSchedule, and an error, if there is any.
+func (c *FakeVirtualMachineSnapshotSchedules) Update(ctx context.Context, virtualMachineSnapshotSchedule *v1beta1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineSnapshotSchedule, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotSchedule{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, virtualMachineSnapshotSchedule, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotSchedule), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineSnapshotSchedules) UpdateStatus(ctx context.Context, virtualMachineSnapshotSchedule *v1beta1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (result *v1beta1.VirtualMachineSnapshotSchedule, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotSchedule{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(virtualmachinesnapshotschedulesResource, "status", c.ns, virtualMachineSnapshotSchedule, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotSchedule), err
+}
+
+// Delete takes name of the virtualMachineSnapshotSchedule and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineSnapshotSchedules) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, name, opts), &v1beta1.VirtualMachineSnapshotSchedule{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineSnapshotSchedules) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VirtualMachineSnapshotScheduleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineSnapshotSchedule.
+func (c *FakeVirtualMachineSnapshotSchedules) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineSnapshotSchedule, err error) {
+	emptyResult := &v1beta1.VirtualMachineSnapshotSchedule{}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceActionWithOptions(virtualmachinesnapshotschedulesResource, c.ns, name, pt, data, opts, subresources...), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.VirtualMachineSnapshotSchedule), err
+}
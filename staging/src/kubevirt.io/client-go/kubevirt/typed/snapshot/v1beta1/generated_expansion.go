@@ -20,8 +20,16 @@ Copyright The KubeVirt Authors.
 
 package v1beta1
 
+type VirtualMachineBackupExpansion interface{}
+
+type VirtualMachineGroupRestoreExpansion interface{}
+
+type VirtualMachineGroupSnapshotExpansion interface{}
+
 type VirtualMachineRestoreExpansion interface{}
 
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
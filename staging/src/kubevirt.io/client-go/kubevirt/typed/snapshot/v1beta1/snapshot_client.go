@@ -30,9 +30,13 @@ import (
 
 type SnapshotV1beta1Interface interface {
 	RESTClient() rest.Interface
+	VirtualMachineBackupsGetter
+	VirtualMachineGroupRestoresGetter
+	VirtualMachineGroupSnapshotsGetter
 	VirtualMachineRestoresGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotSchedulesGetter
 }
 
 // SnapshotV1beta1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -40,6 +44,18 @@ type SnapshotV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineBackups(namespace string) VirtualMachineBackupInterface {
+	return newVirtualMachineBackups(c, namespace)
+}
+
+func (c *SnapshotV1beta1Client) VirtualMachineGroupRestores(namespace string) VirtualMachineGroupRestoreInterface {
+	return newVirtualMachineGroupRestores(c, namespace)
+}
+
+func (c *SnapshotV1beta1Client) VirtualMachineGroupSnapshots(namespace string) VirtualMachineGroupSnapshotInterface {
+	return newVirtualMachineGroupSnapshots(c, namespace)
+}
+
 func (c *SnapshotV1beta1Client) VirtualMachineRestores(namespace string) VirtualMachineRestoreInterface {
 	return newVirtualMachineRestores(c, namespace)
 }
@@ -52,6 +68,10 @@ func (c *SnapshotV1beta1Client) VirtualMachineSnapshotContents(namespace string)
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1beta1Client) VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface {
+	return newVirtualMachineSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
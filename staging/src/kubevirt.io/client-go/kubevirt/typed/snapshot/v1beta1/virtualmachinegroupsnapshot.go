@@ -0,0 +1,71 @@
+/*
+This file is part of the KubeVirt project
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+Copyright The KubeVirt Authors.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	v1beta1 "kubevirt.io/api/snapshot/v1beta1"
+	scheme "kubevirt.io/client-go/kubevirt/scheme"
+)
+
+// VirtualMachineGroupSnapshotsGetter has a method to return a VirtualMachineGroupSnapshotInterface.
+// A group's client should implement this interface.
+type VirtualMachineGroupSnapshotsGetter interface {
+	VirtualMachineGroupSnapshots(namespace string) VirtualMachineGroupSnapshotInterface
+}
+
+// VirtualMachineGroupSnapshotInterface has methods to work with VirtualMachineGroupSnapshot resources.
+type VirtualMachineGroupSnapshotInterface interface {
+	Create(ctx context.Context, virtualMachineGroupSnapshot *v1beta1.VirtualMachineGroupSnapshot, opts v1.CreateOptions) (*v1beta1.VirtualMachineGroupSnapshot, error)
+	Update(ctx context.Context, virtualMachineGroupSnapshot *v1beta1.VirtualMachineGroupSnapshot, opts v1.UpdateOptions) (*v1beta1.VirtualMachineGroupSnapshot, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, virtualMachineGroupSnapshot *v1beta1.VirtualMachineGroupSnapshot, opts v1.UpdateOptions) (*v1beta1.VirtualMachineGroupSnapshot, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.VirtualMachineGroupSnapshot, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.VirtualMachineGroupSnapshotList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.VirtualMachineGroupSnapshot, err error)
+	VirtualMachineGroupSnapshotExpansion
+}
+
+// virtualMachineGroupSnapshots implements VirtualMachineGroupSnapshotInterface
+type virtualMachineGroupSnapshots struct {
+	*gentype.ClientWithList[*v1beta1.VirtualMachineGroupSnapshot, *v1beta1.VirtualMachineGroupSnapshotList]
+}
+
+// newVirtualMachineGroupSnapshots returns a VirtualMachineGroupSnapshots
+func newVirtualMachineGroupSnapshots(c *SnapshotV1beta1Client, namespace string) *virtualMachineGroupSnapshots {
+	return &virtualMachineGroupSnapshots{
+		gentype.NewClientWithList[*v1beta1.VirtualMachineGroupSnapshot, *v1beta1.VirtualMachineGroupSnapshotList](
+			"virtualmachinegroupsnapshots",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *v1beta1.VirtualMachineGroupSnapshot { return &v1beta1.VirtualMachineGroupSnapshot{} },
+			func() *v1beta1.VirtualMachineGroupSnapshotList { return &v1beta1.VirtualMachineGroupSnapshotList{} }),
+	}
+}
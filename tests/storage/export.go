@@ -1325,11 +1325,11 @@ var _ = Describe(SIG("Export", func() {
 		Expect(vmExport.Status.Links).ToNot(BeNil())
 		Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 		Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+		var volumeFormats []exportv1.VirtualMachineExportVolumeFormat
 		for _, volume := range vmExport.Status.Links.Internal.Volumes {
-			Expect(volume.Formats).To(HaveLen(2))
-			Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
+			volumeFormats = append(volumeFormats, volume.Formats...)
 		}
+		Expect(volumeFormats).To(ConsistOf(expectedVolumeFormats))
 	}
 
 	verifyMultiKubevirtInternal := func(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
@@ -1345,6 +1345,10 @@ var _ = Describe(SIG("Export", func() {
 					Format: exportv1.KubeVirtGz,
 					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 				},
+				exportv1.VirtualMachineExportVolumeFormat{
+					Format: exportv1.KubeVirtQcow2,
+					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+				},
 			)
 		}
 
@@ -1360,6 +1364,10 @@ var _ = Describe(SIG("Export", func() {
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 			})
 	}
 